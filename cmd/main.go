@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	"go-issue-tracker/pkg/domain"
 	"go-issue-tracker/pkg/infrastructure/database"
@@ -12,10 +13,14 @@ import (
 	"go-issue-tracker/pkg/interfaces/grpc"
 	"go-issue-tracker/pkg/interfaces/persistence"
 	"go-issue-tracker/pkg/interfaces/rest"
+	"go-issue-tracker/pkg/interfaces/smtp"
+	"go-issue-tracker/pkg/interfaces/webhook"
 	"go-issue-tracker/pkg/usecases"
 	"log"
 	"net/http"
+	"os"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -27,8 +32,52 @@ var GRPCEndpointAddress = "0.0.0.0:3002"
 
 func main() {
 	grpcStatus := flag.Bool("grpc", false, "Use gRPC Color Service")
+	slaCheckInterval := flag.Duration("sla-check-interval", time.Minute, "Interval of checking issues breaching SLA, 0 disables check")
+	migratePriorityLabels := flag.Bool("migrate-priority-labels", false, "Migrate priority:<level> labels to issue priority and exit")
+	priorityLevels := flag.String("priority-levels", strings.Join(domain.PriorityLevels, ","), "Comma separated names of issue priority levels from lowest to highest, first one is issue without priority")
+	severityLevels := flag.String("severity-levels", strings.Join(domain.SeverityLevels, ","), "Comma separated names of issue severity levels from lowest to highest, first one is issue without severity")
+	maxAttachmentSize := flag.Int64("max-attachment-size", domain.MaxAttachmentSize, "Max. size of attachment file in bytes")
+	smtpAddress := flag.String("smtp-address", "", "Address of SMTP server as host:port, empty disables sending of emails")
+	smtpUsername := flag.String("smtp-username", "", "Username for SMTP server, empty disables authentication")
+	smtpPassword := flag.String("smtp-password", "", "Password for SMTP server")
+	mailFrom := flag.String("mail-from", "issue-tracker@localhost", "Sender address of emails")
+	mailInterval := flag.Duration("mail-interval", time.Minute, "Interval of queueing and sending emails, 0 disables emails")
+	eventInterval := flag.Duration("event-interval", time.Second, "Interval of passing outbox events to asynchronous subscribers, 0 disables asynchronous subscribers")
+	webhookInterval := flag.Duration("webhook-interval", time.Minute, "Interval of sending queued webhook deliveries, 0 disables webhooks")
+	jobInterval := flag.Duration("job-interval", time.Minute, "Interval of running due scheduled jobs, 0 disables scheduled jobs")
+	baseURL := flag.String("base-url", domain.MailBaseURL, "Base URL of UI used in links of emails")
+	graphqlMaxDepth := flag.Int("graphql-max-depth", gql.MaxDepth, "Max. depth of GraphQL queries, 0 disables limit")
+	graphqlMaxComplexity := flag.Int("graphql-max-complexity", gql.MaxComplexity, "Max. cost of GraphQL queries, 0 disables limit")
+	graphqlClientBudget := flag.Int("graphql-client-budget", gql.ClientBudget, "Cost of GraphQL queries available to client per minute, 0 disables budgets")
+	graphqlMaxPageSize := flag.Int("graphql-max-page-size", gql.MaxPageSize, "Max. first or last argument of GraphQL connections, 0 disables limit")
+	graphqlMaxBatchSize := flag.Int("graphql-max-batch-size", gql.MaxBatchSize, "Max. number of GraphQL requests in batch, 0 disables limit")
+	graphqlFieldWeights := flag.String("graphql-field-weights", "", "Comma separated Type.field:weight costs of GraphQL fields, e.g. Query.issues:10")
+	trustedProxies := flag.String("trusted-proxies", "", "Comma separated CIDR ranges of proxies whose X-Forwarded-For header is trusted, empty uses address of connection as client address")
+	graphqlSubscriptionOrigins := flag.String("graphql-subscription-origins", "", "Comma separated origins allowed to open GraphQL subscriptions, e.g. http://localhost:3000, empty allows only origin of server")
+	graphqlPersistedQueries := flag.String("graphql-persisted-queries", "", "Directory of allow-listed GraphQL queries in .graphql files, empty disables loading")
+	graphqlStrictPersistedQueries := flag.Bool("graphql-strict-persisted-queries", false, "Reject GraphQL queries which are not allow-listed")
 	flag.Parse()
 
+	if err := domain.SetPriorityLevels(*priorityLevels); err != nil {
+		log.Fatal(err)
+	}
+	if err := domain.SetSeverityLevels(*severityLevels); err != nil {
+		log.Fatal(err)
+	}
+	domain.MaxAttachmentSize = *maxAttachmentSize
+	domain.MailBaseURL = *baseURL
+	gql.MaxDepth = *graphqlMaxDepth
+	gql.MaxComplexity = *graphqlMaxComplexity
+	gql.ClientBudget = *graphqlClientBudget
+	gql.MaxPageSize = *graphqlMaxPageSize
+	gql.MaxBatchSize = *graphqlMaxBatchSize
+	if err := gql.SetFieldWeights(*graphqlFieldWeights); err != nil {
+		log.Fatal(err)
+	}
+	if *graphqlSubscriptionOrigins != "" {
+		gql.SubscriptionOrigins = strings.Split(*graphqlSubscriptionOrigins, ",")
+	}
+
 	// Get db path
 	dbPath, err := database.GetDefaultSQLiteDBFilePath()
 	if err != nil {
@@ -42,15 +91,137 @@ func main() {
 	}
 	defer db.Close()
 
+	// Get attachments dir path
+	attachmentsDirPath, err := database.GetDefaultAttachmentsDirPath()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	// SQLite repositories
-	ir := persistence.NewSQLiteIssueRepository(db)
+	ir := persistence.NewSQLiteIssueRepository(db, attachmentsDirPath)
 	lr := persistence.NewSQLiteLabelRepository(db)
-	pr := persistence.NewSQLiteProjectRepository(db)
+	pr := persistence.NewSQLiteProjectRepository(db, attachmentsDirPath)
+	nr := persistence.NewSQLiteNotificationRepository(db)
+	mr := persistence.NewSQLiteMailRepository(db)
+	wr := persistence.NewSQLiteWebhookRepository(db)
+	er := persistence.NewSQLiteEventRepository(db)
+	ar := persistence.NewSQLiteAutomationRepository(db)
+	jr := persistence.NewSQLiteJobRepository(db)
+
+	// SMTP mail sender
+	var ms domain.MailSender
+	if *smtpAddress != "" {
+		ms = smtp.NewMailSender(*smtpAddress, *smtpUsername, *smtpPassword, *mailFrom)
+	}
+
+	// HTTP webhook sender
+	ws := webhook.NewSender(&http.Client{
+		Timeout: 10 * time.Second,
+	})
 
 	// Use Cases
-	iuc := usecases.NewIssueUseCase(ir)
-	luc := usecases.NewLabelUseCase(lr)
-	puc := usecases.NewProjectUseCase(pr)
+	nuc := usecases.NewNotificationUseCase(nr)
+	euc := usecases.NewEventUseCase(er)
+	wuc := usecases.NewWebhookUseCase(wr, ws)
+	iuc := usecases.NewIssueUseCase(ir, nuc, euc)
+	luc := usecases.NewLabelUseCase(lr, euc)
+	puc := usecases.NewProjectUseCase(pr, nuc, euc)
+	muc := usecases.NewMailUseCase(mr, ms)
+	auc := usecases.NewAutomationUseCase(ar, iuc, luc, puc, nuc)
+	juc := usecases.NewJobUseCase(jr, getJobOwner(), iuc, luc, puc)
+	suc := usecases.NewStreamUseCase()
+
+	if *migratePriorityLabels == true {
+		// Migrate priority labels to issue priority
+		migrated, err := iuc.MigratePriorityLabels()
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Migrated priority labels of %d issues", migrated)
+		return
+	}
+
+	if *slaCheckInterval > 0 {
+		// Background check of issues breaching SLA
+		go func() {
+			ticker := time.NewTicker(*slaCheckInterval)
+			defer ticker.Stop()
+			for now := range ticker.C {
+				if _, err := iuc.CheckSLA(now); err != nil {
+					log.Printf("SLA check failed: %v", err)
+				}
+			}
+		}()
+	}
+
+	if *mailInterval > 0 {
+		// Background queueing of notification emails and sending of queued emails
+		go func() {
+			ticker := time.NewTicker(*mailInterval)
+			defer ticker.Stop()
+			for now := range ticker.C {
+				if _, err := muc.QueueNotifications(now); err != nil {
+					log.Printf("Mail queueing failed: %v", err)
+				}
+				if ms == nil {
+					continue
+				}
+				if _, err := muc.ProcessQueue(now); err != nil {
+					log.Printf("Mail sending failed: %v", err)
+				}
+			}
+		}()
+	}
+
+	// Event subscribers, automation rules are applied synchronously, so their changes are visible
+	// in response to change which triggered them. Event stream is subscribed first, so it gets event
+	// before events published by rules it triggered
+	euc.Subscribe(suc.HandleEvent)
+	euc.Subscribe(auc.HandleEvent, domain.AutomationTriggers...)
+	if err := euc.SubscribeAsync("webhooks", wuc.HandleEvent); err != nil {
+		log.Fatal(err)
+	}
+
+	if *eventInterval > 0 {
+		// Background passing of outbox events to asynchronous subscribers, events left in outbox
+		// by previous run are passed too
+		go func() {
+			ticker := time.NewTicker(*eventInterval)
+			defer ticker.Stop()
+			for now := range ticker.C {
+				if _, err := euc.ProcessOutbox(now); err != nil {
+					log.Printf("Event handling failed: %v", err)
+				}
+			}
+		}()
+	}
+
+	if *webhookInterval > 0 {
+		// Background sending of queued webhook deliveries
+		go func() {
+			ticker := time.NewTicker(*webhookInterval)
+			defer ticker.Stop()
+			for now := range ticker.C {
+				if _, err := wuc.ProcessQueue(now); err != nil {
+					log.Printf("Webhook delivery failed: %v", err)
+				}
+			}
+		}()
+	}
+
+	if *jobInterval > 0 {
+		// Background running of due scheduled jobs, jobs are locked in database, so each due job
+		// runs once when several server instances share database
+		go func() {
+			ticker := time.NewTicker(*jobInterval)
+			defer ticker.Stop()
+			for now := range ticker.C {
+				if _, err := juc.RunDue(now); err != nil {
+					log.Printf("Job run failed: %v", err)
+				}
+			}
+		}()
+	}
 
 	var cr domain.ColorRepository
 	if *grpcStatus == true {
@@ -70,12 +241,15 @@ func main() {
 
 	// Prepare HTTP Server
 	httpServer := rest.PrepareServer()
+	if httpServer.IPExtractor, err = rest.GetIPExtractor(*trustedProxies); err != nil {
+		log.Fatal(err)
+	}
 
 	// Preparing mock endpoint to simulate External Api
 	externalapimock.PrepareEndpoints(httpServer)
 
 	// REST
-	restManager := rest.NewManager(iuc, luc, puc, cuc)
+	restManager := rest.NewManager(iuc, luc, puc, cuc, nuc, muc, wuc, auc, juc, suc)
 	rootDirPath, err := helpers.GetProjectDirPath()
 	uiDirPath := filepath.Join(rootDirPath, "ui")
 	if err != nil {
@@ -84,10 +258,28 @@ func main() {
 	rest.PrepareEndpoints(httpServer, restManager, uiDirPath)
 
 	// GraphQL
-	gqlSchema := gql.PrepareGraphQL(iuc, luc, puc, cuc)
-	gqlManager := gql.NewRequestManager(gqlSchema)
+	gqlSchema := gql.PrepareGraphQL(iuc, luc, puc, cuc, nuc, muc, wuc, auc)
+	gqlQueries := gql.NewPersistedQueryRegistry(*graphqlStrictPersistedQueries)
+	if *graphqlPersistedQueries != "" {
+		// Allow-listed queries, clients send only their hashes
+		count, err := gqlQueries.LoadDir(*graphqlPersistedQueries)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Loaded %d persisted GraphQL queries", count)
+	}
+	gqlManager := gql.NewRequestManager(gqlSchema, suc, gqlQueries)
 	gql.PrepareEndpoints(httpServer, gqlManager)
 
 	// Start HTTP server
 	httpServer.Logger.Fatal(httpServer.Start(EndpointBaseAddress))
 }
+
+// getJobOwner to get owner of job locks unique for server instance
+func getJobOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AutomationTriggers are events of issue which can trigger automation rule
+var AutomationTriggers = []string{
+	EventIssueCreated, EventIssueUpdated, EventIssueStatusChanged, EventLabelsChanged, EventIssueMoved,
+}
+
+const (
+	// AutomationConditionLabel matches issue with label
+	AutomationConditionLabel = "label"
+	// AutomationConditionNotLabel matches issue without label
+	AutomationConditionNotLabel = "notLabel"
+	// AutomationConditionAddedLabel matches LabelsChanged event which added label
+	AutomationConditionAddedLabel = "addedLabel"
+	// AutomationConditionRemovedLabel matches LabelsChanged event which removed label
+	AutomationConditionRemovedLabel = "removedLabel"
+	// AutomationConditionStatus matches issue with status
+	AutomationConditionStatus = "status"
+	// AutomationConditionPreviousStatus matches IssueStatusChanged event from status
+	AutomationConditionPreviousStatus = "previousStatus"
+	// AutomationConditionMinPriority matches issue with priority at least provided level
+	AutomationConditionMinPriority = "minPriority"
+	// AutomationConditionMinSeverity matches issue with severity at least provided level
+	AutomationConditionMinSeverity = "minSeverity"
+	// AutomationConditionTitle matches issue with title containing text, case is ignored
+	AutomationConditionTitle = "title"
+)
+
+const (
+	// AutomationActionSetStatus sets status of issue
+	AutomationActionSetStatus = "setStatus"
+	// AutomationActionSetPriority sets priority of issue
+	AutomationActionSetPriority = "setPriority"
+	// AutomationActionSetSeverity sets severity of issue
+	AutomationActionSetSeverity = "setSeverity"
+	// AutomationActionAddLabel adds global or project label to issue
+	AutomationActionAddLabel = "addLabel"
+	// AutomationActionRemoveLabel removes label from issue
+	AutomationActionRemoveLabel = "removeLabel"
+	// AutomationActionMoveProject moves issue to project with ID
+	AutomationActionMoveProject = "moveProject"
+	// AutomationActionComment notifies watchers of issue with comment, comment can't contain comma
+	AutomationActionComment = "comment"
+)
+
+const (
+	// AutomationExecutionApplied marks execution which applied actions of rule
+	AutomationExecutionApplied = "applied"
+	// AutomationExecutionSkipped marks execution stopped by loop protection
+	AutomationExecutionSkipped = "skipped"
+	// AutomationExecutionFailed marks execution which failed to apply actions of rule
+	AutomationExecutionFailed = "failed"
+)
+
+// AutomationMaxDepth is max. number of nested rule executions caused by changes of single issue
+const AutomationMaxDepth = 5
+
+// AutomationChain is chain of rule executions started by single change of issue, it is carried by events
+// published by changes which rules made, so concurrent changes of the same issue have separate chains
+type AutomationChain struct {
+	Depth int
+	Fired map[uint]bool
+}
+
+// NewAutomationChain to create chain of change which was not made by automation rule
+func NewAutomationChain() *AutomationChain {
+	return &AutomationChain{Fired: make(map[uint]bool)}
+}
+
+// Next to get chain of rules applied while event of chain is handled, fired rules are shared by whole chain
+func (c *AutomationChain) Next() *AutomationChain {
+	return &AutomationChain{Depth: c.Depth + 1, Fired: c.Fired}
+}
+
+// AutomationExecutionsLimit is max. number of executions in execution log of rule
+const AutomationExecutionsLimit = 50
+
+// AutomationRule entity applies actions to issue of project when trigger event matches all conditions,
+// conditions and actions are comma separated name:value pairs
+type AutomationRule struct {
+	ID         uint      `json:"id"`
+	ProjectID  uint      `json:"projectId"`
+	Name       string    `json:"name"`
+	Trigger    string    `json:"trigger"`
+	Conditions string    `json:"conditions"`
+	Actions    string    `json:"actions"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// AutomationCondition is condition of automation rule
+type AutomationCondition struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// AutomationAction is action of automation rule
+type AutomationAction struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// AutomationExecution entity is item of execution log of automation rule
+type AutomationExecution struct {
+	ID        uint      `json:"id"`
+	RuleID    uint      `json:"ruleId"`
+	IssueID   uint      `json:"issueId"`
+	Event     string    `json:"event"`
+	Status    string    `json:"status"`
+	Depth     int       `json:"depth"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// getAutomationPairs to split comma separated name:value pairs
+func getAutomationPairs(value string, kind string) ([][2]string, error) {
+	pairs := [][2]string{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			return pairs, NewValidationError("%s %s not valid", kind, item)
+		}
+		pairs = append(pairs, [2]string{strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])})
+	}
+	return pairs, nil
+}
+
+// GetConditions to parse and validate conditions of rule
+func (r AutomationRule) GetConditions() ([]AutomationCondition, error) {
+	conditions := []AutomationCondition{}
+	pairs, err := getAutomationPairs(r.Conditions, "condition")
+	if err != nil {
+		return conditions, err
+	}
+	for _, pair := range pairs {
+		condition := AutomationCondition{Name: pair[0], Value: pair[1]}
+		var err error
+		switch condition.Name {
+		case AutomationConditionLabel, AutomationConditionNotLabel, AutomationConditionAddedLabel,
+			AutomationConditionRemovedLabel, AutomationConditionTitle:
+		case AutomationConditionStatus, AutomationConditionPreviousStatus:
+			_, err = strconv.Atoi(condition.Value)
+		case AutomationConditionMinPriority:
+			_, err = ParsePriority(condition.Value)
+		case AutomationConditionMinSeverity:
+			_, err = ParseSeverity(condition.Value)
+		default:
+			err = NewValidationError("condition %s not valid", condition.Name)
+		}
+		if err != nil {
+			return conditions, err
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+// GetActions to parse and validate actions of rule
+func (r AutomationRule) GetActions() ([]AutomationAction, error) {
+	actions := []AutomationAction{}
+	pairs, err := getAutomationPairs(r.Actions, "action")
+	if err != nil {
+		return actions, err
+	}
+	for _, pair := range pairs {
+		action := AutomationAction{Name: pair[0], Value: pair[1]}
+		var err error
+		switch action.Name {
+		case AutomationActionAddLabel, AutomationActionRemoveLabel, AutomationActionComment:
+		case AutomationActionSetStatus, AutomationActionMoveProject:
+			_, err = strconv.Atoi(action.Value)
+		case AutomationActionSetPriority:
+			_, err = ParsePriority(action.Value)
+		case AutomationActionSetSeverity:
+			_, err = ParseSeverity(action.Value)
+		default:
+			err = NewValidationError("action %s not valid", action.Name)
+		}
+		if err != nil {
+			return actions, err
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// Matches to check whether rule is triggered by event and event matches all conditions of rule
+func (r AutomationRule) Matches(event DomainEvent) bool {
+	if !r.Active || r.Trigger != event.EventName() || r.ProjectID != event.EventProjectID() {
+		return false
+	}
+	conditions, err := r.GetConditions()
+	if err != nil {
+		return false
+	}
+	for _, condition := range conditions {
+		if !condition.Matches(event) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches to check whether event matches condition
+func (c AutomationCondition) Matches(event DomainEvent) bool {
+	issue, ok := GetEventIssue(event)
+	if !ok {
+		return false
+	}
+	switch c.Name {
+	case AutomationConditionLabel:
+		return hasLabel(issue.Labels, c.Value)
+	case AutomationConditionNotLabel:
+		return !hasLabel(issue.Labels, c.Value)
+	case AutomationConditionAddedLabel:
+		e, ok := event.(LabelsChanged)
+		return ok && hasLabel(e.Added, c.Value)
+	case AutomationConditionRemovedLabel:
+		e, ok := event.(LabelsChanged)
+		return ok && hasLabel(e.Removed, c.Value)
+	case AutomationConditionStatus:
+		return strconv.Itoa(issue.Status) == c.Value
+	case AutomationConditionPreviousStatus:
+		e, ok := event.(IssueStatusChanged)
+		return ok && strconv.Itoa(e.PreviousStatus) == c.Value
+	case AutomationConditionMinPriority:
+		priority, err := ParsePriority(c.Value)
+		return err == nil && issue.Priority >= priority
+	case AutomationConditionMinSeverity:
+		severity, err := ParseSeverity(c.Value)
+		return err == nil && issue.Severity >= severity
+	case AutomationConditionTitle:
+		return strings.Contains(strings.ToLower(issue.Title), strings.ToLower(c.Value))
+	}
+	return false
+}
+
+// hasLabel to check whether labels contain label with name
+func hasLabel(labels []Label, name string) bool {
+	for _, label := range labels {
+		if label.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAutomationTriggerValid to check if trigger is one of automation triggers
+func IsAutomationTriggerValid(trigger string) bool {
+	for _, item := range AutomationTriggers {
+		if item == trigger {
+			return true
+		}
+	}
+	return false
+}
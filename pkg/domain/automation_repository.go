@@ -0,0 +1,13 @@
+package domain
+
+// AutomationRepository repository
+type AutomationRepository interface {
+	Add(rule *AutomationRule) (*AutomationRule, error)
+	Update(rule AutomationRule) (AutomationRule, error)
+	FindByID(id uint) (AutomationRule, error)
+	Find(projectID uint) ([]AutomationRule, error)
+	FindActive(projectID uint, trigger string) ([]AutomationRule, error)
+	Remove(id uint) (bool, error)
+	AddExecution(execution *AutomationExecution) (*AutomationExecution, error)
+	FindExecutions(ruleID uint, limit int) ([]AutomationExecution, error)
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"strings"
+)
+
+// AutomationService interface
+type AutomationService interface {
+	Add(rule *AutomationRule) (*AutomationRule, error)
+	Update(rule AutomationRule) (AutomationRule, error)
+	FindByID(id uint) (AutomationRule, error)
+	Find(projectID uint) ([]AutomationRule, error)
+	FindMatching(event DomainEvent) ([]AutomationRule, error)
+	Remove(rule AutomationRule) (bool, error)
+	AddExecution(execution *AutomationExecution) (*AutomationExecution, error)
+	FindExecutions(ruleID uint) ([]AutomationExecution, error)
+}
+
+// automationService struct
+type automationService struct {
+	repository AutomationRepository
+}
+
+// GetDefaultAutomationService alias to newAutomationService
+var GetDefaultAutomationService = newAutomationService
+
+// ResetDefaultAutomationService to reset GetDefaultAutomationService value
+func ResetDefaultAutomationService() {
+	GetDefaultAutomationService = newAutomationService
+}
+
+// newAutomationService to create new AutomationService
+func newAutomationService(repository AutomationRepository) AutomationService {
+	return &automationService{
+		repository: repository,
+	}
+}
+
+// validate to check and normalize trigger, conditions and actions of rule
+func (s *automationService) validate(rule *AutomationRule) error {
+	if rule.ProjectID == 0 {
+		return NewValidationError("project not provided")
+	}
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" {
+		return NewValidationError("name not provided")
+	}
+	if !IsAutomationTriggerValid(rule.Trigger) {
+		return NewValidationError("trigger %s not valid", rule.Trigger)
+	}
+	conditions, err := rule.GetConditions()
+	if err != nil {
+		return err
+	}
+	actions, err := rule.GetActions()
+	if err != nil {
+		return err
+	}
+	if len(actions) == 0 {
+		return NewValidationError("actions not provided")
+	}
+
+	items := make([]string, len(conditions))
+	for i, condition := range conditions {
+		items[i] = condition.Name + ":" + condition.Value
+	}
+	rule.Conditions = strings.Join(items, ",")
+	items = make([]string, len(actions))
+	for i, action := range actions {
+		items[i] = action.Name + ":" + action.Value
+	}
+	rule.Actions = strings.Join(items, ",")
+	return nil
+}
+
+// Add to add new rule
+func (s *automationService) Add(rule *AutomationRule) (*AutomationRule, error) {
+	if err := s.validate(rule); err != nil {
+		return nil, err
+	}
+
+	item, err := s.repository.Add(rule)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Update to update rule
+func (s *automationService) Update(rule AutomationRule) (AutomationRule, error) {
+	if err := s.validate(&rule); err != nil {
+		return rule, err
+	}
+
+	item, err := s.repository.Update(rule)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindByID to find rule by ID
+func (s *automationService) FindByID(id uint) (AutomationRule, error) {
+	item, err := s.repository.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Find to find rules of project
+func (s *automationService) Find(projectID uint) ([]AutomationRule, error) {
+	items, err := s.repository.Find(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindMatching to find active rules of project of event which are triggered by event and whose
+// conditions match event
+func (s *automationService) FindMatching(event DomainEvent) ([]AutomationRule, error) {
+	items := []AutomationRule{}
+	if !IsAutomationTriggerValid(event.EventName()) {
+		return items, nil
+	}
+	rules, err := s.repository.FindActive(event.EventProjectID(), event.EventName())
+	if err != nil {
+		return items, err
+	}
+	for _, rule := range rules {
+		if rule.Matches(event) {
+			items = append(items, rule)
+		}
+	}
+	return items, nil
+}
+
+// Remove to remove rule with its execution log
+func (s *automationService) Remove(rule AutomationRule) (bool, error) {
+	status, err := s.repository.Remove(rule.ID)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// AddExecution to add execution to execution log of rule
+func (s *automationService) AddExecution(execution *AutomationExecution) (*AutomationExecution, error) {
+	item, err := s.repository.AddExecution(execution)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// FindExecutions to find execution log of rule, newest first
+func (s *automationService) FindExecutions(ruleID uint) ([]AutomationExecution, error) {
+	items, err := s.repository.FindExecutions(ruleID, AutomationExecutionsLimit)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
@@ -0,0 +1,126 @@
+package domain_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"testing"
+)
+
+func TestDomainAutomationResetDefaultAutomationService(t *testing.T) {
+	assert.NotNil(t, domain.GetDefaultAutomationService)
+
+	domain.GetDefaultAutomationService = nil
+	defer domain.ResetDefaultAutomationService()
+
+	assert.Nil(t, domain.GetDefaultAutomationService)
+
+	domain.ResetDefaultAutomationService()
+
+	assert.NotNil(t, domain.GetDefaultAutomationService)
+}
+
+func TestDomainAutomationAddAndUpdate(t *testing.T) {
+	r := domain.AutomationRule{ProjectID: 1, Name: "Security triage", Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Actions: "setPriority:high,addLabel:triage", Active: true}
+
+	m := new(dTesting.AutomationRepositoryMock)
+	m.On("Add", &r).Return(&domain.AutomationRule{ID: 1}, nil).Once()
+	m.On("Update", domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Security triage", Trigger: domain.EventIssueCreated, Conditions: "label:security", Actions: "addLabel:triage"}).Return(domain.AutomationRule{ID: 1}, errors.New("test error")).Once()
+
+	s := domain.GetDefaultAutomationService(m)
+
+	item, err := s.Add(&domain.AutomationRule{ProjectID: 1, Name: " Security triage ", Trigger: domain.EventLabelsChanged, Conditions: "addedLabel: security", Actions: "setPriority:high, addLabel:triage", Active: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	tests := []struct {
+		rule domain.AutomationRule
+		err  string
+	}{
+		{domain.AutomationRule{Name: "Triage", Trigger: domain.EventIssueCreated, Actions: "addLabel:triage"}, "project not provided"},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventIssueCreated, Actions: "addLabel:triage"}, "name not provided"},
+		{domain.AutomationRule{ProjectID: 1, Name: "Triage", Trigger: domain.EventProjectRemoved, Actions: "addLabel:triage"}, "trigger ProjectRemoved not valid"},
+		{domain.AutomationRule{ProjectID: 1, Name: "Triage", Trigger: domain.EventIssueCreated, Conditions: "assignee:alice", Actions: "addLabel:triage"}, "condition assignee not valid"},
+		{domain.AutomationRule{ProjectID: 1, Name: "Triage", Trigger: domain.EventIssueCreated, Actions: "notify:done"}, "action notify not valid"},
+		{domain.AutomationRule{ProjectID: 1, Name: "Triage", Trigger: domain.EventIssueCreated}, "actions not provided"},
+	}
+
+	for _, ts := range tests {
+		rule := ts.rule
+		item, err := s.Add(&rule)
+
+		assert.Nil(t, item)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	_, err = s.Update(domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Security triage", Trigger: domain.EventIssueCreated, Conditions: "label:security", Actions: "addLabel:triage"})
+
+	assert.Equal(t, "test error", err.Error())
+
+	_, err = s.Update(domain.AutomationRule{ID: 1, ProjectID: 1, Trigger: domain.EventIssueCreated})
+
+	assert.Equal(t, "name not provided", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainAutomationFind(t *testing.T) {
+	security := domain.Label{ID: 1, Name: "security"}
+	i := domain.Issue{ID: 1, ProjectID: 1, Labels: []domain.Label{security}}
+	matching := domain.AutomationRule{ID: 1, ProjectID: 1, Trigger: domain.EventIssueCreated, Conditions: "label:security", Actions: "addLabel:triage", Active: true}
+	other := domain.AutomationRule{ID: 2, ProjectID: 1, Trigger: domain.EventIssueCreated, Conditions: "label:bug", Actions: "addLabel:triage", Active: true}
+
+	m := new(dTesting.AutomationRepositoryMock)
+	m.On("FindByID", uint(1)).Return(matching, nil)
+	m.On("Find", uint(1)).Return([]domain.AutomationRule{matching, other}, nil)
+	m.On("FindActive", uint(1), domain.EventIssueCreated).Return([]domain.AutomationRule{matching, other}, nil).Once()
+	m.On("FindActive", uint(1), domain.EventIssueCreated).Return([]domain.AutomationRule{}, errors.New("test error")).Once()
+	m.On("Remove", uint(1)).Return(true, nil)
+	m.On("AddExecution", &domain.AutomationExecution{RuleID: 1, IssueID: 1}).Return(&domain.AutomationExecution{ID: 1}, nil)
+	m.On("FindExecutions", uint(1), domain.AutomationExecutionsLimit).Return([]domain.AutomationExecution{{ID: 1}}, nil)
+
+	s := domain.GetDefaultAutomationService(m)
+
+	item, err := s.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, matching, item)
+
+	items, err := s.Find(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	items, err = s.FindMatching(domain.IssueCreated{Issue: i})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.AutomationRule{matching}, items)
+
+	items, err = s.FindMatching(domain.IssueRemoved{Issue: i})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(items))
+
+	_, err = s.FindMatching(domain.IssueCreated{Issue: i})
+
+	assert.Equal(t, "test error", err.Error())
+
+	status, err := s.Remove(matching)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	execution, err := s.AddExecution(&domain.AutomationExecution{RuleID: 1, IssueID: 1})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), execution.ID)
+
+	executions, err := s.FindExecutions(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(executions))
+
+	m.AssertExpectations(t)
+}
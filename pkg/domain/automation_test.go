@@ -0,0 +1,82 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+)
+
+func TestDomainAutomationGetConditionsAndActions(t *testing.T) {
+	r := domain.AutomationRule{
+		Conditions: " label:security, minPriority:high,previousStatus:1",
+		Actions:    "setPriority:urgent, addLabel:triage,moveProject:2,comment:Needs triage: see log",
+	}
+
+	conditions, err := r.GetConditions()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.AutomationCondition{{"label", "security"}, {"minPriority", "high"}, {"previousStatus", "1"}}, conditions)
+
+	actions, err := r.GetActions()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.AutomationAction{{"setPriority", "urgent"}, {"addLabel", "triage"}, {"moveProject", "2"}, {"comment", "Needs triage: see log"}}, actions)
+
+	tests := []struct {
+		rule domain.AutomationRule
+		err  string
+	}{
+		{domain.AutomationRule{Conditions: "label"}, "condition label not valid"},
+		{domain.AutomationRule{Conditions: "label:"}, "condition label: not valid"},
+		{domain.AutomationRule{Conditions: "assignee:alice"}, "condition assignee not valid"},
+		{domain.AutomationRule{Conditions: "status:open"}, "strconv.Atoi: parsing \"open\": invalid syntax"},
+		{domain.AutomationRule{Conditions: "minSeverity:huge"}, "severity huge not valid"},
+		{domain.AutomationRule{Actions: "close:now"}, "action close not valid"},
+		{domain.AutomationRule{Actions: "setPriority:huge"}, "priority huge not valid"},
+	}
+
+	for _, ts := range tests {
+		_, errConditions := ts.rule.GetConditions()
+		_, errActions := ts.rule.GetActions()
+
+		if errConditions != nil {
+			assert.Equal(t, ts.err, errConditions.Error())
+		} else {
+			assert.Equal(t, ts.err, errActions.Error())
+		}
+	}
+}
+
+func TestDomainAutomationMatches(t *testing.T) {
+	security := domain.Label{ID: 1, Name: "security"}
+	i := domain.Issue{ID: 1, Title: "Login Crash", Status: 2, Priority: domain.PriorityHigh, ProjectID: 1, Labels: []domain.Label{security}}
+
+	tests := []struct {
+		rule    domain.AutomationRule
+		event   domain.DomainEvent
+		matches bool
+	}{
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Active: true}, domain.LabelsChanged{Issue: i, Added: []domain.Label{security}}, true},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventLabelsChanged, Conditions: "removedLabel:security", Active: true}, domain.LabelsChanged{Issue: i, Added: []domain.Label{security}}, false},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Active: false}, domain.LabelsChanged{Issue: i, Added: []domain.Label{security}}, false},
+		{domain.AutomationRule{ProjectID: 2, Trigger: domain.EventIssueCreated, Active: true}, domain.IssueCreated{Issue: i}, false},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventIssueUpdated, Active: true}, domain.IssueCreated{Issue: i}, false},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventIssueCreated, Conditions: "label:security,notLabel:triage,title:crash,minPriority:medium", Active: true}, domain.IssueCreated{Issue: i}, true},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventIssueCreated, Conditions: "notLabel:security", Active: true}, domain.IssueCreated{Issue: i}, false},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventIssueCreated, Conditions: "minSeverity:major", Active: true}, domain.IssueCreated{Issue: i}, false},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventIssueStatusChanged, Conditions: "status:2,previousStatus:1", Active: true}, domain.IssueStatusChanged{Issue: i, PreviousStatus: 1}, true},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventIssueStatusChanged, Conditions: "previousStatus:3", Active: true}, domain.IssueStatusChanged{Issue: i, PreviousStatus: 1}, false},
+		{domain.AutomationRule{ProjectID: 1, Trigger: domain.EventIssueCreated, Conditions: "assignee:alice", Active: true}, domain.IssueCreated{Issue: i}, false},
+	}
+
+	for _, ts := range tests {
+		assert.Equal(t, ts.matches, ts.rule.Matches(ts.event))
+	}
+
+	assert.False(t, domain.AutomationCondition{Name: "label", Value: "security"}.Matches(domain.ProjectCreated{}))
+}
+
+func TestDomainAutomationIsAutomationTriggerValid(t *testing.T) {
+	assert.True(t, domain.IsAutomationTriggerValid(domain.EventLabelsChanged))
+	assert.False(t, domain.IsAutomationTriggerValid(domain.EventProjectRemoved))
+}
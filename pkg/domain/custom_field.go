@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Types of custom field values
+const (
+	CustomFieldTypeText        = "text"
+	CustomFieldTypeNumber      = "number"
+	CustomFieldTypeDate        = "date"
+	CustomFieldTypeSelect      = "select"
+	CustomFieldTypeMultiSelect = "multiselect"
+	CustomFieldTypeUser        = "user"
+)
+
+// CustomFieldDateFormat is format of date custom field values
+const CustomFieldDateFormat = "2006-01-02"
+
+// CustomField entity defines typed field of issues owned by project, options are comma separated
+// list of values available in select and multiselect fields, user fields hold username
+type CustomField struct {
+	ID        uint      `json:"id"`
+	ProjectID uint      `json:"projectId"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Options   string    `json:"options"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CustomFieldValue entity holds value of custom field assigned to issue, multiselect values are comma separated
+type CustomFieldValue struct {
+	ID      uint        `json:"id"`
+	IssueID uint        `json:"issueId"`
+	FieldID uint        `json:"fieldId"`
+	Field   CustomField `json:"field" gorm:"association_autoupdate:false;association_autocreate:false"`
+	Value   string      `json:"value"`
+}
+
+// CustomFieldFilter describes custom field value searched issues have,
+// multiselect fields match when searched value is one of selected options
+type CustomFieldFilter struct {
+	Field CustomField
+	Value string
+}
+
+// IsCustomFieldTypeValid to check if custom field type is supported
+func IsCustomFieldTypeValid(fieldType string) bool {
+	switch fieldType {
+	case CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeDate, CustomFieldTypeSelect, CustomFieldTypeMultiSelect, CustomFieldTypeUser:
+		return true
+	}
+	return false
+}
+
+// GetOptions to get list of options of select and multiselect field
+func (f CustomField) GetOptions() []string {
+	options := []string{}
+	for _, option := range strings.Split(f.Options, ",") {
+		if option = strings.TrimSpace(option); option != "" {
+			options = append(options, option)
+		}
+	}
+	return options
+}
+
+// hasOption to check if option is available in field
+func (f CustomField) hasOption(value string) bool {
+	for _, option := range f.GetOptions() {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate to validate value against field type, returns normalized value stored in CustomFieldValue
+func (f CustomField) Validate(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch f.Type {
+	case CustomFieldTypeText:
+		return value, nil
+	case CustomFieldTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return value, NewValidationError("%s value is not a number", f.Name)
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+	case CustomFieldTypeDate:
+		date, err := time.Parse(CustomFieldDateFormat, value)
+		if err != nil {
+			return value, NewValidationError("%s value is not a date in %s format", f.Name, CustomFieldDateFormat)
+		}
+		return date.Format(CustomFieldDateFormat), nil
+	case CustomFieldTypeSelect:
+		if !f.hasOption(value) {
+			return value, NewValidationError("%s value is not one of options", f.Name)
+		}
+		return value, nil
+	case CustomFieldTypeMultiSelect:
+		values := []string{}
+		selected := make(map[string]bool)
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v == "" || selected[v] {
+				continue
+			}
+			if !f.hasOption(v) {
+				return value, NewValidationError("%s value %s is not one of options", f.Name, v)
+			}
+			selected[v] = true
+			values = append(values, v)
+		}
+		if len(values) == 0 {
+			return value, NewValidationError("%s value not provided", f.Name)
+		}
+		return strings.Join(values, ","), nil
+	case CustomFieldTypeUser:
+		if value == "" || strings.ContainsAny(value, " \t\n,") {
+			return value, NewValidationError("%s value is not a username", f.Name)
+		}
+		return value, nil
+	}
+	return value, NewValidationError("custom field type not valid")
+}
@@ -0,0 +1,60 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+)
+
+func TestDomainCustomFieldValidate(t *testing.T) {
+	tests := []struct {
+		field    domain.CustomField
+		value    string
+		expected string
+	}{
+		{domain.CustomField{Type: domain.CustomFieldTypeText}, " ACME ", "ACME"},
+		{domain.CustomField{Type: domain.CustomFieldTypeNumber}, "3.50", "3.5"},
+		{domain.CustomField{Type: domain.CustomFieldTypeDate}, "2020-02-29", "2020-02-29"},
+		{domain.CustomField{Type: domain.CustomFieldTypeSelect, Options: "1.0, 1.1"}, "1.1", "1.1"},
+		{domain.CustomField{Type: domain.CustomFieldTypeMultiSelect, Options: "dev,staging,prod"}, "prod, dev,prod", "prod,dev"},
+		{domain.CustomField{Type: domain.CustomFieldTypeUser}, "jdoe", "jdoe"},
+	}
+
+	for _, ts := range tests {
+		value, err := ts.field.Validate(ts.value)
+
+		assert.Nil(t, err)
+		assert.Equal(t, ts.expected, value)
+	}
+}
+
+func TestDomainCustomFieldValidateErrs(t *testing.T) {
+	tests := []struct {
+		field domain.CustomField
+		value string
+		err   string
+	}{
+		{domain.CustomField{Name: "points", Type: domain.CustomFieldTypeNumber}, "x", "points value is not a number"},
+		{domain.CustomField{Name: "due", Type: domain.CustomFieldTypeDate}, "2020-02-30", "due value is not a date in 2006-01-02 format"},
+		{domain.CustomField{Name: "version", Type: domain.CustomFieldTypeSelect, Options: "1.0"}, "2.0", "version value is not one of options"},
+		{domain.CustomField{Name: "env", Type: domain.CustomFieldTypeMultiSelect, Options: "dev"}, "dev,prod", "env value prod is not one of options"},
+		{domain.CustomField{Name: "env", Type: domain.CustomFieldTypeMultiSelect, Options: "dev"}, " , ", "env value not provided"},
+		{domain.CustomField{Name: "owner", Type: domain.CustomFieldTypeUser}, "john doe", "owner value is not a username"},
+		{domain.CustomField{Name: "test", Type: "test"}, "x", "custom field type not valid"},
+	}
+
+	for _, ts := range tests {
+		_, err := ts.field.Validate(ts.value)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+}
+
+func TestDomainCustomFieldGetOptions(t *testing.T) {
+	f := domain.CustomField{Options: " dev, ,prod "}
+
+	assert.Equal(t, []string{"dev", "prod"}, f.GetOptions())
+	assert.True(t, domain.IsCustomFieldTypeValid(domain.CustomFieldTypeUser))
+	assert.False(t, domain.IsCustomFieldTypeValid("test"))
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error codes classifying errors for clients
+const (
+	ErrorCodeNotFound    = "NOT_FOUND"
+	ErrorCodeValidation  = "VALIDATION"
+	ErrorCodeForbidden   = "FORBIDDEN"
+	ErrorCodeRateLimited = "RATE_LIMITED"
+	ErrorCodeInternal    = "INTERNAL"
+)
+
+// errRecordNotFound is message of error returned by repositories when record does not exist
+const errRecordNotFound = "record not found"
+
+// Error is error of domain rule classified by code
+type Error struct {
+	Code    string
+	Message string
+}
+
+// Error to get message of error
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// NewValidationError to create error of input which is not valid
+func NewValidationError(format string, a ...interface{}) error {
+	return &Error{Code: ErrorCodeValidation, Message: fmt.Sprintf(format, a...)}
+}
+
+// NewForbiddenError to create error of operation which is not allowed in current state of record
+func NewForbiddenError(format string, a ...interface{}) error {
+	return &Error{Code: ErrorCodeForbidden, Message: fmt.Sprintf(format, a...)}
+}
+
+// NewRateLimitedError to create error of client which exceeded its limit
+func NewRateLimitedError(format string, a ...interface{}) error {
+	return &Error{Code: ErrorCodeRateLimited, Message: fmt.Sprintf(format, a...)}
+}
+
+// NewNotFoundError to create error of record which does not exist
+func NewNotFoundError(format string, a ...interface{}) error {
+	return &Error{Code: ErrorCodeNotFound, Message: fmt.Sprintf(format, a...)}
+}
+
+// GetErrorCode to get code of error, record not found errors of repositories are NOT_FOUND and errors
+// which are not classified are INTERNAL
+func GetErrorCode(err error) string {
+	var domainError *Error
+	if errors.As(err, &domainError) {
+		return domainError.Code
+	}
+	if err.Error() == errRecordNotFound {
+		return ErrorCodeNotFound
+	}
+	return ErrorCodeInternal
+}
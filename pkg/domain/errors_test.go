@@ -0,0 +1,21 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+)
+
+func TestGetErrorCode(t *testing.T) {
+	assert.Equal(t, domain.ErrorCodeValidation, domain.GetErrorCode(domain.NewValidationError("name not provided")))
+	assert.Equal(t, domain.ErrorCodeForbidden, domain.GetErrorCode(domain.NewForbiddenError("project is archived")))
+	assert.Equal(t, domain.ErrorCodeNotFound, domain.GetErrorCode(domain.NewNotFoundError("issue %d not found", 1)))
+	assert.Equal(t, domain.ErrorCodeRateLimited, domain.GetErrorCode(domain.NewRateLimitedError("budget exceeded")))
+	assert.Equal(t, domain.ErrorCodeNotFound, domain.GetErrorCode(errors.New("record not found")))
+	assert.Equal(t, domain.ErrorCodeInternal, domain.GetErrorCode(errors.New("database is locked")))
+	assert.Equal(t, domain.ErrorCodeValidation, domain.GetErrorCode(fmt.Errorf("label: %w", domain.NewValidationError("name not provided"))))
+
+	assert.Equal(t, "issue 1 not found", domain.NewNotFoundError("issue %d not found", 1).Error())
+}
@@ -0,0 +1,418 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// EventIssueCreated is published when issue is added
+	EventIssueCreated = "IssueCreated"
+	// EventIssueUpdated is published when any field of issue is changed
+	EventIssueUpdated = "IssueUpdated"
+	// EventIssueStatusChanged is published together with EventIssueUpdated when status of issue is changed
+	EventIssueStatusChanged = "IssueStatusChanged"
+	// EventLabelsChanged is published together with EventIssueUpdated when labels of issue are changed
+	EventLabelsChanged = "LabelsChanged"
+	// EventIssueMoved is published when issue is moved to another project
+	EventIssueMoved = "IssueMoved"
+	// EventIssueRemoved is published when issue is removed
+	EventIssueRemoved = "IssueRemoved"
+	// EventLabelCreated is published when label is added
+	EventLabelCreated = "LabelCreated"
+	// EventLabelUpdated is published when label or its group is changed
+	EventLabelUpdated = "LabelUpdated"
+	// EventLabelRemoved is published when label is removed or merged into another label
+	EventLabelRemoved = "LabelRemoved"
+	// EventProjectCreated is published when project is added
+	EventProjectCreated = "ProjectCreated"
+	// EventProjectUpdated is published when project or its settings are changed
+	EventProjectUpdated = "ProjectUpdated"
+	// EventProjectRemoved is published when project is removed
+	EventProjectRemoved = "ProjectRemoved"
+)
+
+const (
+	// OutboxEventPending marks outbox event waiting for first attempt or retry
+	OutboxEventPending = "pending"
+	// OutboxEventFailed marks outbox event which was not handled in EventMaxAttempts attempts
+	OutboxEventFailed = "failed"
+)
+
+// EventMaxAttempts is number of attempts of asynchronous handling before outbox event fails
+const EventMaxAttempts = 8
+
+// EventBatchSize is max. number of outbox events handled in one run of outbox
+const EventBatchSize = 100
+
+// DomainEvent is typed event published after change is committed
+type DomainEvent interface {
+	EventName() string
+	EventProjectID() uint
+}
+
+// EventHandler to handle domain event, failed asynchronous handling is retried
+type EventHandler func(event DomainEvent) error
+
+// IssueCreated event
+type IssueCreated struct {
+	Issue Issue            `json:"issue"`
+	Chain *AutomationChain `json:"-"`
+}
+
+// EventName of IssueCreated
+func (e IssueCreated) EventName() string {
+	return EventIssueCreated
+}
+
+// EventProjectID of IssueCreated
+func (e IssueCreated) EventProjectID() uint {
+	return e.Issue.ProjectID
+}
+
+// IssueUpdated event
+type IssueUpdated struct {
+	Issue   Issue            `json:"issue"`
+	Changes []IssueChange    `json:"changes"`
+	Chain   *AutomationChain `json:"-"`
+}
+
+// EventName of IssueUpdated
+func (e IssueUpdated) EventName() string {
+	return EventIssueUpdated
+}
+
+// EventProjectID of IssueUpdated
+func (e IssueUpdated) EventProjectID() uint {
+	return e.Issue.ProjectID
+}
+
+// IssueStatusChanged event
+type IssueStatusChanged struct {
+	Issue          Issue            `json:"issue"`
+	PreviousStatus int              `json:"previousStatus"`
+	Chain          *AutomationChain `json:"-"`
+}
+
+// EventName of IssueStatusChanged
+func (e IssueStatusChanged) EventName() string {
+	return EventIssueStatusChanged
+}
+
+// EventProjectID of IssueStatusChanged
+func (e IssueStatusChanged) EventProjectID() uint {
+	return e.Issue.ProjectID
+}
+
+// LabelsChanged event
+type LabelsChanged struct {
+	Issue   Issue            `json:"issue"`
+	Added   []Label          `json:"added"`
+	Removed []Label          `json:"removed"`
+	Chain   *AutomationChain `json:"-"`
+}
+
+// EventName of LabelsChanged
+func (e LabelsChanged) EventName() string {
+	return EventLabelsChanged
+}
+
+// EventProjectID of LabelsChanged
+func (e LabelsChanged) EventProjectID() uint {
+	return e.Issue.ProjectID
+}
+
+// IssueMoved event
+type IssueMoved struct {
+	Issue             Issue            `json:"issue"`
+	PreviousProjectID uint             `json:"previousProjectId"`
+	Chain             *AutomationChain `json:"-"`
+}
+
+// EventName of IssueMoved
+func (e IssueMoved) EventName() string {
+	return EventIssueMoved
+}
+
+// EventProjectID of IssueMoved is ID of target project
+func (e IssueMoved) EventProjectID() uint {
+	return e.Issue.ProjectID
+}
+
+// IssueRemoved event
+type IssueRemoved struct {
+	Issue Issue `json:"issue"`
+}
+
+// EventName of IssueRemoved
+func (e IssueRemoved) EventName() string {
+	return EventIssueRemoved
+}
+
+// EventProjectID of IssueRemoved
+func (e IssueRemoved) EventProjectID() uint {
+	return e.Issue.ProjectID
+}
+
+// LabelCreated event
+type LabelCreated struct {
+	Label Label `json:"label"`
+}
+
+// EventName of LabelCreated
+func (e LabelCreated) EventName() string {
+	return EventLabelCreated
+}
+
+// EventProjectID of LabelCreated, global labels have no project
+func (e LabelCreated) EventProjectID() uint {
+	return e.Label.ProjectID
+}
+
+// LabelUpdated event
+type LabelUpdated struct {
+	Label Label `json:"label"`
+}
+
+// EventName of LabelUpdated
+func (e LabelUpdated) EventName() string {
+	return EventLabelUpdated
+}
+
+// EventProjectID of LabelUpdated, global labels have no project
+func (e LabelUpdated) EventProjectID() uint {
+	return e.Label.ProjectID
+}
+
+// LabelRemoved event
+type LabelRemoved struct {
+	Label Label `json:"label"`
+}
+
+// EventName of LabelRemoved
+func (e LabelRemoved) EventName() string {
+	return EventLabelRemoved
+}
+
+// EventProjectID of LabelRemoved, global labels have no project
+func (e LabelRemoved) EventProjectID() uint {
+	return e.Label.ProjectID
+}
+
+// ProjectCreated event
+type ProjectCreated struct {
+	Project Project `json:"project"`
+}
+
+// EventName of ProjectCreated
+func (e ProjectCreated) EventName() string {
+	return EventProjectCreated
+}
+
+// EventProjectID of ProjectCreated
+func (e ProjectCreated) EventProjectID() uint {
+	return e.Project.ID
+}
+
+// ProjectUpdated event
+type ProjectUpdated struct {
+	Project Project `json:"project"`
+}
+
+// EventName of ProjectUpdated
+func (e ProjectUpdated) EventName() string {
+	return EventProjectUpdated
+}
+
+// EventProjectID of ProjectUpdated
+func (e ProjectUpdated) EventProjectID() uint {
+	return e.Project.ID
+}
+
+// ProjectRemoved event
+type ProjectRemoved struct {
+	Project Project `json:"project"`
+}
+
+// EventName of ProjectRemoved
+func (e ProjectRemoved) EventName() string {
+	return EventProjectRemoved
+}
+
+// EventProjectID of ProjectRemoved
+func (e ProjectRemoved) EventProjectID() uint {
+	return e.Project.ID
+}
+
+// OutboxEvent entity is domain event stored for asynchronous subscriber until it is handled
+type OutboxEvent struct {
+	ID          uint      `json:"id"`
+	Subscriber  string    `json:"subscriber"`
+	Name        string    `json:"name"`
+	ProjectID   uint      `json:"projectId"`
+	Payload     string    `json:"payload"`
+	Status      string    `json:"status"`
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"lastError"`
+	HandleAfter time.Time `json:"handleAfter"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// GetDomainEvent to decode domain event from name and JSON payload
+func GetDomainEvent(name string, payload string) (DomainEvent, error) {
+	var event DomainEvent
+	var err error
+	switch name {
+	case EventIssueCreated:
+		var e IssueCreated
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventIssueUpdated:
+		var e IssueUpdated
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventIssueStatusChanged:
+		var e IssueStatusChanged
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventLabelsChanged:
+		var e LabelsChanged
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventIssueMoved:
+		var e IssueMoved
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventIssueRemoved:
+		var e IssueRemoved
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventLabelCreated:
+		var e LabelCreated
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventLabelUpdated:
+		var e LabelUpdated
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventLabelRemoved:
+		var e LabelRemoved
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventProjectCreated:
+		var e ProjectCreated
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventProjectUpdated:
+		var e ProjectUpdated
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	case EventProjectRemoved:
+		var e ProjectRemoved
+		err = json.Unmarshal([]byte(payload), &e)
+		event = e
+	default:
+		return nil, fmt.Errorf("event %s not valid", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// GetIssueEvents to get events of issue changed from previous to current state, IssueUpdated is first
+// and it is followed by IssueStatusChanged, LabelsChanged and IssueMoved when status, labels or project
+// were changed
+func GetIssueEvents(previous Issue, current Issue) []DomainEvent {
+	events := []DomainEvent{IssueUpdated{Issue: current, Changes: GetIssueChanges(previous, current)}}
+	if previous.Status != current.Status {
+		events = append(events, IssueStatusChanged{Issue: current, PreviousStatus: previous.Status})
+	}
+	added := getMissingLabels(current.Labels, previous.Labels)
+	removed := getMissingLabels(previous.Labels, current.Labels)
+	if len(added) > 0 || len(removed) > 0 {
+		events = append(events, LabelsChanged{Issue: current, Added: added, Removed: removed})
+	}
+	if previous.ProjectID != current.ProjectID {
+		events = append(events, IssueMoved{Issue: current, PreviousProjectID: previous.ProjectID})
+	}
+	return events
+}
+
+// GetEventIssue to get issue of issue event
+func GetEventIssue(event DomainEvent) (Issue, bool) {
+	switch e := event.(type) {
+	case IssueCreated:
+		return e.Issue, true
+	case IssueUpdated:
+		return e.Issue, true
+	case IssueStatusChanged:
+		return e.Issue, true
+	case LabelsChanged:
+		return e.Issue, true
+	case IssueMoved:
+		return e.Issue, true
+	case IssueRemoved:
+		return e.Issue, true
+	}
+	return Issue{}, false
+}
+
+// GetEventChain to get automation chain of issue event, nil when event was not caused by automation rule
+func GetEventChain(event DomainEvent) *AutomationChain {
+	switch e := event.(type) {
+	case IssueCreated:
+		return e.Chain
+	case IssueUpdated:
+		return e.Chain
+	case IssueStatusChanged:
+		return e.Chain
+	case LabelsChanged:
+		return e.Chain
+	case IssueMoved:
+		return e.Chain
+	}
+	return nil
+}
+
+// SetEventChain to set automation chain of issue event, other events are returned unchanged
+func SetEventChain(event DomainEvent, chain *AutomationChain) DomainEvent {
+	switch e := event.(type) {
+	case IssueCreated:
+		e.Chain = chain
+		return e
+	case IssueUpdated:
+		e.Chain = chain
+		return e
+	case IssueStatusChanged:
+		e.Chain = chain
+		return e
+	case LabelsChanged:
+		e.Chain = chain
+		return e
+	case IssueMoved:
+		e.Chain = chain
+		return e
+	}
+	return event
+}
+
+// getMissingLabels to get labels which are not in other labels
+func getMissingLabels(labels []Label, other []Label) []Label {
+	missing := []Label{}
+	for _, label := range labels {
+		found := false
+		for _, item := range other {
+			if item.ID == label.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, label)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventRepository repository of outbox events
+type EventRepository interface {
+	Begin() (Transaction, error)
+	WithTransaction(tx Transaction) EventRepository
+	AddOutboxEvents(events []OutboxEvent) (int, error)
+	UpdateOutboxEvent(event OutboxEvent) (OutboxEvent, error)
+	RemoveOutboxEvent(id uint) (bool, error)
+	FindDueOutboxEvents(now time.Time, limit int) ([]OutboxEvent, error)
+}
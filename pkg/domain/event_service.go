@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EventService interface
+type EventService interface {
+	AddToOutbox(event DomainEvent, subscribers []string, now time.Time) (int, error)
+	ProcessOutbox(now time.Time, handlers map[string]EventHandler) (int, error)
+}
+
+// eventService struct
+type eventService struct {
+	repository EventRepository
+}
+
+// GetDefaultEventService alias to newEventService
+var GetDefaultEventService = newEventService
+
+// ResetDefaultEventService to reset GetDefaultEventService value
+func ResetDefaultEventService() {
+	GetDefaultEventService = newEventService
+}
+
+// newEventService to create new EventService
+func newEventService(repository EventRepository) EventService {
+	return &eventService{
+		repository: repository,
+	}
+}
+
+// AddToOutbox to store event for each asynchronous subscriber, event is handled by ProcessOutbox
+func (s *eventService) AddToOutbox(event DomainEvent, subscribers []string, now time.Time) (int, error) {
+	if len(subscribers) == 0 {
+		return 0, nil
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+
+	items := make([]OutboxEvent, len(subscribers))
+	for i, subscriber := range subscribers {
+		items[i] = OutboxEvent{
+			Subscriber:  subscriber,
+			Name:        event.EventName(),
+			ProjectID:   event.EventProjectID(),
+			Payload:     string(payload),
+			Status:      OutboxEventPending,
+			HandleAfter: now,
+		}
+	}
+
+	count, err := s.repository.AddOutboxEvents(items)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// ProcessOutbox to pass due outbox events to handlers of their subscribers, handled event is removed
+// from outbox and failed one is retried later with growing delay until EventMaxAttempts is reached
+func (s *eventService) ProcessOutbox(now time.Time, handlers map[string]EventHandler) (int, error) {
+	items, err := s.repository.FindDueOutboxEvents(now, EventBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	handled := 0
+	for _, item := range items {
+		item.Attempts++
+		handler, ok := handlers[item.Subscriber]
+		if !ok {
+			item.Status = OutboxEventFailed
+			item.LastError = fmt.Sprintf("subscriber %s not registered", item.Subscriber)
+		} else if event, err := GetDomainEvent(item.Name, item.Payload); err != nil {
+			item.Status = OutboxEventFailed
+			item.LastError = err.Error()
+		} else if err := handler(event); err != nil {
+			item.LastError = err.Error()
+			if item.Attempts >= EventMaxAttempts {
+				item.Status = OutboxEventFailed
+			} else {
+				item.HandleAfter = now.Add(getRetryDelay(item.Attempts))
+			}
+		} else {
+			if _, err := s.repository.RemoveOutboxEvent(item.ID); err != nil {
+				return handled, err
+			}
+			handled++
+			continue
+		}
+
+		if _, err := s.repository.UpdateOutboxEvent(item); err != nil {
+			return handled, err
+		}
+	}
+	return handled, nil
+}
@@ -0,0 +1,120 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"testing"
+	"time"
+)
+
+func TestDomainEventResetDefaultEventService(t *testing.T) {
+	assert.NotNil(t, domain.GetDefaultEventService)
+
+	domain.GetDefaultEventService = nil
+	defer domain.ResetDefaultEventService()
+
+	assert.Nil(t, domain.GetDefaultEventService)
+
+	domain.ResetDefaultEventService()
+
+	assert.NotNil(t, domain.GetDefaultEventService)
+}
+
+func TestDomainEventAddToOutbox(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := domain.IssueCreated{Issue: domain.Issue{ID: 1, Title: "Bug", ProjectID: 2}}
+	payload, _ := json.Marshal(event)
+	item := domain.OutboxEvent{Name: domain.EventIssueCreated, ProjectID: 2, Payload: string(payload), Status: domain.OutboxEventPending, HandleAfter: now}
+	webhooks := item
+	webhooks.Subscriber = "webhooks"
+	audit := item
+	audit.Subscriber = "audit"
+
+	m := new(dTesting.EventRepositoryMock)
+	m.On("AddOutboxEvents", []domain.OutboxEvent{webhooks, audit}).Return(2, nil).Once()
+	m.On("AddOutboxEvents", []domain.OutboxEvent{webhooks}).Return(0, errors.New("test error")).Once()
+
+	s := domain.GetDefaultEventService(m)
+
+	count, err := s.AddToOutbox(event, []string{"webhooks", "audit"}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	count, err = s.AddToOutbox(event, []string{}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = s.AddToOutbox(event, []string{"webhooks"}, now)
+
+	assert.Equal(t, "test error", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainEventProcessOutbox(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := domain.IssueCreated{Issue: domain.Issue{ID: 1, Title: "Bug", ProjectID: 2}}
+	payload, _ := json.Marshal(event)
+
+	handled := domain.OutboxEvent{ID: 1, Subscriber: "webhooks", Name: domain.EventIssueCreated, Payload: string(payload), Status: domain.OutboxEventPending, HandleAfter: now}
+	retried := domain.OutboxEvent{ID: 2, Subscriber: "audit", Name: domain.EventIssueCreated, Payload: string(payload), Status: domain.OutboxEventPending, Attempts: 2, HandleAfter: now}
+	exhausted := domain.OutboxEvent{ID: 3, Subscriber: "audit", Name: domain.EventIssueCreated, Payload: string(payload), Status: domain.OutboxEventPending, Attempts: domain.EventMaxAttempts - 1, HandleAfter: now}
+	unknown := domain.OutboxEvent{ID: 4, Subscriber: "removed", Name: domain.EventIssueCreated, Payload: string(payload), Status: domain.OutboxEventPending, HandleAfter: now}
+	invalid := domain.OutboxEvent{ID: 5, Subscriber: "webhooks", Name: "IssueCommented", Payload: "{}", Status: domain.OutboxEventPending, HandleAfter: now}
+
+	retriedUpdated := retried
+	retriedUpdated.Attempts = 3
+	retriedUpdated.LastError = "handler error"
+	retriedUpdated.HandleAfter = now.Add(4 * time.Minute)
+	exhaustedUpdated := exhausted
+	exhaustedUpdated.Attempts = domain.EventMaxAttempts
+	exhaustedUpdated.LastError = "handler error"
+	exhaustedUpdated.Status = domain.OutboxEventFailed
+	unknownUpdated := unknown
+	unknownUpdated.Attempts = 1
+	unknownUpdated.LastError = "subscriber removed not registered"
+	unknownUpdated.Status = domain.OutboxEventFailed
+	invalidUpdated := invalid
+	invalidUpdated.Attempts = 1
+	invalidUpdated.LastError = "event IssueCommented not valid"
+	invalidUpdated.Status = domain.OutboxEventFailed
+
+	m := new(dTesting.EventRepositoryMock)
+	m.On("FindDueOutboxEvents", now, domain.EventBatchSize).Return([]domain.OutboxEvent{handled, retried, exhausted, unknown, invalid}, nil).Once()
+	m.On("RemoveOutboxEvent", uint(1)).Return(true, nil).Once()
+	m.On("UpdateOutboxEvent", retriedUpdated).Return(retriedUpdated, nil).Once()
+	m.On("UpdateOutboxEvent", exhaustedUpdated).Return(exhaustedUpdated, nil).Once()
+	m.On("UpdateOutboxEvent", unknownUpdated).Return(unknownUpdated, nil).Once()
+	m.On("UpdateOutboxEvent", invalidUpdated).Return(invalidUpdated, nil).Once()
+	m.On("FindDueOutboxEvents", now, domain.EventBatchSize).Return([]domain.OutboxEvent{}, errors.New("test error")).Once()
+
+	received := []domain.DomainEvent{}
+	handlers := map[string]domain.EventHandler{
+		"webhooks": func(event domain.DomainEvent) error {
+			received = append(received, event)
+			return nil
+		},
+		"audit": func(event domain.DomainEvent) error {
+			return errors.New("handler error")
+		},
+	}
+
+	s := domain.GetDefaultEventService(m)
+
+	count, err := s.ProcessOutbox(now, handlers)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []domain.DomainEvent{event}, received)
+
+	_, err = s.ProcessOutbox(now, handlers)
+
+	assert.Equal(t, "test error", err.Error())
+
+	m.AssertExpectations(t)
+}
@@ -0,0 +1,82 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+)
+
+func TestDomainEventGetDomainEvent(t *testing.T) {
+	i := domain.Issue{ID: 1, Title: "Bug", ProjectID: 2, Labels: []domain.Label{{ID: 1, Name: "bug"}}}
+
+	events := []domain.DomainEvent{
+		domain.IssueCreated{Issue: i},
+		domain.IssueUpdated{Issue: i, Changes: []domain.IssueChange{{Field: "title", Previous: "Bag", Current: "Bug"}}},
+		domain.IssueStatusChanged{Issue: i, PreviousStatus: 1},
+		domain.LabelsChanged{Issue: i, Added: []domain.Label{{ID: 1, Name: "bug"}}, Removed: []domain.Label{}},
+		domain.IssueMoved{Issue: i, PreviousProjectID: 1},
+		domain.IssueRemoved{Issue: i},
+		domain.LabelCreated{Label: domain.Label{ID: 1, Name: "bug"}},
+		domain.LabelUpdated{Label: domain.Label{ID: 1, Name: "bug"}},
+		domain.LabelRemoved{Label: domain.Label{ID: 1, Name: "bug"}},
+		domain.ProjectCreated{Project: domain.Project{ID: 2, Name: "Tracker"}},
+		domain.ProjectUpdated{Project: domain.Project{ID: 2, Name: "Tracker"}},
+		domain.ProjectRemoved{Project: domain.Project{ID: 2, Name: "Tracker"}},
+	}
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+
+		assert.Nil(t, err)
+
+		decoded, err := domain.GetDomainEvent(event.EventName(), string(payload))
+
+		assert.Nil(t, err)
+		assert.Equal(t, event.EventName(), decoded.EventName())
+		assert.Equal(t, event.EventProjectID(), decoded.EventProjectID())
+		reencoded, _ := json.Marshal(decoded)
+		assert.Equal(t, string(payload), string(reencoded))
+	}
+
+	_, err := domain.GetDomainEvent("IssueCommented", "{}")
+
+	assert.Equal(t, "event IssueCommented not valid", err.Error())
+
+	_, err = domain.GetDomainEvent(domain.EventIssueCreated, "{")
+
+	assert.NotNil(t, err)
+}
+
+func TestDomainEventGetIssueEvents(t *testing.T) {
+	bug := domain.Label{ID: 1, Name: "bug"}
+	triage := domain.Label{ID: 2, Name: "triage"}
+	previous := domain.Issue{ID: 1, Title: "Bug", Status: 1, Labels: []domain.Label{bug}}
+
+	current := previous
+	current.Title = "Crash"
+
+	events := domain.GetIssueEvents(previous, current)
+
+	assert.Equal(t, []domain.DomainEvent{
+		domain.IssueUpdated{Issue: current, Changes: []domain.IssueChange{{Field: "title", Previous: "Bug", Current: "Crash"}}},
+	}, events)
+
+	current.Status = 2
+	current.Labels = []domain.Label{triage}
+
+	events = domain.GetIssueEvents(previous, current)
+
+	assert.Len(t, events, 3)
+	assert.Equal(t, domain.EventIssueUpdated, events[0].EventName())
+	assert.Equal(t, domain.IssueStatusChanged{Issue: current, PreviousStatus: 1}, events[1])
+	assert.Equal(t, domain.LabelsChanged{Issue: current, Added: []domain.Label{triage}, Removed: []domain.Label{bug}}, events[2])
+
+	current = previous
+	current.ProjectID = 2
+
+	events = domain.GetIssueEvents(previous, current)
+
+	assert.Len(t, events, 2)
+	assert.Equal(t, domain.IssueMoved{Issue: current, PreviousProjectID: 0}, events[1])
+}
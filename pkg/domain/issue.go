@@ -4,15 +4,29 @@ import (
 	"time"
 )
 
-// Issue entity
+// IssueStatusDefault marks new issue which gets default status of its project
+const IssueStatusDefault = -1
+
+// Issue entity, estimates and time spent are in minutes, reporter and assignee are user names which watch
+// issue automatically
 type Issue struct {
-	ID          uint      `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Status      int       `json:"status"`
-	ProjectID   uint      `json:"projectId"`
-	Project     Project   `json:"project"`
-	Labels      []Label   `json:"labels" gorm:"many2many:issues_labels;"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID                uint               `json:"id"`
+	Title             string             `json:"title"`
+	Description       string             `json:"description"`
+	Status            int                `json:"status"`
+	Priority          int                `json:"priority"`
+	Severity          int                `json:"severity"`
+	Reporter          string             `json:"reporter"`
+	Assignee          string             `json:"assignee"`
+	DueAt             *time.Time         `json:"dueAt"`
+	SLAStatus         int                `json:"slaStatus"`
+	OriginalEstimate  int                `json:"originalEstimate"`
+	RemainingEstimate int                `json:"remainingEstimate"`
+	TimeSpent         int                `json:"timeSpent"`
+	ProjectID         uint               `json:"projectId"`
+	Project           Project            `json:"project"`
+	Labels            []Label            `json:"labels" gorm:"many2many:issues_labels;"`
+	CustomFields      []CustomFieldValue `json:"customFields" gorm:"association_autoupdate:false;association_autocreate:false"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
 }
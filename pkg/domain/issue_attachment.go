@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// MaxAttachmentSize is max. size of attachment file in bytes
+var MaxAttachmentSize int64 = 10 << 20
+
+// Attachment entity holds metadata of file attached to issue, content type is sniffed from content
+type Attachment struct {
+	ID          uint      `json:"id"`
+	IssueID     uint      `json:"issueId"`
+	Filename    string    `json:"filename"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"contentType"`
+	SHA256      string    `json:"sha256" gorm:"column:sha256"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// getAttachmentFilename to get base name of provided filename without directories
+func getAttachmentFilename(filename string) string {
+	filename = strings.TrimSpace(strings.ReplaceAll(filename, "\\", "/"))
+	if filename == "" {
+		return ""
+	}
+	filename = filepath.Base(filename)
+	if filename == "." || filename == "/" || filename == ".." {
+		return ""
+	}
+	return filename
+}
+
+// setContent to set size, content type and SHA-256 checksum of attachment from content
+func (a *Attachment) setContent(content []byte) {
+	checksum := sha256.Sum256(content)
+	a.Size = int64(len(content))
+	a.ContentType = http.DetectContentType(content)
+	a.SHA256 = hex.EncodeToString(checksum[:])
+}
@@ -0,0 +1,69 @@
+package domain
+
+// IssueBulkOperation describes changes applied to multiple issues at once, nil status and project are not changed
+type IssueBulkOperation struct {
+	AddLabels    []Label
+	RemoveLabels []Label
+	Status       *int
+	Project      *Project
+}
+
+// IssueBulkResult describes outcome of bulk operation for single issue
+type IssueBulkResult struct {
+	ID     uint   `json:"id"`
+	Status bool   `json:"status"`
+	Error  string `json:"error"`
+}
+
+// Apply to apply bulk operation to issue, added label replaces label of the same exclusive group and
+// custom field values are dropped when issue is moved to another project
+func (o IssueBulkOperation) Apply(issue *Issue) {
+	for _, label := range o.AddLabels {
+		if label.Group != nil && label.Group.Exclusive {
+			labels := []Label{}
+			for _, l := range issue.Labels {
+				if l.GroupID != label.GroupID || l.ID == label.ID {
+					labels = append(labels, l)
+				}
+			}
+			issue.Labels = labels
+		}
+		exists := false
+		for _, l := range issue.Labels {
+			if l.ID == label.ID {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			issue.Labels = append(issue.Labels, label)
+		}
+	}
+
+	if len(o.RemoveLabels) > 0 {
+		labels := []Label{}
+		for _, l := range issue.Labels {
+			remove := false
+			for _, label := range o.RemoveLabels {
+				if l.ID == label.ID {
+					remove = true
+					break
+				}
+			}
+			if !remove {
+				labels = append(labels, l)
+			}
+		}
+		issue.Labels = labels
+	}
+
+	if o.Status != nil {
+		issue.Status = *o.Status
+	}
+
+	if o.Project != nil && o.Project.ID != issue.ProjectID {
+		issue.ProjectID = o.Project.ID
+		issue.Project = *o.Project
+		issue.CustomFields = []CustomFieldValue{}
+	}
+}
@@ -0,0 +1,95 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+)
+
+func TestDomainIssueBulkOperationApply(t *testing.T) {
+	p := domain.Project{
+		ID:   2,
+		Name: "test-name",
+	}
+	i := domain.Issue{
+		ID:        1,
+		Status:    1,
+		ProjectID: 1,
+		Labels: []domain.Label{
+			{ID: 1},
+			{ID: 2},
+		},
+	}
+	status := 2
+	op := domain.IssueBulkOperation{
+		AddLabels: []domain.Label{
+			{ID: 2},
+			{ID: 3},
+		},
+		RemoveLabels: []domain.Label{
+			{ID: 1},
+		},
+		Status:  &status,
+		Project: &p,
+	}
+
+	op.Apply(&i)
+
+	assert.Equal(t, []domain.Label{{ID: 2}, {ID: 3}}, i.Labels)
+	assert.Equal(t, 2, i.Status)
+	assert.Equal(t, p.ID, i.ProjectID)
+	assert.Equal(t, p, i.Project)
+}
+
+func TestDomainIssueBulkOperationApplyEmpty(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		Status:    1,
+		ProjectID: 1,
+		Labels: []domain.Label{
+			{ID: 1},
+		},
+	}
+	expected := i
+
+	domain.IssueBulkOperation{}.Apply(&i)
+
+	assert.Equal(t, expected, i)
+}
+
+func TestDomainIssueBulkOperationApplyZeroStatus(t *testing.T) {
+	i := domain.Issue{ID: 1, Status: 1}
+	status := 0
+
+	domain.IssueBulkOperation{Status: &status}.Apply(&i)
+
+	assert.Equal(t, 0, i.Status)
+}
+
+func TestDomainIssueBulkOperationApplyExclusiveGroup(t *testing.T) {
+	priority := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+	area := &domain.LabelGroup{ID: 2, Name: "area"}
+	i := domain.Issue{
+		ID: 1,
+		Labels: []domain.Label{
+			{ID: 1, GroupID: 1},
+			{ID: 2, GroupID: 2},
+			{ID: 3},
+		},
+	}
+	op := domain.IssueBulkOperation{
+		AddLabels: []domain.Label{
+			{ID: 4, GroupID: 1, Group: priority},
+			{ID: 5, GroupID: 2, Group: area},
+		},
+	}
+
+	op.Apply(&i)
+
+	assert.Equal(t, []domain.Label{
+		{ID: 2, GroupID: 2},
+		{ID: 3},
+		{ID: 4, GroupID: 1, Group: priority},
+		{ID: 5, GroupID: 2, Group: area},
+	}, i.Labels)
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IssueChange describes field of issue changed by update, values are formatted for humans
+type IssueChange struct {
+	Field    string `json:"field"`
+	Previous string `json:"previous"`
+	Current  string `json:"current"`
+}
+
+// String to format change as single line
+func (c IssueChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Field, c.Previous, c.Current)
+}
+
+// GetIssueChanges to get fields which differ between previous and current state of issue
+func GetIssueChanges(previous Issue, current Issue) []IssueChange {
+	fields := []struct {
+		name     string
+		previous string
+		current  string
+	}{
+		{"title", previous.Title, current.Title},
+		{"description", previous.Description, current.Description},
+		{"status", strconv.Itoa(previous.Status), strconv.Itoa(current.Status)},
+		{"priority", getLevelName(previous.Priority, PriorityLevels), getLevelName(current.Priority, PriorityLevels)},
+		{"severity", getLevelName(previous.Severity, SeverityLevels), getLevelName(current.Severity, SeverityLevels)},
+		{"reporter", previous.Reporter, current.Reporter},
+		{"assignee", previous.Assignee, current.Assignee},
+		{"dueAt", getDueAtValue(previous.DueAt), getDueAtValue(current.DueAt)},
+		{"slaStatus", getLevelName(previous.SLAStatus, SLAStatusLevels), getLevelName(current.SLAStatus, SLAStatusLevels)},
+		{"project", getProjectValue(previous), getProjectValue(current)},
+		{"labels", getLabelsValue(previous.Labels), getLabelsValue(current.Labels)},
+		{"customFields", getCustomFieldsValue(previous.CustomFields), getCustomFieldsValue(current.CustomFields)},
+		{"originalEstimate", strconv.Itoa(previous.OriginalEstimate), strconv.Itoa(current.OriginalEstimate)},
+		{"remainingEstimate", strconv.Itoa(previous.RemainingEstimate), strconv.Itoa(current.RemainingEstimate)},
+		{"timeSpent", strconv.Itoa(previous.TimeSpent), strconv.Itoa(current.TimeSpent)},
+	}
+
+	changes := []IssueChange{}
+	for _, field := range fields {
+		if field.previous != field.current {
+			changes = append(changes, IssueChange{
+				Field:    field.name,
+				Previous: field.previous,
+				Current:  field.current,
+			})
+		}
+	}
+	return changes
+}
+
+// FormatIssueChanges to format changes as lines
+func FormatIssueChanges(changes []IssueChange) string {
+	lines := make([]string, len(changes))
+	for i, change := range changes {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getLevelName to get name of priority or severity level
+func getLevelName(level int, levels []string) string {
+	if level < 0 || level >= len(levels) {
+		return strconv.Itoa(level)
+	}
+	return levels[level]
+}
+
+// getDueAtValue to format due date, empty when not set
+func getDueAtValue(dueAt *time.Time) string {
+	if dueAt == nil {
+		return ""
+	}
+	return dueAt.UTC().Format(time.RFC3339)
+}
+
+// getProjectValue to get name of project of issue, ID is used when project is not loaded
+func getProjectValue(issue Issue) string {
+	if issue.Project.ID == issue.ProjectID && issue.Project.Name != "" {
+		return issue.Project.Name
+	}
+	return strconv.FormatUint(uint64(issue.ProjectID), 10)
+}
+
+// getLabelsValue to get sorted comma separated label names
+func getLabelsValue(labels []Label) string {
+	names := make([]string, len(labels))
+	for i, label := range labels {
+		names[i] = label.Name
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// getCustomFieldsValue to get sorted comma separated name=value pairs of custom fields
+func getCustomFieldsValue(values []CustomFieldValue) string {
+	pairs := make([]string, len(values))
+	for i, value := range values {
+		pairs[i] = value.Field.Name + "=" + value.Value
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+	"time"
+)
+
+func TestDomainIssueChangeGetIssueChanges(t *testing.T) {
+	dueAt := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	previous := domain.Issue{
+		ID:        1,
+		Title:     "Bug",
+		Status:    1,
+		Priority:  domain.PriorityLow,
+		ProjectID: 1,
+		Project:   domain.Project{ID: 1, Name: "Tracker"},
+		Labels:    []domain.Label{{ID: 2, Name: "ui"}, {ID: 1, Name: "bug"}},
+		CustomFields: []domain.CustomFieldValue{
+			{FieldID: 1, Field: domain.CustomField{ID: 1, Name: "Team"}, Value: "core"},
+		},
+	}
+	current := previous
+	current.Title = "Crash"
+	current.Status = 2
+	current.Priority = domain.PriorityUrgent
+	current.DueAt = &dueAt
+	current.ProjectID = 2
+	current.Project = domain.Project{ID: 2, Name: "Mobile"}
+	current.Labels = []domain.Label{{ID: 1, Name: "bug"}}
+	current.OriginalEstimate = 60
+
+	changes := domain.GetIssueChanges(previous, current)
+
+	assert.Equal(t, []domain.IssueChange{
+		{Field: "title", Previous: "Bug", Current: "Crash"},
+		{Field: "status", Previous: "1", Current: "2"},
+		{Field: "priority", Previous: "low", Current: "urgent"},
+		{Field: "dueAt", Previous: "", Current: "2020-01-06T09:00:00Z"},
+		{Field: "project", Previous: "Tracker", Current: "Mobile"},
+		{Field: "labels", Previous: "bug, ui", Current: "bug"},
+		{Field: "originalEstimate", Previous: "0", Current: "60"},
+	}, changes)
+
+	assert.Equal(t, []domain.IssueChange{}, domain.GetIssueChanges(previous, previous))
+}
+
+func TestDomainIssueChangeFormatIssueChanges(t *testing.T) {
+	changes := []domain.IssueChange{
+		{Field: "title", Previous: "Bug", Current: "Crash"},
+		{Field: "status", Previous: "1", Current: "2"},
+	}
+
+	assert.Equal(t, "title: Bug -> Crash\nstatus: 1 -> 2", domain.FormatIssueChanges(changes))
+	assert.Equal(t, "", domain.FormatIssueChanges(nil))
+}
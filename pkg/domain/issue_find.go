@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+)
+
+// Sort orders of found issues, issues are sorted by ID when sort is not provided
+const (
+	IssueSortPriority = "priority"
+	IssueSortSeverity = "severity"
+	IssueSortDue      = "due"
+)
+
+// IssueFindOptions holds additional filters and sort order of found issues, min. priority,
+// severity and SLA status filter issues having at least that level, none level disables filter,
+// updated before filters issues not updated since that time, zero time disables filter
+type IssueFindOptions struct {
+	MinPriority   int
+	MinSeverity   int
+	MinSLAStatus  int
+	UpdatedBefore time.Time
+	Sort          string
+}
+
+// Validate to validate levels and sort order of options
+func (o IssueFindOptions) Validate() error {
+	if !IsPriorityValid(o.MinPriority) {
+		return NewValidationError("priority %d not valid", o.MinPriority)
+	}
+	if !IsSeverityValid(o.MinSeverity) {
+		return NewValidationError("severity %d not valid", o.MinSeverity)
+	}
+	if !IsSLAStatusValid(o.MinSLAStatus) {
+		return NewValidationError("sla status %d not valid", o.MinSLAStatus)
+	}
+	if o.Sort != "" && o.Sort != IssueSortPriority && o.Sort != IssueSortSeverity && o.Sort != IssueSortDue {
+		return NewValidationError("sort %s not valid", o.Sort)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"time"
+)
+
+// IssueMove entity records issue being moved from one project to another
+type IssueMove struct {
+	ID            uint      `json:"id"`
+	IssueID       uint      `json:"issueId"`
+	FromProjectID uint      `json:"fromProjectId"`
+	ToProjectID   uint      `json:"toProjectId"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
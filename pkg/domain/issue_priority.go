@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// levelNamePattern is pattern of level name, upper cased name is used as GraphQL enum value and name
+// cannot be confused with level number
+var levelNamePattern = regexp.MustCompile("^[a-z_][a-z0-9_]*$")
+
+// Priority levels of issue ordered from lowest to highest, level 0 is issue without priority in any
+// configuration of levels
+const (
+	PriorityNone = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityUrgent
+)
+
+// PriorityLevels are names of priority levels indexed by level, configured by SetPriorityLevels
+var PriorityLevels = []string{"none", "low", "medium", "high", "urgent"}
+
+// Severity levels of issue ordered from lowest to highest, level 0 is issue without severity in any
+// configuration of levels
+const (
+	SeverityNone = iota
+	SeverityMinor
+	SeverityMajor
+	SeverityCritical
+	SeverityBlocker
+)
+
+// SeverityLevels are names of severity levels indexed by level, configured by SetSeverityLevels
+var SeverityLevels = []string{"none", "minor", "major", "critical", "blocker"}
+
+// PriorityLabelPrefix is name prefix of labels used as priority before it was issue field
+const PriorityLabelPrefix = "priority:"
+
+// parseLevel to parse level provided by name or number
+func parseLevel(value string, levels []string, kind string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for level, name := range levels {
+		if name == value {
+			return level, nil
+		}
+	}
+	level, err := strconv.Atoi(value)
+	if err != nil || level < 0 || level >= len(levels) {
+		return 0, NewValidationError("%s %s not valid", kind, value)
+	}
+	return level, nil
+}
+
+// parseLevelNames to parse comma separated level names ordered from lowest to highest
+func parseLevelNames(value string, kind string) ([]string, error) {
+	levels := []string{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !levelNamePattern.MatchString(name) {
+			return nil, NewValidationError("%s level %s not valid", kind, name)
+		}
+		for _, level := range levels {
+			if level == name {
+				return nil, NewValidationError("%s level %s is not unique", kind, name)
+			}
+		}
+		levels = append(levels, name)
+	}
+	if len(levels) < 2 {
+		return nil, NewValidationError("at least 2 %s levels must be provided", kind)
+	}
+	return levels, nil
+}
+
+// SetPriorityLevels to configure priority levels by comma separated names ordered from lowest to highest,
+// levels are stored as numbers so renaming levels keeps priority of issues and reordering changes it
+func SetPriorityLevels(value string) error {
+	levels, err := parseLevelNames(value, "priority")
+	if err != nil {
+		return err
+	}
+	PriorityLevels = levels
+	return nil
+}
+
+// SetSeverityLevels to configure severity levels by comma separated names ordered from lowest to highest,
+// levels are stored as numbers so renaming levels keeps severity of issues and reordering changes it
+func SetSeverityLevels(value string) error {
+	levels, err := parseLevelNames(value, "severity")
+	if err != nil {
+		return err
+	}
+	SeverityLevels = levels
+	return nil
+}
+
+// ParsePriority to parse priority provided by name or level
+func ParsePriority(value string) (int, error) {
+	return parseLevel(value, PriorityLevels, "priority")
+}
+
+// ParseSeverity to parse severity provided by name or level
+func ParseSeverity(value string) (int, error) {
+	return parseLevel(value, SeverityLevels, "severity")
+}
+
+// IsPriorityValid to check if priority is one of priority levels
+func IsPriorityValid(priority int) bool {
+	return priority >= 0 && priority < len(PriorityLevels)
+}
+
+// IsSeverityValid to check if severity is one of severity levels
+func IsSeverityValid(severity int) bool {
+	return severity >= 0 && severity < len(SeverityLevels)
+}
+
+// MigratePriorityLabels to get issue with priority:<level> labels moved into issue priority, migrated labels
+// and whether issue has any such label, the highest level wins when issue has more labels and priority is
+// never lowered, labels with unknown level are kept
+func (i Issue) MigratePriorityLabels() (Issue, []Label, bool) {
+	kept := []Label{}
+	removed := []Label{}
+	priority := -1
+	for _, label := range i.Labels {
+		if !strings.HasPrefix(strings.ToLower(label.Name), PriorityLabelPrefix) {
+			kept = append(kept, label)
+			continue
+		}
+		level, err := ParsePriority(label.Name[len(PriorityLabelPrefix):])
+		if err != nil {
+			kept = append(kept, label)
+			continue
+		}
+		if level > priority {
+			priority = level
+		}
+		removed = append(removed, label)
+	}
+	if priority == -1 {
+		return i, removed, false
+	}
+	if priority > i.Priority {
+		i.Priority = priority
+	}
+	i.Labels = kept
+	return i, removed, true
+}
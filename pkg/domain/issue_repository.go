@@ -1,11 +1,34 @@
 package domain
 
+import (
+	"time"
+)
+
 // IssueRepository repository
 type IssueRepository interface {
+	WithTransaction(tx Transaction) IssueRepository
 	Add(issue *Issue) (*Issue, error)
 	Update(issue Issue) (Issue, error)
+	UpdateBulk(issues []Issue, moves []IssueMove) ([]Issue, error)
+	UpdateSLA(issue Issue) (Issue, error)
+	SetEstimates(issue Issue) (Issue, error)
+	MigratePriority(issue Issue, labels []Label) (Issue, error)
+	Move(issue Issue, project Project) (Issue, error)
+	UpdateCustomFields(issue Issue) (Issue, error)
+	FindMoves(id uint) ([]IssueMove, error)
 	FindByID(id uint) (Issue, error)
-	Find(title string, projectID uint, labels []string) ([]Issue, error)
+	Find(title string, projectID uint, labels []string, customFields []CustomFieldFilter, options IssueFindOptions) ([]Issue, error)
 	FindAll() ([]Issue, error)
 	Remove(id uint) (bool, error)
+	AddWorkLog(workLog *WorkLog, issue Issue) (*WorkLog, error)
+	RemoveWorkLog(workLog WorkLog, issue Issue) (bool, error)
+	FindWorkLogByID(id uint) (WorkLog, error)
+	FindWorkLogs(issueID uint) ([]WorkLog, error)
+	FindProjectWorkLogs(projectID uint, from time.Time, to time.Time) ([]WorkLog, error)
+	SumTime(projectID uint) (TimeSummary, error)
+	AddAttachment(attachment *Attachment, content []byte) (*Attachment, error)
+	RemoveAttachment(attachment Attachment) (bool, error)
+	FindAttachmentByID(id uint) (Attachment, error)
+	FindAttachments(issueID uint) ([]Attachment, error)
+	ReadAttachment(attachment Attachment) ([]byte, error)
 }
@@ -1,17 +1,37 @@
 package domain
 
 import (
-	"errors"
+	"sort"
+	"strings"
+	"time"
 )
 
 // IssueService interface
 type IssueService interface {
 	Add(issue *Issue) (*Issue, error)
 	Update(issue Issue) (Issue, error)
+	BulkUpdate(issues []Issue, operation IssueBulkOperation) ([]IssueBulkResult, error)
+	Move(issue Issue, project Project) (Issue, error)
+	SetCustomFields(issue Issue, fields []CustomField, values map[string]string) (Issue, error)
 	FindByID(id uint) (Issue, error)
-	Find(title string, projectID uint, labels []string) ([]Issue, error)
+	FindByProjectAndID(projectID uint, id uint) (Issue, error)
+	Find(title string, projectID uint, labels []string, customFields []CustomFieldFilter, options IssueFindOptions) ([]Issue, error)
 	FindAll() ([]Issue, error)
-	Remove(id uint) (bool, error)
+	Remove(issue Issue) (bool, error)
+	MigratePriority(issue Issue, labels []Label) (Issue, error)
+	UpdateSLA(issue Issue) (Issue, error)
+	SetEstimates(issue Issue, originalEstimate int, remainingEstimate int) (Issue, error)
+	AddWorkLog(issue Issue, workLog *WorkLog) (*WorkLog, error)
+	RemoveWorkLog(issue Issue, workLog WorkLog) (bool, error)
+	FindWorkLogByID(id uint) (WorkLog, error)
+	FindWorkLogs(issueID uint) ([]WorkLog, error)
+	FindTimeSummary(projectID uint) (TimeSummary, error)
+	FindTimesheet(projectID uint, from time.Time, to time.Time) ([]TimesheetEntry, error)
+	AddAttachment(issue Issue, attachment *Attachment, content []byte) (*Attachment, error)
+	RemoveAttachment(issue Issue, attachment Attachment) (bool, error)
+	FindAttachmentByID(id uint) (Attachment, error)
+	FindAttachments(issueID uint) ([]Attachment, error)
+	ReadAttachment(attachment Attachment) ([]byte, error)
 }
 
 // issueService struct
@@ -34,19 +54,101 @@ func newIssueService(repository IssueRepository) IssueService {
 	}
 }
 
-// validateLabels validates if there are too many labels
-func (s *issueService) validateLabels(labels []Label) error {
-	if len(labels) > 10 {
-		return errors.New("max. 10 labels can be assigned to issue")
+// validateLabels validates if there are too many labels or more than one label of exclusive group
+func (s *issueService) validateLabels(labels []Label, settings ProjectSettings) error {
+	if len(labels) > settings.GetMaxLabels() {
+		return NewValidationError("max. %d labels can be assigned to issue", settings.GetMaxLabels())
+	}
+	groups := make(map[uint]bool)
+	for _, label := range labels {
+		if label.Group == nil || !label.Group.Exclusive {
+			continue
+		}
+		if groups[label.GroupID] {
+			return NewValidationError("only one label of %s group can be assigned to issue", label.Group.Name)
+		}
+		groups[label.GroupID] = true
+	}
+	return nil
+}
+
+// validateLabelsProject validates if labels are global or belong to issue project
+func (s *issueService) validateLabelsProject(labels []Label, projectID uint) error {
+	for _, label := range labels {
+		if label.ProjectID != 0 && label.ProjectID != projectID {
+			return NewValidationError("%s label does not belong to issue project", label.Name)
+		}
+	}
+	return nil
+}
+
+// validateSettings validates required fields, status, priority and severity against project settings
+func (s *issueService) validateSettings(issue Issue, settings ProjectSettings) error {
+	if settings.IsRequired(IssueFieldDescription) && issue.Description == "" {
+		return NewValidationError("description not provided")
+	}
+	if settings.IsRequired(IssueFieldLabels) && len(issue.Labels) == 0 {
+		return NewValidationError("no labels assigned")
+	}
+	if !settings.IsStatusAllowed(issue.Status) {
+		return NewValidationError("status %d is not allowed in project", issue.Status)
+	}
+	if !IsPriorityValid(issue.Priority) {
+		return NewValidationError("priority %d not valid", issue.Priority)
+	}
+	if !settings.IsPriorityAllowed(issue.Priority) {
+		return NewValidationError("priority %s is not allowed in project", PriorityLevels[issue.Priority])
+	}
+	if !IsSeverityValid(issue.Severity) {
+		return NewValidationError("severity %d not valid", issue.Severity)
+	}
+	if !settings.IsSeverityAllowed(issue.Severity) {
+		return NewValidationError("severity %s is not allowed in project", SeverityLevels[issue.Severity])
+	}
+	return nil
+}
+
+// validateIssue validates issue against its project and project settings
+func (s *issueService) validateIssue(issue Issue) error {
+	if err := s.validateProject(issue.Project); err != nil {
+		return err
+	}
+	if err := s.validateSettings(issue, issue.Project.Settings); err != nil {
+		return err
+	}
+	if err := s.validateLabels(issue.Labels, issue.Project.Settings); err != nil {
+		return err
+	}
+	return s.validateLabelsProject(issue.Labels, issue.ProjectID)
+}
+
+// applyDefaults applies project default status and default labels to new issue
+func (s *issueService) applyDefaults(issue *Issue) {
+	if issue.Status == IssueStatusDefault {
+		issue.Status = issue.Project.Settings.DefaultStatus
+	}
+	if len(issue.Labels) == 0 && len(issue.Project.DefaultLabels) > 0 {
+		issue.Labels = append([]Label{}, issue.Project.DefaultLabels...)
+	}
+}
+
+// validateProject validates if project accepts issue changes
+func (s *issueService) validateProject(project Project) error {
+	if project.Archived {
+		return NewForbiddenError("project is archived")
 	}
 	return nil
 }
 
-// Add to add new issue
+// Add to add new issue, project defaults are applied before validation and due date is derived
+// from SLA policy of project, reporter and assignee start watching issue
 func (s *issueService) Add(issue *Issue) (*Issue, error) {
-	if err := s.validateLabels(issue.Labels); err != nil {
+	s.applyDefaults(issue)
+	issue.Reporter, issue.Assignee = getNotificationUser(issue.Reporter), getNotificationUser(issue.Assignee)
+	if err := s.validateIssue(*issue); err != nil {
 		return nil, err
 	}
+	issue.ApplySLA(time.Now())
 
 	item, err := s.repository.Add(issue)
 	if err != nil {
@@ -55,11 +157,14 @@ func (s *issueService) Add(issue *Issue) (*Issue, error) {
 	return item, nil
 }
 
-// Update to update issue
+// Update to update issue, due date is derived again as priority or status could change, new reporter
+// or assignee starts watching issue
 func (s *issueService) Update(issue Issue) (Issue, error) {
-	if err := s.validateLabels(issue.Labels); err != nil {
+	issue.Reporter, issue.Assignee = getNotificationUser(issue.Reporter), getNotificationUser(issue.Assignee)
+	if err := s.validateIssue(issue); err != nil {
 		return issue, err
 	}
+	issue.ApplySLA(time.Now())
 
 	item, err := s.repository.Update(issue)
 	if err != nil {
@@ -68,6 +173,91 @@ func (s *issueService) Update(issue Issue) (Issue, error) {
 	return item, nil
 }
 
+// BulkUpdate to apply operation to issues in single transaction, issues moved to another project are
+// validated against target project and their moves are recorded as by Move
+func (s *issueService) BulkUpdate(issues []Issue, operation IssueBulkOperation) ([]IssueBulkResult, error) {
+	results := make([]IssueBulkResult, len(issues))
+	moves := []IssueMove{}
+	valid := true
+	for i := range issues {
+		source := issues[i].Project
+		sourceID := issues[i].ProjectID
+		operation.Apply(&issues[i])
+		if issues[i].ProjectID != sourceID {
+			moves = append(moves, IssueMove{
+				IssueID:       issues[i].ID,
+				FromProjectID: sourceID,
+				ToProjectID:   issues[i].ProjectID,
+			})
+		}
+		results[i] = IssueBulkResult{
+			ID:     issues[i].ID,
+			Status: true,
+		}
+		err := s.validateProject(source)
+		if err == nil {
+			err = s.validateIssue(issues[i])
+		}
+		if err != nil {
+			results[i].Status = false
+			results[i].Error = err.Error()
+			valid = false
+		}
+		issues[i].ApplySLA(time.Now())
+	}
+
+	if !valid {
+		for i := range results {
+			if results[i].Status {
+				results[i].Status = false
+				results[i].Error = "not applied"
+			}
+		}
+		return results, NewValidationError("bulk operation not valid")
+	}
+
+	if _, err := s.repository.UpdateBulk(issues, moves); err != nil {
+		for i := range results {
+			results[i].Status = false
+			results[i].Error = err.Error()
+		}
+		return results, err
+	}
+
+	return results, nil
+}
+
+// Move to move issue to another project
+func (s *issueService) Move(issue Issue, project Project) (Issue, error) {
+	if project.ID == 0 {
+		return issue, NewValidationError("target project not valid")
+	}
+	if issue.ProjectID == project.ID {
+		return issue, NewValidationError("issue already belongs to target project")
+	}
+	if err := s.validateProject(issue.Project); err != nil {
+		return issue, err
+	}
+	if err := s.validateProject(project); err != nil {
+		return issue, NewForbiddenError("target project is archived")
+	}
+	if err := s.validateLabelsProject(issue.Labels, project.ID); err != nil {
+		return issue, err
+	}
+	if err := s.validateSettings(issue, project.Settings); err != nil {
+		return issue, err
+	}
+	if err := s.validateLabels(issue.Labels, project.Settings); err != nil {
+		return issue, err
+	}
+
+	item, err := s.repository.Move(issue, project)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
 // FindByID to find issue by ID
 func (s *issueService) FindByID(id uint) (Issue, error) {
 	item, err := s.repository.FindByID(id)
@@ -77,9 +267,97 @@ func (s *issueService) FindByID(id uint) (Issue, error) {
 	return item, nil
 }
 
-// Find to find issues
-func (s *issueService) Find(title string, projectID uint, labels []string) ([]Issue, error) {
-	items, err := s.repository.Find(title, projectID, labels)
+// FindByProjectAndID to find issue by project and ID, following moves from that project
+func (s *issueService) FindByProjectAndID(projectID uint, id uint) (Issue, error) {
+	item, err := s.repository.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+	if item.ProjectID == projectID {
+		return item, nil
+	}
+
+	moves, err := s.repository.FindMoves(id)
+	if err != nil {
+		return Issue{}, err
+	}
+	for _, move := range moves {
+		if move.FromProjectID == projectID {
+			return item, nil
+		}
+	}
+	return Issue{}, NewNotFoundError("record not found")
+}
+
+// SetCustomFields to set values of custom fields of issue project, empty value removes value of field
+func (s *issueService) SetCustomFields(issue Issue, fields []CustomField, values map[string]string) (Issue, error) {
+	if err := s.validateProject(issue.Project); err != nil {
+		return issue, err
+	}
+	customFields := []CustomFieldValue{}
+	for _, value := range issue.CustomFields {
+		if _, ok := values[value.Field.Name]; !ok {
+			customFields = append(customFields, value)
+		}
+	}
+	for name, value := range values {
+		field, err := s.findCustomField(fields, name, issue.ProjectID)
+		if err != nil {
+			return issue, err
+		}
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		normalized, err := field.Validate(value)
+		if err != nil {
+			return issue, err
+		}
+		customFields = append(customFields, CustomFieldValue{
+			IssueID: issue.ID,
+			FieldID: field.ID,
+			Field:   field,
+			Value:   normalized,
+		})
+	}
+	sort.Slice(customFields, func(i, j int) bool {
+		return customFields[i].FieldID < customFields[j].FieldID
+	})
+	issue.CustomFields = customFields
+
+	item, err := s.repository.UpdateCustomFields(issue)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// findCustomField to find custom field of project by name
+func (s *issueService) findCustomField(fields []CustomField, name string, projectID uint) (CustomField, error) {
+	for _, field := range fields {
+		if field.Name == name && field.ProjectID == projectID {
+			return field, nil
+		}
+	}
+	return CustomField{}, NewValidationError("custom field %s does not belong to issue project", name)
+}
+
+// Find to find issues, custom field filters are validated and normalized against their fields
+func (s *issueService) Find(title string, projectID uint, labels []string, customFields []CustomFieldFilter, options IssueFindOptions) ([]Issue, error) {
+	if err := options.Validate(); err != nil {
+		return []Issue{}, err
+	}
+	for i, filter := range customFields {
+		if projectID == 0 || filter.Field.ProjectID != projectID {
+			return []Issue{}, NewValidationError("custom field %s does not belong to project", filter.Field.Name)
+		}
+		value, err := filter.Field.Validate(filter.Value)
+		if err != nil {
+			return []Issue{}, err
+		}
+		customFields[i].Value = value
+	}
+
+	items, err := s.repository.Find(title, projectID, labels, customFields, options)
 	if err != nil {
 		return items, err
 	}
@@ -95,11 +373,222 @@ func (s *issueService) FindAll() ([]Issue, error) {
 	return items, nil
 }
 
-// Remove to remove issue
-func (s *issueService) Remove(id uint) (bool, error) {
-	status, err := s.repository.Remove(id)
+// Remove to remove issue, issues of archived project cannot be removed
+func (s *issueService) Remove(issue Issue) (bool, error) {
+	if err := s.validateProject(issue.Project); err != nil {
+		return false, err
+	}
+
+	status, err := s.repository.Remove(issue.ID)
 	if err != nil {
 		return status, err
 	}
 	return status, nil
 }
+
+// MigratePriority to write priority raised by migration of priority labels and to remove migrated labels of
+// issue, other fields and update time of issue are not changed
+func (s *issueService) MigratePriority(issue Issue, labels []Label) (Issue, error) {
+	item, err := s.repository.MigratePriority(issue, labels)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// UpdateSLA to write due date and SLA status of issue derived by CheckSLA, only due date and SLA status are
+// written, so update time and concurrent changes of issue are kept
+func (s *issueService) UpdateSLA(issue Issue) (Issue, error) {
+	item, err := s.repository.UpdateSLA(issue)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// SetEstimates to set original and remaining estimate of issue, default remaining estimate is original
+// estimate reduced by time spent
+func (s *issueService) SetEstimates(issue Issue, originalEstimate int, remainingEstimate int) (Issue, error) {
+	if err := s.validateProject(issue.Project); err != nil {
+		return issue, err
+	}
+	if originalEstimate < 0 {
+		return issue, NewValidationError("original estimate not valid")
+	}
+	if remainingEstimate == EstimateDefault {
+		remainingEstimate = originalEstimate - issue.TimeSpent
+		if remainingEstimate < 0 {
+			remainingEstimate = 0
+		}
+	} else if remainingEstimate < 0 {
+		return issue, NewValidationError("remaining estimate not valid")
+	}
+	issue.OriginalEstimate = originalEstimate
+	issue.RemainingEstimate = remainingEstimate
+
+	item, err := s.repository.SetEstimates(issue)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// AddWorkLog to log time spent on issue, time is added to time spent and subtracted from remaining
+// estimate of issue, work is logged now when time of work is not provided
+func (s *issueService) AddWorkLog(issue Issue, workLog *WorkLog) (*WorkLog, error) {
+	if err := s.validateProject(issue.Project); err != nil {
+		return nil, err
+	}
+	workLog.Author = strings.TrimSpace(workLog.Author)
+	if workLog.Author == "" {
+		return nil, NewValidationError("author not provided")
+	}
+	if workLog.Minutes <= 0 {
+		return nil, NewValidationError("time spent not valid")
+	}
+	if workLog.LoggedAt.IsZero() {
+		workLog.LoggedAt = time.Now()
+	}
+	workLog.IssueID = issue.ID
+	issue.TimeSpent += workLog.Minutes
+	issue.RemainingEstimate -= workLog.Minutes
+	if issue.RemainingEstimate < 0 {
+		issue.RemainingEstimate = 0
+	}
+
+	item, err := s.repository.AddWorkLog(workLog, issue)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// RemoveWorkLog to remove work log of issue, time is subtracted from time spent of issue, remaining
+// estimate is kept
+func (s *issueService) RemoveWorkLog(issue Issue, workLog WorkLog) (bool, error) {
+	if workLog.IssueID != issue.ID {
+		return false, NewValidationError("work log does not belong to issue")
+	}
+	if err := s.validateProject(issue.Project); err != nil {
+		return false, err
+	}
+	issue.TimeSpent -= workLog.Minutes
+	if issue.TimeSpent < 0 {
+		issue.TimeSpent = 0
+	}
+
+	status, err := s.repository.RemoveWorkLog(workLog, issue)
+	if err != nil {
+		return false, err
+	}
+	return status, nil
+}
+
+// FindWorkLogByID to find work log by ID
+func (s *issueService) FindWorkLogByID(id uint) (WorkLog, error) {
+	item, err := s.repository.FindWorkLogByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindWorkLogs to find work logs of issue
+func (s *issueService) FindWorkLogs(issueID uint) ([]WorkLog, error) {
+	items, err := s.repository.FindWorkLogs(issueID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindTimeSummary to sum estimates and time spent of issues of project
+func (s *issueService) FindTimeSummary(projectID uint) (TimeSummary, error) {
+	item, err := s.repository.SumTime(projectID)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindTimesheet to sum time logged in time range per author and week, all projects are included
+// when project is not provided
+func (s *issueService) FindTimesheet(projectID uint, from time.Time, to time.Time) ([]TimesheetEntry, error) {
+	if !to.After(from) {
+		return []TimesheetEntry{}, NewValidationError("time range not valid")
+	}
+	workLogs, err := s.repository.FindProjectWorkLogs(projectID, from, to)
+	if err != nil {
+		return []TimesheetEntry{}, err
+	}
+	return NewTimesheet(workLogs), nil
+}
+
+// AddAttachment to attach file to issue, filename is reduced to base name, size, content type and
+// SHA-256 checksum are derived from content
+func (s *issueService) AddAttachment(issue Issue, attachment *Attachment, content []byte) (*Attachment, error) {
+	if err := s.validateProject(issue.Project); err != nil {
+		return nil, err
+	}
+	attachment.Filename = getAttachmentFilename(attachment.Filename)
+	if attachment.Filename == "" {
+		return nil, NewValidationError("filename not provided")
+	}
+	if len(content) == 0 {
+		return nil, NewValidationError("attachment is empty")
+	}
+	if int64(len(content)) > MaxAttachmentSize {
+		return nil, NewValidationError("attachment exceeds %d bytes", MaxAttachmentSize)
+	}
+	attachment.IssueID = issue.ID
+	attachment.setContent(content)
+
+	item, err := s.repository.AddAttachment(attachment, content)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// RemoveAttachment to remove attachment of issue together with its file
+func (s *issueService) RemoveAttachment(issue Issue, attachment Attachment) (bool, error) {
+	if attachment.IssueID != issue.ID {
+		return false, NewValidationError("attachment does not belong to issue")
+	}
+	if err := s.validateProject(issue.Project); err != nil {
+		return false, err
+	}
+
+	status, err := s.repository.RemoveAttachment(attachment)
+	if err != nil {
+		return false, err
+	}
+	return status, nil
+}
+
+// FindAttachmentByID to find attachment by ID
+func (s *issueService) FindAttachmentByID(id uint) (Attachment, error) {
+	item, err := s.repository.FindAttachmentByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAttachments to find attachments of issue
+func (s *issueService) FindAttachments(issueID uint) ([]Attachment, error) {
+	items, err := s.repository.FindAttachments(issueID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// ReadAttachment to read content of attachment
+func (s *issueService) ReadAttachment(attachment Attachment) ([]byte, error) {
+	content, err := s.repository.ReadAttachment(attachment)
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
@@ -3,9 +3,11 @@ package domain_test
 import (
 	"errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go-issue-tracker/pkg/domain"
 	dTesting "go-issue-tracker/pkg/domain/testing"
 	"testing"
+	"time"
 )
 
 var testLabels = []domain.Label{
@@ -180,6 +182,311 @@ func TestDomainIssueUpdateValidateLabelsErr(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
+func TestDomainIssueBulkUpdate(t *testing.T) {
+	issues := []domain.Issue{
+		{
+			ID:     1,
+			Status: 1,
+		},
+		{
+			ID:     2,
+			Status: 1,
+		},
+	}
+	status := 2
+	op := domain.IssueBulkOperation{
+		Status: &status,
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("UpdateBulk", issues, []domain.IssueMove{}).Return(issues, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	results, err := s.BulkUpdate(issues, op)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{
+		{ID: 1, Status: true},
+		{ID: 2, Status: true},
+	}, results)
+	assert.Equal(t, 2, issues[0].Status)
+	assert.Equal(t, 2, issues[1].Status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueBulkUpdateMove(t *testing.T) {
+	p := domain.Project{
+		ID: 2,
+	}
+	issues := []domain.Issue{
+		{
+			ID:        1,
+			ProjectID: 1,
+			CustomFields: []domain.CustomFieldValue{
+				{ID: 1, IssueID: 1, FieldID: 1, Value: "test-value"},
+			},
+		},
+		{
+			ID:        2,
+			ProjectID: 2,
+			Project:   p,
+		},
+	}
+	moves := []domain.IssueMove{
+		{IssueID: 1, FromProjectID: 1, ToProjectID: 2},
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("UpdateBulk", mock.Anything, moves).Return(issues, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	results, err := s.BulkUpdate(issues, domain.IssueBulkOperation{Project: &p})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{
+		{ID: 1, Status: true},
+		{ID: 2, Status: true},
+	}, results)
+	assert.Equal(t, uint(2), issues[0].ProjectID)
+	assert.Empty(t, issues[0].CustomFields)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueBulkUpdateMoveArchivedErr(t *testing.T) {
+	p := domain.Project{
+		ID:       2,
+		Archived: true,
+	}
+	issues := []domain.Issue{
+		{
+			ID:        1,
+			ProjectID: 1,
+		},
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+
+	s := domain.GetDefaultIssueService(m)
+
+	results, err := s.BulkUpdate(issues, domain.IssueBulkOperation{Project: &p})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{
+		{ID: 1, Status: false, Error: "project is archived"},
+	}, results)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueBulkUpdateErr(t *testing.T) {
+	issues := []domain.Issue{
+		{
+			ID: 1,
+		},
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("UpdateBulk", issues, []domain.IssueMove{}).Return(issues, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	results, err := s.BulkUpdate(issues, domain.IssueBulkOperation{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{
+		{ID: 1, Status: false, Error: "test error"},
+	}, results)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueBulkUpdateValidateLabelsErr(t *testing.T) {
+	issues := []domain.Issue{
+		{
+			ID: 1,
+		},
+		{
+			ID:     2,
+			Labels: testLabels[:10],
+		},
+	}
+	op := domain.IssueBulkOperation{
+		AddLabels: testLabels[10:],
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+
+	s := domain.GetDefaultIssueService(m)
+
+	results, err := s.BulkUpdate(issues, op)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{
+		{ID: 1, Status: false, Error: "not applied"},
+		{ID: 2, Status: false, Error: "max. 10 labels can be assigned to issue"},
+	}, results)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueMove(t *testing.T) {
+	p := domain.Project{
+		ID: 2,
+	}
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 1,
+	}
+	moved := domain.Issue{
+		ID:        1,
+		ProjectID: 2,
+		Project:   p,
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("Move", i, p).Return(moved, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.Move(i, p)
+
+	assert.Nil(t, err)
+	assert.Equal(t, moved, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueMoveValueErrs(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 1,
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+
+	s := domain.GetDefaultIssueService(m)
+
+	tests := []struct {
+		project domain.Project
+		err     string
+	}{
+		{domain.Project{}, "target project not valid"},
+		{domain.Project{ID: 1}, "issue already belongs to target project"},
+		{domain.Project{ID: 2, Archived: true}, "target project is archived"},
+	}
+
+	for _, ts := range tests {
+		item, err := s.Move(i, ts.project)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+		assert.Equal(t, i, item)
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueMoveErr(t *testing.T) {
+	p := domain.Project{
+		ID: 2,
+	}
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 1,
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("Move", i, p).Return(i, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.Move(i, p)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, i, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindByProjectAndID(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 2,
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindByID", i.ID).Return(i, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.FindByProjectAndID(2, i.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindByProjectAndIDMoved(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 2,
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindByID", i.ID).Return(i, nil)
+	m.On("FindMoves", i.ID).Return([]domain.IssueMove{
+		{IssueID: 1, FromProjectID: 1, ToProjectID: 2},
+	}, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.FindByProjectAndID(1, i.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindByProjectAndIDNotFound(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 2,
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindByID", i.ID).Return(i, nil)
+	m.On("FindMoves", i.ID).Return([]domain.IssueMove{}, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.FindByProjectAndID(3, i.ID)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "record not found", err.Error())
+	assert.Equal(t, uint(0), item.ID)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindByProjectAndIDErr(t *testing.T) {
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindByID", uint(1)).Return(domain.Issue{}, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	_, err := s.FindByProjectAndID(1, uint(1))
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
 func TestDomainIssueFindByID(t *testing.T) {
 	i := domain.Issue{
 		ID:          1,
@@ -223,11 +530,11 @@ func TestDomainIssueFind(t *testing.T) {
 	v := []domain.Issue{}
 
 	m := new(dTesting.IssueRepositoryMock)
-	m.On("Find", "test", uint(1), []string{"test1", "test2"}).Return(v, nil)
+	m.On("Find", "test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(v, nil)
 
 	s := domain.GetDefaultIssueService(m)
 
-	items, err := s.Find("test", uint(1), []string{"test1", "test2"})
+	items, err := s.Find("test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{})
 
 	assert.Nil(t, err)
 	assert.NotNil(t, items)
@@ -240,11 +547,11 @@ func TestDomainIssueFindErr(t *testing.T) {
 	v := []domain.Issue{}
 
 	m := new(dTesting.IssueRepositoryMock)
-	m.On("Find", "test", uint(1), []string{"test1", "test2"}).Return(v, errors.New("test error"))
+	m.On("Find", "test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(v, errors.New("test error"))
 
 	s := domain.GetDefaultIssueService(m)
 
-	items, err := s.Find("test", uint(1), []string{"test1", "test2"})
+	items, err := s.Find("test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{})
 
 	assert.NotNil(t, err)
 	assert.Equal(t, v, items)
@@ -252,63 +559,1012 @@ func TestDomainIssueFindErr(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
-func TestDomainIssueFindAll(t *testing.T) {
+func TestDomainIssueFindCustomFields(t *testing.T) {
 	v := []domain.Issue{}
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "story points", Type: domain.CustomFieldTypeNumber}
 
 	m := new(dTesting.IssueRepositoryMock)
-	m.On("FindAll").Return(v, nil)
+	m.On("Find", "", uint(1), []string{}, []domain.CustomFieldFilter{{Field: f, Value: "3"}}, domain.IssueFindOptions{}).Return(v, nil)
 
 	s := domain.GetDefaultIssueService(m)
 
-	items, err := s.FindAll()
+	items, err := s.Find("", uint(1), []string{}, []domain.CustomFieldFilter{{Field: f, Value: " 3.0 "}}, domain.IssueFindOptions{})
 
 	assert.Nil(t, err)
-	assert.NotNil(t, items)
 	assert.Equal(t, v, items)
 
 	m.AssertExpectations(t)
 }
 
-func TestDomainIssueFindAllErr(t *testing.T) {
-	v := []domain.Issue{}
+func TestDomainIssueFindCustomFieldsErrs(t *testing.T) {
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "story points", Type: domain.CustomFieldTypeNumber}
+
+	tests := []struct {
+		projectID uint
+		filter    domain.CustomFieldFilter
+		err       string
+	}{
+		{uint(0), domain.CustomFieldFilter{Field: f, Value: "3"}, "custom field story points does not belong to project"},
+		{uint(2), domain.CustomFieldFilter{Field: f, Value: "3"}, "custom field story points does not belong to project"},
+		{uint(1), domain.CustomFieldFilter{Field: f, Value: "three"}, "story points value is not a number"},
+	}
 
 	m := new(dTesting.IssueRepositoryMock)
-	m.On("FindAll").Return(v, errors.New("test error"))
+	s := domain.GetDefaultIssueService(m)
+
+	for _, ts := range tests {
+		items, err := s.Find("", ts.projectID, []string{}, []domain.CustomFieldFilter{ts.filter}, domain.IssueFindOptions{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+		assert.Equal(t, 0, len(items))
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindOptions(t *testing.T) {
+	v := []domain.Issue{{ID: 2, Priority: domain.PriorityUrgent}, {ID: 1, Priority: domain.PriorityHigh}}
+	o := domain.IssueFindOptions{MinPriority: domain.PriorityHigh, MinSeverity: domain.SeverityMinor, Sort: domain.IssueSortPriority}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("Find", "", uint(1), []string{}, []domain.CustomFieldFilter{}, o).Return(v, nil)
 
 	s := domain.GetDefaultIssueService(m)
 
-	items, err := s.FindAll()
+	items, err := s.Find("", uint(1), []string{}, []domain.CustomFieldFilter{}, o)
 
-	assert.NotNil(t, err)
+	assert.Nil(t, err)
 	assert.Equal(t, v, items)
 
 	m.AssertExpectations(t)
 }
 
-func TestDomainIssueRemove(t *testing.T) {
+func TestDomainIssueFindOptionsErrs(t *testing.T) {
+	tests := []struct {
+		options domain.IssueFindOptions
+		err     string
+	}{
+		{domain.IssueFindOptions{MinPriority: 5}, "priority 5 not valid"},
+		{domain.IssueFindOptions{MinSeverity: -1}, "severity -1 not valid"},
+		{domain.IssueFindOptions{MinSLAStatus: 3}, "sla status 3 not valid"},
+		{domain.IssueFindOptions{Sort: "title"}, "sort title not valid"},
+	}
+
 	m := new(dTesting.IssueRepositoryMock)
-	m.On("Remove", uint(1)).Return(true, nil)
+	s := domain.GetDefaultIssueService(m)
+
+	for _, ts := range tests {
+		items, err := s.Find("", uint(1), []string{}, []domain.CustomFieldFilter{}, ts.options)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+		assert.Equal(t, 0, len(items))
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueParseLevels(t *testing.T) {
+	priority, err := domain.ParsePriority(" High ")
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.PriorityHigh, priority)
+
+	severity, err := domain.ParseSeverity("4")
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.SeverityBlocker, severity)
+
+	_, err = domain.ParsePriority("p1")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "priority p1 not valid", err.Error())
+
+	_, err = domain.ParseSeverity("5")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "severity 5 not valid", err.Error())
+}
+
+func TestDomainIssueSetLevels(t *testing.T) {
+	priorities, severities := domain.PriorityLevels, domain.SeverityLevels
+	defer func() {
+		domain.PriorityLevels, domain.SeverityLevels = priorities, severities
+	}()
+
+	err := domain.SetPriorityLevels("None, P3, P2, P1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"none", "p3", "p2", "p1"}, domain.PriorityLevels)
+
+	priority, err := domain.ParsePriority("p1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, priority)
+
+	err = domain.SetSeverityLevels("none,cosmetic,functional")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"none", "cosmetic", "functional"}, domain.SeverityLevels)
+
+	tests := []struct {
+		levels string
+		err    string
+	}{
+		{"none", "at least 2 priority levels must be provided"},
+		{"none,1", "priority level 1 not valid"},
+		{"none,very high", "priority level very high not valid"},
+		{"none,low,Low", "priority level low is not unique"},
+	}
+	for _, ts := range tests {
+		err := domain.SetPriorityLevels(ts.levels)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+		assert.Equal(t, []string{"none", "p3", "p2", "p1"}, domain.PriorityLevels)
+	}
+}
+
+func TestDomainIssueMigratePriorityLabels(t *testing.T) {
+	bug := domain.Label{ID: 1, Name: "bug"}
+	low := domain.Label{ID: 2, Name: "priority:low"}
+	high := domain.Label{ID: 3, Name: "Priority:High"}
+	unknown := domain.Label{ID: 4, Name: "priority:p1"}
+
+	tests := []struct {
+		issue    domain.Issue
+		migrated domain.Issue
+		removed  []domain.Label
+		ok       bool
+	}{
+		{
+			domain.Issue{ID: 1, Labels: []domain.Label{bug, low, high}},
+			domain.Issue{ID: 1, Labels: []domain.Label{bug}, Priority: domain.PriorityHigh},
+			[]domain.Label{low, high},
+			true,
+		},
+		{
+			domain.Issue{ID: 2, Labels: []domain.Label{bug}},
+			domain.Issue{ID: 2, Labels: []domain.Label{bug}},
+			[]domain.Label{},
+			false,
+		},
+		{
+			domain.Issue{ID: 3, Labels: []domain.Label{unknown, low}, Priority: domain.PriorityUrgent},
+			domain.Issue{ID: 3, Labels: []domain.Label{unknown}, Priority: domain.PriorityUrgent},
+			[]domain.Label{low},
+			true,
+		},
+	}
+
+	for _, ts := range tests {
+		labels := append([]domain.Label{}, ts.issue.Labels...)
+
+		migrated, removed, ok := ts.issue.MigratePriorityLabels()
+
+		assert.Equal(t, ts.migrated, migrated)
+		assert.Equal(t, ts.removed, removed)
+		assert.Equal(t, ts.ok, ok)
+		assert.Equal(t, labels, ts.issue.Labels)
+	}
+}
+
+func TestDomainIssueMigratePriority(t *testing.T) {
+	i := domain.Issue{ID: 1, Priority: domain.PriorityHigh}
+	labels := []domain.Label{{ID: 2, Name: "priority:high"}}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("MigratePriority", i, labels).Return(i, nil).Once()
+	m.On("MigratePriority", i, labels).Return(i, errors.New("test error")).Once()
 
 	s := domain.GetDefaultIssueService(m)
 
-	status, err := s.Remove(uint(1))
+	item, err := s.MigratePriority(i, labels)
 
 	assert.Nil(t, err)
-	assert.True(t, status)
+	assert.Equal(t, i, item)
+
+	_, err = s.MigratePriority(i, labels)
+
+	assert.NotNil(t, err)
 
 	m.AssertExpectations(t)
 }
 
-func TestDomainIssueRemoveErr(t *testing.T) {
+func TestDomainIssueApplySLA(t *testing.T) {
+	createdAt := time.Date(2020, time.January, 1, 10, 0, 0, 0, time.UTC)
+	dueAt := createdAt.Add(4 * time.Hour)
+	settings := domain.ProjectSettings{SLAPolicy: "urgent:4h", SLAAtRisk: "30m", SLAResolvedStatuses: "3"}
+
+	tests := []struct {
+		priority  int
+		status    int
+		now       time.Time
+		dueAt     *time.Time
+		slaStatus int
+	}{
+		{domain.PriorityUrgent, 1, createdAt.Add(time.Hour), &dueAt, domain.SLAStatusNone},
+		{domain.PriorityUrgent, 1, dueAt.Add(-30 * time.Minute), &dueAt, domain.SLAStatusAtRisk},
+		{domain.PriorityUrgent, 1, dueAt, &dueAt, domain.SLAStatusBreached},
+		{domain.PriorityUrgent, 3, dueAt.Add(time.Hour), &dueAt, domain.SLAStatusNone},
+		{domain.PriorityHigh, 1, dueAt.Add(time.Hour), nil, domain.SLAStatusNone},
+	}
+
+	for _, ts := range tests {
+		i := domain.Issue{
+			Status:    ts.status,
+			Priority:  ts.priority,
+			SLAStatus: domain.SLAStatusBreached,
+			Project:   domain.Project{Settings: settings},
+			CreatedAt: createdAt,
+		}
+
+		i.ApplySLA(ts.now)
+
+		assert.Equal(t, ts.dueAt, i.DueAt)
+		assert.Equal(t, ts.slaStatus, i.SLAStatus)
+	}
+}
+
+func TestDomainIssueAddSLA(t *testing.T) {
+	i := &domain.Issue{
+		Title:    "test-title",
+		Priority: domain.PriorityHigh,
+		Project:  domain.Project{Settings: domain.ProjectSettings{SLAPolicy: "high:24h"}},
+	}
+
 	m := new(dTesting.IssueRepositoryMock)
-	m.On("Remove", uint(1)).Return(false, errors.New("test error"))
+	m.On("Add", i).Return(i, nil)
 
 	s := domain.GetDefaultIssueService(m)
 
-	status, err := s.Remove(uint(1))
+	item, err := s.Add(i)
 
-	assert.NotNil(t, err)
-	assert.False(t, status)
+	assert.Nil(t, err)
+	assert.NotNil(t, item.DueAt)
+	assert.Equal(t, 24*time.Hour, item.DueAt.Sub(item.CreatedAt))
+	assert.Equal(t, domain.SLAStatusNone, item.SLAStatus)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueCheckSLA(t *testing.T) {
+	now := time.Date(2020, time.January, 2, 10, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-5 * time.Hour)
+	dueAt := createdAt.Add(4 * time.Hour)
+	p := domain.Project{Settings: domain.ProjectSettings{SLAPolicy: "urgent:4h"}}
+
+	tests := []struct {
+		issue   domain.Issue
+		checked domain.Issue
+		changed bool
+	}{
+		{
+			domain.Issue{ID: 1, Priority: domain.PriorityUrgent, Project: p, CreatedAt: createdAt, DueAt: &dueAt, SLAStatus: domain.SLAStatusBreached},
+			domain.Issue{ID: 1, Priority: domain.PriorityUrgent, Project: p, CreatedAt: createdAt, DueAt: &dueAt, SLAStatus: domain.SLAStatusBreached},
+			false,
+		},
+		{
+			domain.Issue{ID: 2, Priority: domain.PriorityUrgent, Project: p, CreatedAt: createdAt, DueAt: &dueAt, SLAStatus: domain.SLAStatusAtRisk},
+			domain.Issue{ID: 2, Priority: domain.PriorityUrgent, Project: p, CreatedAt: createdAt, DueAt: &dueAt, SLAStatus: domain.SLAStatusBreached},
+			true,
+		},
+		{
+			domain.Issue{ID: 3, Priority: domain.PriorityLow, Project: p, CreatedAt: createdAt, DueAt: &dueAt},
+			domain.Issue{ID: 3, Priority: domain.PriorityLow, Project: p, CreatedAt: createdAt},
+			true,
+		},
+		{
+			domain.Issue{ID: 4, Priority: domain.PriorityLow, Project: p, CreatedAt: createdAt},
+			domain.Issue{ID: 4, Priority: domain.PriorityLow, Project: p, CreatedAt: createdAt},
+			false,
+		},
+	}
+
+	for _, ts := range tests {
+		checked, changed := ts.issue.CheckSLA(now)
+
+		assert.Equal(t, ts.checked, checked)
+		assert.Equal(t, ts.changed, changed)
+	}
+}
+
+func TestDomainIssueUpdateSLA(t *testing.T) {
+	i := domain.Issue{ID: 1, SLAStatus: domain.SLAStatusBreached}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("UpdateSLA", i).Return(i, nil).Once()
+	m.On("UpdateSLA", i).Return(i, errors.New("test error")).Once()
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.UpdateSLA(i)
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, item)
+
+	_, err = s.UpdateSLA(i)
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueSetEstimates(t *testing.T) {
+	i := domain.Issue{ID: 1, TimeSpent: 90}
+	tests := []struct {
+		originalEstimate  int
+		remainingEstimate int
+		expected          int
+	}{
+		{480, 240, 240},
+		{480, domain.EstimateDefault, 390},
+		{60, domain.EstimateDefault, 0},
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	for _, ts := range tests {
+		expected := i
+		expected.OriginalEstimate = ts.originalEstimate
+		expected.RemainingEstimate = ts.expected
+		m.On("SetEstimates", expected).Return(expected, nil).Once()
+	}
+
+	s := domain.GetDefaultIssueService(m)
+
+	for _, ts := range tests {
+		item, err := s.SetEstimates(i, ts.originalEstimate, ts.remainingEstimate)
+
+		assert.Nil(t, err)
+		assert.Equal(t, ts.expected, item.RemainingEstimate)
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueSetEstimatesErrs(t *testing.T) {
+	i := domain.Issue{ID: 1}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("SetEstimates", mock.Anything).Return(i, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	tests := []struct {
+		issue             domain.Issue
+		originalEstimate  int
+		remainingEstimate int
+		err               string
+	}{
+		{domain.Issue{ID: 1, Project: domain.Project{Archived: true}}, 60, 60, "project is archived"},
+		{i, -1, 60, "original estimate not valid"},
+		{i, 60, -2, "remaining estimate not valid"},
+		{i, 60, 60, "test error"},
+	}
+
+	for _, ts := range tests {
+		_, err := s.SetEstimates(ts.issue, ts.originalEstimate, ts.remainingEstimate)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+}
+
+func TestDomainIssueAddWorkLog(t *testing.T) {
+	loggedAt := time.Date(2020, time.January, 6, 10, 0, 0, 0, time.UTC)
+	i := domain.Issue{ID: 1, RemainingEstimate: 60, TimeSpent: 30}
+	w := &domain.WorkLog{Author: " alice ", Minutes: 90, LoggedAt: loggedAt}
+	expected := domain.Issue{ID: 1, RemainingEstimate: 0, TimeSpent: 120}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("AddWorkLog", w, expected).Return(w, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.AddWorkLog(i, w)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.IssueID)
+	assert.Equal(t, "alice", item.Author)
+	assert.Equal(t, loggedAt, item.LoggedAt)
+
+	item, err = s.AddWorkLog(i, &domain.WorkLog{Author: "bob", Minutes: 0})
+
+	assert.Nil(t, item)
+	assert.Equal(t, "time spent not valid", err.Error())
+
+	item, err = s.AddWorkLog(i, &domain.WorkLog{Author: " ", Minutes: 30})
+
+	assert.Nil(t, item)
+	assert.Equal(t, "author not provided", err.Error())
+
+	item, err = s.AddWorkLog(domain.Issue{Project: domain.Project{Archived: true}}, &domain.WorkLog{Author: "bob", Minutes: 30})
+
+	assert.Nil(t, item)
+	assert.Equal(t, "project is archived", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueAddWorkLogErr(t *testing.T) {
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("AddWorkLog", mock.Anything, mock.Anything).Return(&domain.WorkLog{}, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	w := &domain.WorkLog{Author: "alice", Minutes: 30}
+
+	item, err := s.AddWorkLog(domain.Issue{ID: 1}, w)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+	assert.False(t, w.LoggedAt.IsZero())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueRemoveWorkLog(t *testing.T) {
+	i := domain.Issue{ID: 1, RemainingEstimate: 60, TimeSpent: 30}
+	w := domain.WorkLog{ID: 2, IssueID: 1, Minutes: 45}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("RemoveWorkLog", w, domain.Issue{ID: 1, RemainingEstimate: 60, TimeSpent: 0}).Return(true, nil).Once()
+	m.On("RemoveWorkLog", w, mock.Anything).Return(false, errors.New("test error")).Once()
+
+	s := domain.GetDefaultIssueService(m)
+
+	status, err := s.RemoveWorkLog(i, w)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = s.RemoveWorkLog(i, w)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	status, err = s.RemoveWorkLog(domain.Issue{ID: 2}, w)
+
+	assert.Equal(t, "work log does not belong to issue", err.Error())
+	assert.False(t, status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindWorkLogs(t *testing.T) {
+	w := []domain.WorkLog{{ID: 1, IssueID: 1, Minutes: 30}}
+	summary := domain.TimeSummary{OriginalEstimate: 60, RemainingEstimate: 30, TimeSpent: 30}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindWorkLogs", uint(1)).Return(w, nil)
+	m.On("FindWorkLogByID", uint(1)).Return(w[0], nil)
+	m.On("SumTime", uint(1)).Return(summary, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	items, err := s.FindWorkLogs(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, items)
+
+	item, err := s.FindWorkLogByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, w[0], item)
+
+	sum, err := s.FindTimeSummary(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, summary, sum)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindWorkLogsErrs(t *testing.T) {
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindWorkLogs", uint(1)).Return([]domain.WorkLog{}, errors.New("test error"))
+	m.On("FindWorkLogByID", uint(1)).Return(domain.WorkLog{}, errors.New("test error"))
+	m.On("SumTime", uint(1)).Return(domain.TimeSummary{}, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	_, err := s.FindWorkLogs(uint(1))
+
+	assert.NotNil(t, err)
+
+	_, err = s.FindWorkLogByID(uint(1))
+
+	assert.NotNil(t, err)
+
+	_, err = s.FindTimeSummary(uint(1))
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindTimesheet(t *testing.T) {
+	from := time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	w := []domain.WorkLog{{Author: "alice", Minutes: 30, LoggedAt: from}, {Author: "alice", Minutes: 45, LoggedAt: from.Add(time.Hour)}}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindProjectWorkLogs", uint(1), from, to).Return(w, nil).Once()
+	m.On("FindProjectWorkLogs", uint(1), from, to).Return([]domain.WorkLog{}, errors.New("test error")).Once()
+
+	s := domain.GetDefaultIssueService(m)
+
+	items, err := s.FindTimesheet(uint(1), from, to)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.TimesheetEntry{{Author: "alice", Week: "2020-W02", WeekStart: from, Minutes: 75}}, items)
+
+	_, err = s.FindTimesheet(uint(1), from, to)
+
+	assert.NotNil(t, err)
+
+	_, err = s.FindTimesheet(uint(1), to, from)
+
+	assert.Equal(t, "time range not valid", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueSetCustomFields(t *testing.T) {
+	customer := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+	environment := domain.CustomField{ID: 2, ProjectID: 1, Name: "environment", Type: domain.CustomFieldTypeMultiSelect, Options: "dev,staging,prod"}
+	version := domain.CustomField{ID: 3, ProjectID: 1, Name: "affected version", Type: domain.CustomFieldTypeSelect, Options: "1.0,1.1"}
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 1,
+		CustomFields: []domain.CustomFieldValue{
+			{ID: 1, IssueID: 1, FieldID: 1, Field: customer, Value: "ACME"},
+			{ID: 2, IssueID: 1, FieldID: 3, Field: version, Value: "1.0"},
+		},
+	}
+	expected := i
+	expected.CustomFields = []domain.CustomFieldValue{
+		{ID: 1, IssueID: 1, FieldID: 1, Field: customer, Value: "ACME"},
+		{IssueID: 1, FieldID: 2, Field: environment, Value: "staging,prod"},
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("UpdateCustomFields", expected).Return(expected, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.SetCustomFields(i, []domain.CustomField{customer, environment, version}, map[string]string{
+		"environment":      "staging, prod, staging",
+		"affected version": "",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueSetCustomFieldsErrs(t *testing.T) {
+	fields := []domain.CustomField{
+		{ID: 1, ProjectID: 1, Name: "due", Type: domain.CustomFieldTypeDate},
+		{ID: 2, ProjectID: 2, Name: "customer", Type: domain.CustomFieldTypeText},
+	}
+
+	tests := []struct {
+		issue  domain.Issue
+		values map[string]string
+		err    string
+	}{
+		{domain.Issue{ID: 1, ProjectID: 1, Project: domain.Project{ID: 1, Archived: true}}, map[string]string{"due": "2020-01-01"}, "project is archived"},
+		{domain.Issue{ID: 1, ProjectID: 1}, map[string]string{"customer": "ACME"}, "custom field customer does not belong to issue project"},
+		{domain.Issue{ID: 1, ProjectID: 1}, map[string]string{"due": "01/01/2020"}, "due value is not a date in 2006-01-02 format"},
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	s := domain.GetDefaultIssueService(m)
+
+	for _, ts := range tests {
+		_, err := s.SetCustomFields(ts.issue, fields, ts.values)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueSetCustomFieldsErr(t *testing.T) {
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+	i := domain.Issue{ID: 1, ProjectID: 1}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("UpdateCustomFields", mock.AnythingOfType("domain.Issue")).Return(i, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	_, err := s.SetCustomFields(i, []domain.CustomField{f}, map[string]string{"customer": "ACME"})
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindAll(t *testing.T) {
+	v := []domain.Issue{}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindAll").Return(v, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	items, err := s.FindAll()
+
+	assert.Nil(t, err)
+	assert.NotNil(t, items)
+	assert.Equal(t, v, items)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindAllErr(t *testing.T) {
+	v := []domain.Issue{}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindAll").Return(v, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	items, err := s.FindAll()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, v, items)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueRemove(t *testing.T) {
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("Remove", uint(1)).Return(true, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	status, err := s.Remove(domain.Issue{ID: 1})
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueRemoveErr(t *testing.T) {
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("Remove", uint(1)).Return(false, errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	status, err := s.Remove(domain.Issue{ID: 1})
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueArchivedProjectErrs(t *testing.T) {
+	ap := domain.Project{ID: 1, Archived: true}
+	i := domain.Issue{ID: 1, ProjectID: 1, Project: ap}
+
+	m := new(dTesting.IssueRepositoryMock)
+
+	s := domain.GetDefaultIssueService(m)
+
+	_, err := s.Add(&i)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project is archived", err.Error())
+
+	_, err = s.Update(i)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project is archived", err.Error())
+
+	_, err = s.Move(i, domain.Project{ID: 2})
+	assert.NotNil(t, err)
+	assert.Equal(t, "project is archived", err.Error())
+
+	_, err = s.Remove(i)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project is archived", err.Error())
+
+	results, err := s.BulkUpdate([]domain.Issue{{ID: 2}}, domain.IssueBulkOperation{Project: &ap})
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{
+		{ID: 2, Status: false, Error: "project is archived"},
+	}, results)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueLabelsProjectErrs(t *testing.T) {
+	l := domain.Label{ID: 1, Name: "backend", ProjectID: 2}
+	i := domain.Issue{ID: 1, ProjectID: 1, Labels: []domain.Label{l}}
+
+	m := new(dTesting.IssueRepositoryMock)
+
+	s := domain.GetDefaultIssueService(m)
+
+	_, err := s.Add(&i)
+	assert.NotNil(t, err)
+	assert.Equal(t, "backend label does not belong to issue project", err.Error())
+
+	_, err = s.Update(i)
+	assert.NotNil(t, err)
+	assert.Equal(t, "backend label does not belong to issue project", err.Error())
+
+	_, err = s.Move(domain.Issue{ID: 1, ProjectID: 2, Labels: []domain.Label{l}}, domain.Project{ID: 3})
+	assert.NotNil(t, err)
+	assert.Equal(t, "backend label does not belong to issue project", err.Error())
+
+	results, err := s.BulkUpdate([]domain.Issue{{ID: 2, ProjectID: 1}}, domain.IssueBulkOperation{AddLabels: []domain.Label{l}})
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{
+		{ID: 2, Status: false, Error: "backend label does not belong to issue project"},
+	}, results)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueExclusiveLabelGroupErr(t *testing.T) {
+	priority := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+	area := &domain.LabelGroup{ID: 2, Name: "area"}
+	i := domain.Issue{
+		ID: 1,
+		Labels: []domain.Label{
+			{ID: 1, GroupID: 2, Group: area},
+			{ID: 2, GroupID: 2, Group: area},
+			{ID: 3, GroupID: 1, Group: priority},
+			{ID: 4, GroupID: 1, Group: priority},
+		},
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+
+	s := domain.GetDefaultIssueService(m)
+
+	_, err := s.Add(&i)
+	assert.NotNil(t, err)
+	assert.Equal(t, "only one label of priority group can be assigned to issue", err.Error())
+
+	_, err = s.Update(i)
+	assert.NotNil(t, err)
+	assert.Equal(t, "only one label of priority group can be assigned to issue", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueAddProjectDefaults(t *testing.T) {
+	labels := []domain.Label{{ID: 1, Name: "bug"}}
+	p := domain.Project{
+		ID: 1,
+		Settings: domain.ProjectSettings{
+			RequiredFields:  "description,labels",
+			DefaultStatus:   2,
+			AllowedStatuses: "2,3",
+		},
+		DefaultLabels: labels,
+	}
+	i := &domain.Issue{
+		Title:       "test-title",
+		Description: "test-description",
+		Status:      domain.IssueStatusDefault,
+		ProjectID:   1,
+		Project:     p,
+	}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("Add", i).Return(i, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.Add(i)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, item.Status)
+	assert.Equal(t, labels, item.Labels)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueProjectSettingsErrs(t *testing.T) {
+	settings := domain.ProjectSettings{
+		MaxLabels:         1,
+		RequiredFields:    "description,labels",
+		AllowedStatuses:   "1,2",
+		AllowedPriorities: "low,medium,high",
+		AllowedSeverities: "none,minor",
+	}
+	p := domain.Project{ID: 1, Settings: settings}
+
+	m := new(dTesting.IssueRepositoryMock)
+
+	s := domain.GetDefaultIssueService(m)
+
+	tests := []struct {
+		issue domain.Issue
+		err   string
+	}{
+		{domain.Issue{ProjectID: 1, Project: p, Status: 1, Labels: []domain.Label{{ID: 1}}}, "description not provided"},
+		{domain.Issue{ProjectID: 1, Project: p, Status: 1, Description: "test"}, "no labels assigned"},
+		{domain.Issue{ProjectID: 1, Project: p, Status: 3, Description: "test", Labels: []domain.Label{{ID: 1}}}, "status 3 is not allowed in project"},
+		{domain.Issue{ProjectID: 1, Project: p, Status: 1, Priority: 1, Description: "test", Labels: []domain.Label{{ID: 1}, {ID: 2}}}, "max. 1 labels can be assigned to issue"},
+		{domain.Issue{ProjectID: 1, Project: p, Status: 1, Priority: 7, Description: "test", Labels: []domain.Label{{ID: 1}}}, "priority 7 not valid"},
+		{domain.Issue{ProjectID: 1, Project: p, Status: 1, Priority: domain.PriorityUrgent, Description: "test", Labels: []domain.Label{{ID: 1}}}, "priority urgent is not allowed in project"},
+		{domain.Issue{ProjectID: 1, Project: p, Status: 1, Priority: 1, Severity: -1, Description: "test", Labels: []domain.Label{{ID: 1}}}, "severity -1 not valid"},
+		{domain.Issue{ProjectID: 1, Project: p, Status: 1, Priority: 1, Severity: domain.SeverityCritical, Description: "test", Labels: []domain.Label{{ID: 1}}}, "severity critical is not allowed in project"},
+	}
+
+	for _, ts := range tests {
+		issue := ts.issue
+		_, err := s.Add(&issue)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+
+		_, err = s.Update(ts.issue)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueMoveProjectSettingsErr(t *testing.T) {
+	i := domain.Issue{ID: 1, Status: 1, ProjectID: 1, Project: domain.Project{ID: 1}}
+	p := domain.Project{ID: 2, Settings: domain.ProjectSettings{AllowedStatuses: "2"}}
+
+	m := new(dTesting.IssueRepositoryMock)
+
+	s := domain.GetDefaultIssueService(m)
+
+	_, err := s.Move(i, p)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "status 1 is not allowed in project", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueAddAttachment(t *testing.T) {
+	content := []byte("%PDF-1.4 test")
+	i := domain.Issue{ID: 1}
+	a := &domain.Attachment{Filename: "../logs/report.pdf"}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("AddAttachment", a, content).Return(a, nil)
+
+	s := domain.GetDefaultIssueService(m)
+
+	item, err := s.AddAttachment(i, a, content)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.IssueID)
+	assert.Equal(t, "report.pdf", item.Filename)
+	assert.Equal(t, int64(len(content)), item.Size)
+	assert.Equal(t, "application/pdf", item.ContentType)
+	assert.Equal(t, "d663640088750cf16276d623c2588d7233f2b84b45f4b2e20832f47b16aa5618", item.SHA256)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueAddAttachmentErrs(t *testing.T) {
+	defer func(size int64) {
+		domain.MaxAttachmentSize = size
+	}(domain.MaxAttachmentSize)
+	domain.MaxAttachmentSize = 4
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("AddAttachment", mock.Anything, mock.Anything).Return((*domain.Attachment)(nil), errors.New("test error"))
+
+	s := domain.GetDefaultIssueService(m)
+
+	tests := []struct {
+		issue    domain.Issue
+		filename string
+		content  []byte
+		err      string
+	}{
+		{domain.Issue{Project: domain.Project{Archived: true}}, "a.txt", []byte("a"), "project is archived"},
+		{domain.Issue{}, " ", []byte("a"), "filename not provided"},
+		{domain.Issue{}, "logs/..", []byte("a"), "filename not provided"},
+		{domain.Issue{}, "a.txt", []byte{}, "attachment is empty"},
+		{domain.Issue{}, "a.txt", []byte("abcde"), "attachment exceeds 4 bytes"},
+		{domain.Issue{}, "a.txt", []byte("abcd"), "test error"},
+	}
+
+	for _, ts := range tests {
+		item, err := s.AddAttachment(ts.issue, &domain.Attachment{Filename: ts.filename}, ts.content)
+
+		assert.Nil(t, item)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueRemoveAttachment(t *testing.T) {
+	i := domain.Issue{ID: 1}
+	a := domain.Attachment{ID: 2, IssueID: 1}
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("RemoveAttachment", a).Return(true, nil).Once()
+	m.On("RemoveAttachment", a).Return(false, errors.New("test error")).Once()
+
+	s := domain.GetDefaultIssueService(m)
+
+	status, err := s.RemoveAttachment(i, a)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = s.RemoveAttachment(i, a)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	status, err = s.RemoveAttachment(domain.Issue{ID: 2}, a)
+
+	assert.Equal(t, "attachment does not belong to issue", err.Error())
+	assert.False(t, status)
+
+	status, err = s.RemoveAttachment(domain.Issue{ID: 1, Project: domain.Project{Archived: true}}, a)
+
+	assert.Equal(t, "project is archived", err.Error())
+	assert.False(t, status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainIssueFindAttachments(t *testing.T) {
+	a := []domain.Attachment{{ID: 1, IssueID: 1, Filename: "log.txt"}}
+	content := []byte("log")
+
+	m := new(dTesting.IssueRepositoryMock)
+	m.On("FindAttachments", uint(1)).Return(a, nil).Once()
+	m.On("FindAttachments", uint(1)).Return([]domain.Attachment{}, errors.New("test error")).Once()
+	m.On("FindAttachmentByID", uint(1)).Return(a[0], nil).Once()
+	m.On("FindAttachmentByID", uint(1)).Return(domain.Attachment{}, errors.New("test error")).Once()
+	m.On("ReadAttachment", a[0]).Return(content, nil).Once()
+	m.On("ReadAttachment", a[0]).Return([]byte{}, errors.New("test error")).Once()
+
+	s := domain.GetDefaultIssueService(m)
+
+	items, err := s.FindAttachments(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, a, items)
+
+	_, err = s.FindAttachments(uint(1))
+
+	assert.NotNil(t, err)
+
+	item, err := s.FindAttachmentByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, a[0], item)
+
+	_, err = s.FindAttachmentByID(uint(1))
+
+	assert.NotNil(t, err)
+
+	data, err := s.ReadAttachment(a[0])
+
+	assert.Nil(t, err)
+	assert.Equal(t, content, data)
+
+	data, err = s.ReadAttachment(a[0])
+
+	assert.NotNil(t, err)
+	assert.Nil(t, data)
 
 	m.AssertExpectations(t)
 }
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SLA statuses of issue ordered by urgency, issue without due date or resolved issue has none status
+const (
+	SLAStatusNone = iota
+	SLAStatusAtRisk
+	SLAStatusBreached
+)
+
+// SLAStatusLevels are names of SLA statuses indexed by status
+var SLAStatusLevels = []string{"none", "at_risk", "breached"}
+
+// DefaultSLAAtRisk is time before due date when issue is at risk when project does not set it
+const DefaultSLAAtRisk = time.Hour
+
+// ParseSLAStatus to parse SLA status provided by name or number
+func ParseSLAStatus(value string) (int, error) {
+	return parseLevel(value, SLAStatusLevels, "sla status")
+}
+
+// IsSLAStatusValid to check if SLA status is one of SLA statuses
+func IsSLAStatusValid(status int) bool {
+	return status >= 0 && status < len(SLAStatusLevels)
+}
+
+// GetSLAPolicy to get time to resolve issue by priority, SLA policy is comma separated list
+// of priority:duration pairs, e.g. urgent:4h,high:24h
+func (s ProjectSettings) GetSLAPolicy() (map[int]time.Duration, error) {
+	policy := map[int]time.Duration{}
+	for _, pair := range strings.Split(s.SLAPolicy, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			return policy, NewValidationError("sla policy %s not valid", pair)
+		}
+		priority, err := ParsePriority(parts[0])
+		if err != nil {
+			return policy, err
+		}
+		duration, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil || duration <= 0 {
+			return policy, NewValidationError("sla policy %s not valid", pair)
+		}
+		policy[priority] = duration
+	}
+	return policy, nil
+}
+
+// GetSLAAtRisk to get time before due date when issue is at risk, DefaultSLAAtRisk when not set
+func (s ProjectSettings) GetSLAAtRisk() (time.Duration, error) {
+	if strings.TrimSpace(s.SLAAtRisk) == "" {
+		return DefaultSLAAtRisk, nil
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(s.SLAAtRisk))
+	if err != nil || duration < 0 {
+		return 0, NewValidationError("sla at risk %s not valid", s.SLAAtRisk)
+	}
+	return duration, nil
+}
+
+// GetSLAResolvedStatuses to get list of statuses of resolved issues which are not tracked by SLA
+func (s ProjectSettings) GetSLAResolvedStatuses() ([]int, error) {
+	statuses := []int{}
+	for _, value := range strings.Split(s.SLAResolvedStatuses, ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		status, err := strconv.Atoi(value)
+		if err != nil {
+			return statuses, NewValidationError("sla resolved status %s not valid", value)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// isSLAResolved to check if issue status is one of resolved statuses
+func (s ProjectSettings) isSLAResolved(status int) bool {
+	statuses, err := s.GetSLAResolvedStatuses()
+	if err != nil {
+		return false
+	}
+	for _, st := range statuses {
+		if st == status {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplySLA to derive due date of issue from SLA policy of its project and creation time and to
+// set SLA status at provided time, due date is removed when project has no policy for priority,
+// new issue gets provided time as creation time
+func (i *Issue) ApplySLA(now time.Time) {
+	i.DueAt = nil
+	i.SLAStatus = SLAStatusNone
+	policy, err := i.Project.Settings.GetSLAPolicy()
+	if err != nil {
+		return
+	}
+	duration, ok := policy[i.Priority]
+	if !ok {
+		return
+	}
+	if i.CreatedAt.IsZero() {
+		i.CreatedAt = now
+	}
+	dueAt := i.CreatedAt.Add(duration)
+	i.DueAt = &dueAt
+	if i.Project.Settings.isSLAResolved(i.Status) {
+		return
+	}
+	atRisk, err := i.Project.Settings.GetSLAAtRisk()
+	if err != nil {
+		atRisk = DefaultSLAAtRisk
+	}
+	if !now.Before(dueAt) {
+		i.SLAStatus = SLAStatusBreached
+	} else if !now.Before(dueAt.Add(-atRisk)) {
+		i.SLAStatus = SLAStatusAtRisk
+	}
+}
+
+// CheckSLA to get issue with due date and SLA status derived at provided time and whether they changed,
+// e.g. when SLA policy of project changed, issue was moved or its due date passed
+func (i Issue) CheckSLA(now time.Time) (Issue, bool) {
+	dueAt := i.DueAt
+	status := i.SLAStatus
+	i.ApplySLA(now)
+	if status != i.SLAStatus {
+		return i, true
+	}
+	return i, getDueAtValue(dueAt) != getDueAtValue(i.DueAt)
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// IssueTemplate entity owned by project, prefills title, description, status and labels of new issue, nil status
+// keeps default status of project
+type IssueTemplate struct {
+	ID          uint      `json:"id"`
+	ProjectID   uint      `json:"projectId"`
+	Name        string    `json:"name"`
+	TitlePrefix string    `json:"titlePrefix"`
+	Description string    `json:"description"`
+	Status      *int      `json:"status"`
+	Labels      []Label   `json:"labels" gorm:"many2many:issue_templates_labels;association_autoupdate:false;association_autocreate:false"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Apply to merge template with provided issue values, provided values take precedence,
+// template labels are added unless provided label of the same exclusive group exists
+func (t IssueTemplate) Apply(issue *Issue) error {
+	if t.ProjectID != issue.ProjectID {
+		return NewValidationError("template does not belong to issue project")
+	}
+	if t.TitlePrefix != "" && !strings.HasPrefix(issue.Title, t.TitlePrefix) {
+		issue.Title = t.TitlePrefix + issue.Title
+	}
+	if issue.Description == "" {
+		issue.Description = t.Description
+	}
+	if issue.Status == IssueStatusDefault && t.Status != nil {
+		issue.Status = *t.Status
+	}
+	for _, label := range t.Labels {
+		skip := false
+		for _, l := range issue.Labels {
+			exclusive := label.Group != nil && label.Group.Exclusive && l.GroupID == label.GroupID
+			if l.ID == label.ID || exclusive {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			issue.Labels = append(issue.Labels, label)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+)
+
+func TestDomainIssueTemplateApply(t *testing.T) {
+	status := 2
+	tpl := domain.IssueTemplate{
+		ID:          1,
+		ProjectID:   1,
+		Name:        "bug",
+		TitlePrefix: "[Bug] ",
+		Description: "## Steps to reproduce",
+		Status:      &status,
+		Labels: []domain.Label{
+			{ID: 2},
+			{ID: 3},
+		},
+	}
+	i := domain.Issue{
+		Title:     "test-title",
+		Status:    domain.IssueStatusDefault,
+		ProjectID: 1,
+		Labels: []domain.Label{
+			{ID: 1},
+			{ID: 2},
+		},
+	}
+
+	err := tpl.Apply(&i)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "[Bug] test-title", i.Title)
+	assert.Equal(t, "## Steps to reproduce", i.Description)
+	assert.Equal(t, 2, i.Status)
+	assert.Equal(t, []domain.Label{{ID: 1}, {ID: 2}, {ID: 3}}, i.Labels)
+}
+
+func TestDomainIssueTemplateApplyProvidedValues(t *testing.T) {
+	priority := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+	status := 2
+	tpl := domain.IssueTemplate{
+		ID:          1,
+		ProjectID:   1,
+		TitlePrefix: "[Bug] ",
+		Description: "## Steps to reproduce",
+		Status:      &status,
+		Labels: []domain.Label{
+			{ID: 2, GroupID: 1, Group: priority},
+		},
+	}
+	i := domain.Issue{
+		Title:       "[Bug] test-title",
+		Description: "test-description",
+		Status:      1,
+		ProjectID:   1,
+		Labels: []domain.Label{
+			{ID: 1, GroupID: 1, Group: priority},
+		},
+	}
+
+	err := tpl.Apply(&i)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "[Bug] test-title", i.Title)
+	assert.Equal(t, "test-description", i.Description)
+	assert.Equal(t, 1, i.Status)
+	assert.Equal(t, []domain.Label{{ID: 1, GroupID: 1, Group: priority}}, i.Labels)
+}
+
+func TestDomainIssueTemplateApplyStatus(t *testing.T) {
+	status := 0
+	tpl := domain.IssueTemplate{ProjectID: 1}
+	i := domain.Issue{Status: domain.IssueStatusDefault, ProjectID: 1}
+
+	assert.Nil(t, tpl.Apply(&i))
+	assert.Equal(t, domain.IssueStatusDefault, i.Status)
+
+	tpl.Status = &status
+
+	assert.Nil(t, tpl.Apply(&i))
+	assert.Equal(t, 0, i.Status)
+}
+
+func TestDomainIssueTemplateApplyProjectErr(t *testing.T) {
+	tpl := domain.IssueTemplate{
+		ID:        1,
+		ProjectID: 2,
+	}
+	i := domain.Issue{
+		Title:     "test-title",
+		ProjectID: 1,
+	}
+
+	err := tpl.Apply(&i)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "test-title", i.Title)
+}
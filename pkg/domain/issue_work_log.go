@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EstimateDefault marks remaining estimate which is derived from original estimate and time spent
+const EstimateDefault = -1
+
+// DefaultTimesheetDays is number of days before now included in timesheet when time range is not provided
+const DefaultTimesheetDays = 28
+
+// WorkLog entity records time spent on issue by author, time is in minutes
+type WorkLog struct {
+	ID        uint      `json:"id"`
+	IssueID   uint      `json:"issueId"`
+	Author    string    `json:"author"`
+	Minutes   int       `json:"minutes"`
+	LoggedAt  time.Time `json:"loggedAt"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TimeSummary holds estimated and spent time of issues in minutes
+type TimeSummary struct {
+	OriginalEstimate  int `json:"originalEstimate"`
+	RemainingEstimate int `json:"remainingEstimate"`
+	TimeSpent         int `json:"timeSpent"`
+}
+
+// TimesheetEntry holds time logged by author in ISO week in minutes
+type TimesheetEntry struct {
+	Author    string    `json:"author"`
+	Week      string    `json:"week"`
+	WeekStart time.Time `json:"weekStart"`
+	Minutes   int       `json:"minutes"`
+}
+
+// ParseMinutes to parse time provided as number of minutes or duration, e.g. 90 or 1h30m
+func ParseMinutes(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if minutes, err := strconv.Atoi(value); err == nil {
+		return minutes, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, NewValidationError("time %s not valid", value)
+	}
+	return int(duration / time.Minute), nil
+}
+
+// getWeekStart to get midnight of Monday of ISO week of time
+func getWeekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// NewTimesheet to sum time of work logs per author and ISO week, entries are ordered by week and author
+func NewTimesheet(workLogs []WorkLog) []TimesheetEntry {
+	entries := []TimesheetEntry{}
+	indexes := map[string]int{}
+	for _, workLog := range workLogs {
+		year, week := workLog.LoggedAt.ISOWeek()
+		name := fmt.Sprintf("%d-W%02d", year, week)
+		key := workLog.Author + "\n" + name
+		if i, ok := indexes[key]; ok {
+			entries[i].Minutes += workLog.Minutes
+			continue
+		}
+		indexes[key] = len(entries)
+		entries = append(entries, TimesheetEntry{
+			Author:    workLog.Author,
+			Week:      name,
+			WeekStart: getWeekStart(workLog.LoggedAt),
+			Minutes:   workLog.Minutes,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Week != entries[j].Week {
+			return entries[i].Week < entries[j].Week
+		}
+		return entries[i].Author < entries[j].Author
+	})
+	return entries
+}
@@ -0,0 +1,53 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+	"time"
+)
+
+func TestDomainWorkLogParseMinutes(t *testing.T) {
+	tests := []struct {
+		value   string
+		minutes int
+		err     string
+	}{
+		{"90", 90, ""},
+		{" 1h30m ", 90, ""},
+		{"45m30s", 45, ""},
+		{"1d", 0, "time 1d not valid"},
+	}
+
+	for _, ts := range tests {
+		minutes, err := domain.ParseMinutes(ts.value)
+
+		assert.Equal(t, ts.minutes, minutes)
+		if ts.err == "" {
+			assert.Nil(t, err)
+		} else {
+			assert.Equal(t, ts.err, err.Error())
+		}
+	}
+}
+
+func TestDomainWorkLogNewTimesheet(t *testing.T) {
+	// 2020-01-05 is Sunday of week 1, 2020-01-06 is Monday of week 2
+	sunday := time.Date(2020, time.January, 5, 18, 0, 0, 0, time.UTC)
+	monday := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	workLogs := []domain.WorkLog{
+		{Author: "bob", Minutes: 30, LoggedAt: monday},
+		{Author: "alice", Minutes: 60, LoggedAt: monday.AddDate(0, 0, 4)},
+		{Author: "alice", Minutes: 15, LoggedAt: sunday},
+		{Author: "alice", Minutes: 45, LoggedAt: monday},
+	}
+
+	entries := domain.NewTimesheet(workLogs)
+
+	assert.Equal(t, []domain.TimesheetEntry{
+		{Author: "alice", Week: "2020-W01", WeekStart: time.Date(2019, time.December, 30, 0, 0, 0, 0, time.UTC), Minutes: 15},
+		{Author: "alice", Week: "2020-W02", WeekStart: time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC), Minutes: 105},
+		{Author: "bob", Week: "2020-W02", WeekStart: time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC), Minutes: 30},
+	}, entries)
+	assert.Equal(t, []domain.TimesheetEntry{}, domain.NewTimesheet([]domain.WorkLog{}))
+}
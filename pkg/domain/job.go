@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// JobKindStaleLabel adds label to issues of project not updated for days
+	JobKindStaleLabel = "staleLabel"
+	// JobKindStaleClose sets status of issues of project with label not updated for days
+	JobKindStaleClose = "staleClose"
+	// JobKindRecurringIssue adds issue from template
+	JobKindRecurringIssue = "recurringIssue"
+)
+
+// JobKinds are kinds of scheduled jobs
+var JobKinds = []string{JobKindStaleLabel, JobKindStaleClose, JobKindRecurringIssue}
+
+const (
+	// JobParamDays is number of days since last update of stale issue
+	JobParamDays = "days"
+	// JobParamLabel is name of label added to or required on stale issue
+	JobParamLabel = "label"
+	// JobParamStatus is status set to stale issue
+	JobParamStatus = "status"
+	// JobParamSkipStatus is status of issues ignored by stale label job, it can be repeated
+	JobParamSkipStatus = "skipStatus"
+	// JobParamTemplateID is ID of template of recurring issue
+	JobParamTemplateID = "templateId"
+	// JobParamTitle is title of recurring issue
+	JobParamTitle = "title"
+)
+
+const (
+	// JobScheduleHourly runs job at start of every hour
+	JobScheduleHourly = "@hourly"
+	// JobScheduleDaily runs job at midnight UTC
+	JobScheduleDaily = "@daily"
+	// JobScheduleWeekly runs job at midnight UTC on Monday
+	JobScheduleWeekly = "@weekly"
+	// JobScheduleMonthly runs job at midnight UTC on first day of month
+	JobScheduleMonthly = "@monthly"
+	// JobScheduleEvery is prefix of schedule running job after duration since its last run, e.g. @every 6h
+	JobScheduleEvery = "@every "
+)
+
+const (
+	// JobRunSucceeded marks job whose last run succeeded
+	JobRunSucceeded = "succeeded"
+	// JobRunFailed marks job whose last run failed
+	JobRunFailed = "failed"
+)
+
+// JobLockDuration is time for which running job is locked for other server instances, lock of
+// instance which stopped during run expires after it
+const JobLockDuration = 10 * time.Minute
+
+// JobMinInterval is min. duration of @every schedule
+const JobMinInterval = time.Minute
+
+// Job entity is scheduled background job, params are comma separated name:value pairs
+type Job struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Kind        string    `json:"kind"`
+	ProjectID   uint      `json:"projectId"`
+	Schedule    string    `json:"schedule"`
+	Params      string    `json:"params"`
+	Active      bool      `json:"active"`
+	NextRunAt   time.Time `json:"nextRunAt"`
+	LastRunAt   time.Time `json:"lastRunAt"`
+	LastStatus  string    `json:"lastStatus"`
+	LastError   string    `json:"lastError"`
+	LastCount   int       `json:"lastCount"`
+	LockedBy    string    `json:"lockedBy"`
+	LockedUntil time.Time `json:"lockedUntil"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// JobParams are parsed params of job
+type JobParams struct {
+	Days         int
+	Label        string
+	Status       int
+	SkipStatuses []int
+	TemplateID   uint
+	Title        string
+}
+
+// GetParams to parse and validate params of job for its kind
+func (j Job) GetParams() (JobParams, error) {
+	params := JobParams{SkipStatuses: []int{}}
+	pairs, err := getAutomationPairs(j.Params, "param")
+	if err != nil {
+		return params, err
+	}
+	for _, pair := range pairs {
+		name, value := pair[0], pair[1]
+		var number int
+		var err error
+		switch name {
+		case JobParamDays, JobParamStatus, JobParamSkipStatus, JobParamTemplateID:
+			number, err = strconv.Atoi(value)
+		}
+		if err != nil {
+			return params, err
+		}
+		switch name {
+		case JobParamDays:
+			params.Days = number
+		case JobParamLabel:
+			params.Label = value
+		case JobParamStatus:
+			params.Status = number
+		case JobParamSkipStatus:
+			params.SkipStatuses = append(params.SkipStatuses, number)
+		case JobParamTemplateID:
+			params.TemplateID = uint(number)
+		case JobParamTitle:
+			params.Title = value
+		default:
+			return params, NewValidationError("param %s not valid", name)
+		}
+	}
+
+	switch j.Kind {
+	case JobKindStaleLabel, JobKindStaleClose:
+		if params.Days <= 0 {
+			return params, NewValidationError("param %s not provided", JobParamDays)
+		}
+		if params.Label == "" {
+			return params, NewValidationError("param %s not provided", JobParamLabel)
+		}
+		if j.Kind == JobKindStaleClose && params.Status == 0 {
+			return params, NewValidationError("param %s not provided", JobParamStatus)
+		}
+	case JobKindRecurringIssue:
+		if params.TemplateID == 0 {
+			return params, NewValidationError("param %s not provided", JobParamTemplateID)
+		}
+		if params.Title == "" {
+			return params, NewValidationError("param %s not provided", JobParamTitle)
+		}
+	default:
+		return params, NewValidationError("kind %s not valid", j.Kind)
+	}
+	return params, nil
+}
+
+// IsSkipped to check whether issue with status is ignored by job
+func (p JobParams) IsSkipped(status int) bool {
+	for _, item := range p.SkipStatuses {
+		if item == status {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNextRun to get time of next run of schedule after provided time, times are in UTC
+func GetNextRun(schedule string, after time.Time) (time.Time, error) {
+	after = after.UTC()
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, time.UTC)
+	switch schedule {
+	case JobScheduleHourly:
+		return after.Truncate(time.Hour).Add(time.Hour), nil
+	case JobScheduleDaily:
+		return day.AddDate(0, 0, 1), nil
+	case JobScheduleWeekly:
+		days := (8 - int(day.Weekday())) % 7
+		if days == 0 {
+			days = 7
+		}
+		return day.AddDate(0, 0, days), nil
+	case JobScheduleMonthly:
+		return time.Date(after.Year(), after.Month()+1, 1, 0, 0, 0, 0, time.UTC), nil
+	}
+	if strings.HasPrefix(schedule, JobScheduleEvery) {
+		interval, err := time.ParseDuration(strings.TrimPrefix(schedule, JobScheduleEvery))
+		if err != nil {
+			return after, NewValidationError("schedule %s not valid", schedule)
+		}
+		if interval < JobMinInterval {
+			return after, NewValidationError("schedule %s is shorter than %s", schedule, JobMinInterval)
+		}
+		return after.Add(interval), nil
+	}
+	return after, NewValidationError("schedule %s not valid", schedule)
+}
+
+// IsJobKindValid to check if kind is one of job kinds
+func IsJobKindValid(kind string) bool {
+	for _, item := range JobKinds {
+		if item == kind {
+			return true
+		}
+	}
+	return false
+}
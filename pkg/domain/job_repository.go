@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"time"
+)
+
+// JobRepository repository
+type JobRepository interface {
+	Add(job *Job) (*Job, error)
+	Update(job Job) (Job, error)
+	FindByID(id uint) (Job, error)
+	FindAll() ([]Job, error)
+	FindDue(now time.Time) ([]Job, error)
+	Lock(id uint, owner string, now time.Time, until time.Time, due bool) (bool, error)
+	Finish(job Job, owner string) (bool, error)
+	Remove(id uint) (bool, error)
+}
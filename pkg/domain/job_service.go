@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// JobService interface
+type JobService interface {
+	Add(job *Job, now time.Time) (*Job, error)
+	Update(job Job, now time.Time) (Job, error)
+	FindByID(id uint) (Job, error)
+	FindAll() ([]Job, error)
+	FindDue(now time.Time) ([]Job, error)
+	Lock(job Job, owner string, now time.Time, due bool) (bool, error)
+	Finish(job Job, owner string, now time.Time, count int, runErr error) (Job, error)
+	Remove(job Job) (bool, error)
+}
+
+// jobService struct
+type jobService struct {
+	repository JobRepository
+}
+
+// GetDefaultJobService alias to newJobService
+var GetDefaultJobService = newJobService
+
+// ResetDefaultJobService to reset GetDefaultJobService value
+func ResetDefaultJobService() {
+	GetDefaultJobService = newJobService
+}
+
+// newJobService to create new JobService
+func newJobService(repository JobRepository) JobService {
+	return &jobService{
+		repository: repository,
+	}
+}
+
+// validate to check and normalize name, kind, schedule and params of job
+func (s *jobService) validate(job *Job) error {
+	job.Name = strings.TrimSpace(job.Name)
+	if job.Name == "" {
+		return NewValidationError("name not provided")
+	}
+	if !IsJobKindValid(job.Kind) {
+		return NewValidationError("kind %s not valid", job.Kind)
+	}
+	if job.ProjectID == 0 {
+		return NewValidationError("project not provided")
+	}
+	job.Schedule = strings.TrimSpace(job.Schedule)
+	if _, err := GetNextRun(job.Schedule, time.Now()); err != nil {
+		return err
+	}
+	if _, err := job.GetParams(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Add to add new job, its first run is scheduled after now
+func (s *jobService) Add(job *Job, now time.Time) (*Job, error) {
+	if err := s.validate(job); err != nil {
+		return nil, err
+	}
+	job.NextRunAt, _ = GetNextRun(job.Schedule, now)
+
+	item, err := s.repository.Add(job)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Update to update job, next run is scheduled again after now
+func (s *jobService) Update(job Job, now time.Time) (Job, error) {
+	if err := s.validate(&job); err != nil {
+		return job, err
+	}
+	job.NextRunAt, _ = GetNextRun(job.Schedule, now)
+
+	item, err := s.repository.Update(job)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindByID to find job by ID
+func (s *jobService) FindByID(id uint) (Job, error) {
+	item, err := s.repository.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAll to find all jobs
+func (s *jobService) FindAll() ([]Job, error) {
+	items, err := s.repository.FindAll()
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindDue to find active jobs whose next run is due
+func (s *jobService) FindDue(now time.Time) ([]Job, error) {
+	items, err := s.repository.FindDue(now)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Lock to lock job for owner for JobLockDuration, lock is acquired only when job is not locked or its
+// lock expired, even owner holding the lock does not get it again, and when due is true only when job
+// is still due, so job runs once when it is run manually during scheduled run or when several server
+// instances share database
+func (s *jobService) Lock(job Job, owner string, now time.Time, due bool) (bool, error) {
+	status, err := s.repository.Lock(job.ID, owner, now, now.Add(JobLockDuration), due)
+	if err != nil {
+		return false, err
+	}
+	return status, nil
+}
+
+// Finish to record result of run of job locked by owner and unlock it, next run is scheduled only when
+// job was due, so manual run does not shift schedule. Result is not recorded when owner lost the lock
+func (s *jobService) Finish(job Job, owner string, now time.Time, count int, runErr error) (Job, error) {
+	job.LastRunAt = now
+	job.LastCount = count
+	job.LastStatus = JobRunSucceeded
+	job.LastError = ""
+	if runErr != nil {
+		job.LastStatus = JobRunFailed
+		job.LastError = runErr.Error()
+	}
+	if !job.NextRunAt.After(now) {
+		next, err := GetNextRun(job.Schedule, now)
+		if err != nil {
+			return job, err
+		}
+		job.NextRunAt = next
+	}
+	job.LockedBy = ""
+	job.LockedUntil = time.Time{}
+
+	status, err := s.repository.Finish(job, owner)
+	if err != nil {
+		return job, err
+	}
+	if !status {
+		return job, fmt.Errorf("job %s is not locked by %s", job.Name, owner)
+	}
+	return job, nil
+}
+
+// Remove to remove job
+func (s *jobService) Remove(job Job) (bool, error) {
+	status, err := s.repository.Remove(job.ID)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
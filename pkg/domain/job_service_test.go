@@ -0,0 +1,162 @@
+package domain_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"testing"
+	"time"
+)
+
+func TestDomainJobResetDefaultJobService(t *testing.T) {
+	assert.NotNil(t, domain.GetDefaultJobService)
+
+	domain.GetDefaultJobService = nil
+	defer domain.ResetDefaultJobService()
+
+	assert.Nil(t, domain.GetDefaultJobService)
+
+	domain.ResetDefaultJobService()
+
+	assert.NotNil(t, domain.GetDefaultJobService)
+}
+
+func TestDomainJobAddAndUpdate(t *testing.T) {
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+	next := time.Date(2020, time.January, 16, 0, 0, 0, 0, time.UTC)
+	j := domain.Job{Name: "Stale", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: domain.JobScheduleDaily, Params: "days:60,label:stale", Active: true, NextRunAt: next}
+
+	m := new(dTesting.JobRepositoryMock)
+	m.On("Add", &j).Return(&domain.Job{ID: 1}, nil).Once()
+	m.On("Update", domain.Job{ID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: "@every 1h", Params: "days:30,label:stale", NextRunAt: now.Add(time.Hour)}).Return(domain.Job{ID: 1}, errors.New("test error")).Once()
+
+	s := domain.GetDefaultJobService(m)
+
+	item, err := s.Add(&domain.Job{Name: " Stale ", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: " @daily", Params: "days:60,label:stale", Active: true}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	tests := []struct {
+		job domain.Job
+		err string
+	}{
+		{domain.Job{Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: domain.JobScheduleDaily, Params: "days:60,label:stale"}, "name not provided"},
+		{domain.Job{Name: "Backup", Kind: "backup", ProjectID: 1, Schedule: domain.JobScheduleDaily}, "kind backup not valid"},
+		{domain.Job{Name: "Stale", Kind: domain.JobKindStaleLabel, Schedule: domain.JobScheduleDaily, Params: "days:60,label:stale"}, "project not provided"},
+		{domain.Job{Name: "Stale", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: "daily", Params: "days:60,label:stale"}, "schedule daily not valid"},
+		{domain.Job{Name: "Stale", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: domain.JobScheduleDaily, Params: "days:60"}, "param label not provided"},
+	}
+
+	for _, ts := range tests {
+		job := ts.job
+		item, err := s.Add(&job, now)
+
+		assert.Nil(t, item)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	_, err = s.Update(domain.Job{ID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: "@every 1h", Params: "days:30,label:stale"}, now)
+
+	assert.Equal(t, "test error", err.Error())
+
+	_, err = s.Update(domain.Job{ID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: "@every 1h"}, now)
+
+	assert.Equal(t, "param days not provided", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainJobFind(t *testing.T) {
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	m := new(dTesting.JobRepositoryMock)
+	m.On("FindByID", uint(1)).Return(domain.Job{ID: 1}, nil)
+	m.On("FindByID", uint(2)).Return(domain.Job{}, errors.New("record not found"))
+	m.On("FindAll").Return([]domain.Job{{ID: 1}, {ID: 2}}, nil)
+	m.On("FindDue", now).Return([]domain.Job{{ID: 2}}, nil)
+	m.On("Remove", uint(1)).Return(true, nil)
+
+	s := domain.GetDefaultJobService(m)
+
+	item, err := s.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	_, err = s.FindByID(2)
+
+	assert.NotNil(t, err)
+
+	items, err := s.FindAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	items, err = s.FindDue(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	status, err := s.Remove(domain.Job{ID: 1})
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainJobLockAndFinish(t *testing.T) {
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+	due := domain.Job{ID: 1, Name: "Stale", Schedule: domain.JobScheduleDaily, NextRunAt: now.Add(-time.Minute), LockedBy: "a", LockedUntil: now.Add(domain.JobLockDuration)}
+	notDue := domain.Job{ID: 2, Schedule: domain.JobScheduleDaily, NextRunAt: now.Add(time.Hour), LockedBy: "a", LockedUntil: now.Add(domain.JobLockDuration)}
+
+	m := new(dTesting.JobRepositoryMock)
+	m.On("Lock", uint(1), "a", now, now.Add(domain.JobLockDuration), true).Return(true, nil)
+	m.On("Lock", uint(2), "a", now, now.Add(domain.JobLockDuration), false).Return(false, nil)
+	m.On("Lock", uint(3), "a", now, now.Add(domain.JobLockDuration), true).Return(false, errors.New("test error"))
+	finished := domain.Job{ID: 1, Name: "Stale", Schedule: domain.JobScheduleDaily, NextRunAt: time.Date(2020, time.January, 16, 0, 0, 0, 0, time.UTC), LastRunAt: now, LastStatus: domain.JobRunSucceeded, LastCount: 3}
+	m.On("Finish", finished, "a").Return(true, nil).Once()
+	m.On("Finish", domain.Job{ID: 2, Schedule: domain.JobScheduleDaily, NextRunAt: now.Add(time.Hour), LastRunAt: now, LastStatus: domain.JobRunFailed, LastError: "test error"}, "a").Return(true, nil)
+	m.On("Finish", finished, "b").Return(false, nil)
+	m.On("Finish", finished, "a").Return(false, errors.New("test error")).Once()
+
+	s := domain.GetDefaultJobService(m)
+
+	status, err := s.Lock(domain.Job{ID: 1}, "a", now, true)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = s.Lock(domain.Job{ID: 2}, "a", now, false)
+
+	assert.Nil(t, err)
+	assert.False(t, status)
+
+	status, err = s.Lock(domain.Job{ID: 3}, "a", now, true)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	item, err := s.Finish(due, "a", now, 3, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, finished, item)
+
+	item, err = s.Finish(notDue, "a", now, 0, errors.New("test error"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.JobRunFailed, item.LastStatus)
+	assert.Equal(t, "", item.LockedBy)
+
+	_, err = s.Finish(due, "b", now, 3, nil)
+
+	assert.Equal(t, "job Stale is not locked by b", err.Error())
+
+	_, err = s.Finish(due, "a", now, 3, nil)
+
+	assert.Equal(t, "test error", err.Error())
+
+	m.AssertExpectations(t)
+}
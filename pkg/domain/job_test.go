@@ -0,0 +1,96 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+	"time"
+)
+
+func TestDomainJobGetParams(t *testing.T) {
+	j := domain.Job{Kind: domain.JobKindStaleLabel, Params: "days:60, label:stale,skipStatus:3,skipStatus:4"}
+
+	params, err := j.GetParams()
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.JobParams{Days: 60, Label: "stale", SkipStatuses: []int{3, 4}}, params)
+	assert.True(t, params.IsSkipped(4))
+	assert.False(t, params.IsSkipped(1))
+
+	j = domain.Job{Kind: domain.JobKindRecurringIssue, Params: "templateId:2,title:Rotate certificates"}
+
+	params, err = j.GetParams()
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(2), params.TemplateID)
+	assert.Equal(t, "Rotate certificates", params.Title)
+
+	tests := []struct {
+		job domain.Job
+		err string
+	}{
+		{domain.Job{Kind: domain.JobKindStaleLabel, Params: "days"}, "param days not valid"},
+		{domain.Job{Kind: domain.JobKindStaleLabel, Params: "days:many,label:stale"}, "strconv.Atoi: parsing \"many\": invalid syntax"},
+		{domain.Job{Kind: domain.JobKindStaleLabel, Params: "days:60,assignee:alice"}, "param assignee not valid"},
+		{domain.Job{Kind: domain.JobKindStaleLabel, Params: "label:stale"}, "param days not provided"},
+		{domain.Job{Kind: domain.JobKindStaleLabel, Params: "days:60"}, "param label not provided"},
+		{domain.Job{Kind: domain.JobKindStaleClose, Params: "days:14,label:stale"}, "param status not provided"},
+		{domain.Job{Kind: domain.JobKindRecurringIssue, Params: "title:Rotate"}, "param templateId not provided"},
+		{domain.Job{Kind: domain.JobKindRecurringIssue, Params: "templateId:1"}, "param title not provided"},
+		{domain.Job{Kind: "backup"}, "kind backup not valid"},
+	}
+
+	for _, ts := range tests {
+		_, err := ts.job.GetParams()
+
+		assert.Equal(t, ts.err, err.Error())
+	}
+}
+
+func TestDomainJobGetNextRun(t *testing.T) {
+	// Wednesday
+	after := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		schedule string
+		next     time.Time
+	}{
+		{domain.JobScheduleHourly, time.Date(2020, time.January, 15, 11, 0, 0, 0, time.UTC)},
+		{domain.JobScheduleDaily, time.Date(2020, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{domain.JobScheduleWeekly, time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC)},
+		{domain.JobScheduleMonthly, time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"@every 6h", time.Date(2020, time.January, 15, 16, 30, 0, 0, time.UTC)},
+	}
+
+	for _, ts := range tests {
+		next, err := domain.GetNextRun(ts.schedule, after)
+
+		assert.Nil(t, err)
+		assert.Equal(t, ts.next, next)
+	}
+
+	next, err := domain.GetNextRun(domain.JobScheduleWeekly, time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2020, time.January, 27, 0, 0, 0, 0, time.UTC), next)
+
+	next, err = domain.GetNextRun(domain.JobScheduleMonthly, time.Date(2020, time.December, 31, 23, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), next)
+
+	for schedule, err := range map[string]string{
+		"* * * * *":     "schedule * * * * * not valid",
+		"@every always": "schedule @every always not valid",
+		"@every 10s":    "schedule @every 10s is shorter than 1m0s",
+	} {
+		_, e := domain.GetNextRun(schedule, after)
+
+		assert.Equal(t, err, e.Error())
+	}
+}
+
+func TestDomainJobIsJobKindValid(t *testing.T) {
+	assert.True(t, domain.IsJobKindValid(domain.JobKindStaleClose))
+	assert.False(t, domain.IsJobKindValid("backup"))
+}
@@ -4,11 +4,14 @@ import (
 	"time"
 )
 
-// Label entity
+// Label entity, global when ProjectID is not set
 type Label struct {
-	ID           uint      `json:"id"`
-	Name         string    `json:"name"`
-	ColorHexCode string    `json:"colorHexCode"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID           uint        `json:"id"`
+	Name         string      `json:"name"`
+	ColorHexCode string      `json:"colorHexCode"`
+	ProjectID    uint        `json:"projectId"`
+	GroupID      uint        `json:"groupId"`
+	Group        *LabelGroup `json:"group,omitempty" gorm:"association_autoupdate:false;association_autocreate:false"`
+	CreatedAt    time.Time   `json:"createdAt"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
 }
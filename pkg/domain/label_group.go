@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"time"
+)
+
+// LabelGroup entity groups labels like priority::high and priority::low,
+// only one label of exclusive group can be assigned to issue
+type LabelGroup struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Exclusive bool      `json:"exclusive"`
+	Labels    []Label   `json:"labels,omitempty" gorm:"foreignkey:GroupID;association_autoupdate:false;association_autocreate:false"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ReplaceExclusiveLabels to drop previous labels replaced by added labels of the same exclusive group,
+// so label of exclusive group added to issue replaces label of that group the issue already has
+func ReplaceExclusiveLabels(previous []Label, current []Label) []Label {
+	replaced := make(map[uint]bool)
+	for _, label := range current {
+		if label.Group == nil || !label.Group.Exclusive {
+			continue
+		}
+		added := true
+		for _, l := range previous {
+			if l.ID == label.ID {
+				added = false
+				break
+			}
+		}
+		if !added {
+			continue
+		}
+		for _, l := range previous {
+			if l.GroupID == label.GroupID {
+				replaced[l.ID] = true
+			}
+		}
+	}
+
+	labels := []Label{}
+	for _, label := range current {
+		if !replaced[label.ID] {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,38 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+)
+
+func TestDomainReplaceExclusiveLabels(t *testing.T) {
+	priority := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+	area := &domain.LabelGroup{ID: 2, Name: "area"}
+	previous := []domain.Label{
+		{ID: 1, GroupID: 1, Group: priority},
+		{ID: 2, GroupID: 2, Group: area},
+		{ID: 3},
+	}
+	current := []domain.Label{
+		{ID: 1, GroupID: 1, Group: priority},
+		{ID: 2, GroupID: 2, Group: area},
+		{ID: 3},
+		{ID: 4, GroupID: 1, Group: priority},
+		{ID: 5, GroupID: 2, Group: area},
+	}
+
+	labels := domain.ReplaceExclusiveLabels(previous, current)
+
+	assert.Equal(t, []domain.Label{
+		{ID: 2, GroupID: 2, Group: area},
+		{ID: 3},
+		{ID: 4, GroupID: 1, Group: priority},
+		{ID: 5, GroupID: 2, Group: area},
+	}, labels)
+
+	// Labels kept from previous state are not replacing each other
+	labels = domain.ReplaceExclusiveLabels(previous, previous)
+
+	assert.Equal(t, previous, labels)
+}
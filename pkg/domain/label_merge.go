@@ -0,0 +1,9 @@
+package domain
+
+// LabelMerge describes merge of source label into target label
+type LabelMerge struct {
+	SourceID   uint `json:"sourceId"`
+	TargetID   uint `json:"targetId"`
+	Issues     int  `json:"issues"`
+	Duplicates int  `json:"duplicates"`
+}
@@ -2,11 +2,21 @@ package domain
 
 // LabelRepository repository
 type LabelRepository interface {
+	WithTransaction(tx Transaction) LabelRepository
 	Add(label *Label) (*Label, error)
 	Update(label Label) (Label, error)
 	FindByID(id uint) (Label, error)
-	FindByName(name string) (Label, error)
-	Find(name string) ([]Label, error)
+	FindByName(name string, projectID uint) (Label, error)
+	Find(name string, projectID uint) ([]Label, error)
 	FindAll() ([]Label, error)
 	Remove(id uint) (bool, error)
+	FindMerge(source Label, target Label) (LabelMerge, error)
+	Merge(source Label, target Label) (bool, error)
+	CountGroupConflicts(groupID uint, labelID uint, exceptID uint) (int, error)
+	AddGroup(group *LabelGroup) (*LabelGroup, error)
+	UpdateGroup(group LabelGroup) (LabelGroup, error)
+	FindGroupByID(id uint) (LabelGroup, error)
+	FindGroupByName(name string) (LabelGroup, error)
+	FindAllGroups() ([]LabelGroup, error)
+	RemoveGroup(id uint) (bool, error)
 }
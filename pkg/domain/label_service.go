@@ -1,7 +1,7 @@
 package domain
 
 import (
-	"fmt"
+	"errors"
 )
 
 // LabelService interface
@@ -9,10 +9,17 @@ type LabelService interface {
 	Add(label *Label) (*Label, error)
 	Update(label Label) (Label, error)
 	FindByID(id uint) (Label, error)
-	FindByName(name string) (Label, error)
-	Find(name string) ([]Label, error)
+	FindByName(name string, projectID uint) (Label, error)
+	Find(name string, projectID uint) ([]Label, error)
 	FindAll() ([]Label, error)
 	Remove(id uint) (bool, error)
+	MergePreview(source Label, target Label) (LabelMerge, error)
+	Merge(source Label, target Label) (LabelMerge, error)
+	AddGroup(group *LabelGroup) (*LabelGroup, error)
+	UpdateGroup(group LabelGroup) (LabelGroup, error)
+	FindGroupByID(id uint) (LabelGroup, error)
+	FindAllGroups() ([]LabelGroup, error)
+	RemoveGroup(id uint) (bool, error)
 }
 
 // labelService struct
@@ -35,11 +42,11 @@ func newLabelService(repository LabelRepository) LabelService {
 	}
 }
 
-// alreadyExists checks if label already exists
-func (s *labelService) alreadyExists(name string) error {
-	item, err := s.repository.FindByName(name)
-	if item.ID != 0 {
-		return fmt.Errorf("%s label already exists", name)
+// alreadyExists checks if other label with the same name already exists in project or global scope of label
+func (s *labelService) alreadyExists(label Label) error {
+	item, err := s.repository.FindByName(label.Name, label.ProjectID)
+	if item.ID != 0 && item.ID != label.ID {
+		return NewValidationError("%s label already exists", label.Name)
 	}
 	if err != nil && err.Error() != "record not found" {
 		return err
@@ -47,9 +54,25 @@ func (s *labelService) alreadyExists(name string) error {
 	return nil
 }
 
+// validateExclusive validates if no issue would have more than one label of exclusive group when label
+// joins the group, issues have to be relabeled before
+func (s *labelService) validateExclusive(group *LabelGroup, labelID uint, exceptID uint) error {
+	if group == nil || !group.Exclusive {
+		return nil
+	}
+	c, err := s.repository.CountGroupConflicts(group.ID, labelID, exceptID)
+	if err != nil {
+		return err
+	}
+	if c > 0 {
+		return NewValidationError("%d issues would have more than one label of %s group", c, group.Name)
+	}
+	return nil
+}
+
 // Add to add new label
 func (s *labelService) Add(label *Label) (*Label, error) {
-	if err := s.alreadyExists(label.Name); err != nil {
+	if err := s.alreadyExists(*label); err != nil {
 		return nil, err
 	}
 
@@ -61,8 +84,16 @@ func (s *labelService) Add(label *Label) (*Label, error) {
 	return item, nil
 }
 
-// Update to update label
+// Update to update label, renamed label has to be unique in its scope and label of exclusive group
+// cannot be on issue together with other label of the group
 func (s *labelService) Update(label Label) (Label, error) {
+	if err := s.alreadyExists(label); err != nil {
+		return label, err
+	}
+	if err := s.validateExclusive(label.Group, label.ID, 0); err != nil {
+		return label, err
+	}
+
 	item, err := s.repository.Update(label)
 	if err != nil {
 		return item, err
@@ -79,18 +110,28 @@ func (s *labelService) FindByID(id uint) (Label, error) {
 	return item, nil
 }
 
-// FindByName to find label by name
-func (s *labelService) FindByName(name string) (Label, error) {
-	item, err := s.repository.FindByName(name)
+// FindByName to find label by name available in project, project label takes precedence over global one
+func (s *labelService) FindByName(name string, projectID uint) (Label, error) {
+	if projectID != 0 {
+		item, err := s.repository.FindByName(name, projectID)
+		if err == nil {
+			return item, nil
+		}
+		if err.Error() != "record not found" {
+			return item, err
+		}
+	}
+
+	item, err := s.repository.FindByName(name, 0)
 	if err != nil {
 		return item, err
 	}
 	return item, nil
 }
 
-// Find to labels
-func (s *labelService) Find(name string) ([]Label, error) {
-	items, err := s.repository.Find(name)
+// Find to find labels, global and project labels when project ID is provided
+func (s *labelService) Find(name string, projectID uint) ([]Label, error) {
+	items, err := s.repository.Find(name, projectID)
 	if err != nil {
 		return items, err
 	}
@@ -114,3 +155,116 @@ func (s *labelService) Remove(id uint) (bool, error) {
 	}
 	return status, nil
 }
+
+// validateMerge validates if source label can be merged into target label
+func (s *labelService) validateMerge(source Label, target Label) error {
+	if source.ID == 0 || target.ID == 0 {
+		return NewValidationError("source and target labels must be provided")
+	}
+	if source.ID == target.ID {
+		return NewValidationError("label cannot be merged into itself")
+	}
+	if target.ProjectID != 0 && target.ProjectID != source.ProjectID {
+		return NewValidationError("label cannot be merged into label of another project")
+	}
+	return s.validateExclusive(target.Group, source.ID, target.ID)
+}
+
+// MergePreview to get number of issues affected by merge
+func (s *labelService) MergePreview(source Label, target Label) (LabelMerge, error) {
+	if err := s.validateMerge(source, target); err != nil {
+		return LabelMerge{}, err
+	}
+
+	item, err := s.repository.FindMerge(source, target)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Merge to reassign issues from source label to target label and remove source label.
+// Issues carrying both labels only lose source label, so label count per issue never grows.
+func (s *labelService) Merge(source Label, target Label) (LabelMerge, error) {
+	item, err := s.MergePreview(source, target)
+	if err != nil {
+		return item, err
+	}
+
+	status, err := s.repository.Merge(source, target)
+	if err != nil {
+		return item, err
+	}
+	if !status {
+		return item, errors.New("labels not merged")
+	}
+	return item, nil
+}
+
+// groupAlreadyExists checks if label group with name already exists
+func (s *labelService) groupAlreadyExists(group LabelGroup) error {
+	item, err := s.repository.FindGroupByName(group.Name)
+	if item.ID != 0 && item.ID != group.ID {
+		return NewValidationError("%s label group already exists", group.Name)
+	}
+	if err != nil && err.Error() != "record not found" {
+		return err
+	}
+	return nil
+}
+
+// AddGroup to add new label group
+func (s *labelService) AddGroup(group *LabelGroup) (*LabelGroup, error) {
+	if err := s.groupAlreadyExists(*group); err != nil {
+		return nil, err
+	}
+
+	item, err := s.repository.AddGroup(group)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// UpdateGroup to update label group, group cannot become exclusive while issue has more than one of its labels
+func (s *labelService) UpdateGroup(group LabelGroup) (LabelGroup, error) {
+	if err := s.groupAlreadyExists(group); err != nil {
+		return group, err
+	}
+	if err := s.validateExclusive(&group, 0, 0); err != nil {
+		return group, err
+	}
+
+	item, err := s.repository.UpdateGroup(group)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindGroupByID to find label group by ID
+func (s *labelService) FindGroupByID(id uint) (LabelGroup, error) {
+	item, err := s.repository.FindGroupByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAllGroups to find all label groups
+func (s *labelService) FindAllGroups() ([]LabelGroup, error) {
+	items, err := s.repository.FindAllGroups()
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveGroup to remove label group, its labels are kept without group
+func (s *labelService) RemoveGroup(id uint) (bool, error) {
+	status, err := s.repository.RemoveGroup(id)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
@@ -37,7 +37,7 @@ func TestDomainLabelAdd(t *testing.T) {
 
 	m := new(dTesting.LabelRepositoryMock)
 	m.On("Add", l).Return(l, nil)
-	m.On("FindByName", l.Name).Return(*l, nil)
+	m.On("FindByName", l.Name, uint(0)).Return(*l, nil)
 
 	s := domain.GetDefaultLabelService(m)
 
@@ -74,7 +74,7 @@ func TestDomainLabelAddAlreadyExists(t *testing.T) {
 
 	for _, ts := range tests {
 		m := new(dTesting.LabelRepositoryMock)
-		m.On("FindByName", ts.v.Name).Return(*ts.v, ts.err)
+		m.On("FindByName", ts.v.Name, uint(0)).Return(*ts.v, ts.err)
 
 		s := domain.GetDefaultLabelService(m)
 
@@ -92,7 +92,7 @@ func TestDomainLabelAddErr(t *testing.T) {
 
 	m := new(dTesting.LabelRepositoryMock)
 	m.On("Add", l).Return(l, errors.New("test error"))
-	m.On("FindByName", l.Name).Return(*l, nil)
+	m.On("FindByName", l.Name, uint(0)).Return(*l, nil)
 
 	s := domain.GetDefaultLabelService(m)
 
@@ -112,6 +112,7 @@ func TestDomainLabelUpdate(t *testing.T) {
 	}
 
 	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindByName", l.Name, uint(0)).Return(l, nil)
 	m.On("Update", l).Return(l, nil)
 
 	s := domain.GetDefaultLabelService(m)
@@ -125,10 +126,32 @@ func TestDomainLabelUpdate(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
+func TestDomainLabelUpdateAlreadyExists(t *testing.T) {
+	l := domain.Label{
+		ID:        1,
+		Name:      "test-name",
+		ProjectID: 2,
+	}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindByName", l.Name, uint(2)).Return(domain.Label{ID: 3, Name: "test-name", ProjectID: 2}, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	item, err := s.Update(l)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "test-name label already exists", err.Error())
+	assert.Equal(t, l, item)
+
+	m.AssertExpectations(t)
+}
+
 func TestDomainLabelUpdateErr(t *testing.T) {
 	l := domain.Label{}
 
 	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindByName", l.Name, uint(0)).Return(domain.Label{}, errors.New("record not found"))
 	m.On("Update", l).Return(l, errors.New("test error"))
 
 	s := domain.GetDefaultLabelService(m)
@@ -186,11 +209,11 @@ func TestDomainLabelFindByName(t *testing.T) {
 	}
 
 	m := new(dTesting.LabelRepositoryMock)
-	m.On("FindByName", l.Name).Return(l, nil)
+	m.On("FindByName", l.Name, uint(0)).Return(l, nil)
 
 	s := domain.GetDefaultLabelService(m)
 
-	item, err := s.FindByName(l.Name)
+	item, err := s.FindByName(l.Name, uint(0))
 
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
@@ -203,11 +226,11 @@ func TestDomainLabelFindByNameErr(t *testing.T) {
 	l := domain.Label{}
 
 	m := new(dTesting.LabelRepositoryMock)
-	m.On("FindByName", "test-name").Return(l, errors.New("test error"))
+	m.On("FindByName", "test-name", uint(0)).Return(l, errors.New("test error"))
 
 	s := domain.GetDefaultLabelService(m)
 
-	item, err := s.FindByName("test-name")
+	item, err := s.FindByName("test-name", uint(0))
 
 	assert.NotNil(t, err)
 	assert.Equal(t, l, item)
@@ -219,11 +242,11 @@ func TestDomainLabelFind(t *testing.T) {
 	v := []domain.Label{}
 
 	m := new(dTesting.LabelRepositoryMock)
-	m.On("Find", "test").Return(v, nil)
+	m.On("Find", "test", uint(0)).Return(v, nil)
 
 	s := domain.GetDefaultLabelService(m)
 
-	items, err := s.Find("test")
+	items, err := s.Find("test", uint(0))
 
 	assert.Nil(t, err)
 	assert.NotNil(t, items)
@@ -236,11 +259,11 @@ func TestDomainLabelFindErr(t *testing.T) {
 	v := []domain.Label{}
 
 	m := new(dTesting.LabelRepositoryMock)
-	m.On("Find", "test").Return(v, errors.New("test error"))
+	m.On("Find", "test", uint(0)).Return(v, errors.New("test error"))
 
 	s := domain.GetDefaultLabelService(m)
 
-	items, err := s.Find("test")
+	items, err := s.Find("test", uint(0))
 
 	assert.NotNil(t, err)
 	assert.Equal(t, v, items)
@@ -308,3 +331,345 @@ func TestDomainLabelRemoveErr(t *testing.T) {
 
 	m.AssertExpectations(t)
 }
+
+func TestDomainLabelMergePreview(t *testing.T) {
+	source := domain.Label{ID: 1, Name: "Bug"}
+	target := domain.Label{ID: 2, Name: "bug"}
+	lm := domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3, Duplicates: 1}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindMerge", source, target).Return(lm, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	item, err := s.MergePreview(source, target)
+
+	assert.Nil(t, err)
+	assert.Equal(t, lm, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelMergePreviewValueErrs(t *testing.T) {
+	m := new(dTesting.LabelRepositoryMock)
+
+	s := domain.GetDefaultLabelService(m)
+
+	tests := []struct {
+		source domain.Label
+		target domain.Label
+		err    string
+	}{
+		{domain.Label{}, domain.Label{ID: 2}, "source and target labels must be provided"},
+		{domain.Label{ID: 1}, domain.Label{}, "source and target labels must be provided"},
+		{domain.Label{ID: 1}, domain.Label{ID: 1}, "label cannot be merged into itself"},
+	}
+
+	for _, ts := range tests {
+		_, err := s.MergePreview(ts.source, ts.target)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelMerge(t *testing.T) {
+	source := domain.Label{ID: 1, Name: "Bug"}
+	target := domain.Label{ID: 2, Name: "bug"}
+	lm := domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3, Duplicates: 1}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindMerge", source, target).Return(lm, nil)
+	m.On("Merge", source, target).Return(true, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	item, err := s.Merge(source, target)
+
+	assert.Nil(t, err)
+	assert.Equal(t, lm, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelMergeErrs(t *testing.T) {
+	source := domain.Label{ID: 1, Name: "Bug"}
+	target := domain.Label{ID: 2, Name: "bug"}
+
+	tests := []struct {
+		findErr  error
+		status   bool
+		mergeErr error
+	}{
+		{errors.New("test error"), false, nil},
+		{nil, false, errors.New("test error")},
+		{nil, false, nil},
+	}
+
+	for _, ts := range tests {
+		m := new(dTesting.LabelRepositoryMock)
+		m.On("FindMerge", source, target).Return(domain.LabelMerge{}, ts.findErr)
+		if ts.findErr == nil {
+			m.On("Merge", source, target).Return(ts.status, ts.mergeErr)
+		}
+
+		s := domain.GetDefaultLabelService(m)
+
+		_, err := s.Merge(source, target)
+
+		assert.NotNil(t, err)
+
+		m.AssertExpectations(t)
+	}
+}
+
+func TestDomainLabelAddProjectScope(t *testing.T) {
+	l := &domain.Label{Name: "backend", ProjectID: 2}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindByName", "backend", uint(2)).Return(domain.Label{}, errors.New("record not found"))
+	m.On("Add", l).Return(l, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	item, err := s.Add(l)
+
+	assert.Nil(t, err)
+	assert.Equal(t, l, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelFindByNameProjectScope(t *testing.T) {
+	pl := domain.Label{ID: 1, Name: "backend", ProjectID: 2}
+	gl := domain.Label{ID: 2, Name: "frontend"}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindByName", "backend", uint(2)).Return(pl, nil)
+	m.On("FindByName", "frontend", uint(2)).Return(domain.Label{}, errors.New("record not found"))
+	m.On("FindByName", "frontend", uint(0)).Return(gl, nil)
+	m.On("FindByName", "test", uint(2)).Return(domain.Label{}, errors.New("test error"))
+
+	s := domain.GetDefaultLabelService(m)
+
+	item, err := s.FindByName("backend", uint(2))
+	assert.Nil(t, err)
+	assert.Equal(t, pl, item)
+
+	item, err = s.FindByName("frontend", uint(2))
+	assert.Nil(t, err)
+	assert.Equal(t, gl, item)
+
+	_, err = s.FindByName("test", uint(2))
+	assert.NotNil(t, err)
+	assert.Equal(t, "test error", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelMergeProjectScopeErr(t *testing.T) {
+	source := domain.Label{ID: 1, Name: "bug", ProjectID: 1}
+	target := domain.Label{ID: 2, Name: "Bug", ProjectID: 2}
+
+	m := new(dTesting.LabelRepositoryMock)
+
+	s := domain.GetDefaultLabelService(m)
+
+	_, err := s.MergePreview(source, target)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "label cannot be merged into label of another project", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelAddGroup(t *testing.T) {
+	g := &domain.LabelGroup{Name: "priority", Exclusive: true}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindGroupByName", "priority").Return(domain.LabelGroup{}, errors.New("record not found"))
+	m.On("AddGroup", g).Return(g, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	item, err := s.AddGroup(g)
+
+	assert.Nil(t, err)
+	assert.Equal(t, g, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelAddGroupErrs(t *testing.T) {
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindGroupByName", "priority").Return(domain.LabelGroup{ID: 1, Name: "priority"}, nil)
+	m.On("FindGroupByName", "area").Return(domain.LabelGroup{}, errors.New("test error"))
+	m.On("FindGroupByName", "type").Return(domain.LabelGroup{}, errors.New("record not found"))
+	m.On("AddGroup", &domain.LabelGroup{Name: "type"}).Return((*domain.LabelGroup)(nil), errors.New("test error"))
+
+	s := domain.GetDefaultLabelService(m)
+
+	tests := []struct {
+		name string
+		err  string
+	}{
+		{"priority", "priority label group already exists"},
+		{"area", "test error"},
+		{"type", "test error"},
+	}
+
+	for _, ts := range tests {
+		item, err := s.AddGroup(&domain.LabelGroup{Name: ts.name})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+		assert.Nil(t, item)
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelUpdateGroup(t *testing.T) {
+	g := domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindGroupByName", "priority").Return(domain.LabelGroup{ID: 1, Name: "priority"}, nil)
+	m.On("CountGroupConflicts", uint(1), uint(0), uint(0)).Return(0, nil)
+	m.On("UpdateGroup", g).Return(g, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	item, err := s.UpdateGroup(g)
+
+	assert.Nil(t, err)
+	assert.Equal(t, g, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelUpdateGroupExclusiveErr(t *testing.T) {
+	g := domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindGroupByName", "priority").Return(domain.LabelGroup{ID: 1, Name: "priority"}, nil)
+	m.On("CountGroupConflicts", uint(1), uint(0), uint(0)).Return(2, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	_, err := s.UpdateGroup(g)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "2 issues would have more than one label of priority group", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelUpdateExclusiveGroupErr(t *testing.T) {
+	g := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+	l := domain.Label{ID: 2, Name: "high", GroupID: 1, Group: g}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindByName", "high", uint(0)).Return(l, nil)
+	m.On("CountGroupConflicts", uint(1), uint(2), uint(0)).Return(1, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	_, err := s.Update(l)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "1 issues would have more than one label of priority group", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelMergeExclusiveGroupErr(t *testing.T) {
+	g := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+	source := domain.Label{ID: 2, Name: "urgent"}
+	target := domain.Label{ID: 3, Name: "high", GroupID: 1, Group: g}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("CountGroupConflicts", uint(1), uint(2), uint(3)).Return(1, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	_, err := s.Merge(source, target)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "1 issues would have more than one label of priority group", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelUpdateGroupErr(t *testing.T) {
+	g := domain.LabelGroup{ID: 2, Name: "priority"}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindGroupByName", "priority").Return(domain.LabelGroup{ID: 1, Name: "priority"}, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	_, err := s.UpdateGroup(g)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "priority label group already exists", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelFindGroups(t *testing.T) {
+	g := domain.LabelGroup{ID: 1, Name: "priority"}
+
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindGroupByID", uint(1)).Return(g, nil)
+	m.On("FindGroupByID", uint(2)).Return(domain.LabelGroup{}, errors.New("record not found"))
+	m.On("FindAllGroups").Return([]domain.LabelGroup{g}, nil)
+
+	s := domain.GetDefaultLabelService(m)
+
+	item, err := s.FindGroupByID(uint(1))
+	assert.Nil(t, err)
+	assert.Equal(t, g, item)
+
+	_, err = s.FindGroupByID(uint(2))
+	assert.NotNil(t, err)
+
+	items, err := s.FindAllGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.LabelGroup{g}, items)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelFindAllGroupsErr(t *testing.T) {
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("FindAllGroups").Return([]domain.LabelGroup{}, errors.New("test error"))
+
+	s := domain.GetDefaultLabelService(m)
+
+	items, err := s.FindAllGroups()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.LabelGroup{}, items)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainLabelRemoveGroup(t *testing.T) {
+	m := new(dTesting.LabelRepositoryMock)
+	m.On("RemoveGroup", uint(1)).Return(true, nil)
+	m.On("RemoveGroup", uint(2)).Return(false, errors.New("test error"))
+
+	s := domain.GetDefaultLabelService(m)
+
+	status, err := s.RemoveGroup(uint(1))
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = s.RemoveGroup(uint(2))
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	m.AssertExpectations(t)
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// MailDeliveryImmediate sends email for every notification of user
+	MailDeliveryImmediate = "immediate"
+	// MailDeliveryDigest sends single email with notifications of user once a day
+	MailDeliveryDigest = "digest"
+	// MailDeliveryNone disables emails of user
+	MailDeliveryNone = "none"
+)
+
+const (
+	// MailStatusQueued marks message waiting for delivery or retry
+	MailStatusQueued = "queued"
+	// MailStatusSent marks delivered message
+	MailStatusSent = "sent"
+	// MailStatusFailed marks message which was not delivered in MailMaxAttempts attempts
+	MailStatusFailed = "failed"
+)
+
+// MailDigestInterval is minimal time between two digests of user
+const MailDigestInterval = 24 * time.Hour
+
+// MailMaxAttempts is number of attempts to deliver message before it fails
+const MailMaxAttempts = 5
+
+// MailBatchSize is max. number of messages delivered in one run of queue
+const MailBatchSize = 100
+
+// MailBaseURL is base URL of links to issues in emails
+var MailBaseURL = "http://localhost:3001"
+
+// MailPreference entity holds email address and delivery mode of user, notifications created
+// until NotifiedAt were already queued
+type MailPreference struct {
+	ID         uint      `json:"id"`
+	User       string    `json:"user"`
+	Email      string    `json:"email"`
+	Delivery   string    `json:"delivery"`
+	NotifiedAt time.Time `json:"notifiedAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// MailMessage entity is an item of outbound mail queue
+type MailMessage struct {
+	ID        uint      `json:"id"`
+	To        string    `json:"to"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	Status    string    `json:"status"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"lastError"`
+	SendAfter time.Time `json:"sendAfter"`
+	SentAt    time.Time `json:"sentAt"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// IsMailDeliveryValid to check delivery mode
+func IsMailDeliveryValid(delivery string) bool {
+	return delivery == MailDeliveryImmediate || delivery == MailDeliveryDigest || delivery == MailDeliveryNone
+}
+
+// GetIssueURL to get link to issue
+func GetIssueURL(id uint) string {
+	return fmt.Sprintf("%s/issues/%d", strings.TrimRight(MailBaseURL, "/"), id)
+}
+
+// isMailDue to check if notifications of user should be queued at provided time
+func (p MailPreference) isMailDue(now time.Time) bool {
+	switch p.Delivery {
+	case MailDeliveryImmediate:
+		return true
+	case MailDeliveryDigest:
+		return !now.Before(p.NotifiedAt.Add(MailDigestInterval))
+	}
+	return false
+}
+
+// getRetryDelay to get delay before next attempt of queued delivery, it doubles with every failed attempt
+// starting at one minute
+func getRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return time.Duration(1<<uint(attempts-1)) * time.Minute
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+)
+
+// MailRepository repository
+type MailRepository interface {
+	SavePreference(preference *MailPreference) (*MailPreference, error)
+	FindPreference(user string) (MailPreference, error)
+	FindActivePreferences() ([]MailPreference, error)
+	FindNotificationsBetween(user string, from time.Time, to time.Time) ([]Notification, error)
+	QueueMessages(preference MailPreference, messages []MailMessage) (int, error)
+	FindDueMessages(now time.Time, limit int) ([]MailMessage, error)
+	UpdateMessage(message MailMessage) (MailMessage, error)
+}
@@ -0,0 +1,6 @@
+package domain
+
+// MailSender delivers messages of mail queue
+type MailSender interface {
+	Send(message MailMessage) error
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// MailService interface
+type MailService interface {
+	SetPreference(preference *MailPreference, now time.Time) (*MailPreference, error)
+	FindPreference(user string) (MailPreference, error)
+	QueueNotifications(now time.Time) (int, error)
+	ProcessQueue(now time.Time) (int, error)
+}
+
+// mailService struct
+type mailService struct {
+	repository MailRepository
+	sender     MailSender
+}
+
+// GetDefaultMailService alias to newMailService
+var GetDefaultMailService = newMailService
+
+// ResetDefaultMailService to reset GetDefaultMailService value
+func ResetDefaultMailService() {
+	GetDefaultMailService = newMailService
+}
+
+// newMailService to create new MailService, queue can not be processed when sender is nil
+func newMailService(repository MailRepository, sender MailSender) MailService {
+	return &mailService{
+		repository: repository,
+		sender:     sender,
+	}
+}
+
+// SetPreference to set email and delivery of user, only notifications created after preference
+// is added or delivery is enabled again are sent
+func (s *mailService) SetPreference(preference *MailPreference, now time.Time) (*MailPreference, error) {
+	preference.User = getNotificationUser(preference.User)
+	if preference.User == "" {
+		return nil, NewValidationError("user not provided")
+	}
+	preference.Delivery = strings.TrimSpace(preference.Delivery)
+	if preference.Delivery == "" {
+		preference.Delivery = MailDeliveryImmediate
+	}
+	if !IsMailDeliveryValid(preference.Delivery) {
+		return nil, NewValidationError("delivery %s not valid", preference.Delivery)
+	}
+	if preference.Delivery != MailDeliveryNone || preference.Email != "" {
+		address, err := mail.ParseAddress(preference.Email)
+		if err != nil {
+			return nil, NewValidationError("email %s not valid", preference.Email)
+		}
+		preference.Email = address.Address
+	}
+
+	existing, err := s.repository.FindPreference(preference.User)
+	if err != nil && err.Error() != "record not found" {
+		return nil, err
+	}
+	preference.NotifiedAt = now
+	if err == nil {
+		preference.ID = existing.ID
+		preference.CreatedAt = existing.CreatedAt
+		if existing.Delivery != MailDeliveryNone {
+			preference.NotifiedAt = existing.NotifiedAt
+		}
+	}
+
+	item, err := s.repository.SavePreference(preference)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// FindPreference to find preference of user
+func (s *mailService) FindPreference(user string) (MailPreference, error) {
+	item, err := s.repository.FindPreference(getNotificationUser(user))
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// QueueNotifications to queue emails with notifications created since last run, users with immediate
+// delivery get email per notification, users with digest delivery get single email once a day
+func (s *mailService) QueueNotifications(now time.Time) (int, error) {
+	preferences, err := s.repository.FindActivePreferences()
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, preference := range preferences {
+		if !preference.isMailDue(now) {
+			continue
+		}
+		notifications, err := s.repository.FindNotificationsBetween(preference.User, preference.NotifiedAt, now)
+		if err != nil {
+			return queued, err
+		}
+		if len(notifications) == 0 && preference.Delivery == MailDeliveryImmediate {
+			continue
+		}
+
+		messages := []MailMessage{}
+		if preference.Delivery == MailDeliveryDigest {
+			if len(notifications) > 0 {
+				message, err := newDigestMail(preference, notifications)
+				if err != nil {
+					return queued, err
+				}
+				messages = append(messages, message)
+			}
+		} else {
+			for _, notification := range notifications {
+				message, err := newNotificationMail(preference, notification)
+				if err != nil {
+					return queued, err
+				}
+				messages = append(messages, message)
+			}
+		}
+		for i := range messages {
+			messages[i].SendAfter = now
+		}
+
+		preference.NotifiedAt = now
+		count, err := s.repository.QueueMessages(preference, messages)
+		if err != nil {
+			return queued, err
+		}
+		queued += count
+	}
+	return queued, nil
+}
+
+// ProcessQueue to send due messages, failed message is retried later with growing delay
+// until MailMaxAttempts is reached
+func (s *mailService) ProcessQueue(now time.Time) (int, error) {
+	if s.sender == nil {
+		return 0, errors.New("mail sender not configured")
+	}
+	messages, err := s.repository.FindDueMessages(now, MailBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, message := range messages {
+		message.Attempts++
+		if err := s.sender.Send(message); err != nil {
+			message.LastError = err.Error()
+			if message.Attempts >= MailMaxAttempts {
+				message.Status = MailStatusFailed
+			} else {
+				message.SendAfter = now.Add(getRetryDelay(message.Attempts))
+			}
+		} else {
+			message.Status = MailStatusSent
+			message.SentAt = now
+			message.LastError = ""
+		}
+
+		if _, err := s.repository.UpdateMessage(message); err != nil {
+			return sent, err
+		}
+		if message.Status == MailStatusSent {
+			sent++
+		}
+	}
+	return sent, nil
+}
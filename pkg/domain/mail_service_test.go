@@ -0,0 +1,172 @@
+package domain_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDomainMailSetPreference(t *testing.T) {
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	notifiedAt := now.Add(-time.Hour)
+
+	m := new(dTesting.MailRepositoryMock)
+	m.On("FindPreference", "alice").Return(domain.MailPreference{}, errors.New("record not found")).Once()
+	m.On("FindPreference", "bob").Return(domain.MailPreference{ID: 2, User: "bob", Delivery: domain.MailDeliveryImmediate, NotifiedAt: notifiedAt}, nil).Once()
+	m.On("FindPreference", "carol").Return(domain.MailPreference{ID: 3, User: "carol", Delivery: domain.MailDeliveryNone, NotifiedAt: notifiedAt}, nil).Once()
+	m.On("FindPreference", "dave").Return(domain.MailPreference{}, errors.New("database error")).Once()
+	alice := &domain.MailPreference{User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryImmediate, NotifiedAt: now}
+	bob := &domain.MailPreference{ID: 2, User: "bob", Email: "bob@example.com", Delivery: domain.MailDeliveryDigest, NotifiedAt: notifiedAt}
+	carol := &domain.MailPreference{ID: 3, User: "carol", Email: "carol@example.com", Delivery: domain.MailDeliveryImmediate, NotifiedAt: now}
+	m.On("SavePreference", alice).Return(alice, nil)
+	m.On("SavePreference", bob).Return(bob, nil)
+	m.On("SavePreference", carol).Return(carol, nil)
+
+	s := domain.GetDefaultMailService(m, nil)
+
+	item, err := s.SetPreference(&domain.MailPreference{User: " alice", Email: "Alice <alice@example.com>"}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, alice, item)
+
+	item, err = s.SetPreference(&domain.MailPreference{User: "bob", Email: "bob@example.com", Delivery: domain.MailDeliveryDigest}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, bob, item)
+
+	item, err = s.SetPreference(&domain.MailPreference{User: "carol", Email: "carol@example.com"}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, carol, item)
+
+	tests := []struct {
+		preference *domain.MailPreference
+		err        string
+	}{
+		{&domain.MailPreference{User: " ", Email: "alice@example.com"}, "user not provided"},
+		{&domain.MailPreference{User: "alice", Email: "alice@example.com", Delivery: "weekly"}, "delivery weekly not valid"},
+		{&domain.MailPreference{User: "alice", Email: "alice"}, "email alice not valid"},
+		{&domain.MailPreference{User: "dave", Email: "dave@example.com"}, "database error"},
+	}
+
+	for _, ts := range tests {
+		_, err := s.SetPreference(ts.preference, now)
+
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainMailQueueNotifications(t *testing.T) {
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	recent := now.Add(-time.Hour)
+	yesterday := now.Add(-domain.MailDigestInterval)
+	n := []domain.Notification{
+		{ID: 1, User: "alice", IssueID: 3, ProjectID: 1, Message: "Issue #3 Crash changed status to 2", Changes: "status: 1 -> 2\ntitle: Bug -> Crash"},
+		{ID: 2, User: "alice", ProjectID: 1, Message: "Project Tracker was updated"},
+	}
+	p := []domain.MailPreference{
+		{User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryImmediate, NotifiedAt: recent},
+		{User: "bob", Email: "bob@example.com", Delivery: domain.MailDeliveryDigest, NotifiedAt: yesterday},
+		{User: "carol", Email: "carol@example.com", Delivery: domain.MailDeliveryDigest, NotifiedAt: recent},
+		{User: "dave", Email: "dave@example.com", Delivery: domain.MailDeliveryImmediate, NotifiedAt: recent},
+	}
+	alice := p[0]
+	alice.NotifiedAt = now
+	bob := p[1]
+	bob.NotifiedAt = now
+
+	m := new(dTesting.MailRepositoryMock)
+	m.On("FindActivePreferences").Return(p, nil).Once()
+	m.On("FindNotificationsBetween", "alice", recent, now).Return(n, nil)
+	m.On("FindNotificationsBetween", "bob", yesterday, now).Return(n, nil)
+	m.On("FindNotificationsBetween", "dave", recent, now).Return([]domain.Notification{}, nil)
+	m.On("QueueMessages", alice, mock.MatchedBy(func(messages []domain.MailMessage) bool {
+		return len(messages) == 2 &&
+			messages[0].To == "alice@example.com" &&
+			messages[0].Subject == "Issue #3 Crash changed status to 2" &&
+			messages[0].Status == domain.MailStatusQueued &&
+			messages[0].SendAfter == now &&
+			strings.Contains(messages[0].Body, "http://localhost:3001/issues/3") &&
+			strings.Contains(messages[0].Body, "    status: 1 -> 2\n    title: Bug -> Crash") &&
+			strings.Contains(messages[1].Body, "http://localhost:3001/projects/1")
+	})).Return(2, nil)
+	m.On("QueueMessages", bob, mock.MatchedBy(func(messages []domain.MailMessage) bool {
+		return len(messages) == 1 &&
+			messages[0].To == "bob@example.com" &&
+			messages[0].Subject == "Daily digest: 2 new notifications" &&
+			strings.Contains(messages[0].Body, "* Issue #3 Crash changed status to 2\n  http://localhost:3001/issues/3\n    status: 1 -> 2") &&
+			strings.Contains(messages[0].Body, "* Project Tracker was updated")
+	})).Return(1, nil)
+	m.On("FindActivePreferences").Return([]domain.MailPreference{}, errors.New("test error")).Once()
+
+	s := domain.GetDefaultMailService(m, nil)
+
+	count, err := s.QueueNotifications(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+
+	_, err = s.QueueNotifications(now)
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainMailProcessQueue(t *testing.T) {
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	messages := []domain.MailMessage{
+		{ID: 1, To: "alice@example.com", Status: domain.MailStatusQueued},
+		{ID: 2, To: "bob@example.com", Status: domain.MailStatusQueued, Attempts: 2},
+		{ID: 3, To: "carol@example.com", Status: domain.MailStatusQueued, Attempts: domain.MailMaxAttempts - 1},
+	}
+
+	sent := messages[0]
+	sent.Attempts = 1
+	sent.Status = domain.MailStatusSent
+	sent.SentAt = now
+	retried := messages[1]
+	retried.Attempts = 3
+	retried.LastError = "connection refused"
+	retried.SendAfter = now.Add(4 * time.Minute)
+	failed := messages[2]
+	failed.Attempts = domain.MailMaxAttempts
+	failed.LastError = "connection refused"
+	failed.Status = domain.MailStatusFailed
+
+	m := new(dTesting.MailRepositoryMock)
+	m.On("FindDueMessages", now, domain.MailBatchSize).Return(messages, nil).Once()
+	m.On("UpdateMessage", sent).Return(sent, nil)
+	m.On("UpdateMessage", retried).Return(retried, nil)
+	m.On("UpdateMessage", failed).Return(failed, nil)
+	m.On("FindDueMessages", now, domain.MailBatchSize).Return([]domain.MailMessage{}, errors.New("test error")).Once()
+
+	ms := new(dTesting.MailSenderMock)
+	ms.On("Send", mock.MatchedBy(func(message domain.MailMessage) bool { return message.ID == 1 })).Return(nil)
+	ms.On("Send", mock.Anything).Return(errors.New("connection refused"))
+
+	s := domain.GetDefaultMailService(m, ms)
+
+	count, err := s.ProcessQueue(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = s.ProcessQueue(now)
+
+	assert.NotNil(t, err)
+
+	_, err = domain.GetDefaultMailService(m, nil).ProcessQueue(now)
+
+	assert.Equal(t, "mail sender not configured", err.Error())
+
+	m.AssertExpectations(t)
+	ms.AssertExpectations(t)
+}
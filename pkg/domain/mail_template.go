@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// mailTemplateFuncs are helpers available in mail templates
+var mailTemplateFuncs = template.FuncMap{
+	"url":    getNotificationURL,
+	"indent": indentLines,
+}
+
+// notificationMailTemplate renders body of email with single notification
+var notificationMailTemplate = template.Must(template.New("notification").Funcs(mailTemplateFuncs).Parse(`Hello {{.User}},
+
+{{.Notification.Message}}
+{{url .Notification}}
+{{- if .Notification.Changes}}
+
+Changes:
+{{indent .Notification.Changes}}
+{{- end}}
+
+You receive this email because you watch this issue or its project.
+`))
+
+// digestMailTemplate renders body of email with notifications of user since last digest
+var digestMailTemplate = template.Must(template.New("digest").Funcs(mailTemplateFuncs).Parse(`Hello {{.User}},
+
+there are {{len .Notifications}} new notifications since your last digest.
+{{range .Notifications}}
+* {{.Message}}
+  {{url .}}
+{{- if .Changes}}
+{{indent .Changes}}
+{{- end}}
+{{end}}
+You receive this digest because you watch these issues or their projects.
+`))
+
+// getNotificationURL to get link to issue of notification, or to project when notification has no issue
+func getNotificationURL(notification Notification) string {
+	if notification.IssueID == 0 {
+		return fmt.Sprintf("%s/projects/%d", strings.TrimRight(MailBaseURL, "/"), notification.ProjectID)
+	}
+	return GetIssueURL(notification.IssueID)
+}
+
+// indentLines to indent every line of text
+func indentLines(text string) string {
+	return "    " + strings.Replace(text, "\n", "\n    ", -1)
+}
+
+// renderMailTemplate to execute template with data
+func renderMailTemplate(t *template.Template, data interface{}) (string, error) {
+	var body bytes.Buffer
+	if err := t.Execute(&body, data); err != nil {
+		return "", err
+	}
+	return body.String(), nil
+}
+
+// newNotificationMail to render message with single notification for user
+func newNotificationMail(preference MailPreference, notification Notification) (MailMessage, error) {
+	body, err := renderMailTemplate(notificationMailTemplate, map[string]interface{}{
+		"User":         preference.User,
+		"Notification": notification,
+	})
+	if err != nil {
+		return MailMessage{}, err
+	}
+	return MailMessage{
+		To:      preference.Email,
+		Subject: notification.Message,
+		Body:    body,
+		Status:  MailStatusQueued,
+	}, nil
+}
+
+// newDigestMail to render message with all notifications of user since last digest
+func newDigestMail(preference MailPreference, notifications []Notification) (MailMessage, error) {
+	body, err := renderMailTemplate(digestMailTemplate, map[string]interface{}{
+		"User":          preference.User,
+		"Notifications": notifications,
+	})
+	if err != nil {
+		return MailMessage{}, err
+	}
+	return MailMessage{
+		To:      preference.Email,
+		Subject: fmt.Sprintf("Daily digest: %d new notifications", len(notifications)),
+		Body:    body,
+		Status:  MailStatusQueued,
+	}, nil
+}
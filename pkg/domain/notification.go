@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// NotificationEventUpdated marks update of watched issue or project
+	NotificationEventUpdated = "updated"
+	// NotificationEventStatusChanged marks status change of watched issue
+	NotificationEventStatusChanged = "status_changed"
+	// NotificationEventCommented marks comment added to watched issue by automation rule
+	NotificationEventCommented = "commented"
+)
+
+// Watcher entity subscribes user to notifications of either issue or project, watchers of project
+// are notified about changes of all its issues
+type Watcher struct {
+	ID        uint      `json:"id"`
+	User      string    `json:"user"`
+	IssueID   uint      `json:"issueId"`
+	ProjectID uint      `json:"projectId"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Notification entity is an item of user inbox, project notifications have no issue ID,
+// changes hold changed fields of issue one per line
+type Notification struct {
+	ID        uint      `json:"id"`
+	User      string    `json:"user"`
+	IssueID   uint      `json:"issueId"`
+	ProjectID uint      `json:"projectId"`
+	Event     string    `json:"event"`
+	Message   string    `json:"message"`
+	Changes   string    `json:"changes"`
+	Read      bool      `json:"read"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// getNotificationUser to get user name without surrounding spaces
+func getNotificationUser(user string) string {
+	return strings.TrimSpace(user)
+}
+
+// GetIssueParticipants to get distinct reporter and assignee of issue, they watch issue automatically
+func GetIssueParticipants(issue Issue) []string {
+	users := []string{}
+	for _, user := range []string{issue.Reporter, issue.Assignee} {
+		user = getNotificationUser(user)
+		if user != "" && (len(users) == 0 || users[0] != user) {
+			users = append(users, user)
+		}
+	}
+	return users
+}
+
+// getIssueNotificationMessage to get message describing event of issue
+func getIssueNotificationMessage(issue Issue, event string) string {
+	switch event {
+	case NotificationEventStatusChanged:
+		return fmt.Sprintf("Issue #%d %s changed status to %d", issue.ID, issue.Title, issue.Status)
+	case NotificationEventCommented:
+		return fmt.Sprintf("Issue #%d %s was commented", issue.ID, issue.Title)
+	}
+	return fmt.Sprintf("Issue #%d %s was updated", issue.ID, issue.Title)
+}
+
+// getProjectNotificationMessage to get message describing update of project
+func getProjectNotificationMessage(project Project) string {
+	return fmt.Sprintf("Project %s was updated", project.Name)
+}
@@ -0,0 +1,15 @@
+package domain
+
+// NotificationRepository repository
+type NotificationRepository interface {
+	AddWatcher(watcher *Watcher) (*Watcher, error)
+	FindWatcherByID(id uint) (Watcher, error)
+	FindWatchers(user string, issueID uint, projectID uint) ([]Watcher, error)
+	FindWatchingUsers(issueID uint, projectID uint) ([]string, error)
+	RemoveWatcher(id uint) (bool, error)
+	AddNotifications(notifications []Notification) (int, error)
+	UpdateNotification(notification Notification) (Notification, error)
+	FindNotificationByID(id uint) (Notification, error)
+	FindNotifications(user string, unread bool) ([]Notification, error)
+	MarkAllNotificationsRead(user string) (int, error)
+}
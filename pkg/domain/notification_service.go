@@ -0,0 +1,170 @@
+package domain
+
+// NotificationService interface
+type NotificationService interface {
+	Watch(watcher *Watcher) (*Watcher, error)
+	Unwatch(watcher Watcher) (bool, error)
+	FindWatcherByID(id uint) (Watcher, error)
+	FindWatchers(user string, issueID uint, projectID uint) ([]Watcher, error)
+	NotifyIssue(issue Issue, event string, changes []IssueChange) (int, error)
+	NotifyProject(project Project, event string) (int, error)
+	FindNotificationByID(id uint) (Notification, error)
+	FindNotifications(user string, unread bool) ([]Notification, error)
+	MarkRead(notification Notification, read bool) (Notification, error)
+	MarkAllRead(user string) (int, error)
+}
+
+// notificationService struct
+type notificationService struct {
+	repository NotificationRepository
+}
+
+// GetDefaultNotificationService alias to newNotificationService
+var GetDefaultNotificationService = newNotificationService
+
+// ResetDefaultNotificationService to reset GetDefaultNotificationService value
+func ResetDefaultNotificationService() {
+	GetDefaultNotificationService = newNotificationService
+}
+
+// newNotificationService to create new NotificationService
+func newNotificationService(repository NotificationRepository) NotificationService {
+	return &notificationService{
+		repository: repository,
+	}
+}
+
+// Watch to subscribe user to issue or project, existing watcher is returned when user already watches it
+func (s *notificationService) Watch(watcher *Watcher) (*Watcher, error) {
+	watcher.User = getNotificationUser(watcher.User)
+	if watcher.User == "" {
+		return nil, NewValidationError("user not provided")
+	}
+	if (watcher.IssueID == 0) == (watcher.ProjectID == 0) {
+		return nil, NewValidationError("either issue or project must be watched")
+	}
+
+	items, err := s.repository.FindWatchers(watcher.User, watcher.IssueID, watcher.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > 0 {
+		return &items[0], nil
+	}
+
+	item, err := s.repository.AddWatcher(watcher)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Unwatch to unsubscribe user by removing watcher
+func (s *notificationService) Unwatch(watcher Watcher) (bool, error) {
+	status, err := s.repository.RemoveWatcher(watcher.ID)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// FindWatcherByID to find watcher by ID
+func (s *notificationService) FindWatcherByID(id uint) (Watcher, error) {
+	item, err := s.repository.FindWatcherByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindWatchers to find watchers, filters are applied only when provided
+func (s *notificationService) FindWatchers(user string, issueID uint, projectID uint) ([]Watcher, error) {
+	items, err := s.repository.FindWatchers(getNotificationUser(user), issueID, projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// notify to add notification for every user watching issue or project
+func (s *notificationService) notify(issueID uint, projectID uint, event string, message string, changes string) (int, error) {
+	users, err := s.repository.FindWatchingUsers(issueID, projectID)
+	if err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, nil
+	}
+
+	notifications := make([]Notification, len(users))
+	for i, user := range users {
+		notifications[i] = Notification{
+			User:      user,
+			IssueID:   issueID,
+			ProjectID: projectID,
+			Event:     event,
+			Message:   message,
+			Changes:   changes,
+		}
+	}
+	added, err := s.repository.AddNotifications(notifications)
+	if err != nil {
+		return added, err
+	}
+	return added, nil
+}
+
+// NotifyIssue to notify watchers of issue and its project about event and changed fields
+func (s *notificationService) NotifyIssue(issue Issue, event string, changes []IssueChange) (int, error) {
+	return s.notify(issue.ID, issue.ProjectID, event, getIssueNotificationMessage(issue, event), FormatIssueChanges(changes))
+}
+
+// NotifyProject to notify watchers of project about event
+func (s *notificationService) NotifyProject(project Project, event string) (int, error) {
+	return s.notify(0, project.ID, event, getProjectNotificationMessage(project), "")
+}
+
+// FindNotificationByID to find notification by ID
+func (s *notificationService) FindNotificationByID(id uint) (Notification, error) {
+	item, err := s.repository.FindNotificationByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindNotifications to find notifications in inbox of user, newest first
+func (s *notificationService) FindNotifications(user string, unread bool) ([]Notification, error) {
+	user = getNotificationUser(user)
+	if user == "" {
+		return []Notification{}, NewValidationError("user not provided")
+	}
+	items, err := s.repository.FindNotifications(user, unread)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// MarkRead to mark notification as read or unread
+func (s *notificationService) MarkRead(notification Notification, read bool) (Notification, error) {
+	notification.Read = read
+	item, err := s.repository.UpdateNotification(notification)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// MarkAllRead to mark all notifications in inbox of user as read
+func (s *notificationService) MarkAllRead(user string) (int, error) {
+	user = getNotificationUser(user)
+	if user == "" {
+		return 0, NewValidationError("user not provided")
+	}
+	marked, err := s.repository.MarkAllNotificationsRead(user)
+	if err != nil {
+		return marked, err
+	}
+	return marked, nil
+}
@@ -0,0 +1,230 @@
+package domain_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"testing"
+)
+
+func TestDomainNotificationResetDefaultNotificationService(t *testing.T) {
+	assert.NotNil(t, domain.GetDefaultNotificationService)
+
+	domain.GetDefaultNotificationService = nil
+	defer domain.ResetDefaultNotificationService()
+
+	assert.Nil(t, domain.GetDefaultNotificationService)
+
+	domain.ResetDefaultNotificationService()
+
+	assert.NotNil(t, domain.GetDefaultNotificationService)
+}
+
+func TestDomainNotificationWatch(t *testing.T) {
+	w := &domain.Watcher{User: " alice ", IssueID: 1}
+	added := &domain.Watcher{ID: 1, User: "alice", IssueID: 1}
+
+	m := new(dTesting.NotificationRepositoryMock)
+	m.On("FindWatchers", "alice", uint(1), uint(0)).Return([]domain.Watcher{}, nil)
+	m.On("AddWatcher", &domain.Watcher{User: "alice", IssueID: 1}).Return(added, nil)
+
+	s := domain.GetDefaultNotificationService(m)
+
+	item, err := s.Watch(w)
+
+	assert.Nil(t, err)
+	assert.Equal(t, added, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainNotificationWatchExisting(t *testing.T) {
+	existing := domain.Watcher{ID: 1, User: "alice", ProjectID: 2}
+
+	m := new(dTesting.NotificationRepositoryMock)
+	m.On("FindWatchers", "alice", uint(0), uint(2)).Return([]domain.Watcher{existing}, nil)
+
+	s := domain.GetDefaultNotificationService(m)
+
+	item, err := s.Watch(&domain.Watcher{User: "alice", ProjectID: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &existing, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainNotificationWatchErrs(t *testing.T) {
+	tests := []struct {
+		w   *domain.Watcher
+		err string
+	}{
+		{&domain.Watcher{User: " ", IssueID: 1}, "user not provided"},
+		{&domain.Watcher{User: "alice"}, "either issue or project must be watched"},
+		{&domain.Watcher{User: "alice", IssueID: 1, ProjectID: 1}, "either issue or project must be watched"},
+		{&domain.Watcher{User: "alice", IssueID: 2}, "test error"},
+	}
+
+	m := new(dTesting.NotificationRepositoryMock)
+	m.On("FindWatchers", "alice", uint(2), uint(0)).Return([]domain.Watcher{}, errors.New("test error"))
+
+	s := domain.GetDefaultNotificationService(m)
+
+	for _, ts := range tests {
+		item, err := s.Watch(ts.w)
+
+		assert.Nil(t, item)
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainNotificationUnwatch(t *testing.T) {
+	m := new(dTesting.NotificationRepositoryMock)
+	m.On("RemoveWatcher", uint(1)).Return(true, nil)
+
+	s := domain.GetDefaultNotificationService(m)
+
+	status, err := s.Unwatch(domain.Watcher{ID: 1})
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainNotificationGetIssueParticipants(t *testing.T) {
+	assert.Equal(t, []string{}, domain.GetIssueParticipants(domain.Issue{}))
+	assert.Equal(t, []string{"alice", "bob"}, domain.GetIssueParticipants(domain.Issue{Reporter: " alice", Assignee: "bob"}))
+	assert.Equal(t, []string{"alice"}, domain.GetIssueParticipants(domain.Issue{Reporter: "alice", Assignee: "alice "}))
+	assert.Equal(t, []string{"bob"}, domain.GetIssueParticipants(domain.Issue{Assignee: "bob"}))
+}
+
+func TestDomainNotificationNotifyIssue(t *testing.T) {
+	i := domain.Issue{ID: 3, Title: "Crash", Status: 2, ProjectID: 1}
+
+	tests := []struct {
+		event   string
+		changes []domain.IssueChange
+		message string
+		diff    string
+	}{
+		{domain.NotificationEventUpdated, []domain.IssueChange{{Field: "title", Previous: "Bug", Current: "Crash"}}, "Issue #3 Crash was updated", "title: Bug -> Crash"},
+		{domain.NotificationEventStatusChanged, []domain.IssueChange{{Field: "status", Previous: "1", Current: "2"}}, "Issue #3 Crash changed status to 2", "status: 1 -> 2"},
+		{domain.NotificationEventCommented, nil, "Issue #3 Crash was commented", ""},
+	}
+
+	for _, ts := range tests {
+		n := []domain.Notification{
+			{User: "alice", IssueID: 3, ProjectID: 1, Event: ts.event, Message: ts.message, Changes: ts.diff},
+			{User: "bob", IssueID: 3, ProjectID: 1, Event: ts.event, Message: ts.message, Changes: ts.diff},
+		}
+
+		m := new(dTesting.NotificationRepositoryMock)
+		m.On("FindWatchingUsers", uint(3), uint(1)).Return([]string{"alice", "bob"}, nil)
+		m.On("AddNotifications", n).Return(2, nil)
+
+		s := domain.GetDefaultNotificationService(m)
+
+		count, err := s.NotifyIssue(i, ts.event, ts.changes)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, count)
+
+		m.AssertExpectations(t)
+	}
+}
+
+func TestDomainNotificationNotifyProject(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "Tracker"}
+	n := []domain.Notification{{User: "alice", ProjectID: 1, Event: domain.NotificationEventUpdated, Message: "Project Tracker was updated"}}
+
+	m := new(dTesting.NotificationRepositoryMock)
+	m.On("FindWatchingUsers", uint(0), uint(1)).Return([]string{"alice"}, nil).Once()
+	m.On("AddNotifications", n).Return(1, nil)
+	m.On("FindWatchingUsers", uint(0), uint(1)).Return([]string{}, nil).Once()
+	m.On("FindWatchingUsers", uint(0), uint(1)).Return([]string{}, errors.New("test error")).Once()
+
+	s := domain.GetDefaultNotificationService(m)
+
+	count, err := s.NotifyProject(p, domain.NotificationEventUpdated)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = s.NotifyProject(p, domain.NotificationEventUpdated)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = s.NotifyProject(p, domain.NotificationEventUpdated)
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainNotificationFindNotifications(t *testing.T) {
+	n := []domain.Notification{{ID: 1, User: "alice"}}
+
+	m := new(dTesting.NotificationRepositoryMock)
+	m.On("FindNotifications", "alice", true).Return(n, nil)
+
+	s := domain.GetDefaultNotificationService(m)
+
+	items, err := s.FindNotifications(" alice", true)
+
+	assert.Nil(t, err)
+	assert.Equal(t, n, items)
+
+	_, err = s.FindNotifications("", false)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "user not provided", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainNotificationMarkRead(t *testing.T) {
+	n := domain.Notification{ID: 1, User: "alice"}
+	read := domain.Notification{ID: 1, User: "alice", Read: true}
+
+	m := new(dTesting.NotificationRepositoryMock)
+	m.On("UpdateNotification", read).Return(read, nil)
+	m.On("UpdateNotification", n).Return(n, errors.New("test error"))
+
+	s := domain.GetDefaultNotificationService(m)
+
+	item, err := s.MarkRead(n, true)
+
+	assert.Nil(t, err)
+	assert.True(t, item.Read)
+
+	_, err = s.MarkRead(read, false)
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainNotificationMarkAllRead(t *testing.T) {
+	m := new(dTesting.NotificationRepositoryMock)
+	m.On("MarkAllNotificationsRead", "alice").Return(3, nil)
+
+	s := domain.GetDefaultNotificationService(m)
+
+	count, err := s.MarkAllRead("alice")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+
+	_, err = s.MarkAllRead(" ")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "user not provided", err.Error())
+
+	m.AssertExpectations(t)
+}
@@ -4,11 +4,14 @@ import (
 	"time"
 )
 
-// Project entity
+// Project entity, default labels are assigned to new issue created without labels
 type Project struct {
-	ID          uint      `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID            uint            `json:"id"`
+	Name          string          `json:"name"`
+	Description   string          `json:"description"`
+	Archived      bool            `json:"archived"`
+	Settings      ProjectSettings `json:"settings" gorm:"embedded;embedded_prefix:settings_"`
+	DefaultLabels []Label         `json:"defaultLabels" gorm:"many2many:projects_default_labels;association_autoupdate:false;association_autocreate:false;association_save_reference:false"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
 }
@@ -0,0 +1,15 @@
+package domain
+
+// Project removal modes
+const (
+	ProjectRemovalCascade = "cascade"
+	ProjectRemovalMove    = "move"
+	ProjectRemovalArchive = "archive"
+)
+
+// ProjectRemoval describes outcome of project removal
+type ProjectRemoval struct {
+	ID     uint   `json:"id"`
+	Mode   string `json:"mode"`
+	Issues int    `json:"issues"`
+}
@@ -2,10 +2,26 @@ package domain
 
 // ProjectRepository repository
 type ProjectRepository interface {
+	WithTransaction(tx Transaction) ProjectRepository
 	Add(project *Project) (*Project, error)
 	Update(project Project) (Project, error)
+	UpdateSettings(project Project) (Project, error)
 	FindByID(id uint) (Project, error)
 	Find(name string) ([]Project, error)
 	FindAll() ([]Project, error)
+	FindArchived() ([]Project, error)
+	CountIssues(id uint) (int, error)
 	Remove(id uint) (bool, error)
+	RemoveCascade(id uint) (int, error)
+	RemoveMovingIssues(id uint, target Project) (int, error)
+	AddTemplate(template *IssueTemplate) (*IssueTemplate, error)
+	UpdateTemplate(template IssueTemplate) (IssueTemplate, error)
+	FindTemplateByID(id uint) (IssueTemplate, error)
+	FindTemplates(projectID uint) ([]IssueTemplate, error)
+	RemoveTemplate(id uint) (bool, error)
+	AddCustomField(field *CustomField) (*CustomField, error)
+	UpdateCustomField(field CustomField) (CustomField, error)
+	FindCustomFieldByID(id uint) (CustomField, error)
+	FindCustomFields(projectID uint) ([]CustomField, error)
+	RemoveCustomField(id uint) (bool, error)
 }
@@ -1,13 +1,30 @@
 package domain
 
+import (
+	"strings"
+)
+
 // ProjectService interface
 type ProjectService interface {
 	Add(project *Project) (*Project, error)
 	Update(project Project) (Project, error)
+	UpdateSettings(project Project) (Project, error)
 	FindByID(id uint) (Project, error)
 	Find(name string) ([]Project, error)
 	FindAll() ([]Project, error)
+	FindArchived() ([]Project, error)
 	Remove(id uint) (bool, error)
+	RemoveWithMode(project Project, mode string, target *Project) (ProjectRemoval, error)
+	AddTemplate(project Project, template *IssueTemplate) (*IssueTemplate, error)
+	UpdateTemplate(project Project, template IssueTemplate) (IssueTemplate, error)
+	FindTemplateByID(id uint) (IssueTemplate, error)
+	FindTemplates(projectID uint) ([]IssueTemplate, error)
+	RemoveTemplate(id uint) (bool, error)
+	AddCustomField(project Project, field *CustomField) (*CustomField, error)
+	UpdateCustomField(project Project, field CustomField) (CustomField, error)
+	FindCustomFieldByID(id uint) (CustomField, error)
+	FindCustomFields(projectID uint) ([]CustomField, error)
+	RemoveCustomField(id uint) (bool, error)
 }
 
 // projectService struct
@@ -30,8 +47,12 @@ func newProjectService(repository ProjectRepository) ProjectService {
 	}
 }
 
-// Add to add new project
+// Add to add new project, default settings are applied when settings are not provided
 func (s *projectService) Add(project *Project) (*Project, error) {
+	if project.Settings == (ProjectSettings{}) {
+		project.Settings = NewProjectSettings()
+	}
+
 	item, err := s.repository.Add(project)
 	if err != nil {
 		return nil, err
@@ -41,6 +62,10 @@ func (s *projectService) Add(project *Project) (*Project, error) {
 
 // Update to update project
 func (s *projectService) Update(project Project) (Project, error) {
+	if project.Archived {
+		return project, NewForbiddenError("project is archived")
+	}
+
 	item, err := s.repository.Update(project)
 	if err != nil {
 		return item, err
@@ -48,6 +73,64 @@ func (s *projectService) Update(project Project) (Project, error) {
 	return item, nil
 }
 
+// validateSettings validates project settings and default labels
+func (s *projectService) validateSettings(project Project) error {
+	if project.Settings.MaxLabels < 1 {
+		return NewValidationError("max labels not valid")
+	}
+	for _, field := range project.Settings.GetRequiredFields() {
+		if field != IssueFieldDescription && field != IssueFieldLabels {
+			return NewValidationError("%s field cannot be required", field)
+		}
+	}
+	if _, err := project.Settings.GetAllowedStatuses(); err != nil {
+		return err
+	}
+	if !project.Settings.IsStatusAllowed(project.Settings.DefaultStatus) {
+		return NewValidationError("default status is not allowed")
+	}
+	if _, err := project.Settings.GetAllowedPriorities(); err != nil {
+		return err
+	}
+	if _, err := project.Settings.GetAllowedSeverities(); err != nil {
+		return err
+	}
+	if _, err := project.Settings.GetSLAPolicy(); err != nil {
+		return err
+	}
+	if _, err := project.Settings.GetSLAAtRisk(); err != nil {
+		return err
+	}
+	if _, err := project.Settings.GetSLAResolvedStatuses(); err != nil {
+		return err
+	}
+	if len(project.DefaultLabels) > project.Settings.MaxLabels {
+		return NewValidationError("max. %d labels can be assigned to issue", project.Settings.MaxLabels)
+	}
+	for _, label := range project.DefaultLabels {
+		if label.ProjectID != 0 && label.ProjectID != project.ID {
+			return NewValidationError("%s label does not belong to project", label.Name)
+		}
+	}
+	return nil
+}
+
+// UpdateSettings to update project settings and default labels
+func (s *projectService) UpdateSettings(project Project) (Project, error) {
+	if project.Archived {
+		return project, NewForbiddenError("project is archived")
+	}
+	if err := s.validateSettings(project); err != nil {
+		return project, err
+	}
+
+	item, err := s.repository.UpdateSettings(project)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
 // FindByID to find project by ID
 func (s *projectService) FindByID(id uint) (Project, error) {
 	item, err := s.repository.FindByID(id)
@@ -75,6 +158,15 @@ func (s *projectService) FindAll() ([]Project, error) {
 	return items, nil
 }
 
+// FindArchived to find archived projects
+func (s *projectService) FindArchived() ([]Project, error) {
+	items, err := s.repository.FindArchived()
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
 // Remove to remove project
 func (s *projectService) Remove(id uint) (bool, error) {
 	status, err := s.repository.Remove(id)
@@ -83,3 +175,205 @@ func (s *projectService) Remove(id uint) (bool, error) {
 	}
 	return status, nil
 }
+
+// RemoveWithMode to remove project deleting, moving or archiving its issues
+func (s *projectService) RemoveWithMode(project Project, mode string, target *Project) (ProjectRemoval, error) {
+	removal := ProjectRemoval{
+		ID:   project.ID,
+		Mode: mode,
+	}
+
+	var err error
+	switch mode {
+	case ProjectRemovalCascade:
+		removal.Issues, err = s.repository.RemoveCascade(project.ID)
+	case ProjectRemovalMove:
+		if target == nil || target.ID == 0 || target.ID == project.ID {
+			return removal, NewValidationError("target project not valid")
+		}
+		if target.Archived {
+			return removal, NewForbiddenError("target project is archived")
+		}
+		removal.Issues, err = s.repository.RemoveMovingIssues(project.ID, *target)
+	case ProjectRemovalArchive:
+		if project.Archived {
+			return removal, NewForbiddenError("project is archived")
+		}
+		if removal.Issues, err = s.repository.CountIssues(project.ID); err != nil {
+			return removal, err
+		}
+		project.Archived = true
+		_, err = s.repository.Update(project)
+	default:
+		return removal, NewValidationError("removal mode not valid")
+	}
+	if err != nil {
+		return removal, err
+	}
+
+	return removal, nil
+}
+
+// validateTemplate validates issue template against its project and project settings
+func (s *projectService) validateTemplate(project Project, template IssueTemplate) error {
+	if project.Archived {
+		return NewForbiddenError("project is archived")
+	}
+	if template.ProjectID != project.ID {
+		return NewValidationError("template does not belong to project")
+	}
+	if template.Status != nil && !project.Settings.IsStatusAllowed(*template.Status) {
+		return NewValidationError("status %d is not allowed in project", *template.Status)
+	}
+	if len(template.Labels) > project.Settings.GetMaxLabels() {
+		return NewValidationError("max. %d labels can be assigned to issue", project.Settings.GetMaxLabels())
+	}
+	for _, label := range template.Labels {
+		if label.ProjectID != 0 && label.ProjectID != project.ID {
+			return NewValidationError("%s label does not belong to project", label.Name)
+		}
+	}
+	return nil
+}
+
+// AddTemplate to add new issue template to project
+func (s *projectService) AddTemplate(project Project, template *IssueTemplate) (*IssueTemplate, error) {
+	if err := s.validateTemplate(project, *template); err != nil {
+		return nil, err
+	}
+
+	item, err := s.repository.AddTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// UpdateTemplate to update issue template of project
+func (s *projectService) UpdateTemplate(project Project, template IssueTemplate) (IssueTemplate, error) {
+	if err := s.validateTemplate(project, template); err != nil {
+		return template, err
+	}
+
+	item, err := s.repository.UpdateTemplate(template)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindTemplateByID to find issue template by ID
+func (s *projectService) FindTemplateByID(id uint) (IssueTemplate, error) {
+	item, err := s.repository.FindTemplateByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindTemplates to find issue templates of project
+func (s *projectService) FindTemplates(projectID uint) ([]IssueTemplate, error) {
+	items, err := s.repository.FindTemplates(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveTemplate to remove issue template
+func (s *projectService) RemoveTemplate(id uint) (bool, error) {
+	status, err := s.repository.RemoveTemplate(id)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// validateCustomField validates custom field against its project and other fields of project
+func (s *projectService) validateCustomField(project Project, field CustomField) error {
+	if project.Archived {
+		return NewForbiddenError("project is archived")
+	}
+	if field.ProjectID != project.ID {
+		return NewValidationError("custom field does not belong to project")
+	}
+	if strings.TrimSpace(field.Name) == "" {
+		return NewValidationError("name not provided")
+	}
+	if !IsCustomFieldTypeValid(field.Type) {
+		return NewValidationError("custom field type %s not valid", field.Type)
+	}
+	selectable := field.Type == CustomFieldTypeSelect || field.Type == CustomFieldTypeMultiSelect
+	if selectable && len(field.GetOptions()) == 0 {
+		return NewValidationError("options not provided")
+	}
+	fields, err := s.repository.FindCustomFields(project.ID)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.ID == field.ID {
+			if f.Type != field.Type {
+				return NewValidationError("custom field type can not be changed")
+			}
+			continue
+		}
+		if f.Name == field.Name {
+			return NewValidationError("custom field %s already exists in project", field.Name)
+		}
+	}
+	return nil
+}
+
+// AddCustomField to add new custom field to project
+func (s *projectService) AddCustomField(project Project, field *CustomField) (*CustomField, error) {
+	if err := s.validateCustomField(project, *field); err != nil {
+		return nil, err
+	}
+
+	item, err := s.repository.AddCustomField(field)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// UpdateCustomField to update custom field of project, type of field can not be changed
+func (s *projectService) UpdateCustomField(project Project, field CustomField) (CustomField, error) {
+	if err := s.validateCustomField(project, field); err != nil {
+		return field, err
+	}
+
+	item, err := s.repository.UpdateCustomField(field)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindCustomFieldByID to find custom field by ID
+func (s *projectService) FindCustomFieldByID(id uint) (CustomField, error) {
+	item, err := s.repository.FindCustomFieldByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindCustomFields to find custom fields of project
+func (s *projectService) FindCustomFields(projectID uint) ([]CustomField, error) {
+	items, err := s.repository.FindCustomFields(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveCustomField to remove custom field and its values
+func (s *projectService) RemoveCustomField(id uint) (bool, error) {
+	status, err := s.repository.RemoveCustomField(id)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
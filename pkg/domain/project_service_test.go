@@ -3,9 +3,11 @@ package domain_test
 import (
 	"errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go-issue-tracker/pkg/domain"
 	dTesting "go-issue-tracker/pkg/domain/testing"
 	"testing"
+	"time"
 )
 
 func TestDomainProjectResetDefaultProjectService(t *testing.T) {
@@ -44,6 +46,7 @@ func TestDomainProjectAdd(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
 	assert.Equal(t, p, item)
+	assert.Equal(t, domain.NewProjectSettings(), item.Settings)
 
 	m.AssertExpectations(t)
 }
@@ -231,3 +234,537 @@ func TestDomainProjectRemoveErr(t *testing.T) {
 
 	m.AssertExpectations(t)
 }
+
+func TestDomainProjectUpdateArchivedErr(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name", Archived: true}
+
+	m := new(dTesting.ProjectRepositoryMock)
+
+	s := domain.GetDefaultProjectService(m)
+
+	item, err := s.Update(p)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "project is archived", err.Error())
+	assert.Equal(t, p, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectFindArchived(t *testing.T) {
+	p := []domain.Project{
+		domain.Project{ID: 1, Name: "test-name", Archived: true},
+	}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindArchived").Return(p, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	items, err := s.FindArchived()
+
+	assert.Nil(t, err)
+	assert.Equal(t, p, items)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectFindArchivedErr(t *testing.T) {
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindArchived").Return([]domain.Project{}, errors.New("test error"))
+
+	s := domain.GetDefaultProjectService(m)
+
+	items, err := s.FindArchived()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.Project{}, items)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectRemoveWithModeCascade(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("RemoveCascade", uint(1)).Return(3, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	removal, err := s.RemoveWithMode(p, domain.ProjectRemovalCascade, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.ProjectRemoval{ID: 1, Mode: domain.ProjectRemovalCascade, Issues: 3}, removal)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectRemoveWithModeMove(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	tp := domain.Project{ID: 2, Name: "test-target"}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("RemoveMovingIssues", uint(1), tp).Return(2, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	removal, err := s.RemoveWithMode(p, domain.ProjectRemovalMove, &tp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.ProjectRemoval{ID: 1, Mode: domain.ProjectRemovalMove, Issues: 2}, removal)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectRemoveWithModeArchive(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	ap := domain.Project{ID: 1, Name: "test-name", Archived: true}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("CountIssues", uint(1)).Return(4, nil)
+	m.On("Update", ap).Return(ap, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	removal, err := s.RemoveWithMode(p, domain.ProjectRemovalArchive, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.ProjectRemoval{ID: 1, Mode: domain.ProjectRemovalArchive, Issues: 4}, removal)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectRemoveWithModeErr(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	ap := domain.Project{ID: 1, Name: "test-name", Archived: true}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("RemoveCascade", uint(1)).Return(0, errors.New("test error"))
+	m.On("CountIssues", uint(1)).Return(0, errors.New("test error"))
+
+	s := domain.GetDefaultProjectService(m)
+
+	tests := []struct {
+		project domain.Project
+		mode    string
+		target  *domain.Project
+		err     string
+	}{
+		{p, "", nil, "removal mode not valid"},
+		{p, domain.ProjectRemovalCascade, nil, "test error"},
+		{p, domain.ProjectRemovalMove, nil, "target project not valid"},
+		{p, domain.ProjectRemovalMove, &p, "target project not valid"},
+		{p, domain.ProjectRemovalMove, &domain.Project{ID: 2, Archived: true}, "target project is archived"},
+		{ap, domain.ProjectRemovalArchive, nil, "project is archived"},
+		{p, domain.ProjectRemovalArchive, nil, "test error"},
+	}
+
+	for _, ts := range tests {
+		_, err := s.RemoveWithMode(ts.project, ts.mode, ts.target)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectUpdateSettings(t *testing.T) {
+	p := domain.Project{
+		ID: 1,
+		Settings: domain.ProjectSettings{
+			MaxLabels:       2,
+			RequiredFields:  "description",
+			DefaultStatus:   1,
+			AllowedStatuses: "1,2",
+		},
+		DefaultLabels: []domain.Label{{ID: 1}, {ID: 2, ProjectID: 1}},
+	}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("UpdateSettings", p).Return(p, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	item, err := s.UpdateSettings(p)
+
+	assert.Nil(t, err)
+	assert.Equal(t, p, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectUpdateSettingsErrs(t *testing.T) {
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("UpdateSettings", mock.AnythingOfType("domain.Project")).Return(domain.Project{}, errors.New("test error"))
+
+	s := domain.GetDefaultProjectService(m)
+
+	tests := []struct {
+		project domain.Project
+		err     string
+	}{
+		{domain.Project{ID: 1, Archived: true, Settings: domain.NewProjectSettings()}, "project is archived"},
+		{domain.Project{ID: 1}, "max labels not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, RequiredFields: "title"}}, "title field cannot be required"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, AllowedStatuses: "1,a"}}, "allowed statuses not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, AllowedStatuses: "1,2"}}, "default status is not allowed"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, AllowedPriorities: "low,asap"}}, "priority asap not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, AllowedSeverities: "9"}}, "severity 9 not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, SLAPolicy: "urgent:4h,high"}}, "sla policy high not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, SLAPolicy: "asap:4h"}}, "priority asap not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, SLAPolicy: "high:-1h"}}, "sla policy high:-1h not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, SLAAtRisk: "1d"}}, "sla at risk 1d not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, SLAResolvedStatuses: "3,done"}}, "sla resolved status done not valid"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1}, DefaultLabels: []domain.Label{{ID: 1}, {ID: 2}}}, "max. 1 labels can be assigned to issue"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1}, DefaultLabels: []domain.Label{{ID: 1, Name: "test", ProjectID: 2}}}, "test label does not belong to project"},
+		{domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1}}, "test error"},
+	}
+
+	for _, ts := range tests {
+		_, err := s.UpdateSettings(ts.project)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectSettings(t *testing.T) {
+	settings := domain.ProjectSettings{RequiredFields: " labels, ", AllowedStatuses: "1, 3"}
+
+	assert.Equal(t, domain.DefaultMaxLabels, settings.GetMaxLabels())
+	assert.Equal(t, []string{"labels"}, settings.GetRequiredFields())
+	assert.True(t, settings.IsRequired(domain.IssueFieldLabels))
+	assert.False(t, settings.IsRequired(domain.IssueFieldDescription))
+	assert.True(t, settings.IsStatusAllowed(3))
+	assert.False(t, settings.IsStatusAllowed(2))
+
+	settings = domain.ProjectSettings{MaxLabels: 3}
+
+	assert.Equal(t, 3, settings.GetMaxLabels())
+	assert.Equal(t, []string{}, settings.GetRequiredFields())
+	assert.True(t, settings.IsStatusAllowed(2))
+
+	settings.AllowedStatuses = "x"
+
+	assert.False(t, settings.IsStatusAllowed(2))
+}
+
+func TestDomainProjectSettingsLevels(t *testing.T) {
+	settings := domain.ProjectSettings{AllowedPriorities: "low, High,4", AllowedSeverities: ""}
+
+	priorities, err := settings.GetAllowedPriorities()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{domain.PriorityLow, domain.PriorityHigh, domain.PriorityUrgent}, priorities)
+	assert.True(t, settings.IsPriorityAllowed(domain.PriorityHigh))
+	assert.False(t, settings.IsPriorityAllowed(domain.PriorityNone))
+	assert.True(t, settings.IsSeverityAllowed(domain.SeverityBlocker))
+
+	settings.AllowedSeverities = "minor,x"
+
+	assert.False(t, settings.IsSeverityAllowed(domain.SeverityMinor))
+}
+
+func TestDomainProjectSettingsSLA(t *testing.T) {
+	settings := domain.ProjectSettings{SLAPolicy: "urgent:4h, high:24h", SLAResolvedStatuses: "3, 4"}
+
+	policy, err := settings.GetSLAPolicy()
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]time.Duration{domain.PriorityUrgent: 4 * time.Hour, domain.PriorityHigh: 24 * time.Hour}, policy)
+
+	atRisk, err := settings.GetSLAAtRisk()
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.DefaultSLAAtRisk, atRisk)
+
+	statuses, err := settings.GetSLAResolvedStatuses()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, 4}, statuses)
+}
+
+func TestDomainProjectAddTemplate(t *testing.T) {
+	p := domain.Project{
+		ID:       1,
+		Settings: domain.ProjectSettings{MaxLabels: 2, AllowedStatuses: "1,2"},
+	}
+	status := 2
+	tpl := &domain.IssueTemplate{
+		ProjectID:   1,
+		Name:        "bug",
+		TitlePrefix: "[Bug] ",
+		Status:      &status,
+		Labels:      []domain.Label{{ID: 1}, {ID: 2, ProjectID: 1}},
+	}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("AddTemplate", tpl).Return(tpl, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	item, err := s.AddTemplate(p, tpl)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tpl, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectAddTemplateErrs(t *testing.T) {
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("AddTemplate", mock.AnythingOfType("*domain.IssueTemplate")).Return((*domain.IssueTemplate)(nil), errors.New("test error"))
+
+	s := domain.GetDefaultProjectService(m)
+
+	p := domain.Project{ID: 1, Settings: domain.ProjectSettings{MaxLabels: 1, AllowedStatuses: "1"}}
+	status := 2
+	tests := []struct {
+		project  domain.Project
+		template domain.IssueTemplate
+		err      string
+	}{
+		{domain.Project{ID: 1, Archived: true}, domain.IssueTemplate{ProjectID: 1}, "project is archived"},
+		{p, domain.IssueTemplate{ProjectID: 2}, "template does not belong to project"},
+		{p, domain.IssueTemplate{ProjectID: 1, Status: &status}, "status 2 is not allowed in project"},
+		{p, domain.IssueTemplate{ProjectID: 1, Labels: []domain.Label{{ID: 1}, {ID: 2}}}, "max. 1 labels can be assigned to issue"},
+		{p, domain.IssueTemplate{ProjectID: 1, Labels: []domain.Label{{ID: 1, Name: "test", ProjectID: 2}}}, "test label does not belong to project"},
+		{p, domain.IssueTemplate{ProjectID: 1}, "test error"},
+	}
+
+	for _, ts := range tests {
+		_, err := s.AddTemplate(ts.project, &ts.template)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectUpdateTemplate(t *testing.T) {
+	p := domain.Project{ID: 1}
+	tpl := domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "bug"}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("UpdateTemplate", tpl).Return(tpl, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	item, err := s.UpdateTemplate(p, tpl)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tpl, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectUpdateTemplateErr(t *testing.T) {
+	p := domain.Project{ID: 1, Archived: true}
+	tpl := domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "bug"}
+
+	m := new(dTesting.ProjectRepositoryMock)
+
+	s := domain.GetDefaultProjectService(m)
+
+	_, err := s.UpdateTemplate(p, tpl)
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectFindTemplates(t *testing.T) {
+	tpl := domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "bug"}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindTemplateByID", uint(1)).Return(tpl, nil)
+	m.On("FindTemplates", uint(1)).Return([]domain.IssueTemplate{tpl}, nil)
+	m.On("RemoveTemplate", uint(1)).Return(true, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	item, err := s.FindTemplateByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tpl, item)
+
+	items, err := s.FindTemplates(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.IssueTemplate{tpl}, items)
+
+	status, err := s.RemoveTemplate(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectFindTemplatesErr(t *testing.T) {
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindTemplateByID", uint(1)).Return(domain.IssueTemplate{}, errors.New("test error"))
+	m.On("FindTemplates", uint(1)).Return([]domain.IssueTemplate{}, errors.New("test error"))
+	m.On("RemoveTemplate", uint(1)).Return(false, errors.New("test error"))
+
+	s := domain.GetDefaultProjectService(m)
+
+	_, err := s.FindTemplateByID(1)
+
+	assert.NotNil(t, err)
+
+	_, err = s.FindTemplates(1)
+
+	assert.NotNil(t, err)
+
+	_, err = s.RemoveTemplate(1)
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectAddCustomField(t *testing.T) {
+	p := domain.Project{ID: 1}
+	f := &domain.CustomField{ProjectID: 1, Name: "environment", Type: domain.CustomFieldTypeSelect, Options: "dev,prod"}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindCustomFields", uint(1)).Return([]domain.CustomField{{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}}, nil)
+	m.On("AddCustomField", f).Return(f, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	item, err := s.AddCustomField(p, f)
+
+	assert.Nil(t, err)
+	assert.Equal(t, f, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectAddCustomFieldErrs(t *testing.T) {
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindCustomFields", uint(1)).Return([]domain.CustomField{{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}}, nil)
+	m.On("AddCustomField", mock.AnythingOfType("*domain.CustomField")).Return((*domain.CustomField)(nil), errors.New("test error"))
+
+	s := domain.GetDefaultProjectService(m)
+
+	p := domain.Project{ID: 1}
+	tests := []struct {
+		project domain.Project
+		field   domain.CustomField
+		err     string
+	}{
+		{domain.Project{ID: 1, Archived: true}, domain.CustomField{ProjectID: 1}, "project is archived"},
+		{p, domain.CustomField{ProjectID: 2}, "custom field does not belong to project"},
+		{p, domain.CustomField{ProjectID: 1, Name: " ", Type: domain.CustomFieldTypeText}, "name not provided"},
+		{p, domain.CustomField{ProjectID: 1, Name: "test", Type: "test"}, "custom field type test not valid"},
+		{p, domain.CustomField{ProjectID: 1, Name: "test", Type: domain.CustomFieldTypeMultiSelect, Options: " , "}, "options not provided"},
+		{p, domain.CustomField{ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeUser}, "custom field customer already exists in project"},
+		{p, domain.CustomField{ProjectID: 1, Name: "test", Type: domain.CustomFieldTypeText}, "test error"},
+	}
+
+	for _, ts := range tests {
+		_, err := s.AddCustomField(ts.project, &ts.field)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectUpdateCustomField(t *testing.T) {
+	p := domain.Project{ID: 1}
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "client", Type: domain.CustomFieldTypeText}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindCustomFields", uint(1)).Return([]domain.CustomField{{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}}, nil)
+	m.On("UpdateCustomField", f).Return(f, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	item, err := s.UpdateCustomField(p, f)
+
+	assert.Nil(t, err)
+	assert.Equal(t, f, item)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectUpdateCustomFieldErrs(t *testing.T) {
+	p := domain.Project{ID: 1}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindCustomFields", uint(1)).Return([]domain.CustomField{{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}}, nil).Once()
+	m.On("FindCustomFields", uint(1)).Return([]domain.CustomField{}, errors.New("test error")).Once()
+
+	s := domain.GetDefaultProjectService(m)
+
+	_, err := s.UpdateCustomField(p, domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeNumber})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "custom field type can not be changed", err.Error())
+
+	_, err = s.UpdateCustomField(p, domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "test error", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectFindCustomFields(t *testing.T) {
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindCustomFieldByID", uint(1)).Return(f, nil)
+	m.On("FindCustomFields", uint(1)).Return([]domain.CustomField{f}, nil)
+	m.On("RemoveCustomField", uint(1)).Return(true, nil)
+
+	s := domain.GetDefaultProjectService(m)
+
+	item, err := s.FindCustomFieldByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, f, item)
+
+	items, err := s.FindCustomFields(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.CustomField{f}, items)
+
+	status, err := s.RemoveCustomField(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainProjectFindCustomFieldsErr(t *testing.T) {
+	m := new(dTesting.ProjectRepositoryMock)
+	m.On("FindCustomFieldByID", uint(1)).Return(domain.CustomField{}, errors.New("test error"))
+	m.On("FindCustomFields", uint(1)).Return([]domain.CustomField{}, errors.New("test error"))
+	m.On("RemoveCustomField", uint(1)).Return(false, errors.New("test error"))
+
+	s := domain.GetDefaultProjectService(m)
+
+	_, err := s.FindCustomFieldByID(1)
+
+	assert.NotNil(t, err)
+
+	_, err = s.FindCustomFields(1)
+
+	assert.NotNil(t, err)
+
+	_, err = s.RemoveCustomField(1)
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
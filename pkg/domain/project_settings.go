@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Issue fields which can be required by project settings
+const (
+	IssueFieldDescription = "description"
+	IssueFieldLabels      = "labels"
+)
+
+// DefaultMaxLabels is max. number of labels assigned to issue when project does not set it
+const DefaultMaxLabels = 10
+
+// ProjectSettings entity holds issue validation rules and SLA policy of project, required fields,
+// allowed statuses, priorities and severities are comma separated lists, empty allowed list allows
+// any value
+type ProjectSettings struct {
+	MaxLabels           int    `json:"maxLabels"`
+	RequiredFields      string `json:"requiredFields"`
+	DefaultStatus       int    `json:"defaultStatus"`
+	AllowedStatuses     string `json:"allowedStatuses"`
+	AllowedPriorities   string `json:"allowedPriorities"`
+	AllowedSeverities   string `json:"allowedSeverities"`
+	SLAPolicy           string `json:"slaPolicy"`
+	SLAAtRisk           string `json:"slaAtRisk"`
+	SLAResolvedStatuses string `json:"slaResolvedStatuses"`
+}
+
+// NewProjectSettings to create settings applied to new project
+func NewProjectSettings() ProjectSettings {
+	return ProjectSettings{
+		MaxLabels:      DefaultMaxLabels,
+		RequiredFields: IssueFieldDescription + "," + IssueFieldLabels,
+	}
+}
+
+// GetMaxLabels to get max. number of labels, DefaultMaxLabels when not set
+func (s ProjectSettings) GetMaxLabels() int {
+	if s.MaxLabels == 0 {
+		return DefaultMaxLabels
+	}
+	return s.MaxLabels
+}
+
+// GetRequiredFields to get list of required issue fields
+func (s ProjectSettings) GetRequiredFields() []string {
+	fields := []string{}
+	for _, field := range strings.Split(s.RequiredFields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// IsRequired to check if issue field is required
+func (s ProjectSettings) IsRequired(field string) bool {
+	for _, f := range s.GetRequiredFields() {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAllowedStatuses to get list of allowed statuses, empty when any status is allowed
+func (s ProjectSettings) GetAllowedStatuses() ([]int, error) {
+	statuses := []int{}
+	for _, value := range strings.Split(s.AllowedStatuses, ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		status, err := strconv.Atoi(value)
+		if err != nil {
+			return statuses, NewValidationError("allowed statuses not valid")
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// IsStatusAllowed to check if status is allowed
+func (s ProjectSettings) IsStatusAllowed(status int) bool {
+	statuses, err := s.GetAllowedStatuses()
+	if err != nil {
+		return false
+	}
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, st := range statuses {
+		if st == status {
+			return true
+		}
+	}
+	return false
+}
+
+// getAllowedLevels to get list of allowed levels parsed by parse func
+func (s ProjectSettings) getAllowedLevels(values string, parse func(string) (int, error)) ([]int, error) {
+	levels := []int{}
+	for _, value := range strings.Split(values, ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		level, err := parse(value)
+		if err != nil {
+			return levels, err
+		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+// isLevelAllowed to check if level is one of allowed levels, any level is allowed when list is empty
+func (s ProjectSettings) isLevelAllowed(level int, levels []int, err error) bool {
+	if err != nil {
+		return false
+	}
+	if len(levels) == 0 {
+		return true
+	}
+	for _, l := range levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAllowedPriorities to get list of allowed priorities, empty when any priority is allowed
+func (s ProjectSettings) GetAllowedPriorities() ([]int, error) {
+	return s.getAllowedLevels(s.AllowedPriorities, ParsePriority)
+}
+
+// IsPriorityAllowed to check if priority is allowed
+func (s ProjectSettings) IsPriorityAllowed(priority int) bool {
+	levels, err := s.GetAllowedPriorities()
+	return s.isLevelAllowed(priority, levels, err)
+}
+
+// GetAllowedSeverities to get list of allowed severities, empty when any severity is allowed
+func (s ProjectSettings) GetAllowedSeverities() ([]int, error) {
+	return s.getAllowedLevels(s.AllowedSeverities, ParseSeverity)
+}
+
+// IsSeverityAllowed to check if severity is allowed
+func (s ProjectSettings) IsSeverityAllowed(severity int) bool {
+	levels, err := s.GetAllowedSeverities()
+	return s.isLevelAllowed(severity, levels, err)
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// StreamLogSize is max. number of events kept in event log of stream for resumption of clients
+const StreamLogSize = 1000
+
+// StreamBufferSize is max. number of events waiting for client of stream, slower client is disconnected
+const StreamBufferSize = 64
+
+// StreamHeartbeatInterval is interval of heartbeat sent to clients of stream, it keeps idle connection open
+var StreamHeartbeatInterval = 15 * time.Second
+
+// StreamEvent is domain event sent to clients of stream, IDs are increasing within process
+type StreamEvent struct {
+	ID         uint64          `json:"id"`
+	Name       string          `json:"name"`
+	ProjectIDs []uint          `json:"projectIds"`
+	Payload    json.RawMessage `json:"payload"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
+
+// StreamSubscription is subscription of client to stream, events are filtered by project when project
+// ID is provided and done is closed when subscription is dropped because client is too slow
+type StreamSubscription struct {
+	ProjectID uint
+	Events    chan StreamEvent
+	Done      chan struct{}
+}
+
+// NewStreamEvent to create stream event from domain event
+func NewStreamEvent(id uint64, event DomainEvent, now time.Time) (StreamEvent, error) {
+	item := StreamEvent{
+		ID:         id,
+		Name:       event.EventName(),
+		ProjectIDs: GetEventProjectIDs(event),
+		CreatedAt:  now,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return item, err
+	}
+	item.Payload = payload
+	return item, nil
+}
+
+// NewStreamSubscription to create subscription to events of project, zero project ID subscribes to all events
+func NewStreamSubscription(projectID uint) *StreamSubscription {
+	return &StreamSubscription{
+		ProjectID: projectID,
+		Events:    make(chan StreamEvent, StreamBufferSize),
+		Done:      make(chan struct{}),
+	}
+}
+
+// Matches to check whether event belongs to project, events of global labels belong to all projects
+func (e StreamEvent) Matches(projectID uint) bool {
+	if projectID == 0 {
+		return true
+	}
+	for _, id := range e.ProjectIDs {
+		if id == 0 || id == projectID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetEventProjectIDs to get projects affected by event, moved issue affects source and target project
+func GetEventProjectIDs(event DomainEvent) []uint {
+	if e, ok := event.(IssueMoved); ok {
+		return []uint{e.PreviousProjectID, e.Issue.ProjectID}
+	}
+	return []uint{event.EventProjectID()}
+}
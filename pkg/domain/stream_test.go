@@ -0,0 +1,59 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+	"time"
+)
+
+func TestDomainStreamNewStreamEvent(t *testing.T) {
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	item, err := domain.NewStreamEvent(3, domain.IssueCreated{Issue: domain.Issue{ID: 1, Title: "XSS", ProjectID: 2}}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), item.ID)
+	assert.Equal(t, domain.EventIssueCreated, item.Name)
+	assert.Equal(t, []uint{2}, item.ProjectIDs)
+	assert.Equal(t, now, item.CreatedAt)
+	assert.Contains(t, string(item.Payload), "\"title\":\"XSS\"")
+}
+
+func TestDomainStreamMatches(t *testing.T) {
+	moved := domain.IssueMoved{Issue: domain.Issue{ID: 1, ProjectID: 2}, PreviousProjectID: 1}
+	label := domain.LabelCreated{Label: domain.Label{ID: 1, Name: "bug"}}
+
+	assert.Equal(t, []uint{1, 2}, domain.GetEventProjectIDs(moved))
+	assert.Equal(t, []uint{0}, domain.GetEventProjectIDs(label))
+
+	tests := []struct {
+		event     domain.DomainEvent
+		projectID uint
+		matches   bool
+	}{
+		{domain.IssueUpdated{Issue: domain.Issue{ID: 1, ProjectID: 1}}, 0, true},
+		{domain.IssueUpdated{Issue: domain.Issue{ID: 1, ProjectID: 1}}, 1, true},
+		{domain.IssueUpdated{Issue: domain.Issue{ID: 1, ProjectID: 1}}, 2, false},
+		{moved, 1, true},
+		{moved, 2, true},
+		{moved, 3, false},
+		{label, 3, true},
+		{domain.ProjectUpdated{Project: domain.Project{ID: 3}}, 3, true},
+	}
+
+	for _, ts := range tests {
+		item, err := domain.NewStreamEvent(1, ts.event, time.Now())
+
+		assert.Nil(t, err)
+		assert.Equal(t, ts.matches, item.Matches(ts.projectID))
+	}
+}
+
+func TestDomainStreamNewStreamSubscription(t *testing.T) {
+	s := domain.NewStreamSubscription(1)
+
+	assert.Equal(t, uint(1), s.ProjectID)
+	assert.Equal(t, domain.StreamBufferSize, cap(s.Events))
+	assert.NotNil(t, s.Done)
+}
@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// AutomationRepositoryMock is a mock of AutomationRepository
+type AutomationRepositoryMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *AutomationRepositoryMock) Add(rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	args := m.Called(rule)
+	return args.Get(0).(*domain.AutomationRule), args.Error(1)
+}
+
+// Update mock
+func (m *AutomationRepositoryMock) Update(rule domain.AutomationRule) (domain.AutomationRule, error) {
+	args := m.Called(rule)
+	return args.Get(0).(domain.AutomationRule), args.Error(1)
+}
+
+// FindByID mock
+func (m *AutomationRepositoryMock) FindByID(id uint) (domain.AutomationRule, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.AutomationRule), args.Error(1)
+}
+
+// Find mock
+func (m *AutomationRepositoryMock) Find(projectID uint) ([]domain.AutomationRule, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.AutomationRule), args.Error(1)
+}
+
+// FindActive mock
+func (m *AutomationRepositoryMock) FindActive(projectID uint, trigger string) ([]domain.AutomationRule, error) {
+	args := m.Called(projectID, trigger)
+	return args.Get(0).([]domain.AutomationRule), args.Error(1)
+}
+
+// Remove mock
+func (m *AutomationRepositoryMock) Remove(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// AddExecution mock
+func (m *AutomationRepositoryMock) AddExecution(execution *domain.AutomationExecution) (*domain.AutomationExecution, error) {
+	args := m.Called(execution)
+	return args.Get(0).(*domain.AutomationExecution), args.Error(1)
+}
+
+// FindExecutions mock
+func (m *AutomationRepositoryMock) FindExecutions(ruleID uint, limit int) ([]domain.AutomationExecution, error) {
+	args := m.Called(ruleID, limit)
+	return args.Get(0).([]domain.AutomationExecution), args.Error(1)
+}
@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// AutomationServiceMock is a mock of AutomationService
+type AutomationServiceMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *AutomationServiceMock) Add(rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	args := m.Called(rule)
+	return args.Get(0).(*domain.AutomationRule), args.Error(1)
+}
+
+// Update mock
+func (m *AutomationServiceMock) Update(rule domain.AutomationRule) (domain.AutomationRule, error) {
+	args := m.Called(rule)
+	return args.Get(0).(domain.AutomationRule), args.Error(1)
+}
+
+// FindByID mock
+func (m *AutomationServiceMock) FindByID(id uint) (domain.AutomationRule, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.AutomationRule), args.Error(1)
+}
+
+// Find mock
+func (m *AutomationServiceMock) Find(projectID uint) ([]domain.AutomationRule, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.AutomationRule), args.Error(1)
+}
+
+// FindMatching mock
+func (m *AutomationServiceMock) FindMatching(event domain.DomainEvent) ([]domain.AutomationRule, error) {
+	args := m.Called(event)
+	return args.Get(0).([]domain.AutomationRule), args.Error(1)
+}
+
+// Remove mock
+func (m *AutomationServiceMock) Remove(rule domain.AutomationRule) (bool, error) {
+	args := m.Called(rule)
+	return args.Bool(0), args.Error(1)
+}
+
+// AddExecution mock
+func (m *AutomationServiceMock) AddExecution(execution *domain.AutomationExecution) (*domain.AutomationExecution, error) {
+	args := m.Called(execution)
+	return args.Get(0).(*domain.AutomationExecution), args.Error(1)
+}
+
+// FindExecutions mock
+func (m *AutomationServiceMock) FindExecutions(ruleID uint) ([]domain.AutomationExecution, error) {
+	args := m.Called(ruleID)
+	return args.Get(0).([]domain.AutomationExecution), args.Error(1)
+}
@@ -0,0 +1,48 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// EventRepositoryMock is a mock of EventRepository
+type EventRepositoryMock struct {
+	mock.Mock
+}
+
+// Begin mock
+func (m *EventRepositoryMock) Begin() (domain.Transaction, error) {
+	args := m.Called()
+	tx, _ := args.Get(0).(domain.Transaction)
+	return tx, args.Error(1)
+}
+
+// WithTransaction mock returns the mock itself
+func (m *EventRepositoryMock) WithTransaction(tx domain.Transaction) domain.EventRepository {
+	return m
+}
+
+// AddOutboxEvents mock
+func (m *EventRepositoryMock) AddOutboxEvents(events []domain.OutboxEvent) (int, error) {
+	args := m.Called(events)
+	return args.Int(0), args.Error(1)
+}
+
+// UpdateOutboxEvent mock
+func (m *EventRepositoryMock) UpdateOutboxEvent(event domain.OutboxEvent) (domain.OutboxEvent, error) {
+	args := m.Called(event)
+	return args.Get(0).(domain.OutboxEvent), args.Error(1)
+}
+
+// RemoveOutboxEvent mock
+func (m *EventRepositoryMock) RemoveOutboxEvent(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindDueOutboxEvents mock
+func (m *EventRepositoryMock) FindDueOutboxEvents(now time.Time, limit int) ([]domain.OutboxEvent, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]domain.OutboxEvent), args.Error(1)
+}
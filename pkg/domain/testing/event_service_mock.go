@@ -0,0 +1,24 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// EventServiceMock is a mock of EventService
+type EventServiceMock struct {
+	mock.Mock
+}
+
+// AddToOutbox mock
+func (m *EventServiceMock) AddToOutbox(event domain.DomainEvent, subscribers []string, now time.Time) (int, error) {
+	args := m.Called(event, subscribers, now)
+	return args.Int(0), args.Error(1)
+}
+
+// ProcessOutbox mock
+func (m *EventServiceMock) ProcessOutbox(now time.Time, handlers map[string]domain.EventHandler) (int, error) {
+	args := m.Called(now, handlers)
+	return args.Int(0), args.Error(1)
+}
@@ -3,6 +3,7 @@ package testing
 import (
 	"github.com/stretchr/testify/mock"
 	"go-issue-tracker/pkg/domain"
+	"time"
 )
 
 // IssueRepositoryMock is a mock of IssueRepository
@@ -10,6 +11,11 @@ type IssueRepositoryMock struct {
 	mock.Mock
 }
 
+// WithTransaction mock returns the mock itself
+func (m *IssueRepositoryMock) WithTransaction(tx domain.Transaction) domain.IssueRepository {
+	return m
+}
+
 // Add mock
 func (m *IssueRepositoryMock) Add(issue *domain.Issue) (*domain.Issue, error) {
 	args := m.Called(issue)
@@ -22,6 +28,48 @@ func (m *IssueRepositoryMock) Update(issue domain.Issue) (domain.Issue, error) {
 	return args.Get(0).(domain.Issue), args.Error(1)
 }
 
+// UpdateBulk mock
+func (m *IssueRepositoryMock) UpdateBulk(issues []domain.Issue, moves []domain.IssueMove) ([]domain.Issue, error) {
+	args := m.Called(issues, moves)
+	return args.Get(0).([]domain.Issue), args.Error(1)
+}
+
+// UpdateSLA mock
+func (m *IssueRepositoryMock) UpdateSLA(issue domain.Issue) (domain.Issue, error) {
+	args := m.Called(issue)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// SetEstimates mock
+func (m *IssueRepositoryMock) SetEstimates(issue domain.Issue) (domain.Issue, error) {
+	args := m.Called(issue)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// MigratePriority mock
+func (m *IssueRepositoryMock) MigratePriority(issue domain.Issue, labels []domain.Label) (domain.Issue, error) {
+	args := m.Called(issue, labels)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// Move mock
+func (m *IssueRepositoryMock) Move(issue domain.Issue, project domain.Project) (domain.Issue, error) {
+	args := m.Called(issue, project)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// UpdateCustomFields mock
+func (m *IssueRepositoryMock) UpdateCustomFields(issue domain.Issue) (domain.Issue, error) {
+	args := m.Called(issue)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// FindMoves mock
+func (m *IssueRepositoryMock) FindMoves(id uint) ([]domain.IssueMove, error) {
+	args := m.Called(id)
+	return args.Get(0).([]domain.IssueMove), args.Error(1)
+}
+
 // FindByID mock
 func (m *IssueRepositoryMock) FindByID(id uint) (domain.Issue, error) {
 	args := m.Called(id)
@@ -29,8 +77,8 @@ func (m *IssueRepositoryMock) FindByID(id uint) (domain.Issue, error) {
 }
 
 // Find mock
-func (m *IssueRepositoryMock) Find(title string, projectID uint, labels []string) ([]domain.Issue, error) {
-	args := m.Called(title, projectID, labels)
+func (m *IssueRepositoryMock) Find(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions) ([]domain.Issue, error) {
+	args := m.Called(title, projectID, labels, customFields, options)
 	return args.Get(0).([]domain.Issue), args.Error(1)
 }
 
@@ -45,3 +93,69 @@ func (m *IssueRepositoryMock) Remove(id uint) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
+
+// AddWorkLog mock
+func (m *IssueRepositoryMock) AddWorkLog(workLog *domain.WorkLog, issue domain.Issue) (*domain.WorkLog, error) {
+	args := m.Called(workLog, issue)
+	return args.Get(0).(*domain.WorkLog), args.Error(1)
+}
+
+// RemoveWorkLog mock
+func (m *IssueRepositoryMock) RemoveWorkLog(workLog domain.WorkLog, issue domain.Issue) (bool, error) {
+	args := m.Called(workLog, issue)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindWorkLogByID mock
+func (m *IssueRepositoryMock) FindWorkLogByID(id uint) (domain.WorkLog, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.WorkLog), args.Error(1)
+}
+
+// FindWorkLogs mock
+func (m *IssueRepositoryMock) FindWorkLogs(issueID uint) ([]domain.WorkLog, error) {
+	args := m.Called(issueID)
+	return args.Get(0).([]domain.WorkLog), args.Error(1)
+}
+
+// FindProjectWorkLogs mock
+func (m *IssueRepositoryMock) FindProjectWorkLogs(projectID uint, from time.Time, to time.Time) ([]domain.WorkLog, error) {
+	args := m.Called(projectID, from, to)
+	return args.Get(0).([]domain.WorkLog), args.Error(1)
+}
+
+// SumTime mock
+func (m *IssueRepositoryMock) SumTime(projectID uint) (domain.TimeSummary, error) {
+	args := m.Called(projectID)
+	return args.Get(0).(domain.TimeSummary), args.Error(1)
+}
+
+// AddAttachment mock
+func (m *IssueRepositoryMock) AddAttachment(attachment *domain.Attachment, content []byte) (*domain.Attachment, error) {
+	args := m.Called(attachment, content)
+	return args.Get(0).(*domain.Attachment), args.Error(1)
+}
+
+// RemoveAttachment mock
+func (m *IssueRepositoryMock) RemoveAttachment(attachment domain.Attachment) (bool, error) {
+	args := m.Called(attachment)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindAttachmentByID mock
+func (m *IssueRepositoryMock) FindAttachmentByID(id uint) (domain.Attachment, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Attachment), args.Error(1)
+}
+
+// FindAttachments mock
+func (m *IssueRepositoryMock) FindAttachments(issueID uint) ([]domain.Attachment, error) {
+	args := m.Called(issueID)
+	return args.Get(0).([]domain.Attachment), args.Error(1)
+}
+
+// ReadAttachment mock
+func (m *IssueRepositoryMock) ReadAttachment(attachment domain.Attachment) ([]byte, error) {
+	args := m.Called(attachment)
+	return args.Get(0).([]byte), args.Error(1)
+}
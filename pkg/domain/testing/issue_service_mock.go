@@ -3,6 +3,7 @@ package testing
 import (
 	"github.com/stretchr/testify/mock"
 	"go-issue-tracker/pkg/domain"
+	"time"
 )
 
 // IssueServiceMock is a mock of IssueService
@@ -22,6 +23,24 @@ func (m *IssueServiceMock) Update(issue domain.Issue) (domain.Issue, error) {
 	return args.Get(0).(domain.Issue), args.Error(1)
 }
 
+// BulkUpdate mock
+func (m *IssueServiceMock) BulkUpdate(issues []domain.Issue, operation domain.IssueBulkOperation) ([]domain.IssueBulkResult, error) {
+	args := m.Called(issues, operation)
+	return args.Get(0).([]domain.IssueBulkResult), args.Error(1)
+}
+
+// Move mock
+func (m *IssueServiceMock) Move(issue domain.Issue, project domain.Project) (domain.Issue, error) {
+	args := m.Called(issue, project)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// FindByProjectAndID mock
+func (m *IssueServiceMock) FindByProjectAndID(projectID uint, id uint) (domain.Issue, error) {
+	args := m.Called(projectID, id)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
 // FindByID mock
 func (m *IssueServiceMock) FindByID(id uint) (domain.Issue, error) {
 	args := m.Called(id)
@@ -29,8 +48,8 @@ func (m *IssueServiceMock) FindByID(id uint) (domain.Issue, error) {
 }
 
 // Find mock
-func (m *IssueServiceMock) Find(title string, projectID uint, labels []string) ([]domain.Issue, error) {
-	args := m.Called(title, projectID, labels)
+func (m *IssueServiceMock) Find(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions) ([]domain.Issue, error) {
+	args := m.Called(title, projectID, labels, customFields, options)
 	return args.Get(0).([]domain.Issue), args.Error(1)
 }
 
@@ -40,8 +59,98 @@ func (m *IssueServiceMock) FindAll() ([]domain.Issue, error) {
 	return args.Get(0).([]domain.Issue), args.Error(1)
 }
 
+// SetCustomFields mock
+func (m *IssueServiceMock) SetCustomFields(issue domain.Issue, fields []domain.CustomField, values map[string]string) (domain.Issue, error) {
+	args := m.Called(issue, fields, values)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
 // Remove mock
-func (m *IssueServiceMock) Remove(id uint) (bool, error) {
+func (m *IssueServiceMock) Remove(issue domain.Issue) (bool, error) {
+	args := m.Called(issue)
+	return args.Bool(0), args.Error(1)
+}
+
+// MigratePriority mock
+func (m *IssueServiceMock) MigratePriority(issue domain.Issue, labels []domain.Label) (domain.Issue, error) {
+	args := m.Called(issue, labels)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// UpdateSLA mock
+func (m *IssueServiceMock) UpdateSLA(issue domain.Issue) (domain.Issue, error) {
+	args := m.Called(issue)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// SetEstimates mock
+func (m *IssueServiceMock) SetEstimates(issue domain.Issue, originalEstimate int, remainingEstimate int) (domain.Issue, error) {
+	args := m.Called(issue, originalEstimate, remainingEstimate)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// AddWorkLog mock
+func (m *IssueServiceMock) AddWorkLog(issue domain.Issue, workLog *domain.WorkLog) (*domain.WorkLog, error) {
+	args := m.Called(issue, workLog)
+	return args.Get(0).(*domain.WorkLog), args.Error(1)
+}
+
+// RemoveWorkLog mock
+func (m *IssueServiceMock) RemoveWorkLog(issue domain.Issue, workLog domain.WorkLog) (bool, error) {
+	args := m.Called(issue, workLog)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindWorkLogByID mock
+func (m *IssueServiceMock) FindWorkLogByID(id uint) (domain.WorkLog, error) {
 	args := m.Called(id)
+	return args.Get(0).(domain.WorkLog), args.Error(1)
+}
+
+// FindWorkLogs mock
+func (m *IssueServiceMock) FindWorkLogs(issueID uint) ([]domain.WorkLog, error) {
+	args := m.Called(issueID)
+	return args.Get(0).([]domain.WorkLog), args.Error(1)
+}
+
+// FindTimeSummary mock
+func (m *IssueServiceMock) FindTimeSummary(projectID uint) (domain.TimeSummary, error) {
+	args := m.Called(projectID)
+	return args.Get(0).(domain.TimeSummary), args.Error(1)
+}
+
+// FindTimesheet mock
+func (m *IssueServiceMock) FindTimesheet(projectID uint, from time.Time, to time.Time) ([]domain.TimesheetEntry, error) {
+	args := m.Called(projectID, from, to)
+	return args.Get(0).([]domain.TimesheetEntry), args.Error(1)
+}
+
+// AddAttachment mock
+func (m *IssueServiceMock) AddAttachment(issue domain.Issue, attachment *domain.Attachment, content []byte) (*domain.Attachment, error) {
+	args := m.Called(issue, attachment, content)
+	return args.Get(0).(*domain.Attachment), args.Error(1)
+}
+
+// RemoveAttachment mock
+func (m *IssueServiceMock) RemoveAttachment(issue domain.Issue, attachment domain.Attachment) (bool, error) {
+	args := m.Called(issue, attachment)
 	return args.Bool(0), args.Error(1)
 }
+
+// FindAttachmentByID mock
+func (m *IssueServiceMock) FindAttachmentByID(id uint) (domain.Attachment, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Attachment), args.Error(1)
+}
+
+// FindAttachments mock
+func (m *IssueServiceMock) FindAttachments(issueID uint) ([]domain.Attachment, error) {
+	args := m.Called(issueID)
+	return args.Get(0).([]domain.Attachment), args.Error(1)
+}
+
+// ReadAttachment mock
+func (m *IssueServiceMock) ReadAttachment(attachment domain.Attachment) ([]byte, error) {
+	args := m.Called(attachment)
+	return args.Get(0).([]byte), args.Error(1)
+}
@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// JobRepositoryMock is a mock of JobRepository
+type JobRepositoryMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *JobRepositoryMock) Add(job *domain.Job) (*domain.Job, error) {
+	args := m.Called(job)
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+// Update mock
+func (m *JobRepositoryMock) Update(job domain.Job) (domain.Job, error) {
+	args := m.Called(job)
+	return args.Get(0).(domain.Job), args.Error(1)
+}
+
+// FindByID mock
+func (m *JobRepositoryMock) FindByID(id uint) (domain.Job, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Job), args.Error(1)
+}
+
+// FindAll mock
+func (m *JobRepositoryMock) FindAll() ([]domain.Job, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.Job), args.Error(1)
+}
+
+// FindDue mock
+func (m *JobRepositoryMock) FindDue(now time.Time) ([]domain.Job, error) {
+	args := m.Called(now)
+	return args.Get(0).([]domain.Job), args.Error(1)
+}
+
+// Lock mock
+func (m *JobRepositoryMock) Lock(id uint, owner string, now time.Time, until time.Time, due bool) (bool, error) {
+	args := m.Called(id, owner, now, until, due)
+	return args.Bool(0), args.Error(1)
+}
+
+// Finish mock
+func (m *JobRepositoryMock) Finish(job domain.Job, owner string) (bool, error) {
+	args := m.Called(job, owner)
+	return args.Bool(0), args.Error(1)
+}
+
+// Remove mock
+func (m *JobRepositoryMock) Remove(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// JobServiceMock is a mock of JobService
+type JobServiceMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *JobServiceMock) Add(job *domain.Job, now time.Time) (*domain.Job, error) {
+	args := m.Called(job, now)
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+// Update mock
+func (m *JobServiceMock) Update(job domain.Job, now time.Time) (domain.Job, error) {
+	args := m.Called(job, now)
+	return args.Get(0).(domain.Job), args.Error(1)
+}
+
+// FindByID mock
+func (m *JobServiceMock) FindByID(id uint) (domain.Job, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Job), args.Error(1)
+}
+
+// FindAll mock
+func (m *JobServiceMock) FindAll() ([]domain.Job, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.Job), args.Error(1)
+}
+
+// FindDue mock
+func (m *JobServiceMock) FindDue(now time.Time) ([]domain.Job, error) {
+	args := m.Called(now)
+	return args.Get(0).([]domain.Job), args.Error(1)
+}
+
+// Lock mock
+func (m *JobServiceMock) Lock(job domain.Job, owner string, now time.Time, due bool) (bool, error) {
+	args := m.Called(job, owner, now, due)
+	return args.Bool(0), args.Error(1)
+}
+
+// Finish mock
+func (m *JobServiceMock) Finish(job domain.Job, owner string, now time.Time, count int, runErr error) (domain.Job, error) {
+	args := m.Called(job, owner, now, count, runErr)
+	return args.Get(0).(domain.Job), args.Error(1)
+}
+
+// Remove mock
+func (m *JobServiceMock) Remove(job domain.Job) (bool, error) {
+	args := m.Called(job)
+	return args.Bool(0), args.Error(1)
+}
@@ -10,6 +10,11 @@ type LabelRepositoryMock struct {
 	mock.Mock
 }
 
+// WithTransaction mock returns the mock itself
+func (m *LabelRepositoryMock) WithTransaction(tx domain.Transaction) domain.LabelRepository {
+	return m
+}
+
 // Add mock
 func (m *LabelRepositoryMock) Add(label *domain.Label) (*domain.Label, error) {
 	args := m.Called(label)
@@ -29,14 +34,14 @@ func (m *LabelRepositoryMock) FindByID(id uint) (domain.Label, error) {
 }
 
 // FindByName mock
-func (m *LabelRepositoryMock) FindByName(name string) (domain.Label, error) {
-	args := m.Called(name)
+func (m *LabelRepositoryMock) FindByName(name string, projectID uint) (domain.Label, error) {
+	args := m.Called(name, projectID)
 	return args.Get(0).(domain.Label), args.Error(1)
 }
 
 // Find mock
-func (m *LabelRepositoryMock) Find(name string) ([]domain.Label, error) {
-	args := m.Called(name)
+func (m *LabelRepositoryMock) Find(name string, projectID uint) ([]domain.Label, error) {
+	args := m.Called(name, projectID)
 	return args.Get(0).([]domain.Label), args.Error(1)
 }
 
@@ -51,3 +56,57 @@ func (m *LabelRepositoryMock) Remove(id uint) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
+
+// FindMerge mock
+func (m *LabelRepositoryMock) FindMerge(source domain.Label, target domain.Label) (domain.LabelMerge, error) {
+	args := m.Called(source, target)
+	return args.Get(0).(domain.LabelMerge), args.Error(1)
+}
+
+// Merge mock
+func (m *LabelRepositoryMock) Merge(source domain.Label, target domain.Label) (bool, error) {
+	args := m.Called(source, target)
+	return args.Bool(0), args.Error(1)
+}
+
+// CountGroupConflicts mock
+func (m *LabelRepositoryMock) CountGroupConflicts(groupID uint, labelID uint, exceptID uint) (int, error) {
+	args := m.Called(groupID, labelID, exceptID)
+	return args.Int(0), args.Error(1)
+}
+
+// AddGroup mock
+func (m *LabelRepositoryMock) AddGroup(group *domain.LabelGroup) (*domain.LabelGroup, error) {
+	args := m.Called(group)
+	return args.Get(0).(*domain.LabelGroup), args.Error(1)
+}
+
+// UpdateGroup mock
+func (m *LabelRepositoryMock) UpdateGroup(group domain.LabelGroup) (domain.LabelGroup, error) {
+	args := m.Called(group)
+	return args.Get(0).(domain.LabelGroup), args.Error(1)
+}
+
+// FindGroupByID mock
+func (m *LabelRepositoryMock) FindGroupByID(id uint) (domain.LabelGroup, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.LabelGroup), args.Error(1)
+}
+
+// FindGroupByName mock
+func (m *LabelRepositoryMock) FindGroupByName(name string) (domain.LabelGroup, error) {
+	args := m.Called(name)
+	return args.Get(0).(domain.LabelGroup), args.Error(1)
+}
+
+// FindAllGroups mock
+func (m *LabelRepositoryMock) FindAllGroups() ([]domain.LabelGroup, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.LabelGroup), args.Error(1)
+}
+
+// RemoveGroup mock
+func (m *LabelRepositoryMock) RemoveGroup(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
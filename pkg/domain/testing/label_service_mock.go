@@ -35,14 +35,14 @@ func (m *LabelServiceMock) FindByID(id uint) (domain.Label, error) {
 }
 
 // FindByName mock
-func (m *LabelServiceMock) FindByName(name string) (domain.Label, error) {
-	args := m.Called(name)
+func (m *LabelServiceMock) FindByName(name string, projectID uint) (domain.Label, error) {
+	args := m.Called(name, projectID)
 	return args.Get(0).(domain.Label), args.Error(1)
 }
 
 // Find mock
-func (m *LabelServiceMock) Find(name string) ([]domain.Label, error) {
-	args := m.Called(name)
+func (m *LabelServiceMock) Find(name string, projectID uint) ([]domain.Label, error) {
+	args := m.Called(name, projectID)
 	return args.Get(0).([]domain.Label), args.Error(1)
 }
 
@@ -57,3 +57,45 @@ func (m *LabelServiceMock) Remove(id uint) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
+
+// MergePreview mock
+func (m *LabelServiceMock) MergePreview(source domain.Label, target domain.Label) (domain.LabelMerge, error) {
+	args := m.Called(source, target)
+	return args.Get(0).(domain.LabelMerge), args.Error(1)
+}
+
+// Merge mock
+func (m *LabelServiceMock) Merge(source domain.Label, target domain.Label) (domain.LabelMerge, error) {
+	args := m.Called(source, target)
+	return args.Get(0).(domain.LabelMerge), args.Error(1)
+}
+
+// AddGroup mock
+func (m *LabelServiceMock) AddGroup(group *domain.LabelGroup) (*domain.LabelGroup, error) {
+	args := m.Called(group)
+	return args.Get(0).(*domain.LabelGroup), args.Error(1)
+}
+
+// UpdateGroup mock
+func (m *LabelServiceMock) UpdateGroup(group domain.LabelGroup) (domain.LabelGroup, error) {
+	args := m.Called(group)
+	return args.Get(0).(domain.LabelGroup), args.Error(1)
+}
+
+// FindGroupByID mock
+func (m *LabelServiceMock) FindGroupByID(id uint) (domain.LabelGroup, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.LabelGroup), args.Error(1)
+}
+
+// FindAllGroups mock
+func (m *LabelServiceMock) FindAllGroups() ([]domain.LabelGroup, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.LabelGroup), args.Error(1)
+}
+
+// RemoveGroup mock
+func (m *LabelServiceMock) RemoveGroup(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// MailRepositoryMock is a mock of MailRepository
+type MailRepositoryMock struct {
+	mock.Mock
+}
+
+// SavePreference mock
+func (m *MailRepositoryMock) SavePreference(preference *domain.MailPreference) (*domain.MailPreference, error) {
+	args := m.Called(preference)
+	return args.Get(0).(*domain.MailPreference), args.Error(1)
+}
+
+// FindPreference mock
+func (m *MailRepositoryMock) FindPreference(user string) (domain.MailPreference, error) {
+	args := m.Called(user)
+	return args.Get(0).(domain.MailPreference), args.Error(1)
+}
+
+// FindActivePreferences mock
+func (m *MailRepositoryMock) FindActivePreferences() ([]domain.MailPreference, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.MailPreference), args.Error(1)
+}
+
+// FindNotificationsBetween mock
+func (m *MailRepositoryMock) FindNotificationsBetween(user string, from time.Time, to time.Time) ([]domain.Notification, error) {
+	args := m.Called(user, from, to)
+	return args.Get(0).([]domain.Notification), args.Error(1)
+}
+
+// QueueMessages mock
+func (m *MailRepositoryMock) QueueMessages(preference domain.MailPreference, messages []domain.MailMessage) (int, error) {
+	args := m.Called(preference, messages)
+	return args.Int(0), args.Error(1)
+}
+
+// FindDueMessages mock
+func (m *MailRepositoryMock) FindDueMessages(now time.Time, limit int) ([]domain.MailMessage, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]domain.MailMessage), args.Error(1)
+}
+
+// UpdateMessage mock
+func (m *MailRepositoryMock) UpdateMessage(message domain.MailMessage) (domain.MailMessage, error) {
+	args := m.Called(message)
+	return args.Get(0).(domain.MailMessage), args.Error(1)
+}
@@ -0,0 +1,17 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// MailSenderMock is a mock of MailSender
+type MailSenderMock struct {
+	mock.Mock
+}
+
+// Send mock
+func (m *MailSenderMock) Send(message domain.MailMessage) error {
+	args := m.Called(message)
+	return args.Error(0)
+}
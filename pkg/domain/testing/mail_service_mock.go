@@ -0,0 +1,36 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// MailServiceMock is a mock of MailService
+type MailServiceMock struct {
+	mock.Mock
+}
+
+// SetPreference mock
+func (m *MailServiceMock) SetPreference(preference *domain.MailPreference, now time.Time) (*domain.MailPreference, error) {
+	args := m.Called(preference, now)
+	return args.Get(0).(*domain.MailPreference), args.Error(1)
+}
+
+// FindPreference mock
+func (m *MailServiceMock) FindPreference(user string) (domain.MailPreference, error) {
+	args := m.Called(user)
+	return args.Get(0).(domain.MailPreference), args.Error(1)
+}
+
+// QueueNotifications mock
+func (m *MailServiceMock) QueueNotifications(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
+
+// ProcessQueue mock
+func (m *MailServiceMock) ProcessQueue(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// NotificationRepositoryMock is a mock of NotificationRepository
+type NotificationRepositoryMock struct {
+	mock.Mock
+}
+
+// AddWatcher mock
+func (m *NotificationRepositoryMock) AddWatcher(watcher *domain.Watcher) (*domain.Watcher, error) {
+	args := m.Called(watcher)
+	return args.Get(0).(*domain.Watcher), args.Error(1)
+}
+
+// FindWatcherByID mock
+func (m *NotificationRepositoryMock) FindWatcherByID(id uint) (domain.Watcher, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Watcher), args.Error(1)
+}
+
+// FindWatchers mock
+func (m *NotificationRepositoryMock) FindWatchers(user string, issueID uint, projectID uint) ([]domain.Watcher, error) {
+	args := m.Called(user, issueID, projectID)
+	return args.Get(0).([]domain.Watcher), args.Error(1)
+}
+
+// FindWatchingUsers mock
+func (m *NotificationRepositoryMock) FindWatchingUsers(issueID uint, projectID uint) ([]string, error) {
+	args := m.Called(issueID, projectID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// RemoveWatcher mock
+func (m *NotificationRepositoryMock) RemoveWatcher(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// AddNotifications mock
+func (m *NotificationRepositoryMock) AddNotifications(notifications []domain.Notification) (int, error) {
+	args := m.Called(notifications)
+	return args.Int(0), args.Error(1)
+}
+
+// UpdateNotification mock
+func (m *NotificationRepositoryMock) UpdateNotification(notification domain.Notification) (domain.Notification, error) {
+	args := m.Called(notification)
+	return args.Get(0).(domain.Notification), args.Error(1)
+}
+
+// FindNotificationByID mock
+func (m *NotificationRepositoryMock) FindNotificationByID(id uint) (domain.Notification, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Notification), args.Error(1)
+}
+
+// FindNotifications mock
+func (m *NotificationRepositoryMock) FindNotifications(user string, unread bool) ([]domain.Notification, error) {
+	args := m.Called(user, unread)
+	return args.Get(0).([]domain.Notification), args.Error(1)
+}
+
+// MarkAllNotificationsRead mock
+func (m *NotificationRepositoryMock) MarkAllNotificationsRead(user string) (int, error) {
+	args := m.Called(user)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// NotificationServiceMock is a mock of NotificationService
+type NotificationServiceMock struct {
+	mock.Mock
+}
+
+// Watch mock
+func (m *NotificationServiceMock) Watch(watcher *domain.Watcher) (*domain.Watcher, error) {
+	args := m.Called(watcher)
+	return args.Get(0).(*domain.Watcher), args.Error(1)
+}
+
+// Unwatch mock
+func (m *NotificationServiceMock) Unwatch(watcher domain.Watcher) (bool, error) {
+	args := m.Called(watcher)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindWatcherByID mock
+func (m *NotificationServiceMock) FindWatcherByID(id uint) (domain.Watcher, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Watcher), args.Error(1)
+}
+
+// FindWatchers mock
+func (m *NotificationServiceMock) FindWatchers(user string, issueID uint, projectID uint) ([]domain.Watcher, error) {
+	args := m.Called(user, issueID, projectID)
+	return args.Get(0).([]domain.Watcher), args.Error(1)
+}
+
+// NotifyIssue mock
+func (m *NotificationServiceMock) NotifyIssue(issue domain.Issue, event string, changes []domain.IssueChange) (int, error) {
+	args := m.Called(issue, event, changes)
+	return args.Int(0), args.Error(1)
+}
+
+// NotifyProject mock
+func (m *NotificationServiceMock) NotifyProject(project domain.Project, event string) (int, error) {
+	args := m.Called(project, event)
+	return args.Int(0), args.Error(1)
+}
+
+// FindNotificationByID mock
+func (m *NotificationServiceMock) FindNotificationByID(id uint) (domain.Notification, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Notification), args.Error(1)
+}
+
+// FindNotifications mock
+func (m *NotificationServiceMock) FindNotifications(user string, unread bool) ([]domain.Notification, error) {
+	args := m.Called(user, unread)
+	return args.Get(0).([]domain.Notification), args.Error(1)
+}
+
+// MarkRead mock
+func (m *NotificationServiceMock) MarkRead(notification domain.Notification, read bool) (domain.Notification, error) {
+	args := m.Called(notification, read)
+	return args.Get(0).(domain.Notification), args.Error(1)
+}
+
+// MarkAllRead mock
+func (m *NotificationServiceMock) MarkAllRead(user string) (int, error) {
+	args := m.Called(user)
+	return args.Int(0), args.Error(1)
+}
@@ -10,6 +10,11 @@ type ProjectRepositoryMock struct {
 	mock.Mock
 }
 
+// WithTransaction mock returns the mock itself
+func (m *ProjectRepositoryMock) WithTransaction(tx domain.Transaction) domain.ProjectRepository {
+	return m
+}
+
 // Add mock
 func (m *ProjectRepositoryMock) Add(project *domain.Project) (*domain.Project, error) {
 	args := m.Called(project)
@@ -45,3 +50,93 @@ func (m *ProjectRepositoryMock) Remove(id uint) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
+
+// FindArchived mock
+func (m *ProjectRepositoryMock) FindArchived() ([]domain.Project, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.Project), args.Error(1)
+}
+
+// CountIssues mock
+func (m *ProjectRepositoryMock) CountIssues(id uint) (int, error) {
+	args := m.Called(id)
+	return args.Int(0), args.Error(1)
+}
+
+// RemoveCascade mock
+func (m *ProjectRepositoryMock) RemoveCascade(id uint) (int, error) {
+	args := m.Called(id)
+	return args.Int(0), args.Error(1)
+}
+
+// RemoveMovingIssues mock
+func (m *ProjectRepositoryMock) RemoveMovingIssues(id uint, target domain.Project) (int, error) {
+	args := m.Called(id, target)
+	return args.Int(0), args.Error(1)
+}
+
+// UpdateSettings mock
+func (m *ProjectRepositoryMock) UpdateSettings(project domain.Project) (domain.Project, error) {
+	args := m.Called(project)
+	return args.Get(0).(domain.Project), args.Error(1)
+}
+
+// AddTemplate mock
+func (m *ProjectRepositoryMock) AddTemplate(template *domain.IssueTemplate) (*domain.IssueTemplate, error) {
+	args := m.Called(template)
+	return args.Get(0).(*domain.IssueTemplate), args.Error(1)
+}
+
+// UpdateTemplate mock
+func (m *ProjectRepositoryMock) UpdateTemplate(template domain.IssueTemplate) (domain.IssueTemplate, error) {
+	args := m.Called(template)
+	return args.Get(0).(domain.IssueTemplate), args.Error(1)
+}
+
+// FindTemplateByID mock
+func (m *ProjectRepositoryMock) FindTemplateByID(id uint) (domain.IssueTemplate, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.IssueTemplate), args.Error(1)
+}
+
+// FindTemplates mock
+func (m *ProjectRepositoryMock) FindTemplates(projectID uint) ([]domain.IssueTemplate, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.IssueTemplate), args.Error(1)
+}
+
+// RemoveTemplate mock
+func (m *ProjectRepositoryMock) RemoveTemplate(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// AddCustomField mock
+func (m *ProjectRepositoryMock) AddCustomField(field *domain.CustomField) (*domain.CustomField, error) {
+	args := m.Called(field)
+	return args.Get(0).(*domain.CustomField), args.Error(1)
+}
+
+// UpdateCustomField mock
+func (m *ProjectRepositoryMock) UpdateCustomField(field domain.CustomField) (domain.CustomField, error) {
+	args := m.Called(field)
+	return args.Get(0).(domain.CustomField), args.Error(1)
+}
+
+// FindCustomFieldByID mock
+func (m *ProjectRepositoryMock) FindCustomFieldByID(id uint) (domain.CustomField, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.CustomField), args.Error(1)
+}
+
+// FindCustomFields mock
+func (m *ProjectRepositoryMock) FindCustomFields(projectID uint) ([]domain.CustomField, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.CustomField), args.Error(1)
+}
+
+// RemoveCustomField mock
+func (m *ProjectRepositoryMock) RemoveCustomField(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
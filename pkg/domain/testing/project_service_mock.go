@@ -45,3 +45,81 @@ func (m *ProjectServiceMock) Remove(id uint) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
+
+// FindArchived mock
+func (m *ProjectServiceMock) FindArchived() ([]domain.Project, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.Project), args.Error(1)
+}
+
+// RemoveWithMode mock
+func (m *ProjectServiceMock) RemoveWithMode(project domain.Project, mode string, target *domain.Project) (domain.ProjectRemoval, error) {
+	args := m.Called(project, mode, target)
+	return args.Get(0).(domain.ProjectRemoval), args.Error(1)
+}
+
+// UpdateSettings mock
+func (m *ProjectServiceMock) UpdateSettings(project domain.Project) (domain.Project, error) {
+	args := m.Called(project)
+	return args.Get(0).(domain.Project), args.Error(1)
+}
+
+// AddTemplate mock
+func (m *ProjectServiceMock) AddTemplate(project domain.Project, template *domain.IssueTemplate) (*domain.IssueTemplate, error) {
+	args := m.Called(project, template)
+	return args.Get(0).(*domain.IssueTemplate), args.Error(1)
+}
+
+// UpdateTemplate mock
+func (m *ProjectServiceMock) UpdateTemplate(project domain.Project, template domain.IssueTemplate) (domain.IssueTemplate, error) {
+	args := m.Called(project, template)
+	return args.Get(0).(domain.IssueTemplate), args.Error(1)
+}
+
+// FindTemplateByID mock
+func (m *ProjectServiceMock) FindTemplateByID(id uint) (domain.IssueTemplate, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.IssueTemplate), args.Error(1)
+}
+
+// FindTemplates mock
+func (m *ProjectServiceMock) FindTemplates(projectID uint) ([]domain.IssueTemplate, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.IssueTemplate), args.Error(1)
+}
+
+// RemoveTemplate mock
+func (m *ProjectServiceMock) RemoveTemplate(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// AddCustomField mock
+func (m *ProjectServiceMock) AddCustomField(project domain.Project, field *domain.CustomField) (*domain.CustomField, error) {
+	args := m.Called(project, field)
+	return args.Get(0).(*domain.CustomField), args.Error(1)
+}
+
+// UpdateCustomField mock
+func (m *ProjectServiceMock) UpdateCustomField(project domain.Project, field domain.CustomField) (domain.CustomField, error) {
+	args := m.Called(project, field)
+	return args.Get(0).(domain.CustomField), args.Error(1)
+}
+
+// FindCustomFieldByID mock
+func (m *ProjectServiceMock) FindCustomFieldByID(id uint) (domain.CustomField, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.CustomField), args.Error(1)
+}
+
+// FindCustomFields mock
+func (m *ProjectServiceMock) FindCustomFields(projectID uint) ([]domain.CustomField, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.CustomField), args.Error(1)
+}
+
+// RemoveCustomField mock
+func (m *ProjectServiceMock) RemoveCustomField(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
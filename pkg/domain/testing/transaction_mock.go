@@ -0,0 +1,22 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+// TransactionMock is a mock of Transaction
+type TransactionMock struct {
+	mock.Mock
+}
+
+// Commit mock
+func (m *TransactionMock) Commit() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Rollback mock
+func (m *TransactionMock) Rollback() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// WebhookRepositoryMock is a mock of WebhookRepository
+type WebhookRepositoryMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *WebhookRepositoryMock) Add(webhook *domain.Webhook) (*domain.Webhook, error) {
+	args := m.Called(webhook)
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+// Update mock
+func (m *WebhookRepositoryMock) Update(webhook domain.Webhook) (domain.Webhook, error) {
+	args := m.Called(webhook)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+// FindByID mock
+func (m *WebhookRepositoryMock) FindByID(id uint) (domain.Webhook, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+// Find mock
+func (m *WebhookRepositoryMock) Find(projectID uint) ([]domain.Webhook, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.Webhook), args.Error(1)
+}
+
+// FindSubscribed mock
+func (m *WebhookRepositoryMock) FindSubscribed(projectID uint) ([]domain.Webhook, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.Webhook), args.Error(1)
+}
+
+// Remove mock
+func (m *WebhookRepositoryMock) Remove(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// AddDeliveries mock
+func (m *WebhookRepositoryMock) AddDeliveries(deliveries []domain.WebhookDelivery) (int, error) {
+	args := m.Called(deliveries)
+	return args.Int(0), args.Error(1)
+}
+
+// UpdateDelivery mock
+func (m *WebhookRepositoryMock) UpdateDelivery(delivery domain.WebhookDelivery) (domain.WebhookDelivery, error) {
+	args := m.Called(delivery)
+	return args.Get(0).(domain.WebhookDelivery), args.Error(1)
+}
+
+// FindDeliveryByID mock
+func (m *WebhookRepositoryMock) FindDeliveryByID(id uint) (domain.WebhookDelivery, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.WebhookDelivery), args.Error(1)
+}
+
+// FindDeliveries mock
+func (m *WebhookRepositoryMock) FindDeliveries(webhookID uint, limit int) ([]domain.WebhookDelivery, error) {
+	args := m.Called(webhookID, limit)
+	return args.Get(0).([]domain.WebhookDelivery), args.Error(1)
+}
+
+// FindDueDeliveries mock
+func (m *WebhookRepositoryMock) FindDueDeliveries(now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]domain.WebhookDelivery), args.Error(1)
+}
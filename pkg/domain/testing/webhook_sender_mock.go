@@ -0,0 +1,17 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// WebhookSenderMock is a mock of WebhookSender
+type WebhookSenderMock struct {
+	mock.Mock
+}
+
+// Send mock
+func (m *WebhookSenderMock) Send(webhook domain.Webhook, delivery domain.WebhookDelivery) (int, error) {
+	args := m.Called(webhook, delivery)
+	return args.Int(0), args.Error(1)
+}
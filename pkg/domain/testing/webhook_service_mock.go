@@ -0,0 +1,72 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// WebhookServiceMock is a mock of WebhookService
+type WebhookServiceMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *WebhookServiceMock) Add(webhook *domain.Webhook) (*domain.Webhook, error) {
+	args := m.Called(webhook)
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+// Update mock
+func (m *WebhookServiceMock) Update(webhook domain.Webhook) (domain.Webhook, error) {
+	args := m.Called(webhook)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+// FindByID mock
+func (m *WebhookServiceMock) FindByID(id uint) (domain.Webhook, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+// Find mock
+func (m *WebhookServiceMock) Find(projectID uint) ([]domain.Webhook, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.Webhook), args.Error(1)
+}
+
+// Remove mock
+func (m *WebhookServiceMock) Remove(webhook domain.Webhook) (bool, error) {
+	args := m.Called(webhook)
+	return args.Bool(0), args.Error(1)
+}
+
+// Publish mock
+func (m *WebhookServiceMock) Publish(event string, projectID uint, data interface{}, now time.Time) (int, error) {
+	args := m.Called(event, projectID, data, now)
+	return args.Int(0), args.Error(1)
+}
+
+// ProcessQueue mock
+func (m *WebhookServiceMock) ProcessQueue(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
+
+// FindDeliveryByID mock
+func (m *WebhookServiceMock) FindDeliveryByID(id uint) (domain.WebhookDelivery, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.WebhookDelivery), args.Error(1)
+}
+
+// FindDeliveries mock
+func (m *WebhookServiceMock) FindDeliveries(webhookID uint) ([]domain.WebhookDelivery, error) {
+	args := m.Called(webhookID)
+	return args.Get(0).([]domain.WebhookDelivery), args.Error(1)
+}
+
+// Redeliver mock
+func (m *WebhookServiceMock) Redeliver(delivery domain.WebhookDelivery, now time.Time) (*domain.WebhookDelivery, error) {
+	args := m.Called(delivery, now)
+	return args.Get(0).(*domain.WebhookDelivery), args.Error(1)
+}
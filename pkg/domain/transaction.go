@@ -0,0 +1,8 @@
+package domain
+
+// Transaction is database transaction shared by repositories bound to it, changes written by bound
+// repositories are committed or rolled back together
+type Transaction interface {
+	Commit() error
+	Rollback() error
+}
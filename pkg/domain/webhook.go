@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	// WebhookEventIssueCreated is published when issue is added
+	WebhookEventIssueCreated = "issue.created"
+	// WebhookEventIssueUpdated is published when issue is changed
+	WebhookEventIssueUpdated = "issue.updated"
+	// WebhookEventIssueDeleted is published when issue is removed
+	WebhookEventIssueDeleted = "issue.deleted"
+	// WebhookEventLabelCreated is published when label is added
+	WebhookEventLabelCreated = "label.created"
+	// WebhookEventLabelUpdated is published when label is changed
+	WebhookEventLabelUpdated = "label.updated"
+	// WebhookEventLabelDeleted is published when label is removed or merged into another label
+	WebhookEventLabelDeleted = "label.deleted"
+	// WebhookEventProjectCreated is published when project is added
+	WebhookEventProjectCreated = "project.created"
+	// WebhookEventProjectUpdated is published when project or its settings are changed
+	WebhookEventProjectUpdated = "project.updated"
+	// WebhookEventProjectDeleted is published when project is removed
+	WebhookEventProjectDeleted = "project.deleted"
+)
+
+// WebhookEvents are all events webhook can subscribe to
+var WebhookEvents = []string{
+	WebhookEventIssueCreated, WebhookEventIssueUpdated, WebhookEventIssueDeleted,
+	WebhookEventLabelCreated, WebhookEventLabelUpdated, WebhookEventLabelDeleted,
+	WebhookEventProjectCreated, WebhookEventProjectUpdated, WebhookEventProjectDeleted,
+}
+
+const (
+	// WebhookDeliveryQueued marks delivery waiting for first attempt or retry
+	WebhookDeliveryQueued = "queued"
+	// WebhookDeliveryDelivered marks delivery accepted by receiver with 2xx status
+	WebhookDeliveryDelivered = "delivered"
+	// WebhookDeliveryFailed marks delivery which was not accepted in WebhookMaxAttempts attempts
+	WebhookDeliveryFailed = "failed"
+)
+
+// WebhookMaxAttempts is number of attempts of delivery before it fails
+const WebhookMaxAttempts = 8
+
+// WebhookBatchSize is max. number of deliveries sent in one run of queue
+const WebhookBatchSize = 100
+
+// WebhookDeliveriesLimit is max. number of deliveries in delivery log of webhook
+const WebhookDeliveriesLimit = 50
+
+// WebhookSignatureHeader is header with HMAC-SHA256 signature of payload
+const WebhookSignatureHeader = "X-Webhook-Signature"
+
+// Webhook entity subscribes URL to events of project, global labels publish to webhooks of all projects,
+// webhook without events is subscribed to all of them, secret is write-only and it is never encoded
+type Webhook struct {
+	ID        uint      `json:"id"`
+	ProjectID uint      `json:"projectId"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"-"`
+	Events    string    `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookDelivery entity is an item of webhook queue and delivery log
+type WebhookDelivery struct {
+	ID             uint      `json:"id"`
+	WebhookID      uint      `json:"webhookId"`
+	Event          string    `json:"event"`
+	Payload        string    `json:"payload"`
+	Status         string    `json:"status"`
+	Attempts       int       `json:"attempts"`
+	ResponseStatus int       `json:"responseStatus"`
+	LastError      string    `json:"lastError"`
+	SendAfter      time.Time `json:"sendAfter"`
+	DeliveredAt    time.Time `json:"deliveredAt"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// WebhookPayload is JSON body posted to webhook URL
+type WebhookPayload struct {
+	Event      string      `json:"event"`
+	ProjectID  uint        `json:"projectId"`
+	OccurredAt time.Time   `json:"occurredAt"`
+	Data       interface{} `json:"data"`
+}
+
+// IsWebhookEventValid to check event name
+func IsWebhookEventValid(event string) bool {
+	for _, item := range WebhookEvents {
+		if item == event {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWebhookSignature to sign payload with secret of webhook, value is sent in WebhookSignatureHeader
+func GetWebhookSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// HasSecret to check if payloads are signed with secret
+func (w Webhook) HasSecret() bool {
+	return w.Secret != ""
+}
+
+// GetEvents to get events webhook is subscribed to, empty when subscribed to all
+func (w Webhook) GetEvents() []string {
+	events := []string{}
+	for _, event := range strings.Split(w.Events, ",") {
+		if event = strings.TrimSpace(event); event != "" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// isSubscribed to check if active webhook should receive event
+func (w Webhook) isSubscribed(event string) bool {
+	if !w.Active {
+		return false
+	}
+	events := w.GetEvents()
+	if len(events) == 0 {
+		return true
+	}
+	for _, item := range events {
+		if item == event {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"time"
+)
+
+// WebhookRepository repository
+type WebhookRepository interface {
+	Add(webhook *Webhook) (*Webhook, error)
+	Update(webhook Webhook) (Webhook, error)
+	FindByID(id uint) (Webhook, error)
+	Find(projectID uint) ([]Webhook, error)
+	FindSubscribed(projectID uint) ([]Webhook, error)
+	Remove(id uint) (bool, error)
+	AddDeliveries(deliveries []WebhookDelivery) (int, error)
+	UpdateDelivery(delivery WebhookDelivery) (WebhookDelivery, error)
+	FindDeliveryByID(id uint) (WebhookDelivery, error)
+	FindDeliveries(webhookID uint, limit int) ([]WebhookDelivery, error)
+	FindDueDeliveries(now time.Time, limit int) ([]WebhookDelivery, error)
+}
@@ -0,0 +1,6 @@
+package domain
+
+// WebhookSender posts payload of delivery to webhook URL and returns HTTP status of response
+type WebhookSender interface {
+	Send(webhook Webhook, delivery WebhookDelivery) (int, error)
+}
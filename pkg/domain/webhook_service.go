@@ -0,0 +1,266 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WebhookService interface
+type WebhookService interface {
+	Add(webhook *Webhook) (*Webhook, error)
+	Update(webhook Webhook) (Webhook, error)
+	FindByID(id uint) (Webhook, error)
+	Find(projectID uint) ([]Webhook, error)
+	Remove(webhook Webhook) (bool, error)
+	Publish(event string, projectID uint, data interface{}, now time.Time) (int, error)
+	ProcessQueue(now time.Time) (int, error)
+	FindDeliveryByID(id uint) (WebhookDelivery, error)
+	FindDeliveries(webhookID uint) ([]WebhookDelivery, error)
+	Redeliver(delivery WebhookDelivery, now time.Time) (*WebhookDelivery, error)
+}
+
+// webhookService struct
+type webhookService struct {
+	repository WebhookRepository
+	sender     WebhookSender
+}
+
+// GetDefaultWebhookService alias to newWebhookService
+var GetDefaultWebhookService = newWebhookService
+
+// ResetDefaultWebhookService to reset GetDefaultWebhookService value
+func ResetDefaultWebhookService() {
+	GetDefaultWebhookService = newWebhookService
+}
+
+// newWebhookService to create new WebhookService, queue can not be processed when sender is nil
+func newWebhookService(repository WebhookRepository, sender WebhookSender) WebhookService {
+	return &webhookService{
+		repository: repository,
+		sender:     sender,
+	}
+}
+
+// validate to check URL and normalize events of webhook
+func (s *webhookService) validate(webhook *Webhook) error {
+	if webhook.ProjectID == 0 {
+		return NewValidationError("project not provided")
+	}
+	webhook.URL = strings.TrimSpace(webhook.URL)
+	address, err := url.Parse(webhook.URL)
+	if err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+		return NewValidationError("url %s not valid", webhook.URL)
+	}
+	events := []string{}
+	for _, event := range webhook.GetEvents() {
+		if !IsWebhookEventValid(event) {
+			return NewValidationError("event %s not valid", event)
+		}
+		found := false
+		for _, item := range events {
+			if item == event {
+				found = true
+				break
+			}
+		}
+		if !found {
+			events = append(events, event)
+		}
+	}
+	webhook.Events = strings.Join(events, ",")
+	return nil
+}
+
+// Add to add new webhook, secret has to be provided because it is write-only and generated secret could
+// not be read by receiver
+func (s *webhookService) Add(webhook *Webhook) (*Webhook, error) {
+	if err := s.validate(webhook); err != nil {
+		return nil, err
+	}
+	webhook.Secret = strings.TrimSpace(webhook.Secret)
+	if webhook.Secret == "" {
+		return nil, NewValidationError("secret not provided")
+	}
+
+	item, err := s.repository.Add(webhook)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Update to update webhook
+func (s *webhookService) Update(webhook Webhook) (Webhook, error) {
+	if err := s.validate(&webhook); err != nil {
+		return webhook, err
+	}
+	webhook.Secret = strings.TrimSpace(webhook.Secret)
+	if webhook.Secret == "" {
+		return webhook, NewValidationError("secret not provided")
+	}
+
+	item, err := s.repository.Update(webhook)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindByID to find webhook by ID
+func (s *webhookService) FindByID(id uint) (Webhook, error) {
+	item, err := s.repository.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Find to find webhooks of project
+func (s *webhookService) Find(projectID uint) ([]Webhook, error) {
+	items, err := s.repository.Find(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Remove to remove webhook together with its delivery log
+func (s *webhookService) Remove(webhook Webhook) (bool, error) {
+	status, err := s.repository.Remove(webhook.ID)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// Publish to queue delivery of event for every webhook subscribed to it, event of global label
+// (project ID 0) is delivered to webhooks of all projects
+func (s *webhookService) Publish(event string, projectID uint, data interface{}, now time.Time) (int, error) {
+	if !IsWebhookEventValid(event) {
+		return 0, NewValidationError("event %s not valid", event)
+	}
+	webhooks, err := s.repository.FindSubscribed(projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	deliveries := []WebhookDelivery{}
+	for _, webhook := range webhooks {
+		if !webhook.isSubscribed(event) {
+			continue
+		}
+		payload, err := json.Marshal(WebhookPayload{
+			Event:      event,
+			ProjectID:  webhook.ProjectID,
+			OccurredAt: now,
+			Data:       data,
+		})
+		if err != nil {
+			return 0, err
+		}
+		deliveries = append(deliveries, WebhookDelivery{
+			WebhookID: webhook.ID,
+			Event:     event,
+			Payload:   string(payload),
+			Status:    WebhookDeliveryQueued,
+			SendAfter: now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	added, err := s.repository.AddDeliveries(deliveries)
+	if err != nil {
+		return added, err
+	}
+	return added, nil
+}
+
+// ProcessQueue to send due deliveries, failed delivery is retried later with growing delay
+// until WebhookMaxAttempts is reached
+func (s *webhookService) ProcessQueue(now time.Time) (int, error) {
+	if s.sender == nil {
+		return 0, errors.New("webhook sender not configured")
+	}
+	deliveries, err := s.repository.FindDueDeliveries(now, WebhookBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	webhooks := make(map[uint]Webhook)
+	delivered := 0
+	for _, delivery := range deliveries {
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			webhook, err = s.repository.FindByID(delivery.WebhookID)
+			if err != nil && err.Error() != "record not found" {
+				return delivered, err
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+
+		delivery.Attempts++
+		if webhook.ID == 0 || !webhook.Active {
+			delivery.Status = WebhookDeliveryFailed
+			delivery.LastError = "webhook not active"
+		} else if status, err := s.sender.Send(webhook, delivery); err != nil {
+			delivery.ResponseStatus = status
+			delivery.LastError = err.Error()
+			if delivery.Attempts >= WebhookMaxAttempts {
+				delivery.Status = WebhookDeliveryFailed
+			} else {
+				delivery.SendAfter = now.Add(getRetryDelay(delivery.Attempts))
+			}
+		} else {
+			delivery.ResponseStatus = status
+			delivery.Status = WebhookDeliveryDelivered
+			delivery.DeliveredAt = now
+			delivery.LastError = ""
+		}
+
+		if _, err := s.repository.UpdateDelivery(delivery); err != nil {
+			return delivered, err
+		}
+		if delivery.Status == WebhookDeliveryDelivered {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// FindDeliveryByID to find delivery by ID
+func (s *webhookService) FindDeliveryByID(id uint) (WebhookDelivery, error) {
+	item, err := s.repository.FindDeliveryByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindDeliveries to find delivery log of webhook, newest first
+func (s *webhookService) FindDeliveries(webhookID uint) ([]WebhookDelivery, error) {
+	items, err := s.repository.FindDeliveries(webhookID, WebhookDeliveriesLimit)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Redeliver to queue new delivery with payload of provided delivery, original delivery is kept in log
+func (s *webhookService) Redeliver(delivery WebhookDelivery, now time.Time) (*WebhookDelivery, error) {
+	deliveries := []WebhookDelivery{{
+		WebhookID: delivery.WebhookID,
+		Event:     delivery.Event,
+		Payload:   delivery.Payload,
+		Status:    WebhookDeliveryQueued,
+		SendAfter: now,
+	}}
+	if _, err := s.repository.AddDeliveries(deliveries); err != nil {
+		return nil, err
+	}
+	return &deliveries[0], nil
+}
@@ -0,0 +1,273 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"testing"
+	"time"
+)
+
+func TestDomainWebhookResetDefaultWebhookService(t *testing.T) {
+	assert.NotNil(t, domain.GetDefaultWebhookService)
+
+	domain.GetDefaultWebhookService = nil
+	defer domain.ResetDefaultWebhookService()
+
+	assert.Nil(t, domain.GetDefaultWebhookService)
+
+	domain.ResetDefaultWebhookService()
+
+	assert.NotNil(t, domain.GetDefaultWebhookService)
+}
+
+func TestDomainWebhookAdd(t *testing.T) {
+	m := new(dTesting.WebhookRepositoryMock)
+	m.On("Add", mock.MatchedBy(func(webhook *domain.Webhook) bool {
+		return webhook.URL == "https://ci.example.com/hook" &&
+			webhook.Events == "issue.created,issue.deleted" &&
+			webhook.Secret == "secret"
+	})).Return(&domain.Webhook{ID: 1}, nil).Once()
+	m.On("Add", mock.Anything).Return((*domain.Webhook)(nil), errors.New("test error")).Once()
+
+	s := domain.GetDefaultWebhookService(m, nil)
+
+	item, err := s.Add(&domain.Webhook{ProjectID: 1, URL: " https://ci.example.com/hook", Secret: " secret ", Events: "issue.created, issue.deleted,issue.created", Active: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	tests := []struct {
+		webhook *domain.Webhook
+		err     string
+	}{
+		{&domain.Webhook{URL: "https://ci.example.com/hook"}, "project not provided"},
+		{&domain.Webhook{ProjectID: 1, URL: "ftp://ci.example.com/hook"}, "url ftp://ci.example.com/hook not valid"},
+		{&domain.Webhook{ProjectID: 1, URL: "https://"}, "url https:// not valid"},
+		{&domain.Webhook{ProjectID: 1, URL: "https://ci.example.com/hook", Events: "issue.commented"}, "event issue.commented not valid"},
+		{&domain.Webhook{ProjectID: 1, URL: "https://ci.example.com/hook", Secret: " "}, "secret not provided"},
+		{&domain.Webhook{ProjectID: 1, URL: "https://ci.example.com/hook", Secret: "secret"}, "test error"},
+	}
+
+	for _, ts := range tests {
+		item, err := s.Add(ts.webhook)
+
+		assert.Nil(t, item)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainWebhookUpdate(t *testing.T) {
+	w := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook", Secret: "secret", Events: "label.created", Active: true}
+
+	m := new(dTesting.WebhookRepositoryMock)
+	m.On("Update", w).Return(w, nil)
+
+	s := domain.GetDefaultWebhookService(m, nil)
+
+	item, err := s.Update(w)
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, item)
+
+	w.Secret = " "
+	_, err = s.Update(w)
+
+	assert.Equal(t, "secret not provided", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainWebhookFind(t *testing.T) {
+	w := domain.Webhook{ID: 1, ProjectID: 1}
+
+	m := new(dTesting.WebhookRepositoryMock)
+	m.On("FindByID", uint(1)).Return(w, nil)
+	m.On("FindByID", uint(2)).Return(domain.Webhook{}, errors.New("record not found"))
+	m.On("Find", uint(1)).Return([]domain.Webhook{w}, nil)
+	m.On("Find", uint(2)).Return([]domain.Webhook{}, errors.New("test error"))
+	m.On("Remove", uint(1)).Return(true, nil)
+	m.On("Remove", uint(2)).Return(false, errors.New("test error"))
+
+	s := domain.GetDefaultWebhookService(m, nil)
+
+	item, err := s.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, item)
+
+	_, err = s.FindByID(2)
+
+	assert.NotNil(t, err)
+
+	items, err := s.Find(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	_, err = s.Find(2)
+
+	assert.NotNil(t, err)
+
+	status, err := s.Remove(w)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	_, err = s.Remove(domain.Webhook{ID: 2})
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainWebhookPublish(t *testing.T) {
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	webhooks := []domain.Webhook{
+		{ID: 1, ProjectID: 1, Active: true},
+		{ID: 2, ProjectID: 1, Active: true, Events: "issue.created"},
+		{ID: 3, ProjectID: 1, Active: false},
+		{ID: 4, ProjectID: 2, Active: true, Events: "issue.updated,label.created"},
+	}
+
+	m := new(dTesting.WebhookRepositoryMock)
+	m.On("FindSubscribed", uint(1)).Return(webhooks[:3], nil).Once()
+	m.On("FindSubscribed", uint(0)).Return(webhooks, nil).Once()
+	m.On("FindSubscribed", uint(3)).Return([]domain.Webhook{}, errors.New("test error")).Once()
+	m.On("AddDeliveries", mock.MatchedBy(func(deliveries []domain.WebhookDelivery) bool {
+		if len(deliveries) != 1 || deliveries[0].WebhookID != 1 || deliveries[0].Status != domain.WebhookDeliveryQueued || deliveries[0].SendAfter != now {
+			return false
+		}
+		payload := domain.WebhookPayload{}
+		err := json.Unmarshal([]byte(deliveries[0].Payload), &payload)
+		return err == nil && payload.Event == "issue.updated" && payload.ProjectID == 1 && payload.OccurredAt.Equal(now)
+	})).Return(1, nil).Once()
+	m.On("AddDeliveries", mock.MatchedBy(func(deliveries []domain.WebhookDelivery) bool {
+		return len(deliveries) == 2 && deliveries[0].WebhookID == 1 && deliveries[1].WebhookID == 4
+	})).Return(2, nil).Once()
+
+	s := domain.GetDefaultWebhookService(m, nil)
+
+	count, err := s.Publish(domain.WebhookEventIssueUpdated, 1, domain.Issue{ID: 1, ProjectID: 1}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = s.Publish(domain.WebhookEventLabelCreated, 0, domain.Label{ID: 1}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = s.Publish(domain.WebhookEventIssueCreated, 3, domain.Issue{ID: 2, ProjectID: 3}, now)
+
+	assert.NotNil(t, err)
+
+	_, err = s.Publish("issue.commented", 1, domain.Issue{ID: 1, ProjectID: 1}, now)
+
+	assert.Equal(t, "event issue.commented not valid", err.Error())
+
+	m.AssertExpectations(t)
+}
+
+func TestDomainWebhookProcessQueue(t *testing.T) {
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	webhook := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook", Secret: "secret", Active: true}
+	deliveries := []domain.WebhookDelivery{
+		{ID: 1, WebhookID: 1, Status: domain.WebhookDeliveryQueued},
+		{ID: 2, WebhookID: 1, Status: domain.WebhookDeliveryQueued, Attempts: 2},
+		{ID: 3, WebhookID: 1, Status: domain.WebhookDeliveryQueued, Attempts: domain.WebhookMaxAttempts - 1},
+		{ID: 4, WebhookID: 2, Status: domain.WebhookDeliveryQueued},
+	}
+
+	delivered := deliveries[0]
+	delivered.Attempts = 1
+	delivered.ResponseStatus = 200
+	delivered.Status = domain.WebhookDeliveryDelivered
+	delivered.DeliveredAt = now
+	retried := deliveries[1]
+	retried.Attempts = 3
+	retried.ResponseStatus = 500
+	retried.LastError = "unexpected status 500"
+	retried.SendAfter = now.Add(4 * time.Minute)
+	failed := deliveries[2]
+	failed.Attempts = domain.WebhookMaxAttempts
+	failed.ResponseStatus = 500
+	failed.LastError = "unexpected status 500"
+	failed.Status = domain.WebhookDeliveryFailed
+	removed := deliveries[3]
+	removed.Attempts = 1
+	removed.LastError = "webhook not active"
+	removed.Status = domain.WebhookDeliveryFailed
+
+	m := new(dTesting.WebhookRepositoryMock)
+	m.On("FindDueDeliveries", now, domain.WebhookBatchSize).Return(deliveries, nil).Once()
+	m.On("FindByID", uint(1)).Return(webhook, nil).Once()
+	m.On("FindByID", uint(2)).Return(domain.Webhook{}, errors.New("record not found")).Once()
+	m.On("UpdateDelivery", delivered).Return(delivered, nil)
+	m.On("UpdateDelivery", retried).Return(retried, nil)
+	m.On("UpdateDelivery", failed).Return(failed, nil)
+	m.On("UpdateDelivery", removed).Return(removed, nil)
+	m.On("FindDueDeliveries", now, domain.WebhookBatchSize).Return([]domain.WebhookDelivery{}, errors.New("test error")).Once()
+
+	ws := new(dTesting.WebhookSenderMock)
+	ws.On("Send", webhook, mock.MatchedBy(func(delivery domain.WebhookDelivery) bool { return delivery.ID == 1 })).Return(200, nil)
+	ws.On("Send", webhook, mock.Anything).Return(500, errors.New("unexpected status 500"))
+
+	s := domain.GetDefaultWebhookService(m, ws)
+
+	count, err := s.ProcessQueue(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = s.ProcessQueue(now)
+
+	assert.NotNil(t, err)
+
+	_, err = domain.GetDefaultWebhookService(m, nil).ProcessQueue(now)
+
+	assert.Equal(t, "webhook sender not configured", err.Error())
+
+	m.AssertExpectations(t)
+	ws.AssertExpectations(t)
+}
+
+func TestDomainWebhookDeliveries(t *testing.T) {
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	d := domain.WebhookDelivery{ID: 1, WebhookID: 1, Event: "issue.created", Payload: "{}", Status: domain.WebhookDeliveryFailed, Attempts: 8}
+
+	m := new(dTesting.WebhookRepositoryMock)
+	m.On("FindDeliveryByID", uint(1)).Return(d, nil)
+	m.On("FindDeliveries", uint(1), domain.WebhookDeliveriesLimit).Return([]domain.WebhookDelivery{d}, nil)
+	m.On("AddDeliveries", []domain.WebhookDelivery{{WebhookID: 1, Event: "issue.created", Payload: "{}", Status: domain.WebhookDeliveryQueued, SendAfter: now}}).Return(1, nil).Once()
+	m.On("AddDeliveries", mock.Anything).Return(0, errors.New("test error")).Once()
+
+	s := domain.GetDefaultWebhookService(m, nil)
+
+	item, err := s.FindDeliveryByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, d, item)
+
+	items, err := s.FindDeliveries(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	redelivery, err := s.Redeliver(d, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.WebhookDeliveryQueued, redelivery.Status)
+	assert.Equal(t, 0, redelivery.Attempts)
+
+	_, err = s.Redeliver(d, now)
+
+	assert.NotNil(t, err)
+
+	m.AssertExpectations(t)
+}
@@ -0,0 +1,26 @@
+package domain_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+)
+
+func TestDomainWebhookGetWebhookSignature(t *testing.T) {
+	signature := domain.GetWebhookSignature("secret", []byte("{\"event\":\"issue.created\"}"))
+
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Equal(t, 71, len(signature))
+	assert.Equal(t, signature, domain.GetWebhookSignature("secret", []byte("{\"event\":\"issue.created\"}")))
+	assert.NotEqual(t, signature, domain.GetWebhookSignature("other", []byte("{\"event\":\"issue.created\"}")))
+}
+
+func TestDomainWebhookGetEvents(t *testing.T) {
+	assert.Equal(t, []string{}, domain.Webhook{}.GetEvents())
+	assert.Equal(t, []string{"issue.created", "label.deleted"}, domain.Webhook{Events: " issue.created, ,label.deleted"}.GetEvents())
+}
+
+func TestDomainWebhookIsWebhookEventValid(t *testing.T) {
+	assert.True(t, domain.IsWebhookEventValid(domain.WebhookEventProjectDeleted))
+	assert.False(t, domain.IsWebhookEventValid("issue.commented"))
+}
@@ -17,6 +17,16 @@ func GetDefaultSQLiteDBFilePath() (string, error) {
 	return filepath.Join(path, "data/db.sqlite3"), nil
 }
 
+// GetDefaultAttachmentsDirPath to get default path of directory with attachment files
+func GetDefaultAttachmentsDirPath() (string, error) {
+	path, err := helpers.GetProjectDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(path, "data/attachments"), nil
+}
+
 // GetSQLiteDB to get DB
 func GetSQLiteDB(path interface{}) (*gorm.DB, error) {
 	db, err := gorm.Open("sqlite3", path)
@@ -26,9 +36,38 @@ func GetSQLiteDB(path interface{}) (*gorm.DB, error) {
 
 	db.LogMode(true)
 
+	db.AutoMigrate(&domain.Attachment{})
+	db.AutoMigrate(&domain.AutomationExecution{})
+	db.AutoMigrate(&domain.AutomationRule{})
+	db.AutoMigrate(&domain.CustomField{})
+	db.AutoMigrate(&domain.CustomFieldValue{})
 	db.AutoMigrate(&domain.Issue{})
+	db.AutoMigrate(&domain.IssueMove{})
+	db.AutoMigrate(&domain.IssueTemplate{})
+	db.AutoMigrate(&domain.Job{})
 	db.AutoMigrate(&domain.Label{})
+	db.AutoMigrate(&domain.LabelGroup{})
+	db.AutoMigrate(&domain.MailMessage{})
+	db.AutoMigrate(&domain.MailPreference{})
+	db.AutoMigrate(&domain.Notification{})
+	db.AutoMigrate(&domain.OutboxEvent{})
 	db.AutoMigrate(&domain.Project{})
+	db.AutoMigrate(&domain.Watcher{})
+	db.AutoMigrate(&domain.Webhook{})
+	db.AutoMigrate(&domain.WebhookDelivery{})
+	db.AutoMigrate(&domain.WorkLog{})
+
+	if err := migrateProjectSettings(db); err != nil {
+		return nil, err
+	}
 
 	return db, nil
 }
+
+// migrateProjectSettings to apply validation rules of NewProjectSettings to projects created before
+// project settings existed, max. labels cannot be set below 1 so 0 marks project never configured
+func migrateProjectSettings(db *gorm.DB) error {
+	settings := domain.NewProjectSettings()
+	return db.Exec("UPDATE \"projects\" SET settings_max_labels = ?, settings_required_fields = ? WHERE settings_max_labels = 0",
+		settings.MaxLabels, settings.RequiredFields).Error
+}
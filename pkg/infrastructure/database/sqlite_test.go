@@ -7,6 +7,7 @@ import (
 	"go-issue-tracker/pkg/infrastructure/database"
 	"go-issue-tracker/pkg/infrastructure/helpers"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -31,16 +32,57 @@ func TestGetDefaultSQLiteDBFilePathErr(t *testing.T) {
 	assert.Equal(t, "", path)
 }
 
+func TestGetDefaultAttachmentsDirPath(t *testing.T) {
+	path, err := database.GetDefaultAttachmentsDirPath()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "attachments", filepath.Base(path))
+}
+
+func TestGetDefaultAttachmentsDirPathErr(t *testing.T) {
+	helpers.ExecutablePath = func() (string, error) {
+		return "", errors.New("test error")
+	}
+	defer func() {
+		helpers.ExecutablePath = os.Executable
+	}()
+
+	path, err := database.GetDefaultAttachmentsDirPath()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "", path)
+}
+
 func TestGetSQLiteDB(t *testing.T) {
-	mockDB, _, err := sqlmock.New()
+	mockDB, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("mock db error %s", err)
 	}
+	mock.MatchExpectationsInOrder(false)
+	mock.ExpectExec("^UPDATE \"projects\" SET settings_max_labels = (.+), settings_required_fields = (.+) WHERE settings_max_labels = 0").
+		WithArgs(10, "description,labels").
+		WillReturnResult(sqlmock.NewResult(0, 2))
 
 	db, err := database.GetSQLiteDB(mockDB)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, db)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSQLiteDBMigrationErr(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("mock db error %s", err)
+	}
+	mock.MatchExpectationsInOrder(false)
+	mock.ExpectExec("^UPDATE \"projects\" SET settings_max_labels").
+		WillReturnError(errors.New("test error"))
+
+	db, err := database.GetSQLiteDB(mockDB)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, db)
 }
 
 func TestGetSQLiteDBErr(t *testing.T) {
@@ -1,59 +1,253 @@
 package gql
 
 import (
+	"bytes"
 	"encoding/json"
-	"errors"
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
 	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/domain"
 	"go-issue-tracker/pkg/usecases"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"strings"
+	"time"
 )
 
 // PrepareGraphQL function to prepare GraphQL
-func PrepareGraphQL(iuc usecases.IssueUseCase, luc usecases.LabelUseCase, puc usecases.ProjectUseCase, cuc usecases.ColorUseCase) graphql.Schema {
-	resolver := GetResolver(iuc, luc, puc, cuc)
+func PrepareGraphQL(iuc usecases.IssueUseCase, luc usecases.LabelUseCase, puc usecases.ProjectUseCase, cuc usecases.ColorUseCase, nuc usecases.NotificationUseCase, muc usecases.MailUseCase, wuc usecases.WebhookUseCase, auc usecases.AutomationUseCase) graphql.Schema {
+	resolver := GetResolver(iuc, luc, puc, cuc, nuc, muc, wuc, auc)
 
 	SetTypesAndNodeDefinitions(resolver)
 
 	query := GetQuery(resolver)
 	mutation := GetMutation(resolver)
-	schema, err := GetSchema(query, mutation)
+	subscription := GetSubscription(resolver)
+	schema, err := GetSchema(query, mutation, subscription)
 	if err != nil {
 		log.Fatal(err)
 	}
 	return schema
 }
 
-// PrepareEndpoints function to prepare GraphQL endpoints
+// PrepareEndpoints function to prepare GraphQL endpoints, GET serves queries and subscriptions over WebSocket
 func PrepareEndpoints(e *echo.Echo, rm RequestManager) {
 	e.POST("/graphql", rm.Handler)
+	e.GET("/graphql", rm.Handler)
 }
 
-// Handler handler
+// Handler to execute GraphQL request of query string of GET or JSON body of POST, JSON array of at most
+// MaxBatchSize requests is executed as batch, GET upgraded to WebSocket is passed to SubscriptionHandler,
+// client of complexity budget is IP address extracted by IPExtractor of server
 func (rm requestManager) Handler(c echo.Context) error {
-	var actual map[string]interface{}
-	json.NewDecoder(c.Request().Body).Decode(&actual)
-	requestString, requestStringOK := actual["query"].(string)
-	if !requestStringOK {
-		return c.JSON(500, map[string]interface{}{
-			"error": errors.New("request not complete"),
-		})
-	}
-	variables, _ := actual["variables"].(map[string]interface{})
-	result := rm.Execute(rm.schema, requestString, variables)
-	if result.HasErrors() {
-		return c.JSON(200, map[string]interface{}{
-			"error": result.Errors,
-		})
-	}
-	return c.JSON(200, result)
-}
-
-// Execute func
-func (rm requestManager) Execute(schema graphql.Schema, requestString string, variableValues map[string]interface{}) *graphql.Result {
-	return graphql.Do(graphql.Params{
+	if c.Request().Method == http.MethodGet {
+		if strings.EqualFold(c.Request().Header.Get(echo.HeaderUpgrade), "websocket") {
+			return rm.SubscriptionHandler(c)
+		}
+		request := Request{Query: c.QueryParam("query"), OperationName: c.QueryParam("operationName")}
+		if variables := c.QueryParam("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				return c.JSON(http.StatusBadRequest, getErrorResponse(domain.NewValidationError("variables not valid")))
+			}
+		}
+		if extensions := c.QueryParam("extensions"); extensions != "" {
+			if err := json.Unmarshal([]byte(extensions), &request.Extensions); err != nil {
+				return c.JSON(http.StatusBadRequest, getErrorResponse(domain.NewValidationError("extensions not valid")))
+			}
+		}
+		status, response := rm.handleRequest(request, c.RealIP(), true)
+		return c.JSON(status, response)
+	}
+
+	body, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, getErrorResponse(domain.NewValidationError("request not valid")))
+	}
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var requests []Request
+		if err := json.Unmarshal(body, &requests); err != nil || len(requests) == 0 {
+			return c.JSON(http.StatusBadRequest, getErrorResponse(domain.NewValidationError("request not valid")))
+		}
+		if MaxBatchSize > 0 && len(requests) > MaxBatchSize {
+			return c.JSON(http.StatusBadRequest, getErrorResponse(domain.NewValidationError("batch of %d requests exceeds max. batch size %d", len(requests), MaxBatchSize)))
+		}
+		responses := make([]interface{}, len(requests))
+		for i, request := range requests {
+			_, responses[i] = rm.handleRequest(request, c.RealIP(), false)
+		}
+		return c.JSON(http.StatusOK, responses)
+	}
+	var request Request
+	if err := json.Unmarshal(body, &request); err != nil {
+		return c.JSON(http.StatusBadRequest, getErrorResponse(domain.NewValidationError("request not valid")))
+	}
+	status, response := rm.handleRequest(request, c.RealIP(), false)
+	return c.JSON(status, response)
+}
+
+// handleRequest to execute request of client and get HTTP status with response, query of persisted query is
+// resolved first and registered when it passes validation and complexity checks. Errors raised before execution are responded without data with 400, GET allows only queries, because it must not change state. Complexity
+// of operation is checked and charged to budget of client before execution and reported in extensions
+func (rm requestManager) handleRequest(request Request, client string, get bool) (int, interface{}) {
+	request, err := rm.queries.Resolve(request)
+	if err != nil {
+		return getPersistedQueryStatus(err), getErrorResponse(err)
+	}
+	if request.Query == "" {
+		return http.StatusBadRequest, getErrorResponse(domain.NewValidationError("query not provided"))
+	}
+	// Syntax errors are responded by Execute with locations
+	document, operation, err := getOperation(request)
+	if _, ok := err.(*domain.Error); ok {
+		return http.StatusBadRequest, getErrorResponse(err)
+	}
+	var extensions map[string]interface{}
+	if err == nil {
+		if operation.Operation == ast.OperationTypeSubscription {
+			return http.StatusBadRequest, getErrorResponse(domain.NewValidationError("subscriptions are served over WebSocket"))
+		}
+		if get && operation.Operation != ast.OperationTypeQuery {
+			return http.StatusMethodNotAllowed, getErrorResponse(domain.NewValidationError("only queries are allowed with GET"))
+		}
+		complexity, err := rm.chargeComplexity(document, operation, request, client)
+		extensions = map[string]interface{}{"complexity": complexity}
+		if err != nil {
+			response := getErrorResponse(err)
+			response["extensions"] = extensions
+			if domain.GetErrorCode(err) == domain.ErrorCodeRateLimited {
+				return http.StatusTooManyRequests, response
+			}
+			return http.StatusBadRequest, response
+		}
+		rm.registerQuery(document, request)
+	}
+
+	result := rm.Execute(rm.schema, request)
+	result.Extensions = extensions
+	if result.Data == nil && result.HasErrors() {
+		response := map[string]interface{}{"errors": result.Errors}
+		if extensions != nil {
+			response["extensions"] = extensions
+		}
+		return http.StatusBadRequest, response
+	}
+	return http.StatusOK, result
+}
+
+// registerQuery to register query sent with persisted query hash after its document is validated against schema,
+// so queries which can't be executed don't fill registry
+func (rm requestManager) registerQuery(document *ast.Document, request Request) {
+	if rm.queries.isRegistered(request) {
+		return
+	}
+	if graphql.ValidateDocument(&rm.schema, document, nil).IsValid {
+		rm.queries.register(strings.ToLower(request.Extensions.PersistedQuery.SHA256Hash), request.Query)
+	}
+}
+
+// getPersistedQueryStatus to get HTTP status of persisted query error, missing persisted query is responded
+// with 200, because Apollo clients disable persisted queries on HTTP errors instead of sending query
+func getPersistedQueryStatus(err error) int {
+	switch domain.GetErrorCode(err) {
+	case ErrorCodePersistedQueryNotFound, ErrorCodePersistedQueryNotSupported:
+		return http.StatusOK
+	case domain.ErrorCodeForbidden:
+		return http.StatusForbidden
+	}
+	return http.StatusBadRequest
+}
+
+// chargeComplexity to check complexity of operation against limits and charge its cost to budget of client
+func (rm requestManager) chargeComplexity(document *ast.Document, operation *ast.OperationDefinition, request Request, client string) (Complexity, error) {
+	complexity := getComplexity(&rm.schema, document, operation, request.Variables)
+	if err := checkComplexity(complexity); err != nil {
+		return complexity, err
+	}
+	if ClientBudget <= 0 {
+		return complexity, nil
+	}
+	remaining, err := rm.budgets.charge(client, complexity.Cost, time.Now())
+	complexity.RemainingBudget = &remaining
+	if err != nil {
+		return complexity, err
+	}
+	return complexity, nil
+}
+
+// Execute to execute request, errors get code of extensions
+func (rm requestManager) Execute(schema graphql.Schema, request Request) *graphql.Result {
+	result := graphql.Do(graphql.Params{
 		Schema:         schema,
-		RequestString:  requestString,
-		VariableValues: variableValues,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
 	})
+	setErrorCodes(result.Errors)
+	return result
+}
+
+// getOperation to parse document of request and get its operation selected by operation name
+func getOperation(request Request) (*ast.Document, *ast.OperationDefinition, error) {
+	document, err := parser.Parse(parser.ParseParams{Source: request.Query})
+	if err != nil {
+		return nil, nil, err
+	}
+	operations := []*ast.OperationDefinition{}
+	for _, definition := range document.Definitions {
+		if operation, ok := definition.(*ast.OperationDefinition); ok {
+			operations = append(operations, operation)
+		}
+	}
+	if request.OperationName == "" && len(operations) > 1 {
+		return document, nil, domain.NewValidationError("operation name not provided")
+	}
+	for _, operation := range operations {
+		if request.OperationName == "" || (operation.Name != nil && operation.Name.Value == request.OperationName) {
+			return document, operation, nil
+		}
+	}
+	return document, nil, domain.NewValidationError("operation %s not found", request.OperationName)
+}
+
+// getErrorResponse to get response of error raised before execution
+func getErrorResponse(err error) map[string]interface{} {
+	return map[string]interface{}{"errors": formatErrors(err)}
+}
+
+// formatErrors to format error with code of extensions
+func formatErrors(err error) []gqlerrors.FormattedError {
+	errs := []gqlerrors.FormattedError{gqlerrors.FormatError(err)}
+	setErrorCodes(errs)
+	return errs
+}
+
+// setErrorCodes to set code of domain error to extensions of errors, errors of document without original
+// error are VALIDATION
+func setErrorCodes(errs []gqlerrors.FormattedError) {
+	for i := range errs {
+		code := domain.ErrorCodeValidation
+		var err error = errs[i]
+		if original := errs[i].OriginalError(); original != nil {
+			err = original
+		}
+		for {
+			located, ok := err.(*gqlerrors.Error)
+			if !ok {
+				code = domain.GetErrorCode(err)
+				break
+			}
+			if located.OriginalError == nil {
+				break
+			}
+			err = located.OriginalError
+		}
+		if errs[i].Extensions == nil {
+			errs[i].Extensions = make(map[string]interface{})
+		}
+		errs[i].Extensions["code"] = code
+	}
 }
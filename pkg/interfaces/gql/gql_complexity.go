@@ -0,0 +1,320 @@
+package gql
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/relay"
+	"go-issue-tracker/pkg/domain"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxDepth is max. depth of selections of operation, 0 disables limit
+var MaxDepth = 12
+
+// MaxComplexity is max. cost of operation, 0 disables limit
+var MaxComplexity = 1000
+
+// ClientBudget is cost available to client in ClientBudgetInterval, 0 disables budgets
+var ClientBudget = 0
+
+// ClientBudgetInterval is interval in which budget of client is refilled
+var ClientBudgetInterval = time.Minute
+
+// DefaultListSize is page size of connection without first or last argument and estimated size of other lists
+var DefaultListSize = 10
+
+// MaxPageSize is max. first or last argument of connection, 0 disables limit
+var MaxPageSize = 100
+
+// MaxBatchSize is max. number of requests in batch, 0 disables limit
+var MaxBatchSize = 10
+
+// FieldWeights are costs of fields as Type.field, object fields without weight cost 1 and scalar fields cost 0
+var FieldWeights = map[string]int{}
+
+// SetFieldWeights to set FieldWeights from comma separated Type.field:weight pairs
+func SetFieldWeights(value string) error {
+	weights := make(map[string]int)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) != 2 || !strings.Contains(parts[0], ".") {
+			return domain.NewValidationError("field weight %s not valid", item)
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || weight < 0 {
+			return domain.NewValidationError("field weight %s not valid", item)
+		}
+		weights[strings.TrimSpace(parts[0])] = weight
+	}
+	FieldWeights = weights
+	return nil
+}
+
+// Complexity is depth and cost of operation computed before execution, it is reported in extensions of response
+type Complexity struct {
+	Depth           int  `json:"depth"`
+	Cost            int  `json:"cost"`
+	MaxDepth        int  `json:"maxDepth"`
+	MaxCost         int  `json:"maxCost"`
+	RemainingBudget *int `json:"remainingBudget,omitempty"`
+	pageSize        int
+}
+
+// complexityAnalyzer computes complexity of selections of operation
+type complexityAnalyzer struct {
+	schema    *graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	pageSize  *int
+}
+
+// getComplexity to compute depth and cost of operation, list fields multiply cost of their selections by first
+// or last argument or by DefaultListSize, fragments of abstract types are counted as all of them are selected,
+// so cost is upper bound
+func getComplexity(schema *graphql.Schema, document *ast.Document, operation *ast.OperationDefinition, variables map[string]interface{}) Complexity {
+	complexity := Complexity{MaxDepth: MaxDepth, MaxCost: MaxComplexity}
+	analyzer := complexityAnalyzer{
+		schema:    schema,
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+		pageSize:  &complexity.pageSize,
+	}
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+			analyzer.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	var root *graphql.Object
+	switch operation.Operation {
+	case ast.OperationTypeMutation:
+		root = schema.MutationType()
+	case ast.OperationTypeSubscription:
+		root = schema.SubscriptionType()
+	default:
+		root = schema.QueryType()
+	}
+	if root != nil {
+		complexity.Depth, complexity.Cost = analyzer.selectionSet(root, operation.SelectionSet, map[string]bool{})
+	}
+	return complexity
+}
+
+// selectionSet to compute depth and cost of selections of parent type, visited fragments guard against cycles
+// of fragments which are rejected later by validation
+func (a complexityAnalyzer) selectionSet(parent graphql.Type, set *ast.SelectionSet, visited map[string]bool) (int, int) {
+	if set == nil || parent == nil {
+		return 0, 0
+	}
+	depth, cost := 0, 0
+	add := func(d int, c int) {
+		if d > depth {
+			depth = d
+		}
+		cost += c
+	}
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			add(a.field(parent, selection, visited))
+		case *ast.InlineFragment:
+			typeCondition := parent
+			if selection.TypeCondition != nil {
+				typeCondition = a.schema.Type(selection.TypeCondition.Name.Value)
+			}
+			add(a.selectionSet(typeCondition, selection.SelectionSet, visited))
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := a.fragments[name]
+			if !ok || visited[name] || fragment.TypeCondition == nil {
+				continue
+			}
+			visited[name] = true
+			add(a.selectionSet(a.schema.Type(fragment.TypeCondition.Name.Value), fragment.SelectionSet, visited))
+			delete(visited, name)
+		}
+	}
+	return depth, cost
+}
+
+// field to compute depth and cost of field with its selections, introspection fields are not counted
+func (a complexityAnalyzer) field(parent graphql.Type, field *ast.Field, visited map[string]bool) (int, int) {
+	name := field.Name.Value
+	if strings.HasPrefix(name, "__") {
+		return 0, 0
+	}
+	var definitions graphql.FieldDefinitionMap
+	switch parent := parent.(type) {
+	case *graphql.Object:
+		definitions = parent.Fields()
+	case *graphql.Interface:
+		definitions = parent.Fields()
+	}
+	definition, ok := definitions[name]
+	if !ok {
+		return 1, 0
+	}
+
+	fieldType, list := unwrapType(definition.Type)
+	weight, ok := FieldWeights[parent.Name()+"."+name]
+	if !ok && field.SelectionSet != nil {
+		weight = 1
+	}
+	depth, cost := a.selectionSet(fieldType, field.SelectionSet, visited)
+
+	size := 1
+	if limit, ok := a.getLimit(field); ok {
+		size = limit
+	} else if list && !strings.HasSuffix(parent.Name(), "Connection") {
+		// Edges of connection are limited by first or last argument of connection field
+		size = DefaultListSize
+	} else if strings.HasSuffix(fieldType.Name(), "Connection") {
+		size = DefaultListSize
+	}
+	return depth + 1, weight + size*cost
+}
+
+// getLimit to get value of first or last argument of field
+func (a complexityAnalyzer) getLimit(field *ast.Field) (int, bool) {
+	limit, found := 0, false
+	for _, argument := range field.Arguments {
+		if argument.Name == nil || (argument.Name.Value != "first" && argument.Name.Value != "last") {
+			continue
+		}
+		if value, ok := a.getIntValue(argument.Value); ok && value > limit {
+			limit, found = value, true
+		}
+	}
+	if limit > *a.pageSize {
+		*a.pageSize = limit
+	}
+	return limit, found
+}
+
+// getConnectionArguments to get arguments of connection, page without first or last argument has DefaultListSize
+// edges and page is limited by MaxPageSize, so connection never returns more edges than its cost was computed for
+func getConnectionArguments(args map[string]interface{}) relay.ConnectionArguments {
+	connection := relay.NewConnectionArguments(args)
+	if connection.First < 0 && connection.Last < 0 {
+		connection.First = DefaultListSize
+	}
+	if MaxPageSize > 0 && connection.First > MaxPageSize {
+		connection.First = MaxPageSize
+	}
+	if MaxPageSize > 0 && connection.Last > MaxPageSize {
+		connection.Last = MaxPageSize
+	}
+	return connection
+}
+
+// getIntValue to get int of literal or variable value
+func (a complexityAnalyzer) getIntValue(value ast.Value) (int, bool) {
+	switch value := value.(type) {
+	case *ast.IntValue:
+		i, err := strconv.Atoi(value.Value)
+		return i, err == nil
+	case *ast.Variable:
+		switch variable := a.variables[value.Name.Value].(type) {
+		case float64:
+			return int(variable), true
+		case int:
+			return variable, true
+		}
+	}
+	return 0, false
+}
+
+// unwrapType to get named type of field type and whether it is list
+func unwrapType(t graphql.Type) (graphql.Type, bool) {
+	list := false
+	for {
+		switch wrapped := t.(type) {
+		case *graphql.NonNull:
+			t = wrapped.OfType
+		case *graphql.List:
+			t = wrapped.OfType
+			list = true
+		default:
+			return t, list
+		}
+	}
+}
+
+// checkComplexity to check depth, page size and cost of operation against limits
+func checkComplexity(complexity Complexity) error {
+	if MaxPageSize > 0 && complexity.pageSize > MaxPageSize {
+		return domain.NewValidationError("page size %d exceeds max. page size %d", complexity.pageSize, MaxPageSize)
+	}
+	if MaxDepth > 0 && complexity.Depth > MaxDepth {
+		return domain.NewValidationError("query depth %d exceeds max. depth %d", complexity.Depth, MaxDepth)
+	}
+	if MaxComplexity > 0 && complexity.Cost > MaxComplexity {
+		return domain.NewValidationError("query cost %d exceeds max. cost %d", complexity.Cost, MaxComplexity)
+	}
+	return nil
+}
+
+// clientBudget is cost available to client refilled continuously in ClientBudgetInterval
+type clientBudget struct {
+	available float64
+	updatedAt time.Time
+}
+
+// complexityBudgets are budgets of clients kept in memory of process
+type complexityBudgets struct {
+	mutex    sync.Mutex
+	clients  map[string]*clientBudget
+	prunedAt time.Time
+}
+
+// newComplexityBudgets to create empty budgets
+func newComplexityBudgets() *complexityBudgets {
+	return &complexityBudgets{clients: make(map[string]*clientBudget)}
+}
+
+// charge to charge cost to budget of client, cost is not charged when budget of client is not sufficient
+func (b *complexityBudgets) charge(client string, cost int, now time.Time) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	capacity := float64(ClientBudget)
+	refill := func(budget *clientBudget) {
+		elapsed := now.Sub(budget.updatedAt)
+		if elapsed > 0 && ClientBudgetInterval > 0 {
+			budget.available += capacity * float64(elapsed) / float64(ClientBudgetInterval)
+		}
+		if budget.available > capacity || ClientBudgetInterval <= 0 {
+			budget.available = capacity
+		}
+		budget.updatedAt = now
+	}
+
+	// Full budgets are same as missing ones, so they are removed to keep memory bounded
+	if now.Sub(b.prunedAt) > ClientBudgetInterval {
+		for key, budget := range b.clients {
+			if refill(budget); budget.available >= capacity {
+				delete(b.clients, key)
+			}
+		}
+		b.prunedAt = now
+	}
+
+	budget, ok := b.clients[client]
+	if !ok {
+		budget = &clientBudget{available: capacity, updatedAt: now}
+		b.clients[client] = budget
+	}
+	refill(budget)
+	if float64(cost) > budget.available {
+		return int(budget.available), domain.NewRateLimitedError("query cost %d exceeds remaining budget %d of client", cost, int(budget.available))
+	}
+	budget.available -= float64(cost)
+	return int(budget.available), nil
+}
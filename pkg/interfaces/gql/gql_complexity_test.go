@@ -0,0 +1,130 @@
+package gql_test
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/interfaces/gql"
+	"strings"
+	"testing"
+)
+
+type complexityResponse struct {
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+	Extensions struct {
+		Complexity gql.Complexity `json:"complexity"`
+	} `json:"extensions"`
+}
+
+func requestComplexity(t *testing.T, gqlm gql.RequestManager, body string) (int, complexityResponse) {
+	c, rec := prepareHTTP(echo.POST, "/graphql", strings.NewReader(body))
+	assert.Nil(t, gqlm.Handler(c))
+	var response complexityResponse
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	return rec.Code, response
+}
+
+func TestHandlerComplexity(t *testing.T) {
+	_, gqlm := prepareLabelRequestManager()
+
+	// Issue is not found, so resolvers of selections are not called
+	status, response := requestComplexity(t, gqlm, `{"query":"{ issue(id: \"wrong\") { title labels { edges { node { name } } } } }"}`)
+
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 5, response.Extensions.Complexity.Depth)
+	assert.Equal(t, 1+1+10*(1+1), response.Extensions.Complexity.Cost)
+
+	status, response = requestComplexity(t, gqlm, `{"query":"query ($n: Int) { issue(id: \"wrong\") { labels(first: $n) { edges { node { name } } } } }","variables":{"n":3}}`)
+
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 1+1+3*(1+1), response.Extensions.Complexity.Cost)
+
+	status, response = requestComplexity(t, gqlm, `{"query":"{ issue(id: \"wrong\") { ...Labels ... on Issue { project { name } } } } fragment Labels on Issue { labels(last: 2) { edges { node { name } } } }"}`)
+
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 1+(1+2*(1+1))+1, response.Extensions.Complexity.Cost)
+
+	gql.FieldWeights["Query.issue"] = 20
+	defer delete(gql.FieldWeights, "Query.issue")
+
+	status, response = requestComplexity(t, gqlm, `{"query":"{ issue(id: \"wrong\") { title } }"}`)
+
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 20, response.Extensions.Complexity.Cost)
+}
+
+func TestHandlerComplexityLimits(t *testing.T) {
+	_, gqlm := prepareLabelRequestManager()
+
+	maxDepth, maxComplexity := gql.MaxDepth, gql.MaxComplexity
+	defer func() {
+		gql.MaxDepth, gql.MaxComplexity = maxDepth, maxComplexity
+	}()
+	gql.MaxDepth, gql.MaxComplexity = 4, 100
+
+	status, response := requestComplexity(t, gqlm, `{"query":"{ issue(id: \"wrong\") { labels { edges { node { name } } } } }"}`)
+
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "query depth 5 exceeds max. depth 4", response.Errors[0].Message)
+	assert.Equal(t, "VALIDATION", response.Errors[0].Extensions["code"])
+	assert.Equal(t, 5, response.Extensions.Complexity.Depth)
+
+	gql.MaxDepth = 5
+	status, response = requestComplexity(t, gqlm, `{"query":"{ issue(id: \"wrong\") { labels(first: 100) { edges { node { id } } } } }"}`)
+
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "query cost 202 exceeds max. cost 100", response.Errors[0].Message)
+	assert.Equal(t, 202, response.Extensions.Complexity.Cost)
+
+	status, response = requestComplexity(t, gqlm, `{"query":"query ($n: Int) { issue(id: \"wrong\") { labels(first: $n) { edges { node { id } } } } }","variables":{"n":101}}`)
+
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "page size 101 exceeds max. page size 100", response.Errors[0].Message)
+
+	// Introspection is not counted
+	status, _ = requestComplexity(t, gqlm, `{"query":"{ __schema { types { fields { type { ofType { ofType { name } } } } } } }"}`)
+
+	assert.Equal(t, 200, status)
+}
+
+func TestHandlerComplexityBudget(t *testing.T) {
+	_, gqlm := prepareLabelRequestManager()
+
+	defer func() {
+		gql.ClientBudget = 0
+	}()
+	gql.ClientBudget = 30
+
+	query := `{"query":"{ issue(id: \"wrong\") { labels { edges { node { name } } } } }"}`
+	status, response := requestComplexity(t, gqlm, query)
+
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 8, *response.Extensions.Complexity.RemainingBudget)
+
+	status, response = requestComplexity(t, gqlm, query)
+
+	assert.Equal(t, 429, status)
+	assert.Equal(t, "query cost 22 exceeds remaining budget 8 of client", response.Errors[0].Message)
+	assert.Equal(t, "RATE_LIMITED", response.Errors[0].Extensions["code"])
+}
+
+func TestSetFieldWeights(t *testing.T) {
+	defer func() {
+		gql.FieldWeights = map[string]int{}
+	}()
+
+	err := gql.SetFieldWeights(" Query.issues:10,Issue.project:2, ")
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"Query.issues": 10, "Issue.project": 2}, gql.FieldWeights)
+
+	for _, value := range []string{"issues:10", "Query.issues", "Query.issues:-1", "Query.issues:many"} {
+		err = gql.SetFieldWeights(value)
+
+		assert.EqualError(t, err, "field weight "+value+" not valid")
+	}
+	assert.Equal(t, map[string]int{"Query.issues": 10, "Issue.project": 2}, gql.FieldWeights)
+}
@@ -17,8 +17,13 @@ func GetMutation(resolver Resolver) *graphql.Object {
 					"title":       &graphql.InputObjectFieldConfig{Type: graphql.String},
 					"description": &graphql.InputObjectFieldConfig{Type: graphql.String},
 					"status":      &graphql.InputObjectFieldConfig{Type: graphql.Int},
+					"priority":    &graphql.InputObjectFieldConfig{Type: IssuePriorityType},
+					"severity":    &graphql.InputObjectFieldConfig{Type: IssueSeverityType},
+					"reporter":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"assignee":    &graphql.InputObjectFieldConfig{Type: graphql.String},
 					"projectId":   &graphql.InputObjectFieldConfig{Type: graphql.String},
 					"labels":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"templateId":  &graphql.InputObjectFieldConfig{Type: graphql.ID},
 				},
 				OutputFields: graphql.Fields{
 					"issue": &graphql.Field{
@@ -37,6 +42,10 @@ func GetMutation(resolver Resolver) *graphql.Object {
 					"title":       &graphql.InputObjectFieldConfig{Type: graphql.String},
 					"description": &graphql.InputObjectFieldConfig{Type: graphql.String},
 					"status":      &graphql.InputObjectFieldConfig{Type: graphql.Int},
+					"priority":    &graphql.InputObjectFieldConfig{Type: IssuePriorityType},
+					"severity":    &graphql.InputObjectFieldConfig{Type: IssueSeverityType},
+					"reporter":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"assignee":    &graphql.InputObjectFieldConfig{Type: graphql.String},
 					"labels":      &graphql.InputObjectFieldConfig{Type: graphql.String},
 				},
 				OutputFields: graphql.Fields{
@@ -68,11 +77,58 @@ func GetMutation(resolver Resolver) *graphql.Object {
 					return resolver.MutateAndGetPayloadForRemoveIssueMutation(ctx, inputMap, info)
 				},
 			}),
+			"bulkUpdateIssues": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "BulkUpdateIssues",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"ids":             &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"title":           &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"projectId":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"labels":          &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"addLabels":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"removeLabels":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"status":          &graphql.InputObjectFieldConfig{Type: graphql.Int},
+					"targetProjectId": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"customFields":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+					"minPriority":     &graphql.InputObjectFieldConfig{Type: IssuePriorityType},
+					"minSeverity":     &graphql.InputObjectFieldConfig{Type: IssueSeverityType},
+					"minSlaStatus":    &graphql.InputObjectFieldConfig{Type: IssueSLAStatusType},
+				},
+				OutputFields: graphql.Fields{
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+					"results": &graphql.Field{
+						Type:    graphql.NewList(IssueBulkResultType),
+						Resolve: resolver.ResolveMutationOutputFieldItems,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForBulkUpdateIssuesMutation(ctx, inputMap, info)
+				},
+			}),
+			"moveIssue": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "MoveIssue",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"projectId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				OutputFields: graphql.Fields{
+					"issue": &graphql.Field{
+						Type:    IssueType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForMoveIssueMutation(ctx, inputMap, info)
+				},
+			}),
 			"addLabel": relay.MutationWithClientMutationID(relay.MutationConfig{
 				Name: "AddLabel",
 				InputFields: graphql.InputObjectConfigFieldMap{
 					"name":         &graphql.InputObjectFieldConfig{Type: graphql.String},
 					"colorHexCode": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"projectId":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
 				},
 				OutputFields: graphql.Fields{
 					"label": &graphql.Field{
@@ -120,6 +176,94 @@ func GetMutation(resolver Resolver) *graphql.Object {
 					return resolver.MutateAndGetPayloadForRemoveLabelMutation(ctx, inputMap, info)
 				},
 			}),
+			"mergeLabels": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "MergeLabels",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"sourceId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"targetId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+					"merge": &graphql.Field{
+						Type:    LabelMergeType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForMergeLabelsMutation(ctx, inputMap, info)
+				},
+			}),
+			"setLabelGroup": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "SetLabelGroup",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"groupId": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				},
+				OutputFields: graphql.Fields{
+					"label": &graphql.Field{
+						Type:    LabelType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForSetLabelGroupMutation(ctx, inputMap, info)
+				},
+			}),
+			"addLabelGroup": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "AddLabelGroup",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"name":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"exclusive": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				},
+				OutputFields: graphql.Fields{
+					"labelGroup": &graphql.Field{
+						Type:    LabelGroupType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForAddLabelGroupMutation(ctx, inputMap, info)
+				},
+			}),
+			"updateLabelGroup": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "UpdateLabelGroup",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"name":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"exclusive": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				},
+				OutputFields: graphql.Fields{
+					"labelGroup": &graphql.Field{
+						Type:    LabelGroupType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForUpdateLabelGroupMutation(ctx, inputMap, info)
+				},
+			}),
+			"removeLabelGroup": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "RemoveLabelGroup",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"labelGroupId": &graphql.Field{
+						Type:    graphql.NewNonNull(graphql.ID),
+						Resolve: resolver.ResolveMutationOutputFieldItemID,
+					},
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForRemoveLabelGroupMutation(ctx, inputMap, info)
+				},
+			}),
 			"addProject": relay.MutationWithClientMutationID(relay.MutationConfig{
 				Name: "AddProject",
 				InputFields: graphql.InputObjectConfigFieldMap{
@@ -153,10 +297,480 @@ func GetMutation(resolver Resolver) *graphql.Object {
 					return resolver.MutateAndGetPayloadForUpdateProjectMutation(ctx, inputMap, info)
 				},
 			}),
+			"updateProjectSettings": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "UpdateProjectSettings",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":                  &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"maxLabels":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"requiredFields":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"defaultStatus":       &graphql.InputObjectFieldConfig{Type: graphql.Int},
+					"allowedStatuses":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"allowedPriorities":   &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"allowedSeverities":   &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"slaPolicy":           &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"slaAtRisk":           &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"slaResolvedStatuses": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"defaultLabels":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+				},
+				OutputFields: graphql.Fields{
+					"project": &graphql.Field{
+						Type:    ProjectType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForUpdateProjectSettingsMutation(ctx, inputMap, info)
+				},
+			}),
+			"addIssueTemplate": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "AddIssueTemplate",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"projectId":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"name":        &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"titlePrefix": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"description": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"status":      &graphql.InputObjectFieldConfig{Type: graphql.Int},
+					"labels":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+				},
+				OutputFields: graphql.Fields{
+					"issueTemplate": &graphql.Field{
+						Type:    IssueTemplateType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForAddIssueTemplateMutation(ctx, inputMap, info)
+				},
+			}),
+			"updateIssueTemplate": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "UpdateIssueTemplate",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"name":        &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"titlePrefix": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"description": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"status":      &graphql.InputObjectFieldConfig{Type: graphql.Int},
+					"labels":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+				},
+				OutputFields: graphql.Fields{
+					"issueTemplate": &graphql.Field{
+						Type:    IssueTemplateType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForUpdateIssueTemplateMutation(ctx, inputMap, info)
+				},
+			}),
+			"removeIssueTemplate": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "RemoveIssueTemplate",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"issueTemplateId": &graphql.Field{
+						Type:    graphql.NewNonNull(graphql.ID),
+						Resolve: resolver.ResolveMutationOutputFieldItemID,
+					},
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForRemoveIssueTemplateMutation(ctx, inputMap, info)
+				},
+			}),
+			"addCustomField": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "AddCustomField",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"projectId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"name":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"type":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"options":   &graphql.InputObjectFieldConfig{Type: graphql.String},
+				},
+				OutputFields: graphql.Fields{
+					"customField": &graphql.Field{
+						Type:    CustomFieldType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForAddCustomFieldMutation(ctx, inputMap, info)
+				},
+			}),
+			"updateCustomField": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "UpdateCustomField",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"name":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"options": &graphql.InputObjectFieldConfig{Type: graphql.String},
+				},
+				OutputFields: graphql.Fields{
+					"customField": &graphql.Field{
+						Type:    CustomFieldType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForUpdateCustomFieldMutation(ctx, inputMap, info)
+				},
+			}),
+			"removeCustomField": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "RemoveCustomField",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"customFieldId": &graphql.Field{
+						Type:    graphql.NewNonNull(graphql.ID),
+						Resolve: resolver.ResolveMutationOutputFieldItemID,
+					},
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForRemoveCustomFieldMutation(ctx, inputMap, info)
+				},
+			}),
+			"setIssueCustomFields": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "SetIssueCustomFields",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"customFields": &graphql.InputObjectFieldConfig{
+						Type:        graphql.NewList(graphql.String),
+						Description: "Custom Field values as name:value pairs, empty value removes value of Custom Field",
+					},
+				},
+				OutputFields: graphql.Fields{
+					"issue": &graphql.Field{
+						Type:    IssueType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForSetIssueCustomFieldsMutation(ctx, inputMap, info)
+				},
+			}),
+			"setIssueEstimates": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "SetIssueEstimates",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"originalEstimate": &graphql.InputObjectFieldConfig{
+						Type:        graphql.NewNonNull(graphql.String),
+						Description: "Minutes or duration, e.g. 90 or 1h30m",
+					},
+					"remainingEstimate": &graphql.InputObjectFieldConfig{
+						Type:        graphql.String,
+						Description: "Minutes or duration, original estimate reduced by time spent when not provided",
+					},
+				},
+				OutputFields: graphql.Fields{
+					"issue": &graphql.Field{
+						Type:    IssueType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForSetIssueEstimatesMutation(ctx, inputMap, info)
+				},
+			}),
+			"addWorkLog": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "AddWorkLog",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"issueId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"author":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"timeSpent": &graphql.InputObjectFieldConfig{
+						Type:        graphql.NewNonNull(graphql.String),
+						Description: "Minutes or duration, e.g. 90 or 1h30m",
+					},
+					"loggedAt": &graphql.InputObjectFieldConfig{Type: graphql.DateTime},
+					"comment":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+				},
+				OutputFields: graphql.Fields{
+					"workLog": &graphql.Field{
+						Type:    WorkLogType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForAddWorkLogMutation(ctx, inputMap, info)
+				},
+			}),
+			"removeWorkLog": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "RemoveWorkLog",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"workLogId": &graphql.Field{
+						Type:    graphql.NewNonNull(graphql.ID),
+						Resolve: resolver.ResolveMutationOutputFieldItemID,
+					},
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForRemoveWorkLogMutation(ctx, inputMap, info)
+				},
+			}),
+			"removeAttachment": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "RemoveAttachment",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"attachmentId": &graphql.Field{
+						Type:    graphql.NewNonNull(graphql.ID),
+						Resolve: resolver.ResolveMutationOutputFieldItemID,
+					},
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForRemoveAttachmentMutation(ctx, inputMap, info)
+				},
+			}),
+			"watchIssue": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "WatchIssue",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"issueId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"user":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				OutputFields: graphql.Fields{
+					"watcher": &graphql.Field{
+						Type:    WatcherType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForWatchIssueMutation(ctx, inputMap, info)
+				},
+			}),
+			"watchProject": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "WatchProject",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"projectId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"user":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				OutputFields: graphql.Fields{
+					"watcher": &graphql.Field{
+						Type:    WatcherType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForWatchProjectMutation(ctx, inputMap, info)
+				},
+			}),
+			"unwatch": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "Unwatch",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"watcherId": &graphql.Field{
+						Type:    graphql.NewNonNull(graphql.ID),
+						Resolve: resolver.ResolveMutationOutputFieldItemID,
+					},
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForUnwatchMutation(ctx, inputMap, info)
+				},
+			}),
+			"markNotificationRead": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "MarkNotificationRead",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"read": &graphql.InputObjectFieldConfig{Type: graphql.Boolean, Description: "Defaults to true"},
+				},
+				OutputFields: graphql.Fields{
+					"notification": &graphql.Field{
+						Type:    NotificationType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForMarkNotificationReadMutation(ctx, inputMap, info)
+				},
+			}),
+			"markAllNotificationsRead": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "MarkAllNotificationsRead",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"user": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				OutputFields: graphql.Fields{
+					"count": &graphql.Field{
+						Type:    graphql.Int,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForMarkAllNotificationsReadMutation(ctx, inputMap, info)
+				},
+			}),
+			"setMailPreference": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "SetMailPreference",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"user":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+					"email":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+					"delivery": &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "One of immediate (default), digest or none"},
+				},
+				OutputFields: graphql.Fields{
+					"mailPreference": &graphql.Field{
+						Type:    MailPreferenceType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForSetMailPreferenceMutation(ctx, inputMap, info)
+				},
+			}),
+			"addWebhook": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "AddWebhook",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"projectId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"url":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+					"secret":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String), Description: "Write-only, it cannot be read back"},
+					"events":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String), Description: "All events when not provided"},
+				},
+				OutputFields: graphql.Fields{
+					"webhook": &graphql.Field{
+						Type:    WebhookType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForAddWebhookMutation(ctx, inputMap, info)
+				},
+			}),
+			"updateWebhook": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "UpdateWebhook",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"url":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+					"secret": &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "Write-only, kept when not provided"},
+					"events": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String), Description: "All events when not provided"},
+					"active": &graphql.InputObjectFieldConfig{Type: graphql.Boolean, Description: "Defaults to true"},
+				},
+				OutputFields: graphql.Fields{
+					"webhook": &graphql.Field{
+						Type:    WebhookType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForUpdateWebhookMutation(ctx, inputMap, info)
+				},
+			}),
+			"removeWebhook": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "RemoveWebhook",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"webhookId": &graphql.Field{
+						Type:    graphql.NewNonNull(graphql.ID),
+						Resolve: resolver.ResolveMutationOutputFieldItemID,
+					},
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForRemoveWebhookMutation(ctx, inputMap, info)
+				},
+			}),
+			"redeliverWebhookDelivery": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "RedeliverWebhookDelivery",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"delivery": &graphql.Field{
+						Type:    WebhookDeliveryType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForRedeliverWebhookDeliveryMutation(ctx, inputMap, info)
+				},
+			}),
+			"addAutomationRule": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "AddAutomationRule",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"projectId":  &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"name":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+					"trigger":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+					"conditions": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String), Description: "name:value pairs, rule matches every trigger event when not provided"},
+					"actions":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.String)), Description: "name:value pairs"},
+				},
+				OutputFields: graphql.Fields{
+					"automationRule": &graphql.Field{
+						Type:    AutomationRuleType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForAddAutomationRuleMutation(ctx, inputMap, info)
+				},
+			}),
+			"updateAutomationRule": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "UpdateAutomationRule",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"name":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+					"trigger":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+					"conditions": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String), Description: "name:value pairs, rule matches every trigger event when not provided"},
+					"actions":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.String)), Description: "name:value pairs"},
+					"active":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean, Description: "Defaults to true"},
+				},
+				OutputFields: graphql.Fields{
+					"automationRule": &graphql.Field{
+						Type:    AutomationRuleType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForUpdateAutomationRuleMutation(ctx, inputMap, info)
+				},
+			}),
+			"removeAutomationRule": relay.MutationWithClientMutationID(relay.MutationConfig{
+				Name: "RemoveAutomationRule",
+				InputFields: graphql.InputObjectConfigFieldMap{
+					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				OutputFields: graphql.Fields{
+					"automationRuleId": &graphql.Field{
+						Type:    graphql.NewNonNull(graphql.ID),
+						Resolve: resolver.ResolveMutationOutputFieldItemID,
+					},
+					"status": &graphql.Field{
+						Type:    graphql.Boolean,
+						Resolve: resolver.ResolveMutationOutputFieldStatus,
+					},
+				},
+				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
+					return resolver.MutateAndGetPayloadForRemoveAutomationRuleMutation(ctx, inputMap, info)
+				},
+			}),
 			"removeProject": relay.MutationWithClientMutationID(relay.MutationConfig{
 				Name: "RemoveProject",
 				InputFields: graphql.InputObjectConfigFieldMap{
-					"id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"id":              &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"mode":            &graphql.InputObjectFieldConfig{Type: ProjectRemovalModeType},
+					"targetProjectId": &graphql.InputObjectFieldConfig{Type: graphql.ID},
 				},
 				OutputFields: graphql.Fields{
 					"projectId": &graphql.Field{
@@ -167,6 +781,10 @@ func GetMutation(resolver Resolver) *graphql.Object {
 						Type:    graphql.Boolean,
 						Resolve: resolver.ResolveMutationOutputFieldStatus,
 					},
+					"removal": &graphql.Field{
+						Type:    ProjectRemovalType,
+						Resolve: resolver.ResolveMutationOutputFieldItem,
+					},
 				},
 				MutateAndGetPayload: func(inputMap map[string]interface{}, info graphql.ResolveInfo, ctx context.Context) (map[string]interface{}, error) {
 					return resolver.MutateAndGetPayloadForRemoveProjectMutation(ctx, inputMap, info)
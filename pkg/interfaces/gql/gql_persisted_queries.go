@@ -0,0 +1,188 @@
+package gql
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"go-issue-tracker/pkg/domain"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// MaxPersistedQueries is max. number of queries registered by clients, least recently used query is evicted when
+// it is exceeded, allow-listed queries are not counted nor evicted
+var MaxPersistedQueries = 1000
+
+// Codes of persisted query errors, Apollo clients send query with hash when hash is not found
+const (
+	ErrorCodePersistedQueryNotFound     = "PERSISTED_QUERY_NOT_FOUND"
+	ErrorCodePersistedQueryNotSupported = "PERSISTED_QUERY_NOT_SUPPORTED"
+)
+
+// PersistedQuery is extension of request with SHA-256 hash of query
+type PersistedQuery struct {
+	Version    int    `json:"version"`
+	SHA256Hash string `json:"sha256Hash"`
+}
+
+// RequestExtensions are extensions of request
+type RequestExtensions struct {
+	PersistedQuery *PersistedQuery `json:"persistedQuery,omitempty"`
+}
+
+// PersistedQueryRegistry is registry of query documents keyed by SHA-256 hash, queries loaded from directory
+// are allow-listed and clients register other queries by automatic persisted queries unless registry is strict
+type PersistedQueryRegistry struct {
+	mutex      sync.Mutex
+	strict     bool
+	allowed    map[string]string
+	registered map[string]*list.Element
+	recent     *list.List
+}
+
+// registeredQuery is query registered by client, elements of recent list are ordered from most recently used
+type registeredQuery struct {
+	hash  string
+	query string
+}
+
+// NewPersistedQueryRegistry to create empty registry, strict registry rejects queries which are not allow-listed
+func NewPersistedQueryRegistry(strict bool) *PersistedQueryRegistry {
+	return &PersistedQueryRegistry{
+		strict:     strict,
+		allowed:    make(map[string]string),
+		registered: make(map[string]*list.Element),
+		recent:     list.New(),
+	}
+}
+
+// GetQueryHash to get SHA-256 hash of query as hex string
+func GetQueryHash(query string) string {
+	hash := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(hash[:])
+}
+
+// Add to allow-list query and get its hash
+func (r *PersistedQueryRegistry) Add(query string) string {
+	hash := GetQueryHash(query)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.allowed[hash] = query
+	if element, ok := r.registered[hash]; ok {
+		r.recent.Remove(element)
+		delete(r.registered, hash)
+	}
+	return hash
+}
+
+// Get to get query by hash, registered query is marked as recently used
+func (r *PersistedQueryRegistry) Get(hash string) (string, bool) {
+	hash = strings.ToLower(hash)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if query, ok := r.allowed[hash]; ok {
+		return query, true
+	}
+	if element, ok := r.registered[hash]; ok {
+		r.recent.MoveToFront(element)
+		return element.Value.(registeredQuery).query, true
+	}
+	return "", false
+}
+
+// LoadDir to allow-list queries of .graphql and .gql files of directory and its subdirectories, hash is computed
+// from content of file, so it must be same as query sent by client
+func (r *PersistedQueryRegistry) LoadDir(path string) (int, error) {
+	count := 0
+	err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		extension := filepath.Ext(filePath)
+		if info.IsDir() || (extension != ".graphql" && extension != ".gql") {
+			return nil
+		}
+		content, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		r.Add(string(content))
+		count++
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// register to register query sent by client with its hash, least recently used query is evicted when registry
+// is full
+func (r *PersistedQueryRegistry) register(hash string, query string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.allowed[hash]; ok || MaxPersistedQueries <= 0 {
+		return
+	}
+	if element, ok := r.registered[hash]; ok {
+		r.recent.MoveToFront(element)
+		return
+	}
+	r.registered[hash] = r.recent.PushFront(registeredQuery{hash: hash, query: query})
+	for r.recent.Len() > MaxPersistedQueries {
+		element := r.recent.Back()
+		r.recent.Remove(element)
+		delete(r.registered, element.Value.(registeredQuery).hash)
+	}
+}
+
+// isRegistered to check whether query of request is registered or allow-listed
+func (r *PersistedQueryRegistry) isRegistered(request Request) bool {
+	persisted := request.Extensions.PersistedQuery
+	if persisted == nil {
+		return true
+	}
+	_, ok := r.Get(persisted.SHA256Hash)
+	return ok
+}
+
+// Resolve to get request with query of persisted query hash, query sent with hash is verified, it is registered
+// by request manager after it is parsed and validated, strict registry rejects queries which are not allow-listed
+func (r *PersistedQueryRegistry) Resolve(request Request) (Request, error) {
+	persisted := request.Extensions.PersistedQuery
+	if persisted == nil {
+		if r.strict && request.Query != "" {
+			if _, ok := r.Get(GetQueryHash(request.Query)); !ok {
+				return request, domain.NewForbiddenError("query not allowed")
+			}
+		}
+		return request, nil
+	}
+
+	if persisted.Version != 1 {
+		return request, &domain.Error{Code: ErrorCodePersistedQueryNotSupported, Message: "PersistedQueryNotSupported"}
+	}
+	hash := strings.ToLower(persisted.SHA256Hash)
+	if request.Query == "" {
+		query, ok := r.Get(hash)
+		if !ok {
+			return request, &domain.Error{Code: ErrorCodePersistedQueryNotFound, Message: "PersistedQueryNotFound"}
+		}
+		request.Query = query
+		return request, nil
+	}
+
+	if GetQueryHash(request.Query) != hash {
+		return request, domain.NewValidationError("provided sha does not match query")
+	}
+	if _, ok := r.Get(hash); ok {
+		return request, nil
+	}
+	if r.strict {
+		return request, domain.NewForbiddenError("query not allowed")
+	}
+	return request, nil
+}
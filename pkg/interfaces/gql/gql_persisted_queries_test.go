@@ -0,0 +1,194 @@
+package gql_test
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/interfaces/gql"
+	ucTesting "go-issue-tracker/pkg/usecases/testing"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func preparePersistedQueryRequestManager(strict bool) (*gql.PersistedQueryRegistry, gql.RequestManager) {
+	schema := gql.PrepareGraphQL(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
+	queries := gql.NewPersistedQueryRegistry(strict)
+	return queries, gql.NewRequestManager(schema, new(ucTesting.StreamUseCaseMock), queries)
+}
+
+func TestGetQueryHash(t *testing.T) {
+	assert.Equal(t, "7f56e67dd21ab3f30d1ff8b7bed08893f0a0db86449836189b361dd1e56ddb4b", gql.GetQueryHash("{ __typename }"))
+}
+
+func TestPersistedQueryRegistryLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "issues"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "typename.graphql"), []byte("{ __typename }"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "issues", "issues.gql"), []byte("{ allIssues { id } }"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("Queries"), 0644))
+
+	queries := gql.NewPersistedQueryRegistry(false)
+	count, err := queries.LoadDir(dir)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	query, ok := queries.Get(gql.GetQueryHash("{ allIssues { id } }"))
+
+	assert.True(t, ok)
+	assert.Equal(t, "{ allIssues { id } }", query)
+
+	_, ok = queries.Get(gql.GetQueryHash("Queries"))
+
+	assert.False(t, ok)
+
+	_, err = queries.LoadDir(filepath.Join(dir, "missing"))
+
+	assert.NotNil(t, err)
+}
+
+func TestHandlerAutomaticPersistedQuery(t *testing.T) {
+	_, gqlm := preparePersistedQueryRequestManager(false)
+
+	hash := gql.GetQueryHash("{ __typename }")
+	hashOnly := fmt.Sprintf(`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, hash)
+
+	c, rec := prepareHTTP(echo.POST, "/graphql", strings.NewReader(hashOnly))
+
+	err := gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.JSONEq(t, `{"errors":[{"message":"PersistedQueryNotFound","locations":[],"extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}]}`, rec.Body.String())
+
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(fmt.Sprintf(`{"query":"{ __typename }","extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, hash)))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"data":{"__typename":"Query"}`)
+
+	query := url.Values{}
+	query.Set("extensions", fmt.Sprintf(`{"persistedQuery":{"version":1,"sha256Hash":"%s"}}`, hash))
+	c, rec = prepareHTTP(echo.GET, "/graphql?"+query.Encode(), strings.NewReader(""))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"data":{"__typename":"Query"}`)
+
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(fmt.Sprintf(`{"query":"{ allIssues { id } }","extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, hash)))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"message":"provided sha does not match query"`)
+
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(fmt.Sprintf(`{"extensions":{"persistedQuery":{"version":2,"sha256Hash":"%s"}}}`, hash)))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"PERSISTED_QUERY_NOT_SUPPORTED"`)
+}
+
+func TestHandlerStrictPersistedQuery(t *testing.T) {
+	queries, gqlm := preparePersistedQueryRequestManager(true)
+
+	hash := queries.Add("{ __typename }")
+
+	c, rec := prepareHTTP(echo.POST, "/graphql", strings.NewReader(fmt.Sprintf(`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, hash)))
+
+	err := gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"data":{"__typename":"Query"}`)
+
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(`{"query":"{ __typename }"}`))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(`{"query":"{ allIssues { id } }"}`))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 403, rec.Code)
+	assert.JSONEq(t, `{"errors":[{"message":"query not allowed","locations":[],"extensions":{"code":"FORBIDDEN"}}]}`, rec.Body.String())
+
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(fmt.Sprintf(`{"query":"{ allIssues { id } }","extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, gql.GetQueryHash("{ allIssues { id } }"))))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 403, rec.Code)
+
+	_, ok := queries.Get(gql.GetQueryHash("{ allIssues { id } }"))
+
+	assert.False(t, ok)
+}
+
+func requestPersistedQuery(t *testing.T, gqlm gql.RequestManager, query string) int {
+	c, rec := prepareHTTP(echo.POST, "/graphql", strings.NewReader(fmt.Sprintf(`{"query":"%s","extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, query, gql.GetQueryHash(query))))
+
+	err := gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	return rec.Code
+}
+
+func TestHandlerPersistedQueryNotValid(t *testing.T) {
+	queries, gqlm := preparePersistedQueryRequestManager(false)
+
+	for _, query := range []string{"{ __typename", "{ unknown }"} {
+		assert.Equal(t, 400, requestPersistedQuery(t, gqlm, query))
+
+		_, ok := queries.Get(gql.GetQueryHash(query))
+
+		assert.False(t, ok)
+	}
+}
+
+func TestHandlerPersistedQueryEviction(t *testing.T) {
+	queries, gqlm := preparePersistedQueryRequestManager(false)
+
+	maxPersistedQueries := gql.MaxPersistedQueries
+	defer func() {
+		gql.MaxPersistedQueries = maxPersistedQueries
+	}()
+	gql.MaxPersistedQueries = 2
+
+	allowed := queries.Add("{ __typename }")
+	first, second, third := "{ a: __typename }", "{ b: __typename }", "{ c: __typename }"
+
+	assert.Equal(t, 200, requestPersistedQuery(t, gqlm, first))
+	assert.Equal(t, 200, requestPersistedQuery(t, gqlm, second))
+
+	// First query is used, so second query is least recently used
+	_, ok := queries.Get(gql.GetQueryHash(first))
+
+	assert.True(t, ok)
+	assert.Equal(t, 200, requestPersistedQuery(t, gqlm, third))
+
+	for query, registered := range map[string]bool{first: true, second: false, third: true} {
+		_, ok = queries.Get(gql.GetQueryHash(query))
+
+		assert.Equal(t, registered, ok, query)
+	}
+
+	_, ok = queries.Get(allowed)
+
+	assert.True(t, ok)
+}
@@ -24,6 +24,14 @@ func GetQuery(resolver Resolver) *graphql.Object {
 					"title":     &graphql.ArgumentConfig{Type: graphql.String},
 					"projectId": &graphql.ArgumentConfig{Type: graphql.String},
 					"labels":    &graphql.ArgumentConfig{Type: graphql.String},
+					"customFields": &graphql.ArgumentConfig{
+						Type:        graphql.NewList(graphql.String),
+						Description: "Custom Field values of Project as name:value pairs",
+					},
+					"minPriority":  &graphql.ArgumentConfig{Type: IssuePriorityType},
+					"minSeverity":  &graphql.ArgumentConfig{Type: IssueSeverityType},
+					"minSlaStatus": &graphql.ArgumentConfig{Type: IssueSLAStatusType},
+					"sort":         &graphql.ArgumentConfig{Type: IssueSortType},
 				},
 				Resolve: resolver.ResolveFindIssuesQuery,
 			},
@@ -42,9 +50,10 @@ func GetQuery(resolver Resolver) *graphql.Object {
 			},
 			"labels": &graphql.Field{
 				Type:        graphql.NewList(LabelType),
-				Description: "Find Labels",
+				Description: "Find Labels, global and Project Labels when projectId is provided",
 				Args: graphql.FieldConfigArgument{
-					"name": &graphql.ArgumentConfig{Type: graphql.String},
+					"name":      &graphql.ArgumentConfig{Type: graphql.String},
+					"projectId": &graphql.ArgumentConfig{Type: graphql.ID},
 				},
 				Resolve: resolver.ResolveFindLabelsQuery,
 			},
@@ -53,6 +62,28 @@ func GetQuery(resolver Resolver) *graphql.Object {
 				Description: "Find All Labels",
 				Resolve:     resolver.ResolveFindAllLabelsQuery,
 			},
+			"labelMergePreview": &graphql.Field{
+				Type:        LabelMergeType,
+				Description: "Preview Issues affected by merging source Label into target Label",
+				Args: graphql.FieldConfigArgument{
+					"sourceId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"targetId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveLabelMergePreviewQuery,
+			},
+			"labelGroup": &graphql.Field{
+				Type:        LabelGroupType,
+				Description: "Find Label Group by ID",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindLabelGroupByIDQuery,
+			},
+			"labelGroups": &graphql.Field{
+				Type:        graphql.NewList(LabelGroupType),
+				Description: "Find All Label Groups",
+				Resolve:     resolver.ResolveFindAllLabelGroupsQuery,
+			},
 			"project": &graphql.Field{
 				Type:        ProjectType,
 				Description: "Find Project by ID",
@@ -71,9 +102,115 @@ func GetQuery(resolver Resolver) *graphql.Object {
 			},
 			"allProjects": &graphql.Field{
 				Type:        graphql.NewList(ProjectType),
-				Description: "Find All Projects",
+				Description: "Find All Projects which are not archived",
 				Resolve:     resolver.ResolveFindAllProjectsQuery,
 			},
+			"archivedProjects": &graphql.Field{
+				Type:        graphql.NewList(ProjectType),
+				Description: "Find archived Projects",
+				Resolve:     resolver.ResolveFindArchivedProjectsQuery,
+			},
+			"issueTemplate": &graphql.Field{
+				Type:        IssueTemplateType,
+				Description: "Find Issue Template by ID",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindIssueTemplateByIDQuery,
+			},
+			"issueTemplates": &graphql.Field{
+				Type:        graphql.NewList(IssueTemplateType),
+				Description: "Find Issue Templates of Project",
+				Args: graphql.FieldConfigArgument{
+					"projectId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindIssueTemplatesQuery,
+			},
+			"customField": &graphql.Field{
+				Type:        CustomFieldType,
+				Description: "Find Custom Field by ID",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindCustomFieldByIDQuery,
+			},
+			"customFields": &graphql.Field{
+				Type:        graphql.NewList(CustomFieldType),
+				Description: "Find Custom Fields of Project",
+				Args: graphql.FieldConfigArgument{
+					"projectId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindCustomFieldsQuery,
+			},
+			"timesheet": &graphql.Field{
+				Type:        graphql.NewList(TimesheetEntryType),
+				Description: "Sum time logged per author and ISO week, last 28 days are included when time range is not provided",
+				Args: graphql.FieldConfigArgument{
+					"projectId": &graphql.ArgumentConfig{Type: graphql.ID},
+					"from":      &graphql.ArgumentConfig{Type: graphql.DateTime},
+					"to":        &graphql.ArgumentConfig{Type: graphql.DateTime},
+				},
+				Resolve: resolver.ResolveTimesheetQuery,
+			},
+			"watchers": &graphql.Field{
+				Type:        graphql.NewList(WatcherType),
+				Description: "Find Watchers by user, Issue or Project",
+				Args: graphql.FieldConfigArgument{
+					"user":      &graphql.ArgumentConfig{Type: graphql.String},
+					"issueId":   &graphql.ArgumentConfig{Type: graphql.ID},
+					"projectId": &graphql.ArgumentConfig{Type: graphql.ID},
+				},
+				Resolve: resolver.ResolveFindWatchersQuery,
+			},
+			"notifications": &graphql.Field{
+				Type:        graphql.NewList(NotificationType),
+				Description: "Find Notifications of user, newest first",
+				Args: graphql.FieldConfigArgument{
+					"user":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"unread": &graphql.ArgumentConfig{Type: graphql.Boolean, Description: "Only unread Notifications"},
+				},
+				Resolve: resolver.ResolveFindNotificationsQuery,
+			},
+			"mailPreference": &graphql.Field{
+				Type:        MailPreferenceType,
+				Description: "Find MailPreference of user, null when user has no preference",
+				Args: graphql.FieldConfigArgument{
+					"user": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: resolver.ResolveFindMailPreferenceQuery,
+			},
+			"webhooks": &graphql.Field{
+				Type:        graphql.NewList(WebhookType),
+				Description: "Find Webhooks of Project",
+				Args: graphql.FieldConfigArgument{
+					"projectId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindWebhooksQuery,
+			},
+			"webhookDeliveries": &graphql.Field{
+				Type:        graphql.NewList(WebhookDeliveryType),
+				Description: "Find latest Deliveries of Webhook, newest first",
+				Args: graphql.FieldConfigArgument{
+					"webhookId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindWebhookDeliveriesQuery,
+			},
+			"automationRules": &graphql.Field{
+				Type:        graphql.NewList(AutomationRuleType),
+				Description: "Find AutomationRules of Project",
+				Args: graphql.FieldConfigArgument{
+					"projectId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindAutomationRulesQuery,
+			},
+			"automationExecutions": &graphql.Field{
+				Type:        graphql.NewList(AutomationExecutionType),
+				Description: "Find latest Executions of AutomationRule, newest first",
+				Args: graphql.FieldConfigArgument{
+					"ruleId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: resolver.ResolveFindAutomationExecutionsQuery,
+			},
 			"node": NodeDefinitions.NodeField,
 		},
 	})
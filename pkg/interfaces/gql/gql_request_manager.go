@@ -3,22 +3,39 @@ package gql
 import (
 	"github.com/graphql-go/graphql"
 	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/usecases"
 )
 
 // RequestManager interface
 type RequestManager interface {
 	Handler(c echo.Context) error
-	Execute(schema graphql.Schema, query string, variableValues map[string]interface{}) *graphql.Result
+	SubscriptionHandler(c echo.Context) error
+	Execute(schema graphql.Schema, request Request) *graphql.Result
+}
+
+// Request is GraphQL request, operation name selects operation of document with several operations
+type Request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+	Extensions    RequestExtensions      `json:"extensions"`
 }
 
 // requestManager contains base tooling
 type requestManager struct {
-	schema graphql.Schema
+	schema  graphql.Schema
+	suc     usecases.StreamUseCase
+	budgets *complexityBudgets
+	queries *PersistedQueryRegistry
 }
 
-// NewRequestManager to init RequestManager
-func NewRequestManager(schema graphql.Schema) RequestManager {
+// NewRequestManager to init RequestManager, subscriptions get domain events from stream use case, budgets
+// of clients are kept in memory of process and queries are resolved by persisted query registry
+func NewRequestManager(schema graphql.Schema, suc usecases.StreamUseCase, queries *PersistedQueryRegistry) RequestManager {
 	return &requestManager{
-		schema: schema,
+		schema:  schema,
+		suc:     suc,
+		budgets: newComplexityBudgets(),
+		queries: queries,
 	}
 }
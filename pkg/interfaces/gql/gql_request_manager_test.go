@@ -4,13 +4,14 @@ import (
 	"github.com/graphql-go/graphql"
 	"github.com/stretchr/testify/assert"
 	"go-issue-tracker/pkg/interfaces/gql"
+	ucTesting "go-issue-tracker/pkg/usecases/testing"
 	"testing"
 )
 
 func TestNewRequestManager(t *testing.T) {
 	schema, _ := graphql.NewSchema(graphql.SchemaConfig{})
 
-	m := gql.NewRequestManager(schema)
+	m := gql.NewRequestManager(schema, new(ucTesting.StreamUseCaseMock), gql.NewPersistedQueryRegistry(false))
 
 	assert.NotNil(t, m)
 }
@@ -2,7 +2,6 @@ package gql
 
 import (
 	"errors"
-	"fmt"
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/relay"
 	"go-issue-tracker/pkg/domain"
@@ -10,6 +9,7 @@ import (
 	"golang.org/x/net/context"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Resolver interface
@@ -17,27 +17,85 @@ type Resolver interface {
 	ResolveNodeID(context context.Context, id string, info graphql.ResolveInfo) (interface{}, error)
 	ResolveType(p graphql.ResolveTypeParams) *graphql.Object
 	ResolveFieldLabels(p graphql.ResolveParams) (interface{}, error)
+	ResolveFieldProjectLabels(p graphql.ResolveParams) (interface{}, error)
+	ResolveFieldProjectTemplates(p graphql.ResolveParams) (interface{}, error)
+	ResolveFieldProjectCustomFields(p graphql.ResolveParams) (interface{}, error)
+	ResolveFieldProjectTimeSummary(p graphql.ResolveParams) (interface{}, error)
+	ResolveFieldIssueWorkLogs(p graphql.ResolveParams) (interface{}, error)
+	ResolveFieldIssueAttachments(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindIssueByIDQuery(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindIssuesQuery(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindAllIssuesQuery(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindLabelByIDQuery(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindLabelsQuery(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindAllLabelsQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveLabelMergePreviewQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindLabelGroupByIDQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindAllLabelGroupsQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindIssueTemplateByIDQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindIssueTemplatesQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindCustomFieldByIDQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindCustomFieldsQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveTimesheetQuery(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindProjectByIDQuery(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindProjectsQuery(p graphql.ResolveParams) (interface{}, error)
 	ResolveFindAllProjectsQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindArchivedProjectsQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindWatchersQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindNotificationsQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindMailPreferenceQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindWebhooksQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindWebhookDeliveriesQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindAutomationRulesQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveFindAutomationExecutionsQuery(p graphql.ResolveParams) (interface{}, error)
+	ResolveIssueAddedSubscription(p graphql.ResolveParams) (interface{}, error)
+	ResolveIssueUpdatedSubscription(p graphql.ResolveParams) (interface{}, error)
+	ResolveIssueRemovedSubscription(p graphql.ResolveParams) (interface{}, error)
 	ResolveMutationOutputFieldItem(p graphql.ResolveParams) (interface{}, error)
 	ResolveMutationOutputFieldItemID(p graphql.ResolveParams) (interface{}, error)
 	ResolveMutationOutputFieldStatus(p graphql.ResolveParams) (interface{}, error)
+	ResolveMutationOutputFieldItems(p graphql.ResolveParams) (interface{}, error)
 	MutateAndGetPayloadForAddIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 	MutateAndGetPayloadForUpdateIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 	MutateAndGetPayloadForRemoveIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForBulkUpdateIssuesMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForMoveIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 	MutateAndGetPayloadForAddProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 	MutateAndGetPayloadForUpdateProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForUpdateProjectSettingsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 	MutateAndGetPayloadForRemoveProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForAddIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForUpdateIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForRemoveIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForAddCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForUpdateCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForRemoveCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForSetIssueCustomFieldsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForSetIssueEstimatesMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForAddWorkLogMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForRemoveWorkLogMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForRemoveAttachmentMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForWatchIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForWatchProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForUnwatchMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForMarkNotificationReadMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForMarkAllNotificationsReadMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForSetMailPreferenceMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForAddWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForUpdateWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForRemoveWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForRedeliverWebhookDeliveryMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForAddAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForUpdateAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForRemoveAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 	MutateAndGetPayloadForAddLabelMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 	MutateAndGetPayloadForUpdateLabelMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 	MutateAndGetPayloadForRemoveLabelMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForMergeLabelsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForSetLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForAddLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForUpdateLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
+	MutateAndGetPayloadForRemoveLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error)
 }
 
 // resolver contains base tooling like GraphQL use case etc.
@@ -46,15 +104,23 @@ type resolver struct {
 	luc usecases.LabelUseCase
 	puc usecases.ProjectUseCase
 	cuc usecases.ColorUseCase
+	nuc usecases.NotificationUseCase
+	muc usecases.MailUseCase
+	wuc usecases.WebhookUseCase
+	auc usecases.AutomationUseCase
 }
 
 // GetResolver to init Resolver
-func GetResolver(iuc usecases.IssueUseCase, luc usecases.LabelUseCase, puc usecases.ProjectUseCase, cuc usecases.ColorUseCase) Resolver {
+func GetResolver(iuc usecases.IssueUseCase, luc usecases.LabelUseCase, puc usecases.ProjectUseCase, cuc usecases.ColorUseCase, nuc usecases.NotificationUseCase, muc usecases.MailUseCase, wuc usecases.WebhookUseCase, auc usecases.AutomationUseCase) Resolver {
 	return &resolver{
 		iuc: iuc,
 		luc: luc,
 		puc: puc,
 		cuc: cuc,
+		nuc: nuc,
+		muc: muc,
+		wuc: wuc,
+		auc: auc,
 	}
 }
 
@@ -62,19 +128,39 @@ func GetResolver(iuc usecases.IssueUseCase, luc usecases.LabelUseCase, puc useca
 func (r *resolver) ResolveNodeID(context context.Context, id string, info graphql.ResolveInfo) (interface{}, error) {
 	resolvedID := relay.FromGlobalID(id)
 	if resolvedID == nil {
-		return nil, errors.New("provided id not valid")
+		return nil, domain.NewValidationError("provided id not valid")
 	}
 	intID, err := strconv.Atoi(resolvedID.ID)
 	if err != nil {
-		return nil, err
+		return nil, domain.NewValidationError("provided id not valid")
 	}
 
 	if resolvedID.Type == "Issue" {
 		return r.iuc.FindByID(uint(intID))
 	} else if resolvedID.Type == "Label" {
 		return r.luc.FindByID(uint(intID))
+	} else if resolvedID.Type == "LabelGroup" {
+		return r.luc.FindGroupByID(uint(intID))
 	} else if resolvedID.Type == "Project" {
 		return r.puc.FindByID(uint(intID))
+	} else if resolvedID.Type == "IssueTemplate" {
+		return r.puc.FindTemplateByID(uint(intID))
+	} else if resolvedID.Type == "CustomField" {
+		return r.puc.FindCustomFieldByID(uint(intID))
+	} else if resolvedID.Type == "WorkLog" {
+		return r.iuc.FindWorkLogByID(uint(intID))
+	} else if resolvedID.Type == "Attachment" {
+		return r.iuc.FindAttachmentByID(uint(intID))
+	} else if resolvedID.Type == "Watcher" {
+		return r.nuc.FindWatcherByID(uint(intID))
+	} else if resolvedID.Type == "Notification" {
+		return r.nuc.FindNotificationByID(uint(intID))
+	} else if resolvedID.Type == "Webhook" {
+		return r.wuc.FindByID(uint(intID))
+	} else if resolvedID.Type == "WebhookDelivery" {
+		return r.wuc.FindDeliveryByID(uint(intID))
+	} else if resolvedID.Type == "AutomationRule" {
+		return r.auc.FindByID(uint(intID))
 	}
 
 	return nil, errors.New("unknown type")
@@ -91,10 +177,50 @@ func (r *resolver) ResolveType(p graphql.ResolveTypeParams) *graphql.Object {
 		return LabelType
 	case *domain.Label:
 		return LabelType
+	case domain.LabelGroup:
+		return LabelGroupType
+	case *domain.LabelGroup:
+		return LabelGroupType
 	case domain.Project:
 		return ProjectType
 	case *domain.Project:
 		return ProjectType
+	case domain.IssueTemplate:
+		return IssueTemplateType
+	case *domain.IssueTemplate:
+		return IssueTemplateType
+	case domain.CustomField:
+		return CustomFieldType
+	case *domain.CustomField:
+		return CustomFieldType
+	case domain.WorkLog:
+		return WorkLogType
+	case *domain.WorkLog:
+		return WorkLogType
+	case domain.Attachment:
+		return AttachmentType
+	case *domain.Attachment:
+		return AttachmentType
+	case domain.Watcher:
+		return WatcherType
+	case *domain.Watcher:
+		return WatcherType
+	case domain.Notification:
+		return NotificationType
+	case *domain.Notification:
+		return NotificationType
+	case domain.Webhook:
+		return WebhookType
+	case *domain.Webhook:
+		return WebhookType
+	case domain.WebhookDelivery:
+		return WebhookDeliveryType
+	case *domain.WebhookDelivery:
+		return WebhookDeliveryType
+	case domain.AutomationRule:
+		return AutomationRuleType
+	case *domain.AutomationRule:
+		return AutomationRuleType
 	}
 	return nil
 }
@@ -109,7 +235,7 @@ func (r *resolver) getLabelsConnectionData(labels []domain.Label, args relay.Con
 
 // ResolveFieldLabels to get labels connection
 func (r *resolver) ResolveFieldLabels(p graphql.ResolveParams) (interface{}, error) {
-	args := relay.NewConnectionArguments(p.Args)
+	args := getConnectionArguments(p.Args)
 	if source, ok := p.Source.(domain.Issue); ok {
 		return r.getLabelsConnectionData(source.Labels, args)
 	}
@@ -119,6 +245,115 @@ func (r *resolver) ResolveFieldLabels(p graphql.ResolveParams) (interface{}, err
 	return nil, errors.New("no labels found")
 }
 
+// ResolveFieldProjectLabels to get connection of global and project labels
+func (r *resolver) ResolveFieldProjectLabels(p graphql.ResolveParams) (interface{}, error) {
+	args := getConnectionArguments(p.Args)
+	var projectID uint
+	if source, ok := p.Source.(domain.Project); ok {
+		projectID = source.ID
+	} else if source, ok := p.Source.(*domain.Project); ok {
+		projectID = source.ID
+	} else {
+		return nil, errors.New("no labels found")
+	}
+
+	labels, err := r.luc.Find("", projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.getLabelsConnectionData(labels, args)
+}
+
+// ResolveFieldProjectTemplates to get issue templates of project
+func (r *resolver) ResolveFieldProjectTemplates(p graphql.ResolveParams) (interface{}, error) {
+	var projectID uint
+	if source, ok := p.Source.(domain.Project); ok {
+		projectID = source.ID
+	} else if source, ok := p.Source.(*domain.Project); ok {
+		projectID = source.ID
+	} else {
+		return nil, errors.New("no templates found")
+	}
+
+	items, err := r.puc.FindTemplates(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ResolveFieldProjectCustomFields to get custom fields of project
+func (r *resolver) ResolveFieldProjectCustomFields(p graphql.ResolveParams) (interface{}, error) {
+	var projectID uint
+	if source, ok := p.Source.(domain.Project); ok {
+		projectID = source.ID
+	} else if source, ok := p.Source.(*domain.Project); ok {
+		projectID = source.ID
+	} else {
+		return nil, errors.New("no custom fields found")
+	}
+
+	items, err := r.puc.FindCustomFields(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ResolveFieldProjectTimeSummary to sum estimates and time spent of issues of project
+func (r *resolver) ResolveFieldProjectTimeSummary(p graphql.ResolveParams) (interface{}, error) {
+	var projectID uint
+	if source, ok := p.Source.(domain.Project); ok {
+		projectID = source.ID
+	} else if source, ok := p.Source.(*domain.Project); ok {
+		projectID = source.ID
+	} else {
+		return nil, errors.New("no time summary found")
+	}
+
+	item, err := r.iuc.FindTimeSummary(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// ResolveFieldIssueWorkLogs to get work logs of issue
+func (r *resolver) ResolveFieldIssueWorkLogs(p graphql.ResolveParams) (interface{}, error) {
+	var issueID uint
+	if source, ok := p.Source.(domain.Issue); ok {
+		issueID = source.ID
+	} else if source, ok := p.Source.(*domain.Issue); ok {
+		issueID = source.ID
+	} else {
+		return nil, errors.New("no work logs found")
+	}
+
+	items, err := r.iuc.FindWorkLogs(issueID)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ResolveFieldIssueAttachments to get attachments of issue
+func (r *resolver) ResolveFieldIssueAttachments(p graphql.ResolveParams) (interface{}, error) {
+	var issueID uint
+	if source, ok := p.Source.(domain.Issue); ok {
+		issueID = source.ID
+	} else if source, ok := p.Source.(*domain.Issue); ok {
+		issueID = source.ID
+	} else {
+		return nil, errors.New("no attachments found")
+	}
+
+	items, err := r.iuc.FindAttachments(issueID)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 // ResolveMutationOutputFieldItem to get item for output field
 func (r *resolver) ResolveMutationOutputFieldItem(p graphql.ResolveParams) (interface{}, error) {
 	if source, ok := p.Source.(map[string]interface{}); ok {
@@ -143,18 +378,105 @@ func (r *resolver) ResolveMutationOutputFieldStatus(p graphql.ResolveParams) (in
 	return nil, errors.New("status not resolved")
 }
 
+// ResolveMutationOutputFieldItems to get items for output field
+func (r *resolver) ResolveMutationOutputFieldItems(p graphql.ResolveParams) (interface{}, error) {
+	if source, ok := p.Source.(map[string]interface{}); ok {
+		return source["items"], nil
+	}
+	return nil, errors.New("items not resolved")
+}
+
+// fromGlobalID to get numeric ID from global ID
+func (r *resolver) fromGlobalID(id string) (uint, error) {
+	resolvedID := relay.FromGlobalID(id)
+	if resolvedID == nil {
+		return uint(0), domain.NewValidationError("provided id not valid")
+	}
+	intID, err := strconv.Atoi(resolvedID.ID)
+	if err != nil {
+		return uint(0), domain.NewValidationError("provided id not valid")
+	}
+	return uint(intID), nil
+}
+
+// findLabelsByGlobalIDs to find labels by comma separated global IDs
+func (r *resolver) findLabelsByGlobalIDs(values string) ([]domain.Label, error) {
+	labels := []domain.Label{}
+	for _, ls := range strings.Split(strings.Trim(values, " "), ",") {
+		if ls == "" {
+			continue
+		}
+		id, err := r.fromGlobalID(ls)
+		if err != nil {
+			return nil, err
+		}
+		label, err := r.luc.FindByID(id)
+		if err != nil {
+			return nil, domain.NewValidationError("label %s is not valid", ls)
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
+// getCustomFieldValues to get custom field values provided as list of name:value pairs
+func (r *resolver) getCustomFieldValues(pairs interface{}) (map[string]string, error) {
+	values := make(map[string]string)
+	list, _ := pairs.([]interface{})
+	for _, item := range list {
+		pair, _ := item.(string)
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return values, domain.NewValidationError("custom field value %s is not valid", pair)
+		}
+		values[strings.TrimSpace(parts[0])] = parts[1]
+	}
+	return values, nil
+}
+
+// getCustomFieldFilters to get filters of custom fields of project provided as list of name:value pairs
+func (r *resolver) getCustomFieldFilters(pairs interface{}, projectID uint) ([]domain.CustomFieldFilter, error) {
+	filters := []domain.CustomFieldFilter{}
+	values, err := r.getCustomFieldValues(pairs)
+	if err != nil || len(values) == 0 {
+		return filters, err
+	}
+	if projectID == 0 {
+		return filters, domain.NewValidationError("project not provided for custom field filter")
+	}
+	fields, err := r.puc.FindCustomFields(projectID)
+	if err != nil {
+		return filters, err
+	}
+	for name, value := range values {
+		found := false
+		for _, field := range fields {
+			if field.Name == name {
+				filters = append(filters, domain.CustomFieldFilter{Field: field, Value: value})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return filters, domain.NewNotFoundError("custom field %s not found", name)
+		}
+	}
+	return filters, nil
+}
+
+// getLabels to get labels from input data, required labels are validated by project settings
 func (r *resolver) getLabels(inputMap map[string]interface{}) (map[string]domain.Label, error) {
+	labels := make(map[string]domain.Label)
 	labelValues, labelValuesOK := inputMap["labels"].(string)
 	if !labelValuesOK || labelValues == "" {
-		return nil, errors.New("labels not provided")
+		return labels, nil
 	}
 	labelStrings := strings.Split(strings.Trim(labelValues, " "), ",")
-	labels := make(map[string]domain.Label)
 	for _, ls := range labelStrings {
 		if labels[ls].ID == 0 && ls != "" {
 			lID := relay.FromGlobalID(ls)
 			if lID == nil {
-				return nil, errors.New("provided label id not valid")
+				return nil, domain.NewValidationError("provided label id not valid")
 			}
 			lIDInt, err := strconv.Atoi(lID.ID)
 			if err != nil {
@@ -162,20 +484,17 @@ func (r *resolver) getLabels(inputMap map[string]interface{}) (map[string]domain
 			}
 			label, err := r.luc.FindByID(uint(lIDInt))
 			if err != nil {
-				return nil, fmt.Errorf("label %s is not valid", ls)
+				return nil, domain.NewValidationError("label %s is not valid", ls)
 			}
 			labels[ls] = label
 		}
 	}
 
-	if len(labels) == 0 {
-		return nil, errors.New("no labels provided")
-	}
-
 	return labels, nil
 }
 
-// MutateAndGetPayloadForAddIssueMutation func
+// MutateAndGetPayloadForAddIssueMutation func, project default status is used when status is not provided,
+// values of issue template are merged with provided values when templateId is provided
 func (r *resolver) MutateAndGetPayloadForAddIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
 	errResponse := map[string]interface{}{
 		"item": nil,
@@ -183,23 +502,24 @@ func (r *resolver) MutateAndGetPayloadForAddIssueMutation(ctx context.Context, i
 
 	title, titleOK := inputMap["title"].(string)
 	if !titleOK || title == "" {
-		return errResponse, errors.New("title not provided")
-	}
-	description, descriptionOK := inputMap["description"].(string)
-	if !descriptionOK || description == "" {
-		return errResponse, errors.New("description not provided")
+		return errResponse, domain.NewValidationError("title not provided")
 	}
+	description, _ := inputMap["description"].(string)
 	status, statusOK := inputMap["status"].(int)
-	if !statusOK || status == 0 {
-		return errResponse, errors.New("status not provided")
+	if !statusOK {
+		status = domain.IssueStatusDefault
 	}
+	priority, _ := inputMap["priority"].(int)
+	severity, _ := inputMap["severity"].(int)
+	reporter, _ := inputMap["reporter"].(string)
+	assignee, _ := inputMap["assignee"].(string)
 	projectID, projectIDOK := inputMap["projectId"].(string)
 	if !projectIDOK || projectID == "" {
-		return errResponse, errors.New("project id not provided")
+		return errResponse, domain.NewValidationError("project id not provided")
 	}
 	resolvedID := relay.FromGlobalID(projectID)
 	if resolvedID == nil {
-		return errResponse, errors.New("provided project id not valid")
+		return errResponse, domain.NewValidationError("provided project id not valid")
 	}
 	projectIDInt, err := strconv.Atoi(resolvedID.ID)
 	if err != nil {
@@ -207,18 +527,28 @@ func (r *resolver) MutateAndGetPayloadForAddIssueMutation(ctx context.Context, i
 	}
 	project, err := r.puc.FindByID(uint(projectIDInt))
 	if err != nil {
-		return errResponse, errors.New("provided project id not valid")
+		return errResponse, domain.NewValidationError("provided project id not valid")
 	}
 	labels, err := r.getLabels(inputMap)
 	if err != nil {
 		return errResponse, err
 	}
 
-	item, err := r.iuc.Add(title, description, status, project, labels)
-	if err != nil {
-		return map[string]interface{}{
-			"item": nil,
-		}, err
+	var item *domain.Issue
+	if templateID, ok := inputMap["templateId"].(string); ok && templateID != "" {
+		id, err := r.fromGlobalID(templateID)
+		if err != nil {
+			return errResponse, err
+		}
+		template, err := r.puc.FindTemplateByID(id)
+		if err != nil {
+			return errResponse, domain.NewValidationError("provided template id not valid")
+		}
+		if item, err = r.iuc.AddFromTemplate(title, description, status, priority, severity, reporter, assignee, project, labels, template); err != nil {
+			return errResponse, err
+		}
+	} else if item, err = r.iuc.Add(title, description, status, priority, severity, reporter, assignee, project, labels); err != nil {
+		return errResponse, err
 	}
 
 	return map[string]interface{}{
@@ -229,11 +559,11 @@ func (r *resolver) MutateAndGetPayloadForAddIssueMutation(ctx context.Context, i
 func (r *resolver) getIDFromMutationData(inputMap map[string]interface{}) (uint, error) {
 	id, idOK := inputMap["id"].(string)
 	if !idOK {
-		return uint(0), errors.New("id not provided")
+		return uint(0), domain.NewValidationError("id not provided")
 	}
 	resolvedID := relay.FromGlobalID(id)
 	if resolvedID == nil {
-		return uint(0), errors.New("provided id not valid")
+		return uint(0), domain.NewValidationError("provided id not valid")
 	}
 	intID, err := strconv.Atoi(resolvedID.ID)
 	if err != nil {
@@ -242,7 +572,8 @@ func (r *resolver) getIDFromMutationData(inputMap map[string]interface{}) (uint,
 	return uint(intID), nil
 }
 
-// MutateAndGetPayloadForUpdateIssueMutation func
+// MutateAndGetPayloadForUpdateIssueMutation func, priority, severity, reporter and assignee are kept when
+// not provided
 func (r *resolver) MutateAndGetPayloadForUpdateIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
 	errResponse := map[string]interface{}{
 		"item": nil,
@@ -254,22 +585,41 @@ func (r *resolver) MutateAndGetPayloadForUpdateIssueMutation(ctx context.Context
 	}
 	title, titleOK := inputMap["title"].(string)
 	if !titleOK || title == "" {
-		return errResponse, errors.New("title not provided")
-	}
-	description, descriptionOK := inputMap["description"].(string)
-	if !descriptionOK || description == "" {
-		return errResponse, errors.New("description not provided")
+		return errResponse, domain.NewValidationError("title not provided")
 	}
+	description, _ := inputMap["description"].(string)
 	status, statusOK := inputMap["status"].(int)
 	if !statusOK || status == 0 {
-		return errResponse, errors.New("status not provided")
+		return errResponse, domain.NewValidationError("status not provided")
 	}
 	labels, err := r.getLabels(inputMap)
 	if err != nil {
 		return errResponse, err
 	}
+	priority, priorityOK := inputMap["priority"].(int)
+	severity, severityOK := inputMap["severity"].(int)
+	reporter, reporterOK := inputMap["reporter"].(string)
+	assignee, assigneeOK := inputMap["assignee"].(string)
+	if !priorityOK || !severityOK || !reporterOK || !assigneeOK {
+		issue, err := r.iuc.FindByID(id)
+		if err != nil {
+			return errResponse, domain.NewNotFoundError("issue not found")
+		}
+		if !priorityOK {
+			priority = issue.Priority
+		}
+		if !severityOK {
+			severity = issue.Severity
+		}
+		if !reporterOK {
+			reporter = issue.Reporter
+		}
+		if !assigneeOK {
+			assignee = issue.Assignee
+		}
+	}
 
-	item, err := r.iuc.Update(id, title, description, status, labels)
+	item, err := r.iuc.Update(id, title, description, status, priority, severity, reporter, assignee, labels)
 	if err != nil {
 		return errResponse, err
 	}
@@ -303,6 +653,128 @@ func (r *resolver) MutateAndGetPayloadForRemoveIssueMutation(ctx context.Context
 	}, nil
 }
 
+// MutateAndGetPayloadForBulkUpdateIssuesMutation func
+func (r *resolver) MutateAndGetPayloadForBulkUpdateIssuesMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"status": false,
+		"items":  nil,
+	}
+
+	var err error
+	operation := domain.IssueBulkOperation{}
+	if addLabels, ok := inputMap["addLabels"].(string); ok {
+		if operation.AddLabels, err = r.findLabelsByGlobalIDs(addLabels); err != nil {
+			return errResponse, err
+		}
+	}
+	if removeLabels, ok := inputMap["removeLabels"].(string); ok {
+		if operation.RemoveLabels, err = r.findLabelsByGlobalIDs(removeLabels); err != nil {
+			return errResponse, err
+		}
+	}
+	if status, ok := inputMap["status"].(int); ok {
+		operation.Status = &status
+	}
+	if targetProjectID, ok := inputMap["targetProjectId"].(string); ok && targetProjectID != "" {
+		id, err := r.fromGlobalID(targetProjectID)
+		if err != nil {
+			return errResponse, err
+		}
+		project, err := r.puc.FindByID(id)
+		if err != nil {
+			return errResponse, domain.NewValidationError("provided target project id not valid")
+		}
+		operation.Project = &project
+	}
+
+	var results []domain.IssueBulkResult
+	if idValues, ok := inputMap["ids"].(string); ok && idValues != "" {
+		ids := []uint{}
+		for _, is := range strings.Split(strings.Trim(idValues, " "), ",") {
+			if is == "" {
+				continue
+			}
+			id, err := r.fromGlobalID(is)
+			if err != nil {
+				return errResponse, err
+			}
+			ids = append(ids, id)
+		}
+		results, err = r.iuc.BulkUpdate(ids, operation)
+	} else {
+		title, _ := inputMap["title"].(string)
+		projectID := uint(0)
+		if projectIDValue, ok := inputMap["projectId"].(string); ok && projectIDValue != "" {
+			if projectID, err = r.fromGlobalID(projectIDValue); err != nil {
+				return errResponse, err
+			}
+		}
+		labels := []string{}
+		if labelValues, ok := inputMap["labels"].(string); ok {
+			for _, ls := range strings.Split(strings.Trim(labelValues, " "), ",") {
+				if ls == "" {
+					continue
+				}
+				id, err := r.fromGlobalID(ls)
+				if err != nil {
+					return errResponse, err
+				}
+				labels = append(labels, strconv.Itoa(int(id)))
+			}
+		}
+		var customFields []domain.CustomFieldFilter
+		if customFields, err = r.getCustomFieldFilters(inputMap["customFields"], projectID); err != nil {
+			return errResponse, err
+		}
+		options := domain.IssueFindOptions{}
+		options.MinPriority, _ = inputMap["minPriority"].(int)
+		options.MinSeverity, _ = inputMap["minSeverity"].(int)
+		options.MinSLAStatus, _ = inputMap["minSlaStatus"].(int)
+		if title == "" && projectID == 0 && len(labels) == 0 {
+			return errResponse, domain.NewValidationError("ids or filter not provided")
+		}
+		results, err = r.iuc.BulkUpdateFound(title, projectID, labels, customFields, options, operation)
+	}
+
+	return map[string]interface{}{
+		"status": err == nil,
+		"items":  results,
+	}, nil
+}
+
+// MutateAndGetPayloadForMoveIssueMutation func
+func (r *resolver) MutateAndGetPayloadForMoveIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+	projectID, projectIDOK := inputMap["projectId"].(string)
+	if !projectIDOK || projectID == "" {
+		return errResponse, domain.NewValidationError("project id not provided")
+	}
+	projectIDInt, err := r.fromGlobalID(projectID)
+	if err != nil {
+		return errResponse, err
+	}
+	project, err := r.puc.FindByID(projectIDInt)
+	if err != nil {
+		return errResponse, domain.NewValidationError("provided project id not valid")
+	}
+
+	item, err := r.iuc.Move(id, project)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
 // MutateAndGetPayloadForAddLabelMutation func
 func (r *resolver) MutateAndGetPayloadForAddLabelMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
 	errResponse := map[string]interface{}{
@@ -311,7 +783,7 @@ func (r *resolver) MutateAndGetPayloadForAddLabelMutation(ctx context.Context, i
 
 	name, nameOK := inputMap["name"].(string)
 	if !nameOK || name == "" {
-		return errResponse, errors.New("name not provided")
+		return errResponse, domain.NewValidationError("name not provided")
 	}
 
 	colorHexCode := inputMap["colorHexCode"].(string)
@@ -323,7 +795,20 @@ func (r *resolver) MutateAndGetPayloadForAddLabelMutation(ctx context.Context, i
 		colorHexCode = cl.HexCode
 	}
 
-	item, err := r.luc.Add(name, colorHexCode)
+	projectID := uint(0)
+	if projectIDValue, ok := inputMap["projectId"].(string); ok && projectIDValue != "" {
+		projectIDInt, err := r.fromGlobalID(projectIDValue)
+		if err != nil {
+			return errResponse, err
+		}
+		project, err := r.puc.FindByID(projectIDInt)
+		if err != nil {
+			return errResponse, domain.NewValidationError("provided project id not valid")
+		}
+		projectID = project.ID
+	}
+
+	item, err := r.luc.Add(name, colorHexCode, projectID)
 	if err != nil {
 		return map[string]interface{}{
 			"item": nil,
@@ -348,7 +833,7 @@ func (r *resolver) MutateAndGetPayloadForUpdateLabelMutation(ctx context.Context
 
 	name, nameOK := inputMap["name"].(string)
 	if !nameOK || name == "" {
-		return errResponse, errors.New("name not provided")
+		return errResponse, domain.NewValidationError("name not provided")
 	}
 
 	colorHexCode := inputMap["colorHexCode"].(string)
@@ -396,20 +881,93 @@ func (r *resolver) MutateAndGetPayloadForRemoveLabelMutation(ctx context.Context
 	}, nil
 }
 
-// MutateAndGetPayloadForAddProjectMutation func
-func (r *resolver) MutateAndGetPayloadForAddProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+// getMergeLabelIDs to get source and target label IDs from mutation or query data
+func (r *resolver) getMergeLabelIDs(data map[string]interface{}) (uint, uint, error) {
+	sourceID, sourceIDOK := data["sourceId"].(string)
+	if !sourceIDOK || sourceID == "" {
+		return uint(0), uint(0), domain.NewValidationError("source id not provided")
+	}
+	targetID, targetIDOK := data["targetId"].(string)
+	if !targetIDOK || targetID == "" {
+		return uint(0), uint(0), domain.NewValidationError("target id not provided")
+	}
+
+	source, err := r.fromGlobalID(sourceID)
+	if err != nil {
+		return uint(0), uint(0), err
+	}
+	target, err := r.fromGlobalID(targetID)
+	if err != nil {
+		return uint(0), uint(0), err
+	}
+
+	return source, target, nil
+}
+
+// MutateAndGetPayloadForMergeLabelsMutation func
+func (r *resolver) MutateAndGetPayloadForMergeLabelsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"status": false,
+		"item":   nil,
+	}
+
+	sourceID, targetID, err := r.getMergeLabelIDs(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+
+	item, err := r.luc.Merge(sourceID, targetID)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"status": true,
+		"item":   item,
+	}, nil
+}
+
+// MutateAndGetPayloadForSetLabelGroupMutation func
+func (r *resolver) MutateAndGetPayloadForSetLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+
+	groupID := uint(0)
+	if groupIDValue, ok := inputMap["groupId"].(string); ok && groupIDValue != "" {
+		if groupID, err = r.fromGlobalID(groupIDValue); err != nil {
+			return errResponse, err
+		}
+	}
+
+	item, err := r.luc.SetGroup(id, groupID)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForAddLabelGroupMutation func
+func (r *resolver) MutateAndGetPayloadForAddLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
 	errResponse := map[string]interface{}{
 		"item": nil,
 	}
 
 	name, nameOK := inputMap["name"].(string)
 	if !nameOK || name == "" {
-		return errResponse, errors.New("name not provided")
+		return errResponse, domain.NewValidationError("name not provided")
 	}
+	exclusive, _ := inputMap["exclusive"].(bool)
 
-	description := inputMap["description"].(string)
-
-	item, err := r.puc.Add(name, description)
+	item, err := r.luc.AddGroup(name, exclusive)
 	if err != nil {
 		return errResponse, err
 	}
@@ -419,8 +977,8 @@ func (r *resolver) MutateAndGetPayloadForAddProjectMutation(ctx context.Context,
 	}, nil
 }
 
-// MutateAndGetPayloadForUpdateProjectMutation func
-func (r *resolver) MutateAndGetPayloadForUpdateProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+// MutateAndGetPayloadForUpdateLabelGroupMutation func
+func (r *resolver) MutateAndGetPayloadForUpdateLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
 	errResponse := map[string]interface{}{
 		"item": nil,
 	}
@@ -432,12 +990,11 @@ func (r *resolver) MutateAndGetPayloadForUpdateProjectMutation(ctx context.Conte
 
 	name, nameOK := inputMap["name"].(string)
 	if !nameOK || name == "" {
-		return errResponse, errors.New("name not provided")
+		return errResponse, domain.NewValidationError("name not provided")
 	}
+	exclusive, _ := inputMap["exclusive"].(bool)
 
-	description := inputMap["description"].(string)
-
-	item, err := r.puc.Update(id, name, description)
+	item, err := r.luc.UpdateGroup(id, name, exclusive)
 	if err != nil {
 		return map[string]interface{}{
 			"item": item,
@@ -449,8 +1006,8 @@ func (r *resolver) MutateAndGetPayloadForUpdateProjectMutation(ctx context.Conte
 	}, nil
 }
 
-// MutateAndGetPayloadForRemoveProjectMutation func
-func (r *resolver) MutateAndGetPayloadForRemoveProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+// MutateAndGetPayloadForRemoveLabelGroupMutation func
+func (r *resolver) MutateAndGetPayloadForRemoveLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
 	id, err := r.getIDFromMutationData(inputMap)
 	if err != nil {
 		return map[string]interface{}{
@@ -459,7 +1016,7 @@ func (r *resolver) MutateAndGetPayloadForRemoveProjectMutation(ctx context.Conte
 		}, err
 	}
 
-	status, err := r.puc.Remove(id)
+	status, err := r.luc.RemoveGroup(id)
 	if err != nil {
 		return map[string]interface{}{
 			"id":     id,
@@ -473,22 +1030,834 @@ func (r *resolver) MutateAndGetPayloadForRemoveProjectMutation(ctx context.Conte
 	}, nil
 }
 
-func (r *resolver) getIDFromQueryData(p graphql.ResolveParams) (uint, error) {
-	id, idOK := p.Args["id"].(string)
-	if !idOK {
-		return uint(0), errors.New("id not provided")
+// MutateAndGetPayloadForAddProjectMutation func
+func (r *resolver) MutateAndGetPayloadForAddProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
 	}
 
-	resolvedID := relay.FromGlobalID(id)
-	if resolvedID == nil {
-		return uint(0), errors.New("provided id not valid")
-	}
-	intID, err := strconv.Atoi(resolvedID.ID)
-	if err != nil {
-		return uint(0), err
+	name, nameOK := inputMap["name"].(string)
+	if !nameOK || name == "" {
+		return errResponse, domain.NewValidationError("name not provided")
 	}
 
-	return uint(intID), err
+	description := inputMap["description"].(string)
+
+	item, err := r.puc.Add(name, description)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForUpdateProjectMutation func
+func (r *resolver) MutateAndGetPayloadForUpdateProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+
+	name, nameOK := inputMap["name"].(string)
+	if !nameOK || name == "" {
+		return errResponse, domain.NewValidationError("name not provided")
+	}
+
+	description := inputMap["description"].(string)
+
+	item, err := r.puc.Update(id, name, description)
+	if err != nil {
+		return map[string]interface{}{
+			"item": item,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForUpdateProjectSettingsMutation func
+func (r *resolver) MutateAndGetPayloadForUpdateProjectSettingsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+
+	maxLabels, maxLabelsOK := inputMap["maxLabels"].(int)
+	if !maxLabelsOK {
+		return errResponse, domain.NewValidationError("max labels not provided")
+	}
+	settings := domain.ProjectSettings{MaxLabels: maxLabels}
+	settings.RequiredFields, _ = inputMap["requiredFields"].(string)
+	settings.DefaultStatus, _ = inputMap["defaultStatus"].(int)
+	settings.AllowedStatuses, _ = inputMap["allowedStatuses"].(string)
+	settings.AllowedPriorities, _ = inputMap["allowedPriorities"].(string)
+	settings.AllowedSeverities, _ = inputMap["allowedSeverities"].(string)
+	settings.SLAPolicy, _ = inputMap["slaPolicy"].(string)
+	settings.SLAAtRisk, _ = inputMap["slaAtRisk"].(string)
+	settings.SLAResolvedStatuses, _ = inputMap["slaResolvedStatuses"].(string)
+
+	defaultLabels, _ := inputMap["defaultLabels"].(string)
+	labels, err := r.findLabelsByGlobalIDs(defaultLabels)
+	if err != nil {
+		return errResponse, err
+	}
+
+	item, err := r.puc.UpdateSettings(id, settings, labels)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// getIssueTemplateData to get issue template values and labels from input data
+func (r *resolver) getIssueTemplateData(inputMap map[string]interface{}) (string, string, string, *int, []domain.Label, error) {
+	name, nameOK := inputMap["name"].(string)
+	if !nameOK || name == "" {
+		return "", "", "", nil, nil, domain.NewValidationError("name not provided")
+	}
+	titlePrefix, _ := inputMap["titlePrefix"].(string)
+	description, _ := inputMap["description"].(string)
+	var status *int
+	if value, ok := inputMap["status"].(int); ok {
+		status = &value
+	}
+	labelValues, _ := inputMap["labels"].(string)
+	labels, err := r.findLabelsByGlobalIDs(labelValues)
+	if err != nil {
+		return "", "", "", nil, nil, err
+	}
+	return name, titlePrefix, description, status, labels, nil
+}
+
+// MutateAndGetPayloadForAddIssueTemplateMutation func
+func (r *resolver) MutateAndGetPayloadForAddIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	projectIDValue, projectIDOK := inputMap["projectId"].(string)
+	if !projectIDOK || projectIDValue == "" {
+		return errResponse, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return errResponse, err
+	}
+	name, titlePrefix, description, status, labels, err := r.getIssueTemplateData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+
+	item, err := r.puc.AddTemplate(projectID, name, titlePrefix, description, status, labels)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForUpdateIssueTemplateMutation func
+func (r *resolver) MutateAndGetPayloadForUpdateIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+	name, titlePrefix, description, status, labels, err := r.getIssueTemplateData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+
+	item, err := r.puc.UpdateTemplate(id, name, titlePrefix, description, status, labels)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForRemoveIssueTemplateMutation func
+func (r *resolver) MutateAndGetPayloadForRemoveIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": false,
+		}, err
+	}
+
+	status, err := r.puc.RemoveTemplate(id)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": status,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}, nil
+}
+
+// MutateAndGetPayloadForAddCustomFieldMutation func
+func (r *resolver) MutateAndGetPayloadForAddCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	projectIDValue, projectIDOK := inputMap["projectId"].(string)
+	if !projectIDOK || projectIDValue == "" {
+		return errResponse, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return errResponse, err
+	}
+	name, _ := inputMap["name"].(string)
+	fieldType, _ := inputMap["type"].(string)
+	options, _ := inputMap["options"].(string)
+
+	item, err := r.puc.AddCustomField(projectID, name, fieldType, options)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForUpdateCustomFieldMutation func
+func (r *resolver) MutateAndGetPayloadForUpdateCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+	name, _ := inputMap["name"].(string)
+	options, _ := inputMap["options"].(string)
+
+	item, err := r.puc.UpdateCustomField(id, name, options)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForRemoveCustomFieldMutation func
+func (r *resolver) MutateAndGetPayloadForRemoveCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": false,
+		}, err
+	}
+
+	status, err := r.puc.RemoveCustomField(id)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": status,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}, nil
+}
+
+// MutateAndGetPayloadForSetIssueCustomFieldsMutation func
+func (r *resolver) MutateAndGetPayloadForSetIssueCustomFieldsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+	values, err := r.getCustomFieldValues(inputMap["customFields"])
+	if err != nil {
+		return errResponse, err
+	}
+	if len(values) == 0 {
+		return errResponse, domain.NewValidationError("custom field values not provided")
+	}
+	issue, err := r.iuc.FindByID(id)
+	if err != nil {
+		return errResponse, domain.NewNotFoundError("issue not found")
+	}
+	fields, err := r.puc.FindCustomFields(issue.ProjectID)
+	if err != nil {
+		return errResponse, err
+	}
+
+	item, err := r.iuc.SetCustomFields(id, fields, values)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForSetIssueEstimatesMutation func, remaining estimate is original estimate reduced
+// by time spent when not provided
+func (r *resolver) MutateAndGetPayloadForSetIssueEstimatesMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+	originalEstimateValue, _ := inputMap["originalEstimate"].(string)
+	if originalEstimateValue == "" {
+		return errResponse, domain.NewValidationError("original estimate not provided")
+	}
+	originalEstimate, err := domain.ParseMinutes(originalEstimateValue)
+	if err != nil {
+		return errResponse, err
+	}
+	remainingEstimate := domain.EstimateDefault
+	if remainingEstimateValue, ok := inputMap["remainingEstimate"].(string); ok && remainingEstimateValue != "" {
+		if remainingEstimate, err = domain.ParseMinutes(remainingEstimateValue); err != nil {
+			return errResponse, err
+		}
+	}
+
+	item, err := r.iuc.SetEstimates(id, originalEstimate, remainingEstimate)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForAddWorkLogMutation func, work is logged now when loggedAt is not provided
+func (r *resolver) MutateAndGetPayloadForAddWorkLogMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	issueIDValue, issueIDOK := inputMap["issueId"].(string)
+	if !issueIDOK || issueIDValue == "" {
+		return errResponse, domain.NewValidationError("issue id not provided")
+	}
+	issueID, err := r.fromGlobalID(issueIDValue)
+	if err != nil {
+		return errResponse, err
+	}
+	timeSpent, _ := inputMap["timeSpent"].(string)
+	minutes, err := domain.ParseMinutes(timeSpent)
+	if err != nil {
+		return errResponse, err
+	}
+	author, _ := inputMap["author"].(string)
+	loggedAt, _ := inputMap["loggedAt"].(time.Time)
+	comment, _ := inputMap["comment"].(string)
+
+	item, err := r.iuc.AddWorkLog(issueID, author, minutes, loggedAt, comment)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForRemoveWorkLogMutation func
+func (r *resolver) MutateAndGetPayloadForRemoveWorkLogMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": false,
+		}, err
+	}
+
+	status, err := r.iuc.RemoveWorkLog(id)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": status,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}, nil
+}
+
+// MutateAndGetPayloadForRemoveAttachmentMutation func, files are uploaded through REST API only
+func (r *resolver) MutateAndGetPayloadForRemoveAttachmentMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": false,
+		}, err
+	}
+
+	status, err := r.iuc.RemoveAttachment(id)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": status,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}, nil
+}
+
+// MutateAndGetPayloadForWatchIssueMutation func
+func (r *resolver) MutateAndGetPayloadForWatchIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	issueIDValue, issueIDOK := inputMap["issueId"].(string)
+	if !issueIDOK || issueIDValue == "" {
+		return errResponse, domain.NewValidationError("issue id not provided")
+	}
+	issueID, err := r.fromGlobalID(issueIDValue)
+	if err != nil {
+		return errResponse, err
+	}
+	issue, err := r.iuc.FindByID(issueID)
+	if err != nil {
+		return errResponse, err
+	}
+	user, _ := inputMap["user"].(string)
+
+	item, err := r.nuc.WatchIssue(user, issue)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForWatchProjectMutation func, user is notified about all issues of project
+func (r *resolver) MutateAndGetPayloadForWatchProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	projectIDValue, projectIDOK := inputMap["projectId"].(string)
+	if !projectIDOK || projectIDValue == "" {
+		return errResponse, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return errResponse, err
+	}
+	project, err := r.puc.FindByID(projectID)
+	if err != nil {
+		return errResponse, err
+	}
+	user, _ := inputMap["user"].(string)
+
+	item, err := r.nuc.WatchProject(user, project)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForUnwatchMutation func
+func (r *resolver) MutateAndGetPayloadForUnwatchMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": false,
+		}, err
+	}
+
+	status, err := r.nuc.Unwatch(id)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": status,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}, nil
+}
+
+// MutateAndGetPayloadForMarkNotificationReadMutation func, notification is marked read when read is not provided
+func (r *resolver) MutateAndGetPayloadForMarkNotificationReadMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+	read, ok := inputMap["read"].(bool)
+	if !ok {
+		read = true
+	}
+
+	item, err := r.nuc.MarkNotificationRead(id, read)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForMarkAllNotificationsReadMutation func
+func (r *resolver) MutateAndGetPayloadForMarkAllNotificationsReadMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	user, _ := inputMap["user"].(string)
+
+	count, err := r.nuc.MarkAllNotificationsRead(user)
+	if err != nil {
+		return map[string]interface{}{
+			"item": 0,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"item": count,
+	}, nil
+}
+
+// MutateAndGetPayloadForSetMailPreferenceMutation func
+func (r *resolver) MutateAndGetPayloadForSetMailPreferenceMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	user, _ := inputMap["user"].(string)
+	email, _ := inputMap["email"].(string)
+	delivery, _ := inputMap["delivery"].(string)
+
+	item, err := r.muc.SetPreference(user, email, delivery)
+	if err != nil {
+		return map[string]interface{}{
+			"item": nil,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// getStrings to get strings provided as list, e.g. webhook events or actions of automation rule
+func (r *resolver) getStrings(value interface{}) []string {
+	events := []string{}
+	list, _ := value.([]interface{})
+	for _, item := range list {
+		if event, ok := item.(string); ok {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// MutateAndGetPayloadForAddWebhookMutation func
+func (r *resolver) MutateAndGetPayloadForAddWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	projectIDValue, projectIDOK := inputMap["projectId"].(string)
+	if !projectIDOK || projectIDValue == "" {
+		return errResponse, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return errResponse, err
+	}
+	project, err := r.puc.FindByID(projectID)
+	if err != nil {
+		return errResponse, err
+	}
+	url, _ := inputMap["url"].(string)
+	secret, _ := inputMap["secret"].(string)
+	events := r.getStrings(inputMap["events"])
+
+	item, err := r.wuc.Add(project, url, secret, events)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForUpdateWebhookMutation func, secret is kept when not provided
+func (r *resolver) MutateAndGetPayloadForUpdateWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+	url, _ := inputMap["url"].(string)
+	secret, _ := inputMap["secret"].(string)
+	events := r.getStrings(inputMap["events"])
+	active, ok := inputMap["active"].(bool)
+	if !ok {
+		active = true
+	}
+
+	item, err := r.wuc.Update(id, url, secret, events, active)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForRemoveWebhookMutation func
+func (r *resolver) MutateAndGetPayloadForRemoveWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": false,
+		}, err
+	}
+
+	status, err := r.wuc.Remove(id)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": status,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}, nil
+}
+
+// MutateAndGetPayloadForRedeliverWebhookDeliveryMutation func, delivery is queued again as new delivery
+func (r *resolver) MutateAndGetPayloadForRedeliverWebhookDeliveryMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+
+	item, err := r.wuc.Redeliver(id)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForAddAutomationRuleMutation func
+func (r *resolver) MutateAndGetPayloadForAddAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	projectIDValue, projectIDOK := inputMap["projectId"].(string)
+	if !projectIDOK || projectIDValue == "" {
+		return errResponse, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return errResponse, err
+	}
+	project, err := r.puc.FindByID(projectID)
+	if err != nil {
+		return errResponse, err
+	}
+	name, _ := inputMap["name"].(string)
+	trigger, _ := inputMap["trigger"].(string)
+
+	item, err := r.auc.Add(project, name, trigger, r.getStrings(inputMap["conditions"]), r.getStrings(inputMap["actions"]))
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForUpdateAutomationRuleMutation func
+func (r *resolver) MutateAndGetPayloadForUpdateAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	errResponse := map[string]interface{}{
+		"item": nil,
+	}
+
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return errResponse, err
+	}
+	name, _ := inputMap["name"].(string)
+	trigger, _ := inputMap["trigger"].(string)
+	active, ok := inputMap["active"].(bool)
+	if !ok {
+		active = true
+	}
+
+	item, err := r.auc.Update(id, name, trigger, r.getStrings(inputMap["conditions"]), r.getStrings(inputMap["actions"]), active)
+	if err != nil {
+		return errResponse, err
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}, nil
+}
+
+// MutateAndGetPayloadForRemoveAutomationRuleMutation func
+func (r *resolver) MutateAndGetPayloadForRemoveAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": false,
+		}, err
+	}
+
+	status, err := r.auc.Remove(id)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": status,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}, nil
+}
+
+// MutateAndGetPayloadForRemoveProjectMutation func
+func (r *resolver) MutateAndGetPayloadForRemoveProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	id, err := r.getIDFromMutationData(inputMap)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": false,
+		}, err
+	}
+
+	if mode, ok := inputMap["mode"].(string); ok && mode != "" {
+		targetID := uint(0)
+		if targetIDValue, ok := inputMap["targetProjectId"].(string); ok && targetIDValue != "" {
+			if targetID, err = r.fromGlobalID(targetIDValue); err != nil {
+				return map[string]interface{}{
+					"id":     id,
+					"status": false,
+				}, err
+			}
+		}
+
+		item, err := r.puc.RemoveWithMode(id, mode, targetID)
+		if err != nil {
+			return map[string]interface{}{
+				"id":     id,
+				"status": false,
+			}, err
+		}
+
+		return map[string]interface{}{
+			"id":     id,
+			"status": true,
+			"item":   item,
+		}, nil
+	}
+
+	status, err := r.puc.Remove(id)
+	if err != nil {
+		return map[string]interface{}{
+			"id":     id,
+			"status": status,
+		}, err
+	}
+
+	return map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}, nil
+}
+
+func (r *resolver) getIDFromQueryData(p graphql.ResolveParams) (uint, error) {
+	id, idOK := p.Args["id"].(string)
+	if !idOK {
+		return uint(0), domain.NewValidationError("id not provided")
+	}
+
+	resolvedID := relay.FromGlobalID(id)
+	if resolvedID == nil {
+		return uint(0), domain.NewValidationError("provided id not valid")
+	}
+	intID, err := strconv.Atoi(resolvedID.ID)
+	if err != nil {
+		return uint(0), err
+	}
+
+	return uint(intID), err
 }
 
 func (r *resolver) ResolveFindIssueByIDQuery(p graphql.ResolveParams) (interface{}, error) {
@@ -512,7 +1881,7 @@ func (r *resolver) ResolveFindIssuesQuery(p graphql.ResolveParams) (interface{},
 	if projectIDOK && projectID != "" && projectID != "0" {
 		resolvedID := relay.FromGlobalID(projectID)
 		if resolvedID == nil {
-			return nil, errors.New("provided project id not valid")
+			return nil, domain.NewValidationError("provided project id not valid")
 		}
 		var err error
 		projectIDInt, err = strconv.Atoi(resolvedID.ID)
@@ -528,14 +1897,24 @@ func (r *resolver) ResolveFindIssuesQuery(p graphql.ResolveParams) (interface{},
 			if ls != "" {
 				resolvedID := relay.FromGlobalID(ls)
 				if resolvedID == nil {
-					return nil, errors.New("provided label id not valid")
+					return nil, domain.NewValidationError("provided label id not valid")
 				}
 				labels = append(labels, resolvedID.ID)
 			}
 		}
 	}
 
-	items, err := r.iuc.Find(title, uint(projectIDInt), labels)
+	customFields, err := r.getCustomFieldFilters(p.Args["customFields"], uint(projectIDInt))
+	if err != nil {
+		return nil, err
+	}
+	options := domain.IssueFindOptions{}
+	options.MinPriority, _ = p.Args["minPriority"].(int)
+	options.MinSeverity, _ = p.Args["minSeverity"].(int)
+	options.MinSLAStatus, _ = p.Args["minSlaStatus"].(int)
+	options.Sort, _ = p.Args["sort"].(string)
+
+	items, err := r.iuc.Find(title, uint(projectIDInt), labels, customFields, options)
 	if err != nil {
 		return items, err
 	}
@@ -566,8 +1945,15 @@ func (r *resolver) ResolveFindLabelByIDQuery(p graphql.ResolveParams) (interface
 
 func (r *resolver) ResolveFindLabelsQuery(p graphql.ResolveParams) (interface{}, error) {
 	name := p.Args["name"].(string)
+	projectID := uint(0)
+	if projectIDValue, ok := p.Args["projectId"].(string); ok && projectIDValue != "" {
+		var err error
+		if projectID, err = r.fromGlobalID(projectIDValue); err != nil {
+			return nil, err
+		}
+	}
 
-	items, err := r.luc.Find(name)
+	items, err := r.luc.Find(name, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -583,6 +1969,128 @@ func (r *resolver) ResolveFindAllLabelsQuery(p graphql.ResolveParams) (interface
 	return items, nil
 }
 
+func (r *resolver) ResolveLabelMergePreviewQuery(p graphql.ResolveParams) (interface{}, error) {
+	sourceID, targetID, err := r.getMergeLabelIDs(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := r.luc.MergePreview(sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *resolver) ResolveFindLabelGroupByIDQuery(p graphql.ResolveParams) (interface{}, error) {
+	id, err := r.getIDFromQueryData(p)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := r.luc.FindGroupByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *resolver) ResolveFindAllLabelGroupsQuery(p graphql.ResolveParams) (interface{}, error) {
+	items, err := r.luc.FindAllGroups()
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveFindIssueTemplateByIDQuery(p graphql.ResolveParams) (interface{}, error) {
+	id, err := r.getIDFromQueryData(p)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := r.puc.FindTemplateByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *resolver) ResolveFindIssueTemplatesQuery(p graphql.ResolveParams) (interface{}, error) {
+	projectIDValue, projectIDOK := p.Args["projectId"].(string)
+	if !projectIDOK {
+		return nil, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.puc.FindTemplates(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveFindCustomFieldByIDQuery(p graphql.ResolveParams) (interface{}, error) {
+	id, err := r.getIDFromQueryData(p)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := r.puc.FindCustomFieldByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *resolver) ResolveFindCustomFieldsQuery(p graphql.ResolveParams) (interface{}, error) {
+	projectIDValue, projectIDOK := p.Args["projectId"].(string)
+	if !projectIDOK {
+		return nil, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.puc.FindCustomFields(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveTimesheetQuery(p graphql.ResolveParams) (interface{}, error) {
+	projectID := uint(0)
+	if projectIDValue, ok := p.Args["projectId"].(string); ok && projectIDValue != "" {
+		var err error
+		if projectID, err = r.fromGlobalID(projectIDValue); err != nil {
+			return nil, err
+		}
+	}
+	to, ok := p.Args["to"].(time.Time)
+	if !ok {
+		to = time.Now()
+	}
+	from, ok := p.Args["from"].(time.Time)
+	if !ok {
+		from = to.AddDate(0, 0, -domain.DefaultTimesheetDays)
+	}
+
+	items, err := r.iuc.FindTimesheet(projectID, from, to)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
 func (r *resolver) ResolveFindProjectByIDQuery(p graphql.ResolveParams) (interface{}, error) {
 	id, err := r.getIDFromQueryData(p)
 	if err != nil {
@@ -615,3 +2123,189 @@ func (r *resolver) ResolveFindAllProjectsQuery(p graphql.ResolveParams) (interfa
 	}
 	return items, nil
 }
+
+func (r *resolver) ResolveFindArchivedProjectsQuery(p graphql.ResolveParams) (interface{}, error) {
+	items, err := r.puc.FindArchived()
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveFindWatchersQuery(p graphql.ResolveParams) (interface{}, error) {
+	user, _ := p.Args["user"].(string)
+	issueID := uint(0)
+	if issueIDValue, ok := p.Args["issueId"].(string); ok && issueIDValue != "" {
+		var err error
+		if issueID, err = r.fromGlobalID(issueIDValue); err != nil {
+			return nil, err
+		}
+	}
+	projectID := uint(0)
+	if projectIDValue, ok := p.Args["projectId"].(string); ok && projectIDValue != "" {
+		var err error
+		if projectID, err = r.fromGlobalID(projectIDValue); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := r.nuc.FindWatchers(user, issueID, projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveFindNotificationsQuery(p graphql.ResolveParams) (interface{}, error) {
+	user, _ := p.Args["user"].(string)
+	unread, _ := p.Args["unread"].(bool)
+
+	items, err := r.nuc.FindNotifications(user, unread)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveFindMailPreferenceQuery(p graphql.ResolveParams) (interface{}, error) {
+	user, _ := p.Args["user"].(string)
+
+	item, err := r.muc.FindPreference(user)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return item, nil
+}
+
+func (r *resolver) ResolveFindWebhooksQuery(p graphql.ResolveParams) (interface{}, error) {
+	projectIDValue, projectIDOK := p.Args["projectId"].(string)
+	if !projectIDOK {
+		return nil, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.wuc.Find(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveFindWebhookDeliveriesQuery(p graphql.ResolveParams) (interface{}, error) {
+	webhookIDValue, webhookIDOK := p.Args["webhookId"].(string)
+	if !webhookIDOK {
+		return nil, domain.NewValidationError("webhook id not provided")
+	}
+	webhookID, err := r.fromGlobalID(webhookIDValue)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.wuc.FindDeliveries(webhookID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveFindAutomationRulesQuery(p graphql.ResolveParams) (interface{}, error) {
+	projectIDValue, projectIDOK := p.Args["projectId"].(string)
+	if !projectIDOK {
+		return nil, domain.NewValidationError("project id not provided")
+	}
+	projectID, err := r.fromGlobalID(projectIDValue)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.auc.Find(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *resolver) ResolveFindAutomationExecutionsQuery(p graphql.ResolveParams) (interface{}, error) {
+	ruleIDValue, ruleIDOK := p.Args["ruleId"].(string)
+	if !ruleIDOK {
+		return nil, domain.NewValidationError("rule id not provided")
+	}
+	ruleID, err := r.fromGlobalID(ruleIDValue)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.auc.FindExecutions(ruleID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// getSubscriptionEvent to get domain event of subscription root value and project filter of subscription,
+// root value has no event when subscription document is validated before it is subscribed
+func (r *resolver) getSubscriptionEvent(p graphql.ResolveParams) (domain.DomainEvent, uint, error) {
+	var projectID uint
+	if value, ok := p.Args["projectId"].(string); ok && value != "" && value != "0" {
+		id, err := r.fromGlobalID(value)
+		if err != nil {
+			return nil, 0, err
+		}
+		projectID = id
+	}
+	root, _ := p.Source.(map[string]interface{})
+	event, _ := root[SubscriptionEventKey].(domain.DomainEvent)
+	return event, projectID, nil
+}
+
+// ResolveIssueAddedSubscription to resolve issue of IssueCreated event of project
+func (r *resolver) ResolveIssueAddedSubscription(p graphql.ResolveParams) (interface{}, error) {
+	event, projectID, err := r.getSubscriptionEvent(p)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := event.(domain.IssueCreated)
+	if !ok || (projectID != 0 && e.Issue.ProjectID != projectID) {
+		return nil, nil
+	}
+	return &e.Issue, nil
+}
+
+// ResolveIssueUpdatedSubscription to resolve issue of IssueUpdated or IssueMoved event of project, moved issue
+// is resolved for source and target project
+func (r *resolver) ResolveIssueUpdatedSubscription(p graphql.ResolveParams) (interface{}, error) {
+	event, projectID, err := r.getSubscriptionEvent(p)
+	if err != nil {
+		return nil, err
+	}
+	switch e := event.(type) {
+	case domain.IssueUpdated:
+		if projectID == 0 || e.Issue.ProjectID == projectID {
+			return &e.Issue, nil
+		}
+	case domain.IssueMoved:
+		if projectID == 0 || e.Issue.ProjectID == projectID || e.PreviousProjectID == projectID {
+			return &e.Issue, nil
+		}
+	}
+	return nil, nil
+}
+
+// ResolveIssueRemovedSubscription to resolve last state of issue of IssueRemoved event of project
+func (r *resolver) ResolveIssueRemovedSubscription(p graphql.ResolveParams) (interface{}, error) {
+	event, projectID, err := r.getSubscriptionEvent(p)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := event.(domain.IssueRemoved)
+	if !ok || (projectID != 0 && e.Issue.ProjectID != projectID) {
+		return nil, nil
+	}
+	return &e.Issue, nil
+}
@@ -10,6 +10,7 @@ import (
 	ucTesting "go-issue-tracker/pkg/usecases/testing"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func checkAssertions(t *testing.T, cucm *ucTesting.ColorUseCaseMock, iucm *ucTesting.IssueUseCaseMock, lucm *ucTesting.LabelUseCaseMock, pucm *ucTesting.ProjectUseCaseMock) {
@@ -20,15 +21,38 @@ func checkAssertions(t *testing.T, cucm *ucTesting.ColorUseCaseMock, iucm *ucTes
 }
 
 func prepareMocksAndResolver() (*ucTesting.ColorUseCaseMock, *ucTesting.IssueUseCaseMock, *ucTesting.LabelUseCaseMock, *ucTesting.ProjectUseCaseMock, gql.Resolver) {
+	cucm, iucm, lucm, pucm, _, r := prepareNotificationMocksAndResolver()
+	return cucm, iucm, lucm, pucm, r
+}
+
+func prepareNotificationMocksAndResolver() (*ucTesting.ColorUseCaseMock, *ucTesting.IssueUseCaseMock, *ucTesting.LabelUseCaseMock, *ucTesting.ProjectUseCaseMock, *ucTesting.NotificationUseCaseMock, gql.Resolver) {
 	cucm := new(ucTesting.ColorUseCaseMock)
 	iucm := new(ucTesting.IssueUseCaseMock)
 	lucm := new(ucTesting.LabelUseCaseMock)
 	pucm := new(ucTesting.ProjectUseCaseMock)
-	return cucm, iucm, lucm, pucm, gql.GetResolver(iucm, lucm, pucm, cucm)
+	nucm := new(ucTesting.NotificationUseCaseMock)
+	return cucm, iucm, lucm, pucm, nucm, gql.GetResolver(iucm, lucm, pucm, cucm, nucm, new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
+}
+
+func prepareWebhookMocksAndResolver() (*ucTesting.ProjectUseCaseMock, *ucTesting.WebhookUseCaseMock, gql.Resolver) {
+	pucm := new(ucTesting.ProjectUseCaseMock)
+	wucm := new(ucTesting.WebhookUseCaseMock)
+	return pucm, wucm, gql.GetResolver(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), pucm, new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), wucm, new(ucTesting.AutomationUseCaseMock))
+}
+
+func prepareAutomationMocksAndResolver() (*ucTesting.ProjectUseCaseMock, *ucTesting.AutomationUseCaseMock, gql.Resolver) {
+	pucm := new(ucTesting.ProjectUseCaseMock)
+	aucm := new(ucTesting.AutomationUseCaseMock)
+	return pucm, aucm, gql.GetResolver(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), pucm, new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), aucm)
+}
+
+func prepareMailMocksAndResolver() (*ucTesting.MailUseCaseMock, gql.Resolver) {
+	mucm := new(ucTesting.MailUseCaseMock)
+	return mucm, gql.GetResolver(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), mucm, new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
 }
 
 func TestResolveNodeID(t *testing.T) {
-	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+	cucm, iucm, lucm, pucm, nucm, r := prepareNotificationMocksAndResolver()
 
 	tests := []struct {
 		id     string
@@ -42,10 +66,38 @@ func TestResolveNodeID(t *testing.T) {
 			relay.ToGlobalID("Label", "1"),
 			"Label",
 		},
+		{
+			relay.ToGlobalID("LabelGroup", "1"),
+			"LabelGroup",
+		},
 		{
 			relay.ToGlobalID("Project", "1"),
 			"Project",
 		},
+		{
+			relay.ToGlobalID("IssueTemplate", "1"),
+			"IssueTemplate",
+		},
+		{
+			relay.ToGlobalID("CustomField", "1"),
+			"CustomField",
+		},
+		{
+			relay.ToGlobalID("WorkLog", "1"),
+			"WorkLog",
+		},
+		{
+			relay.ToGlobalID("Attachment", "1"),
+			"Attachment",
+		},
+		{
+			relay.ToGlobalID("Watcher", "1"),
+			"Watcher",
+		},
+		{
+			relay.ToGlobalID("Notification", "1"),
+			"Notification",
+		},
 	}
 
 	for _, ts := range tests {
@@ -53,8 +105,22 @@ func TestResolveNodeID(t *testing.T) {
 			iucm.On("FindByID", uint(1)).Return(domain.Issue{}, nil)
 		} else if ts.idType == "Label" {
 			lucm.On("FindByID", uint(1)).Return(domain.Label{}, nil)
+		} else if ts.idType == "LabelGroup" {
+			lucm.On("FindGroupByID", uint(1)).Return(domain.LabelGroup{}, nil)
 		} else if ts.idType == "Project" {
 			pucm.On("FindByID", uint(1)).Return(domain.Project{}, nil)
+		} else if ts.idType == "IssueTemplate" {
+			pucm.On("FindTemplateByID", uint(1)).Return(domain.IssueTemplate{}, nil)
+		} else if ts.idType == "CustomField" {
+			pucm.On("FindCustomFieldByID", uint(1)).Return(domain.CustomField{}, nil)
+		} else if ts.idType == "WorkLog" {
+			iucm.On("FindWorkLogByID", uint(1)).Return(domain.WorkLog{}, nil)
+		} else if ts.idType == "Attachment" {
+			iucm.On("FindAttachmentByID", uint(1)).Return(domain.Attachment{}, nil)
+		} else if ts.idType == "Watcher" {
+			nucm.On("FindWatcherByID", uint(1)).Return(domain.Watcher{}, nil)
+		} else if ts.idType == "Notification" {
+			nucm.On("FindNotificationByID", uint(1)).Return(domain.Notification{}, nil)
 		}
 
 		item, err := r.ResolveNodeID(nil, ts.id, graphql.ResolveInfo{})
@@ -63,6 +129,7 @@ func TestResolveNodeID(t *testing.T) {
 		assert.NotNil(t, item)
 
 		checkAssertions(t, cucm, iucm, lucm, pucm)
+		nucm.AssertExpectations(t)
 	}
 }
 
@@ -119,12 +186,72 @@ func TestResolveType(t *testing.T) {
 		{
 			new(domain.Label),
 		},
+		{
+			domain.LabelGroup{},
+		},
+		{
+			new(domain.LabelGroup),
+		},
 		{
 			domain.Project{},
 		},
 		{
 			new(domain.Project),
 		},
+		{
+			domain.IssueTemplate{},
+		},
+		{
+			new(domain.IssueTemplate),
+		},
+		{
+			domain.CustomField{},
+		},
+		{
+			new(domain.CustomField),
+		},
+		{
+			domain.WorkLog{},
+		},
+		{
+			new(domain.WorkLog),
+		},
+		{
+			domain.Attachment{},
+		},
+		{
+			new(domain.Attachment),
+		},
+		{
+			domain.Watcher{},
+		},
+		{
+			new(domain.Watcher),
+		},
+		{
+			domain.Notification{},
+		},
+		{
+			new(domain.Notification),
+		},
+		{
+			domain.Webhook{},
+		},
+		{
+			new(domain.Webhook),
+		},
+		{
+			domain.WebhookDelivery{},
+		},
+		{
+			new(domain.WebhookDelivery),
+		},
+		{
+			domain.AutomationRule{},
+		},
+		{
+			new(domain.AutomationRule),
+		},
 	}
 
 	bType := reflect.TypeOf(new(graphql.Object))
@@ -195,6 +322,28 @@ func TestResolveFieldLabels(t *testing.T) {
 	}
 }
 
+func TestResolveFieldLabelsPageSize(t *testing.T) {
+	_, _, _, _, r := prepareMocksAndResolver()
+
+	i := domain.Issue{Labels: make([]domain.Label, 120)}
+
+	tests := []struct {
+		args  map[string]interface{}
+		edges int
+	}{
+		{map[string]interface{}{}, gql.DefaultListSize},
+		{map[string]interface{}{"first": 20}, 20},
+		{map[string]interface{}{"last": 200}, gql.MaxPageSize},
+	}
+
+	for _, ts := range tests {
+		connectionData, err := r.ResolveFieldLabels(graphql.ResolveParams{Source: i, Args: ts.args})
+
+		assert.Nil(t, err)
+		assert.Len(t, connectionData.(*relay.Connection).Edges, ts.edges)
+	}
+}
+
 func TestResolveFieldLabelsErr(t *testing.T) {
 	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
 
@@ -307,6 +456,38 @@ func TestResolveMutationOutputFieldStatusErr(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
+func TestResolveMutationOutputFieldItems(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	rp := graphql.ResolveParams{
+		Source: map[string]interface{}{
+			"items": []domain.IssueBulkResult{},
+		},
+	}
+
+	items, err := r.ResolveMutationOutputFieldItems(rp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{}, items)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveMutationOutputFieldItemsErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	rp := graphql.ResolveParams{
+		Source: nil,
+	}
+
+	items, err := r.ResolveMutationOutputFieldItems(rp)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, items)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestMutateAndGetPayloadForAddIssueMutation(t *testing.T) {
 	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
 
@@ -330,7 +511,7 @@ func TestMutateAndGetPayloadForAddIssueMutation(t *testing.T) {
 	i.ProjectID = p.ID
 	i.Project = p
 	i.Labels = []domain.Label{l}
-	iucm.On("Add", i.Title, i.Description, i.Status, p, map[string]domain.Label{
+	iucm.On("Add", i.Title, i.Description, i.Status, 0, 0, "", "", p, map[string]domain.Label{
 		relay.ToGlobalID("Label", "1"): l,
 	}).Return(i, nil)
 
@@ -350,6 +531,75 @@ func TestMutateAndGetPayloadForAddIssueMutation(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
+func TestMutateAndGetPayloadForAddIssueMutationDefaults(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	p := domain.Project{
+		ID:          1,
+		Name:        "test-name",
+		Description: "test-description",
+	}
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+
+	i := new(domain.Issue)
+	i.Title = "test-title"
+	i.Status = 1
+	i.ProjectID = p.ID
+	i.Project = p
+	iucm.On("Add", i.Title, "", domain.IssueStatusDefault, 0, 0, "", "", p, map[string]domain.Label{}).Return(i, nil)
+
+	inputMap := map[string]interface{}{
+		"title":     i.Title,
+		"projectId": relay.ToGlobalID("Project", "1"),
+	}
+
+	result, err := r.MutateAndGetPayloadForAddIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddIssueMutationFromTemplate(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	p := domain.Project{
+		ID:   1,
+		Name: "test-name",
+	}
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+
+	tpl := domain.IssueTemplate{ID: 3, ProjectID: 1, TitlePrefix: "[Bug] "}
+	pucm.On("FindTemplateByID", uint(3)).Return(tpl, nil)
+	pucm.On("FindTemplateByID", uint(4)).Return(domain.IssueTemplate{}, errors.New("record not found"))
+
+	i := &domain.Issue{Title: "[Bug] test-title", Status: 1, ProjectID: 1, Project: p}
+	iucm.On("AddFromTemplate", "test-title", "", domain.IssueStatusDefault, 0, 0, "", "", p, map[string]domain.Label{}, tpl).Return(i, nil)
+
+	inputMap := map[string]interface{}{
+		"title":      "test-title",
+		"projectId":  relay.ToGlobalID("Project", "1"),
+		"templateId": relay.ToGlobalID("IssueTemplate", "3"),
+	}
+
+	result, err := r.MutateAndGetPayloadForAddIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, result["item"])
+
+	for _, templateID := range []string{relay.ToGlobalID("IssueTemplate", "test"), relay.ToGlobalID("IssueTemplate", "4")} {
+		inputMap["templateId"] = templateID
+
+		result, err = r.MutateAndGetPayloadForAddIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestMutateAndGetPayloadForAddIssueMutationArgErr(t *testing.T) {
 	tests := []struct {
 		title                  string
@@ -373,28 +623,6 @@ func TestMutateAndGetPayloadForAddIssueMutationArgErr(t *testing.T) {
 			false,
 			false,
 		},
-		{
-			"test-title",
-			"",
-			1,
-			relay.ToGlobalID("Project", "1"),
-			relay.ToGlobalID("Label", "1"),
-			false,
-			false,
-			false,
-			false,
-		},
-		{
-			"test-title",
-			"test-description",
-			"test",
-			relay.ToGlobalID("Project", "1"),
-			relay.ToGlobalID("Label", "1"),
-			false,
-			false,
-			false,
-			false,
-		},
 		{
 			"test-title",
 			"test-description",
@@ -439,28 +667,6 @@ func TestMutateAndGetPayloadForAddIssueMutationArgErr(t *testing.T) {
 			false,
 			false,
 		},
-		{
-			"test-title",
-			"test-description",
-			1,
-			relay.ToGlobalID("Project", "1"),
-			"",
-			true,
-			false,
-			false,
-			false,
-		},
-		{
-			"test-title",
-			"test-description",
-			1,
-			relay.ToGlobalID("Project", "1"),
-			",",
-			true,
-			false,
-			false,
-			false,
-		},
 		{
 			"test-title",
 			"test-description",
@@ -573,7 +779,7 @@ func TestMutateAndGetPayloadForAddIssueMutationErr(t *testing.T) {
 	i.ProjectID = p.ID
 	i.Project = p
 	i.Labels = []domain.Label{l}
-	iucm.On("Add", i.Title, i.Description, i.Status, p, map[string]domain.Label{
+	iucm.On("Add", i.Title, i.Description, i.Status, 0, 0, "", "", p, map[string]domain.Label{
 		relay.ToGlobalID("Label", "1"): l,
 	}).Return(i, errors.New("test error"))
 
@@ -612,11 +818,13 @@ func TestMutateAndGetPayloadForUpdateIssueMutation(t *testing.T) {
 		Title:       "test-title",
 		Description: "test-description",
 		Status:      1,
+		Reporter:    "alice",
+		Assignee:    "bob",
 		ProjectID:   p.ID,
 		Project:     p,
 		Labels:      []domain.Label{l},
 	}
-	iucm.On("Update", uint(1), i.Title, i.Description, i.Status, map[string]domain.Label{
+	iucm.On("Update", uint(1), i.Title, i.Description, i.Status, i.Priority, i.Severity, i.Reporter, i.Assignee, map[string]domain.Label{
 		relay.ToGlobalID("Label", "1"): l,
 	}).Return(i, nil)
 
@@ -625,6 +833,10 @@ func TestMutateAndGetPayloadForUpdateIssueMutation(t *testing.T) {
 		"title":       i.Title,
 		"description": i.Description,
 		"status":      i.Status,
+		"priority":    i.Priority,
+		"severity":    i.Severity,
+		"reporter":    i.Reporter,
+		"assignee":    i.Assignee,
 		"labels":      relay.ToGlobalID("Label", "1"),
 	}
 
@@ -636,6 +848,55 @@ func TestMutateAndGetPayloadForUpdateIssueMutation(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
+func TestMutateAndGetPayloadForUpdateIssueMutationKeepsLevels(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	i := domain.Issue{
+		ID:       1,
+		Title:    "test-title",
+		Status:   1,
+		Priority: domain.PriorityHigh,
+		Severity: domain.SeverityMinor,
+		Reporter: "alice",
+		Assignee: "bob",
+	}
+	iucm.On("FindByID", uint(1)).Return(i, nil)
+	iucm.On("Update", uint(1), i.Title, "", i.Status, i.Priority, domain.SeverityCritical, "alice", "", map[string]domain.Label{}).Return(i, nil)
+
+	inputMap := map[string]interface{}{
+		"id":       relay.ToGlobalID("Issue", "1"),
+		"title":    i.Title,
+		"status":   i.Status,
+		"severity": domain.SeverityCritical,
+		"assignee": "",
+	}
+
+	result, err := r.MutateAndGetPayloadForUpdateIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForUpdateIssueMutationNotFoundErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	iucm.On("FindByID", uint(1)).Return(domain.Issue{}, errors.New("test error"))
+
+	inputMap := map[string]interface{}{
+		"id":     relay.ToGlobalID("Issue", "1"),
+		"title":  "test-title",
+		"status": 1,
+	}
+
+	_, err := r.MutateAndGetPayloadForUpdateIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Equal(t, "issue not found", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestMutateAndGetPayloadForUpdateIssueMutationArgErr(t *testing.T) {
 	tests := []struct {
 		id                   string
@@ -664,15 +925,6 @@ func TestMutateAndGetPayloadForUpdateIssueMutationArgErr(t *testing.T) {
 			false,
 			false,
 		},
-		{
-			relay.ToGlobalID("Issue", "1"),
-			"test-title",
-			"",
-			1,
-			relay.ToGlobalID("Label", "1"),
-			false,
-			false,
-		},
 		{
 			relay.ToGlobalID("Issue", "1"),
 			"test-title",
@@ -682,24 +934,6 @@ func TestMutateAndGetPayloadForUpdateIssueMutationArgErr(t *testing.T) {
 			false,
 			false,
 		},
-		{
-			relay.ToGlobalID("Issue", "1"),
-			"test-title",
-			"test-description",
-			1,
-			"",
-			false,
-			false,
-		},
-		{
-			relay.ToGlobalID("Issue", "1"),
-			"test-title",
-			"test-description",
-			1,
-			",",
-			false,
-			false,
-		},
 		{
 			relay.ToGlobalID("Issue", "1"),
 			"test-title",
@@ -784,11 +1018,13 @@ func TestMutateAndGetPayloadForUpdateIssueMutationErr(t *testing.T) {
 		Title:       "test-title",
 		Description: "test-description",
 		Status:      1,
+		Reporter:    "alice",
+		Assignee:    "bob",
 		ProjectID:   p.ID,
 		Project:     p,
 		Labels:      []domain.Label{l},
 	}
-	iucm.On("Update", uint(1), i.Title, i.Description, i.Status, map[string]domain.Label{
+	iucm.On("Update", uint(1), i.Title, i.Description, i.Status, i.Priority, i.Severity, i.Reporter, i.Assignee, map[string]domain.Label{
 		relay.ToGlobalID("Label", "1"): l,
 	}).Return(i, errors.New("test error"))
 
@@ -797,6 +1033,10 @@ func TestMutateAndGetPayloadForUpdateIssueMutationErr(t *testing.T) {
 		"title":       i.Title,
 		"description": i.Description,
 		"status":      i.Status,
+		"priority":    i.Priority,
+		"severity":    i.Severity,
+		"reporter":    i.Reporter,
+		"assignee":    i.Assignee,
 		"labels":      relay.ToGlobalID("Label", "1"),
 	}
 
@@ -875,14 +1115,203 @@ func TestMutateAndGetPayloadForRemoveIssueMutationErr(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
-func TestMutateAndGetPayloadForAddLabelMutation(t *testing.T) {
+func TestMutateAndGetPayloadForBulkUpdateIssuesMutation(t *testing.T) {
 	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
 
-	l := new(domain.Label)
-	l.Name = "test-name"
-	l.ColorHexCode = "FFFFFF"
-
-	lucm.On("Add", "test-name", "FFFFFF").Return(l, nil)
+	p := domain.Project{
+		ID: 2,
+	}
+	pucm.On("FindByID", uint(2)).Return(p, nil)
+
+	l := domain.Label{
+		ID:   1,
+		Name: "test-name",
+	}
+	lucm.On("FindByID", uint(1)).Return(l, nil)
+
+	status := 2
+	op := domain.IssueBulkOperation{
+		AddLabels:    []domain.Label{l},
+		RemoveLabels: []domain.Label{},
+		Status:       &status,
+		Project:      &p,
+	}
+	results := []domain.IssueBulkResult{
+		{ID: 1, Status: true},
+	}
+	iucm.On("BulkUpdate", []uint{1}, op).Return(results, nil)
+
+	inputMap := map[string]interface{}{
+		"ids":             relay.ToGlobalID("Issue", "1"),
+		"addLabels":       relay.ToGlobalID("Label", "1"),
+		"removeLabels":    "",
+		"status":          2,
+		"targetProjectId": relay.ToGlobalID("Project", "2"),
+	}
+
+	result, err := r.MutateAndGetPayloadForBulkUpdateIssuesMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+	assert.Equal(t, results, result["items"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForBulkUpdateIssuesMutationFound(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	status := 2
+	op := domain.IssueBulkOperation{
+		Status: &status,
+	}
+	results := []domain.IssueBulkResult{
+		{ID: 1, Status: false, Error: "test error"},
+	}
+	iucm.On("BulkUpdateFound", "test", uint(1), []string{"3"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}, op).Return(results, errors.New("test error"))
+
+	inputMap := map[string]interface{}{
+		"title":     "test",
+		"projectId": relay.ToGlobalID("Project", "1"),
+		"labels":    relay.ToGlobalID("Label", "3"),
+		"status":    2,
+	}
+
+	result, err := r.MutateAndGetPayloadForBulkUpdateIssuesMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, false, result["status"])
+	assert.Equal(t, results, result["items"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForBulkUpdateIssuesMutationArgErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("FindByID", uint(2)).Return(domain.Label{}, errors.New("record not found"))
+	pucm.On("FindByID", uint(3)).Return(domain.Project{}, errors.New("record not found"))
+
+	tests := []map[string]interface{}{
+		{
+			"status": 2,
+		},
+		{
+			"ids": relay.ToGlobalID("Issue", "test"),
+		},
+		{
+			"ids":       relay.ToGlobalID("Issue", "1"),
+			"addLabels": relay.ToGlobalID("Label", "2"),
+		},
+		{
+			"ids":             relay.ToGlobalID("Issue", "1"),
+			"targetProjectId": relay.ToGlobalID("Project", "3"),
+		},
+		{
+			"projectId": relay.ToGlobalID("Project", "test"),
+		},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForBulkUpdateIssuesMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, false, result["status"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForMoveIssueMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	p := domain.Project{
+		ID: 2,
+	}
+	pucm.On("FindByID", uint(2)).Return(p, nil)
+
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 2,
+		Project:   p,
+	}
+	iucm.On("Move", uint(1), p).Return(i, nil)
+
+	inputMap := map[string]interface{}{
+		"id":        relay.ToGlobalID("Issue", "1"),
+		"projectId": relay.ToGlobalID("Project", "2"),
+	}
+
+	result, err := r.MutateAndGetPayloadForMoveIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForMoveIssueMutationArgErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	pucm.On("FindByID", uint(3)).Return(domain.Project{}, errors.New("record not found"))
+
+	tests := []map[string]interface{}{
+		{
+			"projectId": relay.ToGlobalID("Project", "2"),
+		},
+		{
+			"id": relay.ToGlobalID("Issue", "1"),
+		},
+		{
+			"id":        relay.ToGlobalID("Issue", "1"),
+			"projectId": relay.ToGlobalID("Project", "test"),
+		},
+		{
+			"id":        relay.ToGlobalID("Issue", "1"),
+			"projectId": relay.ToGlobalID("Project", "3"),
+		},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForMoveIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForMoveIssueMutationErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	p := domain.Project{
+		ID: 1,
+	}
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	iucm.On("Move", uint(1), p).Return(domain.Issue{}, errors.New("test error"))
+
+	inputMap := map[string]interface{}{
+		"id":        relay.ToGlobalID("Issue", "1"),
+		"projectId": relay.ToGlobalID("Project", "1"),
+	}
+
+	result, err := r.MutateAndGetPayloadForMoveIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddLabelMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	l := new(domain.Label)
+	l.Name = "test-name"
+	l.ColorHexCode = "FFFFFF"
+
+	lucm.On("Add", "test-name", "FFFFFF", uint(0)).Return(l, nil)
 
 	inputMap := map[string]interface{}{
 		"name":         l.Name,
@@ -909,7 +1338,7 @@ func TestMutateAndGetPayloadForAddLabelMutationColorHexCodeNotProvided(t *testin
 	l.Name = "test-name"
 	l.ColorHexCode = "FFFFFF"
 
-	lucm.On("Add", "test-name", "FFFFFF").Return(l, nil)
+	lucm.On("Add", "test-name", "FFFFFF", uint(0)).Return(l, nil)
 
 	inputMap := map[string]interface{}{
 		"name":         l.Name,
@@ -967,7 +1396,7 @@ func TestMutateAndGetPayloadForAddLabelMutationErr(t *testing.T) {
 	l.Name = "test-name"
 	l.ColorHexCode = "FFFFFF"
 
-	lucm.On("Add", "test-name", "FFFFFF").Return(l, errors.New("test error"))
+	lucm.On("Add", "test-name", "FFFFFF", uint(0)).Return(l, errors.New("test error"))
 
 	inputMap := map[string]interface{}{
 		"name":         l.Name,
@@ -1358,6 +1787,119 @@ func TestMutateAndGetPayloadForUpdateProjectMutationErr(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
+func TestMutateAndGetPayloadForUpdateProjectSettingsMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	l := domain.Label{
+		ID:        1,
+		Name:      "test-name",
+		ProjectID: 1,
+	}
+	lucm.On("FindByID", uint(1)).Return(l, nil)
+
+	settings := domain.ProjectSettings{
+		MaxLabels:       3,
+		RequiredFields:  "labels",
+		DefaultStatus:   2,
+		AllowedStatuses: "1,2",
+		SLAPolicy:       "urgent:4h",
+		SLAAtRisk:       "1h",
+	}
+	p := domain.Project{
+		ID:            1,
+		Name:          "test-name",
+		Settings:      settings,
+		DefaultLabels: []domain.Label{l},
+	}
+	pucm.On("UpdateSettings", uint(1), settings, []domain.Label{l}).Return(p, nil)
+
+	inputMap := map[string]interface{}{
+		"id":              relay.ToGlobalID("Project", "1"),
+		"maxLabels":       3,
+		"requiredFields":  "labels",
+		"defaultStatus":   2,
+		"allowedStatuses": "1,2",
+		"slaPolicy":       "urgent:4h",
+		"slaAtRisk":       "1h",
+		"defaultLabels":   relay.ToGlobalID("Label", "1"),
+	}
+
+	result, err := r.MutateAndGetPayloadForUpdateProjectSettingsMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, p, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForUpdateProjectSettingsMutationArgErr(t *testing.T) {
+	tests := []struct {
+		inputMap             map[string]interface{}
+		mockLabelFindByIDErr bool
+	}{
+		{
+			map[string]interface{}{},
+			false,
+		},
+		{
+			map[string]interface{}{
+				"id": relay.ToGlobalID("Project", "1"),
+			},
+			false,
+		},
+		{
+			map[string]interface{}{
+				"id":            relay.ToGlobalID("Project", "1"),
+				"maxLabels":     3,
+				"defaultLabels": "test",
+			},
+			false,
+		},
+		{
+			map[string]interface{}{
+				"id":            relay.ToGlobalID("Project", "1"),
+				"maxLabels":     3,
+				"defaultLabels": relay.ToGlobalID("Label", "1"),
+			},
+			true,
+		},
+	}
+
+	for _, ts := range tests {
+		cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+		if ts.mockLabelFindByIDErr {
+			lucm.On("FindByID", uint(1)).Return(domain.Label{}, errors.New("test error"))
+		}
+
+		result, err := r.MutateAndGetPayloadForUpdateProjectSettingsMutation(nil, ts.inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.NotNil(t, result)
+
+		checkAssertions(t, cucm, iucm, lucm, pucm)
+	}
+}
+
+func TestMutateAndGetPayloadForUpdateProjectSettingsMutationErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	settings := domain.ProjectSettings{MaxLabels: 0}
+	pucm.On("UpdateSettings", uint(1), settings, []domain.Label{}).Return(domain.Project{}, errors.New("max labels not valid"))
+
+	inputMap := map[string]interface{}{
+		"id":        relay.ToGlobalID("Project", "1"),
+		"maxLabels": 0,
+	}
+
+	result, err := r.MutateAndGetPayloadForUpdateProjectSettingsMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestMutateAndGetPayloadForRemoveProjectMutation(t *testing.T) {
 	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
 
@@ -1545,7 +2087,7 @@ func TestResolveFindIssuesQuery(t *testing.T) {
 
 	i := []domain.Issue{}
 
-	iucm.On("Find", "test-title", uint(1), []string{"1"}).Return(i, nil)
+	iucm.On("Find", "test-title", uint(1), []string{"1"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(i, nil)
 
 	rp := graphql.ResolveParams{
 		Args: map[string]interface{}{
@@ -1562,6 +2104,37 @@ func TestResolveFindIssuesQuery(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
+func TestResolveFindIssuesQueryOptions(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	i := []domain.Issue{}
+	options := domain.IssueFindOptions{
+		MinPriority:  domain.PriorityHigh,
+		MinSeverity:  domain.SeverityMajor,
+		MinSLAStatus: domain.SLAStatusBreached,
+		Sort:         domain.IssueSortPriority,
+	}
+
+	iucm.On("Find", "test-title", uint(0), []string{}, []domain.CustomFieldFilter{}, options).Return(i, nil)
+
+	rp := graphql.ResolveParams{
+		Args: map[string]interface{}{
+			"title":        "test-title",
+			"labels":       "",
+			"minPriority":  domain.PriorityHigh,
+			"minSeverity":  domain.SeverityMajor,
+			"minSlaStatus": domain.SLAStatusBreached,
+			"sort":         domain.IssueSortPriority,
+		}}
+
+	item, err := r.ResolveFindIssuesQuery(rp)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, item)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestResolveFindIssuesQueryArgErr(t *testing.T) {
 	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
 
@@ -1600,12 +2173,59 @@ func TestResolveFindIssuesQueryArgErr(t *testing.T) {
 	}
 }
 
+func TestResolveFindIssuesQueryCustomFields(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	f := domain.CustomField{ID: 2, ProjectID: 1, Name: "severity", Type: domain.CustomFieldTypeSelect, Options: "low,high"}
+	i := []domain.Issue{{ID: 1, ProjectID: 1}}
+
+	pucm.On("FindCustomFields", uint(1)).Return([]domain.CustomField{f}, nil)
+	iucm.On("Find", "", uint(1), []string{}, []domain.CustomFieldFilter{{Field: f, Value: "high"}}, domain.IssueFindOptions{}).Return(i, nil)
+
+	rp := graphql.ResolveParams{
+		Args: map[string]interface{}{
+			"title":        "",
+			"projectId":    relay.ToGlobalID("Project", "1"),
+			"labels":       "",
+			"customFields": []interface{}{"severity:high"},
+		},
+	}
+
+	items, err := r.ResolveFindIssuesQuery(rp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, items)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindIssuesQueryCustomFieldsErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	pucm.On("FindCustomFields", uint(1)).Return([]domain.CustomField{}, nil)
+
+	tests := []map[string]interface{}{
+		{"title": "", "labels": "", "customFields": []interface{}{"severity:high"}},
+		{"title": "", "labels": "", "projectId": relay.ToGlobalID("Project", "1"), "customFields": []interface{}{"severity"}},
+		{"title": "", "labels": "", "projectId": relay.ToGlobalID("Project", "1"), "customFields": []interface{}{"severity:high"}},
+	}
+
+	for _, args := range tests {
+		items, err := r.ResolveFindIssuesQuery(graphql.ResolveParams{Args: args})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, items)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestResolveFindIssuesQueryErr(t *testing.T) {
 	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
 
 	i := []domain.Issue{}
 
-	iucm.On("Find", "test-title", uint(1), []string{"1"}).Return(i, errors.New("test error"))
+	iucm.On("Find", "test-title", uint(1), []string{"1"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(i, errors.New("test error"))
 
 	rp := graphql.ResolveParams{
 		Args: map[string]interface{}{
@@ -1792,7 +2412,7 @@ func TestResolveFindLabelsQuery(t *testing.T) {
 		},
 	}
 
-	lucm.On("Find", "test").Return(l, nil)
+	lucm.On("Find", "test", uint(0)).Return(l, nil)
 
 	rp := graphql.ResolveParams{
 		Args: map[string]interface{}{
@@ -1824,7 +2444,7 @@ func TestResolveFindLabelsQueryErr(t *testing.T) {
 		},
 	}
 
-	lucm.On("Find", "test").Return(l, errors.New("test error"))
+	lucm.On("Find", "test", uint(0)).Return(l, errors.New("test error"))
 
 	rp := graphql.ResolveParams{
 		Args: map[string]interface{}{
@@ -2064,3 +2684,1843 @@ func TestResolveFindAllProjectsQueryErr(t *testing.T) {
 
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
+
+func TestMutateAndGetPayloadForMergeLabelsMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lm := domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3, Duplicates: 1}
+
+	lucm.On("Merge", uint(1), uint(2)).Return(lm, nil)
+
+	inputMap := map[string]interface{}{
+		"sourceId": relay.ToGlobalID("Label", "1"),
+		"targetId": relay.ToGlobalID("Label", "2"),
+	}
+
+	result, err := r.MutateAndGetPayloadForMergeLabelsMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+	assert.Equal(t, lm, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForMergeLabelsMutationArgErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	tests := []map[string]interface{}{
+		{},
+		{"sourceId": relay.ToGlobalID("Label", "1")},
+		{"sourceId": relay.ToGlobalID("Label", "test"), "targetId": relay.ToGlobalID("Label", "2")},
+		{"sourceId": relay.ToGlobalID("Label", "1"), "targetId": relay.ToGlobalID("Label", "test")},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForMergeLabelsMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, false, result["status"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForMergeLabelsMutationErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("Merge", uint(1), uint(2)).Return(domain.LabelMerge{}, errors.New("test error"))
+
+	inputMap := map[string]interface{}{
+		"sourceId": relay.ToGlobalID("Label", "1"),
+		"targetId": relay.ToGlobalID("Label", "2"),
+	}
+
+	result, err := r.MutateAndGetPayloadForMergeLabelsMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveLabelMergePreviewQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lm := domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3, Duplicates: 1}
+
+	lucm.On("MergePreview", uint(1), uint(2)).Return(lm, nil)
+
+	rp := graphql.ResolveParams{
+		Args: map[string]interface{}{
+			"sourceId": relay.ToGlobalID("Label", "1"),
+			"targetId": relay.ToGlobalID("Label", "2"),
+		},
+	}
+
+	item, err := r.ResolveLabelMergePreviewQuery(rp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, lm, item)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveLabelMergePreviewQueryErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("MergePreview", uint(1), uint(1)).Return(domain.LabelMerge{}, errors.New("label cannot be merged into itself"))
+
+	tests := []map[string]interface{}{
+		{},
+		{"sourceId": relay.ToGlobalID("Label", "1"), "targetId": relay.ToGlobalID("Label", "1")},
+	}
+
+	for _, args := range tests {
+		item, err := r.ResolveLabelMergePreviewQuery(graphql.ResolveParams{Args: args})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, item)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForRemoveProjectMutationWithMode(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	removal := domain.ProjectRemoval{ID: 1, Mode: domain.ProjectRemovalMove, Issues: 2}
+
+	pucm.On("RemoveWithMode", uint(1), domain.ProjectRemovalMove, uint(2)).Return(removal, nil)
+
+	inputMap := map[string]interface{}{
+		"id":              relay.ToGlobalID("Project", "1"),
+		"mode":            domain.ProjectRemovalMove,
+		"targetProjectId": relay.ToGlobalID("Project", "2"),
+	}
+
+	result, err := r.MutateAndGetPayloadForRemoveProjectMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+	assert.Equal(t, removal, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForRemoveProjectMutationWithModeErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	pucm.On("RemoveWithMode", uint(1), domain.ProjectRemovalCascade, uint(0)).Return(domain.ProjectRemoval{}, errors.New("test error"))
+
+	tests := []map[string]interface{}{
+		{
+			"id":              relay.ToGlobalID("Project", "1"),
+			"mode":            domain.ProjectRemovalMove,
+			"targetProjectId": relay.ToGlobalID("Project", "test"),
+		},
+		{
+			"id":   relay.ToGlobalID("Project", "1"),
+			"mode": domain.ProjectRemovalCascade,
+		},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForRemoveProjectMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, false, result["status"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindArchivedProjectsQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	p := []domain.Project{
+		domain.Project{ID: 1, Name: "test-name", Archived: true},
+	}
+
+	pucm.On("FindArchived").Return(p, nil)
+
+	item, err := r.ResolveFindArchivedProjectsQuery(graphql.ResolveParams{Args: map[string]interface{}{}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, p, item)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindArchivedProjectsQueryErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	pucm.On("FindArchived").Return([]domain.Project{}, errors.New("test error"))
+
+	item, err := r.ResolveFindArchivedProjectsQuery(graphql.ResolveParams{Args: map[string]interface{}{}})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.Project{}, item)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddProjectLabelMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	l := &domain.Label{Name: "test-name", ColorHexCode: "FFFFFF", ProjectID: 2}
+
+	pucm.On("FindByID", uint(2)).Return(domain.Project{ID: 2}, nil)
+	pucm.On("FindByID", uint(3)).Return(domain.Project{}, errors.New("record not found"))
+	lucm.On("Add", "test-name", "FFFFFF", uint(2)).Return(l, nil)
+
+	inputMap := map[string]interface{}{
+		"name":         l.Name,
+		"colorHexCode": l.ColorHexCode,
+		"projectId":    relay.ToGlobalID("Project", "2"),
+	}
+
+	result, err := r.MutateAndGetPayloadForAddLabelMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, l, result["item"])
+
+	for _, projectID := range []string{relay.ToGlobalID("Project", "test"), relay.ToGlobalID("Project", "3")} {
+		inputMap["projectId"] = projectID
+
+		result, err := r.MutateAndGetPayloadForAddLabelMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindLabelsQueryInProject(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	l := []domain.Label{
+		domain.Label{ID: 1, Name: "test-name", ProjectID: 2},
+	}
+
+	lucm.On("Find", "test", uint(2)).Return(l, nil)
+
+	rp := graphql.ResolveParams{
+		Args: map[string]interface{}{
+			"name":      "test",
+			"projectId": relay.ToGlobalID("Project", "2"),
+		},
+	}
+
+	items, err := r.ResolveFindLabelsQuery(rp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &l, items)
+
+	rp.Args["projectId"] = relay.ToGlobalID("Project", "test")
+
+	_, err = r.ResolveFindLabelsQuery(rp)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFieldProjectLabels(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	l := []domain.Label{
+		domain.Label{ID: 1, Name: "test-global"},
+		domain.Label{ID: 2, Name: "test-project", ProjectID: 2},
+	}
+
+	lucm.On("Find", "", uint(2)).Return(l, nil)
+
+	for _, source := range []interface{}{domain.Project{ID: 2}, &domain.Project{ID: 2}} {
+		result, err := r.ResolveFieldProjectLabels(graphql.ResolveParams{
+			Source: source,
+			Args:   map[string]interface{}{},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result.(*relay.Connection).Edges))
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFieldProjectLabelsErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("Find", "", uint(2)).Return([]domain.Label{}, errors.New("test error"))
+
+	for _, source := range []interface{}{nil, domain.Project{ID: 2}} {
+		result, err := r.ResolveFieldProjectLabels(graphql.ResolveParams{
+			Source: source,
+			Args:   map[string]interface{}{},
+		})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForSetLabelGroupMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	l := domain.Label{ID: 1, Name: "high", GroupID: 2}
+
+	lucm.On("SetGroup", uint(1), uint(2)).Return(l, nil)
+	lucm.On("SetGroup", uint(1), uint(0)).Return(domain.Label{ID: 1, Name: "high"}, nil)
+
+	inputMap := map[string]interface{}{
+		"id":      relay.ToGlobalID("Label", "1"),
+		"groupId": relay.ToGlobalID("LabelGroup", "2"),
+	}
+
+	result, err := r.MutateAndGetPayloadForSetLabelGroupMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, l, result["item"])
+
+	inputMap = map[string]interface{}{
+		"id": relay.ToGlobalID("Label", "1"),
+	}
+
+	result, err = r.MutateAndGetPayloadForSetLabelGroupMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.Label{ID: 1, Name: "high"}, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForSetLabelGroupMutationErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("SetGroup", uint(1), uint(3)).Return(domain.Label{}, errors.New("record not found"))
+
+	tests := []map[string]interface{}{
+		{},
+		{"id": relay.ToGlobalID("Label", "1"), "groupId": relay.ToGlobalID("LabelGroup", "test")},
+		{"id": relay.ToGlobalID("Label", "1"), "groupId": relay.ToGlobalID("LabelGroup", "3")},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForSetLabelGroupMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddLabelGroupMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	g := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+
+	lucm.On("AddGroup", "priority", true).Return(g, nil)
+
+	inputMap := map[string]interface{}{
+		"name":      "priority",
+		"exclusive": true,
+	}
+
+	result, err := r.MutateAndGetPayloadForAddLabelGroupMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, g, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddLabelGroupMutationErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("AddGroup", "priority", false).Return((*domain.LabelGroup)(nil), errors.New("priority label group already exists"))
+
+	tests := []map[string]interface{}{
+		{},
+		{"name": ""},
+		{"name": "priority"},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForAddLabelGroupMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForUpdateLabelGroupMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	g := domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+
+	lucm.On("UpdateGroup", uint(1), "priority", true).Return(g, nil)
+
+	inputMap := map[string]interface{}{
+		"id":        relay.ToGlobalID("LabelGroup", "1"),
+		"name":      "priority",
+		"exclusive": true,
+	}
+
+	result, err := r.MutateAndGetPayloadForUpdateLabelGroupMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, g, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForUpdateLabelGroupMutationErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("UpdateGroup", uint(1), "priority", false).Return(domain.LabelGroup{}, errors.New("test error"))
+
+	tests := []map[string]interface{}{
+		{},
+		{"id": relay.ToGlobalID("LabelGroup", "1")},
+		{"id": relay.ToGlobalID("LabelGroup", "1"), "name": "priority"},
+	}
+
+	for _, inputMap := range tests {
+		_, err := r.MutateAndGetPayloadForUpdateLabelGroupMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForRemoveLabelGroupMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("RemoveGroup", uint(1)).Return(true, nil)
+	lucm.On("RemoveGroup", uint(2)).Return(false, errors.New("test error"))
+
+	result, err := r.MutateAndGetPayloadForRemoveLabelGroupMutation(nil, map[string]interface{}{
+		"id": relay.ToGlobalID("LabelGroup", "1"),
+	}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+	assert.Equal(t, uint(1), result["id"])
+
+	result, err = r.MutateAndGetPayloadForRemoveLabelGroupMutation(nil, map[string]interface{}{
+		"id": relay.ToGlobalID("LabelGroup", "2"),
+	}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	result, err = r.MutateAndGetPayloadForRemoveLabelGroupMutation(nil, map[string]interface{}{}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindLabelGroupByIDQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	g := domain.LabelGroup{ID: 1, Name: "priority"}
+
+	lucm.On("FindGroupByID", uint(1)).Return(g, nil)
+	lucm.On("FindGroupByID", uint(2)).Return(domain.LabelGroup{}, errors.New("record not found"))
+
+	item, err := r.ResolveFindLabelGroupByIDQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"id": relay.ToGlobalID("LabelGroup", "1")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &g, item)
+
+	item, err = r.ResolveFindLabelGroupByIDQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"id": relay.ToGlobalID("LabelGroup", "2")},
+	})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindAllLabelGroupsQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	groups := []domain.LabelGroup{{ID: 1, Name: "priority"}}
+
+	lucm.On("FindAllGroups").Return(groups, nil).Once()
+	lucm.On("FindAllGroups").Return([]domain.LabelGroup{}, errors.New("test error")).Once()
+
+	items, err := r.ResolveFindAllLabelGroupsQuery(graphql.ResolveParams{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, groups, items)
+
+	_, err = r.ResolveFindAllLabelGroupsQuery(graphql.ResolveParams{})
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFieldProjectTemplates(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	templates := []domain.IssueTemplate{{ID: 1, ProjectID: 2, Name: "bug"}}
+
+	pucm.On("FindTemplates", uint(2)).Return(templates, nil)
+
+	for _, source := range []interface{}{domain.Project{ID: 2}, &domain.Project{ID: 2}} {
+		result, err := r.ResolveFieldProjectTemplates(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, templates, result)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFieldProjectTemplatesErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	pucm.On("FindTemplates", uint(2)).Return([]domain.IssueTemplate{}, errors.New("test error"))
+
+	for _, source := range []interface{}{nil, domain.Project{ID: 2}} {
+		result, err := r.ResolveFieldProjectTemplates(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindIssueTemplateByIDQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	tpl := domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "bug"}
+
+	pucm.On("FindTemplateByID", uint(1)).Return(tpl, nil)
+	pucm.On("FindTemplateByID", uint(2)).Return(domain.IssueTemplate{}, errors.New("record not found"))
+
+	item, err := r.ResolveFindIssueTemplateByIDQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"id": relay.ToGlobalID("IssueTemplate", "1")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &tpl, item)
+
+	item, err = r.ResolveFindIssueTemplateByIDQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"id": relay.ToGlobalID("IssueTemplate", "2")},
+	})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	_, err = r.ResolveFindIssueTemplateByIDQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{},
+	})
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindIssueTemplatesQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	templates := []domain.IssueTemplate{{ID: 1, ProjectID: 1, Name: "bug"}}
+
+	pucm.On("FindTemplates", uint(1)).Return(templates, nil).Once()
+	pucm.On("FindTemplates", uint(1)).Return([]domain.IssueTemplate{}, errors.New("test error")).Once()
+
+	rp := graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1")},
+	}
+
+	items, err := r.ResolveFindIssueTemplatesQuery(rp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, templates, items)
+
+	_, err = r.ResolveFindIssueTemplatesQuery(rp)
+
+	assert.NotNil(t, err)
+
+	for _, args := range []map[string]interface{}{{}, {"projectId": relay.ToGlobalID("Project", "test")}} {
+		_, err = r.ResolveFindIssueTemplatesQuery(graphql.ResolveParams{Args: args})
+
+		assert.NotNil(t, err)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddIssueTemplateMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	l := domain.Label{ID: 2, Name: "bug", ProjectID: 1}
+	status := 1
+	tpl := &domain.IssueTemplate{
+		ID:          1,
+		ProjectID:   1,
+		Name:        "bug",
+		TitlePrefix: "[Bug] ",
+		Description: "## Steps",
+		Status:      &status,
+		Labels:      []domain.Label{l},
+	}
+
+	lucm.On("FindByID", uint(2)).Return(l, nil)
+	pucm.On("AddTemplate", uint(1), "bug", "[Bug] ", "## Steps", &status, []domain.Label{l}).Return(tpl, nil)
+
+	inputMap := map[string]interface{}{
+		"projectId":   relay.ToGlobalID("Project", "1"),
+		"name":        "bug",
+		"titlePrefix": "[Bug] ",
+		"description": "## Steps",
+		"status":      1,
+		"labels":      relay.ToGlobalID("Label", "2"),
+	}
+
+	result, err := r.MutateAndGetPayloadForAddIssueTemplateMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, tpl, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddIssueTemplateMutationErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	lucm.On("FindByID", uint(2)).Return(domain.Label{}, errors.New("record not found"))
+	pucm.On("AddTemplate", uint(1), "bug", "", "", (*int)(nil), []domain.Label{}).Return((*domain.IssueTemplate)(nil), errors.New("project is archived"))
+
+	tests := []map[string]interface{}{
+		{},
+		{"projectId": relay.ToGlobalID("Project", "test")},
+		{"projectId": relay.ToGlobalID("Project", "1"), "name": ""},
+		{"projectId": relay.ToGlobalID("Project", "1"), "name": "bug", "labels": relay.ToGlobalID("Label", "2")},
+		{"projectId": relay.ToGlobalID("Project", "1"), "name": "bug"},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForAddIssueTemplateMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForUpdateIssueTemplateMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	status := 2
+	tpl := domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "feature", Status: &status}
+
+	pucm.On("UpdateTemplate", uint(1), "feature", "", "", &status, []domain.Label{}).Return(tpl, nil)
+
+	inputMap := map[string]interface{}{
+		"id":     relay.ToGlobalID("IssueTemplate", "1"),
+		"name":   "feature",
+		"status": 2,
+	}
+
+	result, err := r.MutateAndGetPayloadForUpdateIssueTemplateMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, tpl, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForUpdateIssueTemplateMutationErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	status := 3
+	pucm.On("UpdateTemplate", uint(1), "bug", "", "", &status, []domain.Label{}).Return(domain.IssueTemplate{}, errors.New("status 3 is not allowed in project"))
+
+	tests := []map[string]interface{}{
+		{},
+		{"id": relay.ToGlobalID("IssueTemplate", "1"), "name": ""},
+		{"id": relay.ToGlobalID("IssueTemplate", "1"), "name": "bug", "labels": "test"},
+		{"id": relay.ToGlobalID("IssueTemplate", "1"), "name": "bug", "status": 3},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForUpdateIssueTemplateMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForRemoveIssueTemplateMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	pucm.On("RemoveTemplate", uint(1)).Return(true, nil).Once()
+	pucm.On("RemoveTemplate", uint(1)).Return(false, errors.New("test error")).Once()
+
+	inputMap := map[string]interface{}{
+		"id": relay.ToGlobalID("IssueTemplate", "1"),
+	}
+
+	result, err := r.MutateAndGetPayloadForRemoveIssueTemplateMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+
+	result, err = r.MutateAndGetPayloadForRemoveIssueTemplateMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	result, err = r.MutateAndGetPayloadForRemoveIssueTemplateMutation(nil, map[string]interface{}{}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFieldProjectCustomFields(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	fields := []domain.CustomField{{ID: 1, ProjectID: 2, Name: "severity", Type: domain.CustomFieldTypeText}}
+
+	pucm.On("FindCustomFields", uint(2)).Return(fields, nil).Twice()
+	pucm.On("FindCustomFields", uint(2)).Return([]domain.CustomField{}, errors.New("test error")).Once()
+
+	for _, source := range []interface{}{domain.Project{ID: 2}, &domain.Project{ID: 2}} {
+		result, err := r.ResolveFieldProjectCustomFields(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, fields, result)
+	}
+
+	for _, source := range []interface{}{nil, domain.Project{ID: 2}} {
+		result, err := r.ResolveFieldProjectCustomFields(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindCustomFieldByIDQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "severity", Type: domain.CustomFieldTypeText}
+
+	pucm.On("FindCustomFieldByID", uint(1)).Return(f, nil)
+	pucm.On("FindCustomFieldByID", uint(2)).Return(domain.CustomField{}, errors.New("record not found"))
+
+	item, err := r.ResolveFindCustomFieldByIDQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"id": relay.ToGlobalID("CustomField", "1")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &f, item)
+
+	item, err = r.ResolveFindCustomFieldByIDQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"id": relay.ToGlobalID("CustomField", "2")},
+	})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	_, err = r.ResolveFindCustomFieldByIDQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{},
+	})
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindCustomFieldsQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	fields := []domain.CustomField{{ID: 1, ProjectID: 1, Name: "severity", Type: domain.CustomFieldTypeText}}
+
+	pucm.On("FindCustomFields", uint(1)).Return(fields, nil).Once()
+	pucm.On("FindCustomFields", uint(1)).Return([]domain.CustomField{}, errors.New("test error")).Once()
+
+	rp := graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1")},
+	}
+
+	items, err := r.ResolveFindCustomFieldsQuery(rp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, fields, items)
+
+	_, err = r.ResolveFindCustomFieldsQuery(rp)
+
+	assert.NotNil(t, err)
+
+	for _, args := range []map[string]interface{}{{}, {"projectId": relay.ToGlobalID("Project", "test")}} {
+		_, err = r.ResolveFindCustomFieldsQuery(graphql.ResolveParams{Args: args})
+
+		assert.NotNil(t, err)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddCustomFieldMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	f := &domain.CustomField{ID: 1, ProjectID: 1, Name: "severity", Type: domain.CustomFieldTypeSelect, Options: "low,high"}
+
+	pucm.On("AddCustomField", uint(1), "severity", "select", "low,high").Return(f, nil)
+
+	inputMap := map[string]interface{}{
+		"projectId": relay.ToGlobalID("Project", "1"),
+		"name":      "severity",
+		"type":      "select",
+		"options":   "low,high",
+	}
+
+	result, err := r.MutateAndGetPayloadForAddCustomFieldMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, f, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddCustomFieldMutationErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	pucm.On("AddCustomField", uint(1), "severity", "color", "").Return((*domain.CustomField)(nil), errors.New("custom field type color not valid"))
+
+	tests := []map[string]interface{}{
+		{},
+		{"projectId": relay.ToGlobalID("Project", "test")},
+		{"projectId": relay.ToGlobalID("Project", "1"), "name": "severity", "type": "color"},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForAddCustomFieldMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForUpdateCustomFieldMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "priority", Type: domain.CustomFieldTypeText}
+
+	pucm.On("UpdateCustomField", uint(1), "priority", "").Return(f, nil).Once()
+	pucm.On("UpdateCustomField", uint(1), "", "").Return(domain.CustomField{}, errors.New("name not provided")).Once()
+
+	inputMap := map[string]interface{}{
+		"id":   relay.ToGlobalID("CustomField", "1"),
+		"name": "priority",
+	}
+
+	result, err := r.MutateAndGetPayloadForUpdateCustomFieldMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, f, result["item"])
+
+	for _, inputMap := range []map[string]interface{}{{}, {"id": relay.ToGlobalID("CustomField", "1")}} {
+		result, err = r.MutateAndGetPayloadForUpdateCustomFieldMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForRemoveCustomFieldMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	pucm.On("RemoveCustomField", uint(1)).Return(true, nil).Once()
+	pucm.On("RemoveCustomField", uint(1)).Return(false, errors.New("test error")).Once()
+
+	inputMap := map[string]interface{}{
+		"id": relay.ToGlobalID("CustomField", "1"),
+	}
+
+	result, err := r.MutateAndGetPayloadForRemoveCustomFieldMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+
+	result, err = r.MutateAndGetPayloadForRemoveCustomFieldMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	result, err = r.MutateAndGetPayloadForRemoveCustomFieldMutation(nil, map[string]interface{}{}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForSetIssueCustomFieldsMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	fields := []domain.CustomField{{ID: 2, ProjectID: 1, Name: "estimate", Type: domain.CustomFieldTypeNumber}}
+	i := domain.Issue{ID: 1, ProjectID: 1}
+	item := domain.Issue{ID: 1, ProjectID: 1, CustomFields: []domain.CustomFieldValue{{IssueID: 1, FieldID: 2, Field: fields[0], Value: "3"}}}
+
+	iucm.On("FindByID", uint(1)).Return(i, nil)
+	pucm.On("FindCustomFields", uint(1)).Return(fields, nil)
+	iucm.On("SetCustomFields", uint(1), fields, map[string]string{"estimate": "3"}).Return(item, nil)
+
+	inputMap := map[string]interface{}{
+		"id":           relay.ToGlobalID("Issue", "1"),
+		"customFields": []interface{}{"estimate:3"},
+	}
+
+	result, err := r.MutateAndGetPayloadForSetIssueCustomFieldsMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, item, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForSetIssueCustomFieldsMutationErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	fields := []domain.CustomField{{ID: 2, ProjectID: 1, Name: "estimate", Type: domain.CustomFieldTypeNumber}}
+
+	iucm.On("FindByID", uint(1)).Return(domain.Issue{ID: 1, ProjectID: 1}, nil)
+	iucm.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	pucm.On("FindCustomFields", uint(1)).Return(fields, nil)
+	iucm.On("SetCustomFields", uint(1), fields, map[string]string{"estimate": "many"}).Return(domain.Issue{}, errors.New("estimate value is not a number"))
+
+	tests := []map[string]interface{}{
+		{},
+		{"id": relay.ToGlobalID("Issue", "1")},
+		{"id": relay.ToGlobalID("Issue", "1"), "customFields": []interface{}{"estimate"}},
+		{"id": relay.ToGlobalID("Issue", "2"), "customFields": []interface{}{"estimate:3"}},
+		{"id": relay.ToGlobalID("Issue", "1"), "customFields": []interface{}{"estimate:many"}},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForSetIssueCustomFieldsMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFieldProjectTimeSummary(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	summary := domain.TimeSummary{OriginalEstimate: 480, RemainingEstimate: 120, TimeSpent: 390}
+
+	iucm.On("FindTimeSummary", uint(2)).Return(summary, nil).Twice()
+	iucm.On("FindTimeSummary", uint(2)).Return(domain.TimeSummary{}, errors.New("test error")).Once()
+
+	for _, source := range []interface{}{domain.Project{ID: 2}, &domain.Project{ID: 2}} {
+		result, err := r.ResolveFieldProjectTimeSummary(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, summary, result)
+	}
+
+	for _, source := range []interface{}{nil, domain.Project{ID: 2}} {
+		result, err := r.ResolveFieldProjectTimeSummary(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFieldIssueWorkLogs(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	w := []domain.WorkLog{{ID: 1, IssueID: 2, Author: "alice", Minutes: 90}}
+
+	iucm.On("FindWorkLogs", uint(2)).Return(w, nil).Twice()
+	iucm.On("FindWorkLogs", uint(2)).Return([]domain.WorkLog{}, errors.New("test error")).Once()
+
+	for _, source := range []interface{}{domain.Issue{ID: 2}, &domain.Issue{ID: 2}} {
+		result, err := r.ResolveFieldIssueWorkLogs(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, w, result)
+	}
+
+	for _, source := range []interface{}{nil, domain.Issue{ID: 2}} {
+		result, err := r.ResolveFieldIssueWorkLogs(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFieldIssueAttachments(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	a := []domain.Attachment{{ID: 1, IssueID: 2, Filename: "report.pdf"}}
+
+	iucm.On("FindAttachments", uint(2)).Return(a, nil).Twice()
+	iucm.On("FindAttachments", uint(2)).Return([]domain.Attachment{}, errors.New("test error")).Once()
+
+	for _, source := range []interface{}{domain.Issue{ID: 2}, &domain.Issue{ID: 2}} {
+		result, err := r.ResolveFieldIssueAttachments(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, a, result)
+	}
+
+	for _, source := range []interface{}{nil, domain.Issue{ID: 2}} {
+		result, err := r.ResolveFieldIssueAttachments(graphql.ResolveParams{
+			Source: source,
+		})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveTimesheetQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	from := time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	entries := []domain.TimesheetEntry{{Author: "alice", Week: "2020-W02", WeekStart: from, Minutes: 90}}
+
+	iucm.On("FindTimesheet", uint(1), from, to).Return(entries, nil).Once()
+	iucm.On("FindTimesheet", uint(0), to.AddDate(0, 0, -domain.DefaultTimesheetDays), to).Return(entries, nil).Once()
+	iucm.On("FindTimesheet", uint(1), from, to).Return([]domain.TimesheetEntry{}, errors.New("time range not valid")).Once()
+
+	items, err := r.ResolveTimesheetQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1"), "from": from, "to": to},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, entries, items)
+
+	items, err = r.ResolveTimesheetQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"to": to},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, entries, items)
+
+	_, err = r.ResolveTimesheetQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1"), "from": from, "to": to},
+	})
+
+	assert.NotNil(t, err)
+
+	_, err = r.ResolveTimesheetQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "test")},
+	})
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForSetIssueEstimatesMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	i := domain.Issue{ID: 1, OriginalEstimate: 480, RemainingEstimate: 90}
+
+	iucm.On("SetEstimates", uint(1), 480, 90).Return(i, nil)
+	iucm.On("SetEstimates", uint(1), 60, domain.EstimateDefault).Return(domain.Issue{}, errors.New("project is archived"))
+
+	inputMap := map[string]interface{}{
+		"id":                relay.ToGlobalID("Issue", "1"),
+		"originalEstimate":  "8h",
+		"remainingEstimate": "90",
+	}
+
+	result, err := r.MutateAndGetPayloadForSetIssueEstimatesMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, result["item"])
+
+	tests := []map[string]interface{}{
+		{},
+		{"id": relay.ToGlobalID("Issue", "1")},
+		{"id": relay.ToGlobalID("Issue", "1"), "originalEstimate": "1w"},
+		{"id": relay.ToGlobalID("Issue", "1"), "originalEstimate": "60", "remainingEstimate": "x"},
+		{"id": relay.ToGlobalID("Issue", "1"), "originalEstimate": "60"},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForSetIssueEstimatesMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForAddWorkLogMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	loggedAt := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	w := &domain.WorkLog{ID: 1, IssueID: 1, Author: "alice", Minutes: 90, LoggedAt: loggedAt, Comment: "review"}
+
+	iucm.On("AddWorkLog", uint(1), "alice", 90, loggedAt, "review").Return(w, nil)
+	iucm.On("AddWorkLog", uint(1), "", 30, time.Time{}, "").Return((*domain.WorkLog)(nil), errors.New("author not provided"))
+
+	inputMap := map[string]interface{}{
+		"issueId":   relay.ToGlobalID("Issue", "1"),
+		"author":    "alice",
+		"timeSpent": "1h30m",
+		"loggedAt":  loggedAt,
+		"comment":   "review",
+	}
+
+	result, err := r.MutateAndGetPayloadForAddWorkLogMutation(nil, inputMap, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, result["item"])
+
+	tests := []map[string]interface{}{
+		{},
+		{"issueId": relay.ToGlobalID("Issue", "test"), "timeSpent": "30"},
+		{"issueId": relay.ToGlobalID("Issue", "1"), "timeSpent": "soon"},
+		{"issueId": relay.ToGlobalID("Issue", "1"), "timeSpent": "30"},
+	}
+
+	for _, inputMap := range tests {
+		result, err := r.MutateAndGetPayloadForAddWorkLogMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForRemoveWorkLogMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	iucm.On("RemoveWorkLog", uint(1)).Return(true, nil)
+	iucm.On("RemoveWorkLog", uint(2)).Return(false, errors.New("record not found"))
+
+	result, err := r.MutateAndGetPayloadForRemoveWorkLogMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("WorkLog", "1")}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+
+	for _, inputMap := range []map[string]interface{}{{}, {"id": relay.ToGlobalID("WorkLog", "2")}} {
+		result, err := r.MutateAndGetPayloadForRemoveWorkLogMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, false, result["status"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMutateAndGetPayloadForRemoveAttachmentMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, r := prepareMocksAndResolver()
+
+	iucm.On("RemoveAttachment", uint(1)).Return(true, nil)
+	iucm.On("RemoveAttachment", uint(2)).Return(false, errors.New("record not found"))
+
+	result, err := r.MutateAndGetPayloadForRemoveAttachmentMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("Attachment", "1")}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+
+	for _, inputMap := range []map[string]interface{}{{}, {"id": relay.ToGlobalID("Attachment", "2")}} {
+		result, err := r.MutateAndGetPayloadForRemoveAttachmentMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, false, result["status"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestResolveFindWatchersQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, r := prepareNotificationMocksAndResolver()
+
+	watchers := []domain.Watcher{{ID: 1, User: "alice", IssueID: 1}}
+
+	nucm.On("FindWatchers", "alice", uint(0), uint(0)).Return(watchers, nil).Once()
+	nucm.On("FindWatchers", "", uint(1), uint(2)).Return(watchers, nil).Once()
+	nucm.On("FindWatchers", "bob", uint(0), uint(0)).Return([]domain.Watcher{}, errors.New("database error")).Once()
+
+	items, err := r.ResolveFindWatchersQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"user": "alice"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, watchers, items)
+
+	items, err = r.ResolveFindWatchersQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"issueId": relay.ToGlobalID("Issue", "1"), "projectId": relay.ToGlobalID("Project", "2")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, watchers, items)
+
+	for _, args := range []map[string]interface{}{{"user": "bob"}, {"issueId": relay.ToGlobalID("Issue", "test")}, {"projectId": relay.ToGlobalID("Project", "test")}} {
+		_, err = r.ResolveFindWatchersQuery(graphql.ResolveParams{Args: args})
+
+		assert.NotNil(t, err)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestResolveFindNotificationsQuery(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, r := prepareNotificationMocksAndResolver()
+
+	notifications := []domain.Notification{{ID: 1, User: "alice", IssueID: 1, Event: domain.NotificationEventUpdated}}
+
+	nucm.On("FindNotifications", "alice", true).Return(notifications, nil).Once()
+	nucm.On("FindNotifications", "", false).Return([]domain.Notification{}, errors.New("user not provided")).Once()
+
+	items, err := r.ResolveFindNotificationsQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"user": "alice", "unread": true},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, notifications, items)
+
+	_, err = r.ResolveFindNotificationsQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{},
+	})
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForWatchIssueMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, r := prepareNotificationMocksAndResolver()
+
+	issue := domain.Issue{ID: 1}
+	watcher := &domain.Watcher{ID: 1, User: "alice", IssueID: 1}
+
+	iucm.On("FindByID", uint(1)).Return(issue, nil)
+	iucm.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	nucm.On("WatchIssue", "alice", issue).Return(watcher, nil).Once()
+	nucm.On("WatchIssue", "", issue).Return(new(domain.Watcher), errors.New("user not provided")).Once()
+
+	result, err := r.MutateAndGetPayloadForWatchIssueMutation(nil, map[string]interface{}{"issueId": relay.ToGlobalID("Issue", "1"), "user": "alice"}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, watcher, result["item"])
+
+	for _, inputMap := range []map[string]interface{}{
+		{},
+		{"issueId": relay.ToGlobalID("Issue", "test")},
+		{"issueId": relay.ToGlobalID("Issue", "2"), "user": "alice"},
+		{"issueId": relay.ToGlobalID("Issue", "1")},
+	} {
+		result, err := r.MutateAndGetPayloadForWatchIssueMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForWatchProjectMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, r := prepareNotificationMocksAndResolver()
+
+	project := domain.Project{ID: 1}
+	watcher := &domain.Watcher{ID: 1, User: "alice", ProjectID: 1}
+
+	pucm.On("FindByID", uint(1)).Return(project, nil)
+	pucm.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	nucm.On("WatchProject", "alice", project).Return(watcher, nil).Once()
+	nucm.On("WatchProject", "", project).Return(new(domain.Watcher), errors.New("user not provided")).Once()
+
+	result, err := r.MutateAndGetPayloadForWatchProjectMutation(nil, map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1"), "user": "alice"}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, watcher, result["item"])
+
+	for _, inputMap := range []map[string]interface{}{
+		{},
+		{"projectId": relay.ToGlobalID("Project", "test")},
+		{"projectId": relay.ToGlobalID("Project", "2"), "user": "alice"},
+		{"projectId": relay.ToGlobalID("Project", "1")},
+	} {
+		result, err := r.MutateAndGetPayloadForWatchProjectMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForUnwatchMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, r := prepareNotificationMocksAndResolver()
+
+	nucm.On("Unwatch", uint(1)).Return(true, nil)
+	nucm.On("Unwatch", uint(2)).Return(false, errors.New("record not found"))
+
+	result, err := r.MutateAndGetPayloadForUnwatchMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("Watcher", "1")}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+
+	for _, inputMap := range []map[string]interface{}{{}, {"id": relay.ToGlobalID("Watcher", "2")}} {
+		result, err := r.MutateAndGetPayloadForUnwatchMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, false, result["status"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForMarkNotificationReadMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, r := prepareNotificationMocksAndResolver()
+
+	nucm.On("MarkNotificationRead", uint(1), true).Return(domain.Notification{ID: 1, Read: true}, nil).Once()
+	nucm.On("MarkNotificationRead", uint(1), false).Return(domain.Notification{ID: 1}, nil).Once()
+	nucm.On("MarkNotificationRead", uint(2), true).Return(domain.Notification{}, errors.New("record not found")).Once()
+
+	result, err := r.MutateAndGetPayloadForMarkNotificationReadMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("Notification", "1")}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.Notification{ID: 1, Read: true}, result["item"])
+
+	result, err = r.MutateAndGetPayloadForMarkNotificationReadMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("Notification", "1"), "read": false}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.Notification{ID: 1}, result["item"])
+
+	for _, inputMap := range []map[string]interface{}{{}, {"id": relay.ToGlobalID("Notification", "2")}} {
+		result, err := r.MutateAndGetPayloadForMarkNotificationReadMutation(nil, inputMap, graphql.ResolveInfo{})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result["item"])
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForMarkAllNotificationsReadMutation(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, r := prepareNotificationMocksAndResolver()
+
+	nucm.On("MarkAllNotificationsRead", "alice").Return(3, nil)
+	nucm.On("MarkAllNotificationsRead", "").Return(0, errors.New("user not provided"))
+
+	result, err := r.MutateAndGetPayloadForMarkAllNotificationsReadMutation(nil, map[string]interface{}{"user": "alice"}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result["item"])
+
+	result, err = r.MutateAndGetPayloadForMarkAllNotificationsReadMutation(nil, map[string]interface{}{}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, result["item"])
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestResolveFindMailPreferenceQuery(t *testing.T) {
+	mucm, r := prepareMailMocksAndResolver()
+
+	preference := domain.MailPreference{ID: 1, User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryDigest}
+
+	mucm.On("FindPreference", "alice").Return(preference, nil)
+	mucm.On("FindPreference", "bob").Return(domain.MailPreference{}, errors.New("record not found"))
+	mucm.On("FindPreference", "").Return(domain.MailPreference{}, errors.New("test error"))
+
+	item, err := r.ResolveFindMailPreferenceQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"user": "alice"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, preference, item)
+
+	item, err = r.ResolveFindMailPreferenceQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"user": "bob"},
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, item)
+
+	_, err = r.ResolveFindMailPreferenceQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{},
+	})
+
+	assert.NotNil(t, err)
+
+	mucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForSetMailPreferenceMutation(t *testing.T) {
+	mucm, r := prepareMailMocksAndResolver()
+
+	preference := &domain.MailPreference{ID: 1, User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryDigest}
+
+	mucm.On("SetPreference", "alice", "alice@example.com", "digest").Return(preference, nil)
+	mucm.On("SetPreference", "alice", "alice", "").Return((*domain.MailPreference)(nil), errors.New("email alice not valid"))
+
+	result, err := r.MutateAndGetPayloadForSetMailPreferenceMutation(nil, map[string]interface{}{"user": "alice", "email": "alice@example.com", "delivery": "digest"}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, preference, result["item"])
+
+	result, err = r.MutateAndGetPayloadForSetMailPreferenceMutation(nil, map[string]interface{}{"user": "alice", "email": "alice"}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, result["item"])
+
+	mucm.AssertExpectations(t)
+}
+
+func TestResolveWebhookNodeID(t *testing.T) {
+	_, wucm, r := prepareWebhookMocksAndResolver()
+
+	wucm.On("FindByID", uint(1)).Return(domain.Webhook{ID: 1}, nil)
+	wucm.On("FindDeliveryByID", uint(2)).Return(domain.WebhookDelivery{ID: 2}, nil)
+
+	item, err := r.ResolveNodeID(nil, relay.ToGlobalID("Webhook", "1"), graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.Webhook{ID: 1}, item)
+
+	item, err = r.ResolveNodeID(nil, relay.ToGlobalID("WebhookDelivery", "2"), graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.WebhookDelivery{ID: 2}, item)
+
+	wucm.AssertExpectations(t)
+}
+
+func TestResolveFindWebhooksQuery(t *testing.T) {
+	_, wucm, r := prepareWebhookMocksAndResolver()
+
+	webhooks := []domain.Webhook{{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook"}}
+	deliveries := []domain.WebhookDelivery{{ID: 1, WebhookID: 1, Event: domain.WebhookEventIssueCreated}}
+
+	wucm.On("Find", uint(1)).Return(webhooks, nil)
+	wucm.On("Find", uint(2)).Return([]domain.Webhook{}, errors.New("test error"))
+	wucm.On("FindDeliveries", uint(1)).Return(deliveries, nil)
+
+	items, err := r.ResolveFindWebhooksQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, webhooks, items)
+
+	_, err = r.ResolveFindWebhooksQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "2")},
+	})
+
+	assert.NotNil(t, err)
+
+	_, err = r.ResolveFindWebhooksQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{},
+	})
+
+	assert.Equal(t, "project id not provided", err.Error())
+
+	items, err = r.ResolveFindWebhookDeliveriesQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"webhookId": relay.ToGlobalID("Webhook", "1")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, deliveries, items)
+
+	_, err = r.ResolveFindWebhookDeliveriesQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{},
+	})
+
+	assert.Equal(t, "webhook id not provided", err.Error())
+
+	wucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForAddWebhookMutation(t *testing.T) {
+	pucm, wucm, r := prepareWebhookMocksAndResolver()
+
+	project := domain.Project{ID: 1}
+	webhook := &domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook", Events: "issue.created", Active: true}
+
+	pucm.On("FindByID", uint(1)).Return(project, nil)
+	pucm.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	wucm.On("Add", project, "https://ci.example.com/hook", "", []string{"issue.created"}).Return(webhook, nil)
+	wucm.On("Add", project, "ci", "", []string{}).Return((*domain.Webhook)(nil), errors.New("url ci not valid"))
+
+	result, err := r.MutateAndGetPayloadForAddWebhookMutation(nil, map[string]interface{}{
+		"projectId": relay.ToGlobalID("Project", "1"),
+		"url":       "https://ci.example.com/hook",
+		"events":    []interface{}{"issue.created"},
+	}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, webhook, result["item"])
+
+	tests := []struct {
+		inputMap map[string]interface{}
+		err      string
+	}{
+		{map[string]interface{}{"url": "https://ci.example.com/hook"}, "project id not provided"},
+		{map[string]interface{}{"projectId": relay.ToGlobalID("Project", "2"), "url": "https://ci.example.com/hook"}, "record not found"},
+		{map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1"), "url": "ci"}, "url ci not valid"},
+	}
+
+	for _, ts := range tests {
+		result, err := r.MutateAndGetPayloadForAddWebhookMutation(nil, ts.inputMap, graphql.ResolveInfo{})
+
+		assert.Equal(t, ts.err, err.Error())
+		assert.Nil(t, result["item"])
+	}
+
+	pucm.AssertExpectations(t)
+	wucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForUpdateWebhookMutation(t *testing.T) {
+	_, wucm, r := prepareWebhookMocksAndResolver()
+
+	webhook := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/v2"}
+
+	wucm.On("Update", uint(1), "https://ci.example.com/v2", "", []string{}, false).Return(webhook, nil)
+	wucm.On("Update", uint(2), "https://ci.example.com/v2", "other", []string{}, true).Return(domain.Webhook{}, errors.New("record not found"))
+
+	result, err := r.MutateAndGetPayloadForUpdateWebhookMutation(nil, map[string]interface{}{
+		"id":     relay.ToGlobalID("Webhook", "1"),
+		"url":    "https://ci.example.com/v2",
+		"active": false,
+	}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, webhook, result["item"])
+
+	result, err = r.MutateAndGetPayloadForUpdateWebhookMutation(nil, map[string]interface{}{
+		"id":     relay.ToGlobalID("Webhook", "2"),
+		"url":    "https://ci.example.com/v2",
+		"secret": "other",
+	}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, result["item"])
+
+	_, err = r.MutateAndGetPayloadForUpdateWebhookMutation(nil, map[string]interface{}{}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+
+	wucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForRemoveWebhookMutation(t *testing.T) {
+	_, wucm, r := prepareWebhookMocksAndResolver()
+
+	wucm.On("Remove", uint(1)).Return(true, nil)
+	wucm.On("Remove", uint(2)).Return(false, errors.New("record not found"))
+
+	result, err := r.MutateAndGetPayloadForRemoveWebhookMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("Webhook", "1")}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+	assert.Equal(t, uint(1), result["id"])
+
+	result, err = r.MutateAndGetPayloadForRemoveWebhookMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("Webhook", "2")}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	wucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForRedeliverWebhookDeliveryMutation(t *testing.T) {
+	_, wucm, r := prepareWebhookMocksAndResolver()
+
+	delivery := &domain.WebhookDelivery{ID: 2, WebhookID: 1, Event: domain.WebhookEventIssueCreated, Status: domain.WebhookDeliveryQueued}
+
+	wucm.On("Redeliver", uint(1)).Return(delivery, nil)
+	wucm.On("Redeliver", uint(3)).Return((*domain.WebhookDelivery)(nil), errors.New("record not found"))
+
+	result, err := r.MutateAndGetPayloadForRedeliverWebhookDeliveryMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("WebhookDelivery", "1")}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, delivery, result["item"])
+
+	result, err = r.MutateAndGetPayloadForRedeliverWebhookDeliveryMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("WebhookDelivery", "3")}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, result["item"])
+
+	wucm.AssertExpectations(t)
+}
+
+func TestResolveAutomationRuleNodeID(t *testing.T) {
+	_, aucm, r := prepareAutomationMocksAndResolver()
+
+	aucm.On("FindByID", uint(1)).Return(domain.AutomationRule{ID: 1}, nil)
+
+	item, err := r.ResolveNodeID(nil, relay.ToGlobalID("AutomationRule", "1"), graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.AutomationRule{ID: 1}, item)
+
+	aucm.AssertExpectations(t)
+}
+
+func TestResolveFindAutomationRulesQuery(t *testing.T) {
+	_, aucm, r := prepareAutomationMocksAndResolver()
+
+	rules := []domain.AutomationRule{{ID: 1, ProjectID: 1, Name: "Security"}}
+	executions := []domain.AutomationExecution{{ID: 1, RuleID: 1, IssueID: 1, Status: domain.AutomationExecutionApplied}}
+
+	aucm.On("Find", uint(1)).Return(rules, nil)
+	aucm.On("Find", uint(2)).Return([]domain.AutomationRule{}, errors.New("test error"))
+	aucm.On("FindExecutions", uint(1)).Return(executions, nil)
+
+	items, err := r.ResolveFindAutomationRulesQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, rules, items)
+
+	_, err = r.ResolveFindAutomationRulesQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"projectId": relay.ToGlobalID("Project", "2")},
+	})
+
+	assert.NotNil(t, err)
+
+	_, err = r.ResolveFindAutomationRulesQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{},
+	})
+
+	assert.Equal(t, "project id not provided", err.Error())
+
+	items, err = r.ResolveFindAutomationExecutionsQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{"ruleId": relay.ToGlobalID("AutomationRule", "1")},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, executions, items)
+
+	_, err = r.ResolveFindAutomationExecutionsQuery(graphql.ResolveParams{
+		Args: map[string]interface{}{},
+	})
+
+	assert.Equal(t, "rule id not provided", err.Error())
+
+	aucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForAddAutomationRuleMutation(t *testing.T) {
+	pucm, aucm, r := prepareAutomationMocksAndResolver()
+
+	project := domain.Project{ID: 1}
+	rule := &domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Security", Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Actions: "setPriority:high,addLabel:triage", Active: true}
+
+	pucm.On("FindByID", uint(1)).Return(project, nil)
+	pucm.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	aucm.On("Add", project, "Security", domain.EventLabelsChanged, []string{"addedLabel:security"}, []string{"setPriority:high", "addLabel:triage"}).Return(rule, nil)
+	aucm.On("Add", project, "Security", domain.EventLabelsChanged, []string{}, []string{}).Return((*domain.AutomationRule)(nil), errors.New("actions not provided"))
+
+	result, err := r.MutateAndGetPayloadForAddAutomationRuleMutation(nil, map[string]interface{}{
+		"projectId":  relay.ToGlobalID("Project", "1"),
+		"name":       "Security",
+		"trigger":    domain.EventLabelsChanged,
+		"conditions": []interface{}{"addedLabel:security"},
+		"actions":    []interface{}{"setPriority:high", "addLabel:triage"},
+	}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, rule, result["item"])
+
+	tests := []struct {
+		inputMap map[string]interface{}
+		err      string
+	}{
+		{map[string]interface{}{"name": "Security"}, "project id not provided"},
+		{map[string]interface{}{"projectId": relay.ToGlobalID("Project", "2"), "name": "Security"}, "record not found"},
+		{map[string]interface{}{"projectId": relay.ToGlobalID("Project", "1"), "name": "Security", "trigger": domain.EventLabelsChanged}, "actions not provided"},
+	}
+
+	for _, ts := range tests {
+		result, err := r.MutateAndGetPayloadForAddAutomationRuleMutation(nil, ts.inputMap, graphql.ResolveInfo{})
+
+		assert.Equal(t, ts.err, err.Error())
+		assert.Nil(t, result["item"])
+	}
+
+	pucm.AssertExpectations(t)
+	aucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForUpdateAutomationRuleMutation(t *testing.T) {
+	_, aucm, r := prepareAutomationMocksAndResolver()
+
+	rule := domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Urgent", Trigger: domain.EventIssueCreated, Actions: "setSeverity:major"}
+
+	aucm.On("Update", uint(1), "Urgent", domain.EventIssueCreated, []string{}, []string{"setSeverity:major"}, false).Return(rule, nil)
+	aucm.On("Update", uint(2), "Urgent", domain.EventIssueCreated, []string{}, []string{"setSeverity:major"}, true).Return(domain.AutomationRule{}, errors.New("record not found"))
+
+	result, err := r.MutateAndGetPayloadForUpdateAutomationRuleMutation(nil, map[string]interface{}{
+		"id":      relay.ToGlobalID("AutomationRule", "1"),
+		"name":    "Urgent",
+		"trigger": domain.EventIssueCreated,
+		"actions": []interface{}{"setSeverity:major"},
+		"active":  false,
+	}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, rule, result["item"])
+
+	result, err = r.MutateAndGetPayloadForUpdateAutomationRuleMutation(nil, map[string]interface{}{
+		"id":      relay.ToGlobalID("AutomationRule", "2"),
+		"name":    "Urgent",
+		"trigger": domain.EventIssueCreated,
+		"actions": []interface{}{"setSeverity:major"},
+	}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, result["item"])
+
+	_, err = r.MutateAndGetPayloadForUpdateAutomationRuleMutation(nil, map[string]interface{}{}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+
+	aucm.AssertExpectations(t)
+}
+
+func TestMutateAndGetPayloadForRemoveAutomationRuleMutation(t *testing.T) {
+	_, aucm, r := prepareAutomationMocksAndResolver()
+
+	aucm.On("Remove", uint(1)).Return(true, nil)
+	aucm.On("Remove", uint(2)).Return(false, errors.New("record not found"))
+
+	result, err := r.MutateAndGetPayloadForRemoveAutomationRuleMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("AutomationRule", "1")}, graphql.ResolveInfo{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, true, result["status"])
+	assert.Equal(t, uint(1), result["id"])
+
+	result, err = r.MutateAndGetPayloadForRemoveAutomationRuleMutation(nil, map[string]interface{}{"id": relay.ToGlobalID("AutomationRule", "2")}, graphql.ResolveInfo{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, false, result["status"])
+
+	aucm.AssertExpectations(t)
+}
+
+func TestResolveIssueSubscriptions(t *testing.T) {
+	_, _, _, _, r := prepareMocksAndResolver()
+
+	issue := domain.Issue{ID: 1, Title: "Issue", ProjectID: 1}
+	source := func(event domain.DomainEvent) map[string]interface{} {
+		return map[string]interface{}{gql.SubscriptionEventKey: event}
+	}
+	project := func(id string) map[string]interface{} {
+		return map[string]interface{}{"projectId": relay.ToGlobalID("Project", id)}
+	}
+
+	item, err := r.ResolveIssueAddedSubscription(graphql.ResolveParams{Source: source(domain.IssueCreated{Issue: issue}), Args: project("1")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &issue, item)
+
+	item, err = r.ResolveIssueAddedSubscription(graphql.ResolveParams{Source: source(domain.IssueCreated{Issue: issue}), Args: project("2")})
+
+	assert.Nil(t, err)
+	assert.Nil(t, item)
+
+	item, err = r.ResolveIssueAddedSubscription(graphql.ResolveParams{Source: source(domain.IssueRemoved{Issue: issue}), Args: map[string]interface{}{}})
+
+	assert.Nil(t, err)
+	assert.Nil(t, item)
+
+	item, err = r.ResolveIssueUpdatedSubscription(graphql.ResolveParams{Source: source(domain.IssueUpdated{Issue: issue}), Args: map[string]interface{}{}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &issue, item)
+
+	item, err = r.ResolveIssueUpdatedSubscription(graphql.ResolveParams{Source: source(domain.IssueMoved{Issue: issue, PreviousProjectID: 2}), Args: project("2")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &issue, item)
+
+	item, err = r.ResolveIssueUpdatedSubscription(graphql.ResolveParams{Source: source(domain.IssueUpdated{Issue: issue}), Args: project("2")})
+
+	assert.Nil(t, err)
+	assert.Nil(t, item)
+
+	item, err = r.ResolveIssueRemovedSubscription(graphql.ResolveParams{Source: source(domain.IssueRemoved{Issue: issue}), Args: project("1")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &issue, item)
+
+	item, err = r.ResolveIssueRemovedSubscription(graphql.ResolveParams{Source: source(nil), Args: map[string]interface{}{}})
+
+	assert.Nil(t, err)
+	assert.Nil(t, item)
+
+	_, err = r.ResolveIssueRemovedSubscription(graphql.ResolveParams{Source: source(nil), Args: map[string]interface{}{"projectId": "wrong"}})
+
+	assert.NotNil(t, err)
+}
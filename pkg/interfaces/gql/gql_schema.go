@@ -5,9 +5,10 @@ import (
 )
 
 // GetSchema to get GraphQL schema
-func GetSchema(query *graphql.Object, mutation *graphql.Object) (graphql.Schema, error) {
+func GetSchema(query *graphql.Object, mutation *graphql.Object, subscription *graphql.Object) (graphql.Schema, error) {
 	return graphql.NewSchema(graphql.SchemaConfig{
-		Query:    query,
-		Mutation: mutation,
+		Query:        query,
+		Mutation:     mutation,
+		Subscription: subscription,
 	})
 }
@@ -2,6 +2,7 @@ package gql_test
 
 import (
 	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
 	"go-issue-tracker/pkg/interfaces/gql"
 	gqlTesting "go-issue-tracker/pkg/interfaces/gql/testing"
 	"testing"
@@ -16,8 +17,26 @@ func TestSchema(t *testing.T) {
 
 	mutation := gql.GetMutation(rm)
 
-	schema, err := gql.GetSchema(query, mutation)
+	subscription := gql.GetSubscription(rm)
+
+	schema, err := gql.GetSchema(query, mutation, subscription)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, schema)
 }
+
+func TestSchemaPriorityLevels(t *testing.T) {
+	levels := domain.PriorityLevels
+	defer func() {
+		domain.PriorityLevels = levels
+	}()
+	domain.PriorityLevels = []string{"none", "p2", "p1"}
+
+	gql.SetTypesAndNodeDefinitions(new(gqlTesting.ResolverMock))
+
+	values := []string{}
+	for _, value := range gql.IssuePriorityType.Values() {
+		values = append(values, value.Name)
+	}
+	assert.ElementsMatch(t, []string{"NONE", "P2", "P1"}, values)
+}
@@ -0,0 +1,310 @@
+package gql
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/domain"
+	"golang.org/x/net/websocket"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SubscriptionProtocol is WebSocket subprotocol of GraphQL subscriptions
+const SubscriptionProtocol = "graphql-ws"
+
+// Message types of graphql-ws protocol
+const (
+	subscriptionConnectionInit      = "connection_init"
+	subscriptionConnectionAck       = "connection_ack"
+	subscriptionConnectionError     = "connection_error"
+	subscriptionConnectionKeepAlive = "ka"
+	subscriptionConnectionTerminate = "connection_terminate"
+	subscriptionStart               = "start"
+	subscriptionData                = "data"
+	subscriptionError               = "error"
+	subscriptionComplete            = "complete"
+	subscriptionStop                = "stop"
+)
+
+// SubscriptionOrigins are origins as scheme://host:port allowed to open subscription connections, only origin
+// of host of request is allowed when it is empty
+var SubscriptionOrigins []string
+
+// subscriptionMessage is message of graphql-ws protocol
+type subscriptionMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// subscriptionConnection is WebSocket connection with its running operations, messages are sent by
+// operations concurrently, so sending is serialized
+type subscriptionConnection struct {
+	rm         *requestManager
+	ws         *websocket.Conn
+	client     string
+	mutex      sync.Mutex
+	operations map[string]chan struct{}
+	done       chan struct{}
+}
+
+// SubscriptionHandler to serve GraphQL subscriptions over WebSocket with graphql-ws protocol
+func (rm requestManager) SubscriptionHandler(c echo.Context) error {
+	server := websocket.Server{
+		Handshake: func(config *websocket.Config, req *http.Request) error {
+			if err := checkSubscriptionOrigin(config, req); err != nil {
+				return err
+			}
+			for _, protocol := range config.Protocol {
+				if protocol == SubscriptionProtocol {
+					config.Protocol = []string{SubscriptionProtocol}
+					return nil
+				}
+			}
+			return errors.New("protocol not supported")
+		},
+		Handler: func(ws *websocket.Conn) {
+			conn := &subscriptionConnection{
+				rm:         &rm,
+				ws:         ws,
+				client:     c.RealIP(),
+				operations: make(map[string]chan struct{}),
+				done:       make(chan struct{}),
+			}
+			conn.serve()
+		},
+	}
+	server.ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// checkSubscriptionOrigin to check origin of handshake against allowed origins, browsers send origin of page,
+// so other sites can't open connection with cookies of user
+func checkSubscriptionOrigin(config *websocket.Config, req *http.Request) error {
+	origin, err := websocket.Origin(config, req)
+	if err != nil {
+		return err
+	}
+	if origin == nil {
+		return errors.New("origin not provided")
+	}
+	config.Origin = origin
+	if len(SubscriptionOrigins) == 0 {
+		if strings.EqualFold(origin.Host, req.Host) {
+			return nil
+		}
+		return errors.New("origin not allowed")
+	}
+	for _, allowed := range SubscriptionOrigins {
+		if strings.EqualFold(strings.TrimRight(strings.TrimSpace(allowed), "/"), origin.Scheme+"://"+origin.Host) {
+			return nil
+		}
+	}
+	return errors.New("origin not allowed")
+}
+
+// send to send message to client
+func (conn *subscriptionConnection) send(id string, messageType string, payload interface{}) error {
+	message := subscriptionMessage{ID: id, Type: messageType}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		message.Payload = data
+	}
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	return websocket.JSON.Send(conn.ws, message)
+}
+
+// serve to read messages of client until connection is terminated, operations are stopped when it returns
+func (conn *subscriptionConnection) serve() {
+	defer func() {
+		close(conn.done)
+		conn.ws.Close()
+	}()
+
+	var message subscriptionMessage
+	if err := websocket.JSON.Receive(conn.ws, &message); err != nil {
+		return
+	}
+	if message.Type != subscriptionConnectionInit {
+		conn.send("", subscriptionConnectionError, map[string]interface{}{"message": "connection not initialized"})
+		return
+	}
+	if err := conn.send("", subscriptionConnectionAck, nil); err != nil {
+		return
+	}
+	conn.send("", subscriptionConnectionKeepAlive, nil)
+	go conn.keepAlive()
+
+	for {
+		var message subscriptionMessage
+		if err := websocket.JSON.Receive(conn.ws, &message); err != nil {
+			return
+		}
+		switch message.Type {
+		case subscriptionStart:
+			conn.start(message)
+		case subscriptionStop:
+			conn.stop(message.ID)
+		case subscriptionConnectionTerminate:
+			return
+		default:
+			conn.send(message.ID, subscriptionError, formatErrors(domain.NewValidationError("message type %s not valid", message.Type)))
+		}
+	}
+}
+
+// keepAlive to send keep alive message in intervals of stream heartbeat
+func (conn *subscriptionConnection) keepAlive() {
+	ticker := time.NewTicker(domain.StreamHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-conn.done:
+			return
+		case <-ticker.C:
+			if err := conn.send("", subscriptionConnectionKeepAlive, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// start to start operation, query and mutation are executed once and subscription is subscribed to domain events
+// before it is validated by execution without event, so events published meanwhile are buffered and not lost,
+// complexity is charged once when operation starts
+func (conn *subscriptionConnection) start(message subscriptionMessage) {
+	var payload Request
+	if err := json.Unmarshal(message.Payload, &payload); err != nil {
+		conn.send(message.ID, subscriptionError, formatErrors(domain.NewValidationError("payload not valid")))
+		return
+	}
+	conn.mutex.Lock()
+	_, running := conn.operations[message.ID]
+	conn.mutex.Unlock()
+	if message.ID == "" || running {
+		conn.send(message.ID, subscriptionError, formatErrors(domain.NewValidationError("operation id not valid")))
+		return
+	}
+
+	payload, err := conn.rm.queries.Resolve(payload)
+	if err != nil {
+		conn.send(message.ID, subscriptionError, formatErrors(err))
+		return
+	}
+	document, operation, err := getOperation(payload)
+	if _, ok := err.(*domain.Error); ok {
+		conn.send(message.ID, subscriptionError, formatErrors(err))
+		return
+	}
+	if err == nil {
+		if _, err := conn.rm.chargeComplexity(document, operation, payload, conn.client); err != nil {
+			conn.send(message.ID, subscriptionError, formatErrors(err))
+			return
+		}
+		conn.rm.registerQuery(document, payload)
+	}
+
+	var subscription *domain.StreamSubscription
+	if operation != nil && operation.Operation == ast.OperationTypeSubscription {
+		subscription, _, _ = conn.rm.suc.Subscribe(0, 0)
+	}
+	result := conn.execute(payload, nil)
+	if subscription == nil {
+		if len(result.Errors) > 0 && result.Data == nil {
+			conn.send(message.ID, subscriptionError, result.Errors)
+			return
+		}
+		conn.send(message.ID, subscriptionData, result)
+		conn.send(message.ID, subscriptionComplete, nil)
+		return
+	}
+	if len(result.Errors) > 0 {
+		conn.rm.suc.Unsubscribe(subscription)
+		conn.send(message.ID, subscriptionError, result.Errors)
+		return
+	}
+
+	stop := make(chan struct{})
+	conn.mutex.Lock()
+	conn.operations[message.ID] = stop
+	conn.mutex.Unlock()
+	go conn.subscribe(message.ID, payload, subscription, stop)
+}
+
+// stop to stop running operation
+func (conn *subscriptionConnection) stop(id string) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	if stop, ok := conn.operations[id]; ok {
+		close(stop)
+		delete(conn.operations, id)
+	}
+}
+
+// subscribe to execute subscription for each domain event until it is stopped, events which do not match
+// any field of subscription are not sent and subscription dropped by stream is completed with error
+func (conn *subscriptionConnection) subscribe(id string, payload Request, subscription *domain.StreamSubscription, stop chan struct{}) {
+	defer conn.rm.suc.Unsubscribe(subscription)
+
+	for {
+		select {
+		case <-conn.done:
+			return
+		case <-stop:
+			return
+		case <-subscription.Done:
+			conn.stop(id)
+			conn.send(id, subscriptionError, formatErrors(errors.New("slow consumer")))
+			conn.send(id, subscriptionComplete, nil)
+			return
+		case item := <-subscription.Events:
+			event, err := domain.GetDomainEvent(item.Name, string(item.Payload))
+			if err != nil {
+				continue
+			}
+			result := conn.execute(payload, event)
+			if !hasSubscriptionData(result) && len(result.Errors) == 0 {
+				continue
+			}
+			if err := conn.send(id, subscriptionData, result); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// execute to execute operation with domain event in root value
+func (conn *subscriptionConnection) execute(payload Request, event domain.DomainEvent) *graphql.Result {
+	result := graphql.Do(graphql.Params{
+		Schema:         conn.rm.schema,
+		RequestString:  payload.Query,
+		VariableValues: payload.Variables,
+		OperationName:  payload.OperationName,
+		RootObject:     map[string]interface{}{SubscriptionEventKey: event},
+	})
+	setErrorCodes(result.Errors)
+	return result
+}
+
+// hasSubscriptionData to check whether any field of subscription resolved for event
+func hasSubscriptionData(result *graphql.Result) bool {
+	data, ok := result.Data.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for _, value := range data {
+		if value != nil {
+			return true
+		}
+	}
+	return false
+}
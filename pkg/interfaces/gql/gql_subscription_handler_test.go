@@ -0,0 +1,182 @@
+package gql_test
+
+import (
+	"encoding/json"
+	"github.com/graphql-go/relay"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/gql"
+	"go-issue-tracker/pkg/usecases"
+	ucTesting "go-issue-tracker/pkg/usecases/testing"
+	"golang.org/x/net/websocket"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type subscriptionMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func prepareSubscriptionServer(t *testing.T) (usecases.StreamUseCase, *websocket.Conn, func()) {
+	schema := gql.PrepareGraphQL(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
+	suc := usecases.NewStreamUseCase()
+
+	e := echo.New()
+	gql.PrepareEndpoints(e, gql.NewRequestManager(schema, suc, gql.NewPersistedQueryRegistry(false)))
+	server := httptest.NewServer(e)
+
+	ws := dialSubscriptionServer(t, server.URL, gql.SubscriptionProtocol)
+	return suc, ws, func() {
+		ws.Close()
+		server.Close()
+	}
+}
+
+func dialSubscriptionServer(t *testing.T, url string, protocol string) *websocket.Conn {
+	return dialSubscriptionServerFrom(t, url, url, protocol)
+}
+
+func dialSubscriptionServerFrom(t *testing.T, url string, origin string, protocol string) *websocket.Conn {
+	config, err := websocket.NewConfig(strings.Replace(url, "http", "ws", 1)+"/graphql", origin)
+	assert.Nil(t, err)
+	config.Protocol = []string{protocol}
+	ws, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil
+	}
+	return ws
+}
+
+func sendSubscriptionMessage(t *testing.T, ws *websocket.Conn, id string, messageType string, payload string) {
+	message := subscriptionMessage{ID: id, Type: messageType}
+	if payload != "" {
+		message.Payload = json.RawMessage(payload)
+	}
+	assert.Nil(t, websocket.JSON.Send(ws, message))
+}
+
+func receiveSubscriptionMessage(t *testing.T, ws *websocket.Conn) subscriptionMessage {
+	var message subscriptionMessage
+	for {
+		assert.Nil(t, websocket.JSON.Receive(ws, &message))
+		if message.Type != "ka" {
+			return message
+		}
+	}
+}
+
+func initSubscriptionConnection(t *testing.T, ws *websocket.Conn) {
+	sendSubscriptionMessage(t, ws, "", "connection_init", "{}")
+	assert.Equal(t, "connection_ack", receiveSubscriptionMessage(t, ws).Type)
+}
+
+func TestSubscriptionHandler(t *testing.T) {
+	suc, ws, closeServer := prepareSubscriptionServer(t)
+	defer closeServer()
+
+	initSubscriptionConnection(t, ws)
+
+	sendSubscriptionMessage(t, ws, "1", "start", `{"query":"subscription { issueAdded(projectId: \"`+relay.ToGlobalID("Project", "1")+`\") { id title } }"}`)
+	sendSubscriptionMessage(t, ws, "2", "start", `{"query":"subscription { issueRemoved { id title } }"}`)
+
+	// Operations are started in order of messages, so sending a query and waiting for its result ensures both
+	// subscriptions are running before events are published
+	sendSubscriptionMessage(t, ws, "3", "start", `{"query":"query { __typename }"}`)
+	message := receiveSubscriptionMessage(t, ws)
+	assert.Equal(t, "3", message.ID)
+	assert.Equal(t, "data", message.Type)
+	assert.JSONEq(t, `{"data":{"__typename":"Query"}}`, string(message.Payload))
+	assert.Equal(t, "complete", receiveSubscriptionMessage(t, ws).Type)
+
+	suc.HandleEvent(domain.IssueCreated{Issue: domain.Issue{ID: 2, Title: "Other", ProjectID: 2}})
+	suc.HandleEvent(domain.IssueCreated{Issue: domain.Issue{ID: 1, Title: "Issue", ProjectID: 1}})
+
+	message = receiveSubscriptionMessage(t, ws)
+	assert.Equal(t, "1", message.ID)
+	assert.Equal(t, "data", message.Type)
+	assert.JSONEq(t, `{"data":{"issueAdded":{"id":"`+relay.ToGlobalID("Issue", "1")+`","title":"Issue"}}}`, string(message.Payload))
+
+	sendSubscriptionMessage(t, ws, "1", "stop", "")
+	suc.HandleEvent(domain.IssueRemoved{Issue: domain.Issue{ID: 1, Title: "Issue", ProjectID: 1}})
+
+	message = receiveSubscriptionMessage(t, ws)
+	assert.Equal(t, "2", message.ID)
+	assert.Equal(t, "data", message.Type)
+	assert.JSONEq(t, `{"data":{"issueRemoved":{"id":"`+relay.ToGlobalID("Issue", "1")+`","title":"Issue"}}}`, string(message.Payload))
+}
+
+func TestSubscriptionHandlerErrors(t *testing.T) {
+	_, ws, closeServer := prepareSubscriptionServer(t)
+	defer closeServer()
+
+	initSubscriptionConnection(t, ws)
+
+	sendSubscriptionMessage(t, ws, "1", "start", `{"query":"subscription { issueAdded { unknown } }"}`)
+
+	message := receiveSubscriptionMessage(t, ws)
+	assert.Equal(t, "1", message.ID)
+	assert.Equal(t, "error", message.Type)
+
+	sendSubscriptionMessage(t, ws, "2", "start", `{"query":"subscription { issueAdded(projectId: \"wrong\") { id } }"}`)
+
+	message = receiveSubscriptionMessage(t, ws)
+	assert.Equal(t, "2", message.ID)
+	assert.Equal(t, "error", message.Type)
+
+	sendSubscriptionMessage(t, ws, "", "start", `{"query":"subscription { issueAdded { id } }"}`)
+
+	message = receiveSubscriptionMessage(t, ws)
+	assert.Equal(t, "error", message.Type)
+}
+
+func TestSubscriptionHandlerNotInitialized(t *testing.T) {
+	_, ws, closeServer := prepareSubscriptionServer(t)
+	defer closeServer()
+
+	sendSubscriptionMessage(t, ws, "1", "start", `{"query":"subscription { issueAdded { id } }"}`)
+
+	message := receiveSubscriptionMessage(t, ws)
+	assert.Equal(t, "connection_error", message.Type)
+}
+
+func TestSubscriptionHandlerProtocol(t *testing.T) {
+	schema := gql.PrepareGraphQL(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
+	e := echo.New()
+	gql.PrepareEndpoints(e, gql.NewRequestManager(schema, usecases.NewStreamUseCase(), gql.NewPersistedQueryRegistry(false)))
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	ws := dialSubscriptionServer(t, server.URL, "graphql-transport-ws")
+
+	assert.Nil(t, ws)
+}
+
+func TestSubscriptionHandlerOrigin(t *testing.T) {
+	schema := gql.PrepareGraphQL(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
+	e := echo.New()
+	gql.PrepareEndpoints(e, gql.NewRequestManager(schema, usecases.NewStreamUseCase(), gql.NewPersistedQueryRegistry(false)))
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	ws := dialSubscriptionServerFrom(t, server.URL, "http://example.com", gql.SubscriptionProtocol)
+
+	assert.Nil(t, ws)
+
+	defer func() {
+		gql.SubscriptionOrigins = nil
+	}()
+	gql.SubscriptionOrigins = []string{"https://issues.example.com", "http://example.com/"}
+
+	ws = dialSubscriptionServerFrom(t, server.URL, "http://example.com", gql.SubscriptionProtocol)
+
+	assert.NotNil(t, ws)
+	ws.Close()
+
+	ws = dialSubscriptionServer(t, server.URL, gql.SubscriptionProtocol)
+
+	assert.Nil(t, ws)
+}
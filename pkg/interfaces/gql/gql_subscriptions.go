@@ -0,0 +1,42 @@
+package gql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+// SubscriptionEventKey is key of domain event in root value of executed subscription
+const SubscriptionEventKey = "event"
+
+// GetSubscription to get subscription root type, subscription document is executed for each domain event
+// passed in root value and field resolves to null when event does not match it
+func GetSubscription(resolver Resolver) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"issueAdded": &graphql.Field{
+				Type:        IssueType,
+				Description: "Issue added to Project or to any Project when Project is not provided",
+				Args: graphql.FieldConfigArgument{
+					"projectId": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: resolver.ResolveIssueAddedSubscription,
+			},
+			"issueUpdated": &graphql.Field{
+				Type:        IssueType,
+				Description: "Issue of Project updated or moved from or to Project",
+				Args: graphql.FieldConfigArgument{
+					"projectId": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: resolver.ResolveIssueUpdatedSubscription,
+			},
+			"issueRemoved": &graphql.Field{
+				Type:        IssueType,
+				Description: "Issue removed from Project",
+				Args: graphql.FieldConfigArgument{
+					"projectId": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: resolver.ResolveIssueRemovedSubscription,
+			},
+		},
+	})
+}
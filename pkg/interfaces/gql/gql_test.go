@@ -1,17 +1,21 @@
 package gql_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/relay"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
 	"go-issue-tracker/pkg/interfaces/gql"
 	gqlTesting "go-issue-tracker/pkg/interfaces/gql/testing"
 	ucTesting "go-issue-tracker/pkg/usecases/testing"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 )
@@ -30,8 +34,9 @@ func TestPrepareGraphQL(t *testing.T) {
 	iucm := new(ucTesting.IssueUseCaseMock)
 	lucm := new(ucTesting.LabelUseCaseMock)
 	pucm := new(ucTesting.ProjectUseCaseMock)
+	nucm := new(ucTesting.NotificationUseCaseMock)
 
-	schema := gql.PrepareGraphQL(iucm, lucm, pucm, cucm)
+	schema := gql.PrepareGraphQL(iucm, lucm, pucm, cucm, nucm, new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
 
 	assert.NotNil(t, schema)
 }
@@ -53,6 +58,14 @@ func TestPrepareEndpoints(t *testing.T) {
 
 	assert.Equal(t, 200, recorder.Code)
 
+	// /graphql GET
+	request = httptest.NewRequest(echo.GET, "/graphql", nil)
+	recorder = httptest.NewRecorder()
+
+	e.ServeHTTP(recorder, request)
+
+	assert.Equal(t, 200, recorder.Code)
+
 	gqlm.AssertExpectations(t)
 }
 
@@ -61,7 +74,7 @@ func TestHandler(t *testing.T) {
 		Query:    nil,
 		Mutation: nil,
 	})
-	gqlm := gql.NewRequestManager(gqlSchema)
+	gqlm := gql.NewRequestManager(gqlSchema, new(ucTesting.StreamUseCaseMock), gql.NewPersistedQueryRegistry(false))
 
 	json := fmt.Sprintf(`
 	{"query":"query {\n    label (id: 1){\n        id\n        name\n        createdAt\n    }\n}"}
@@ -80,15 +93,16 @@ func TestHandlerMutation(t *testing.T) {
 	iucm := new(ucTesting.IssueUseCaseMock)
 	lucm := new(ucTesting.LabelUseCaseMock)
 	pucm := new(ucTesting.ProjectUseCaseMock)
+	nucm := new(ucTesting.NotificationUseCaseMock)
 
 	lucm.On("Remove", uint(1)).Return(true, nil)
 
-	schema := gql.PrepareGraphQL(iucm, lucm, pucm, cucm)
+	schema := gql.PrepareGraphQL(iucm, lucm, pucm, cucm, nucm, new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
 
-	gqlm := gql.NewRequestManager(schema)
+	gqlm := gql.NewRequestManager(schema, new(ucTesting.StreamUseCaseMock), gql.NewPersistedQueryRegistry(false))
 
 	json := fmt.Sprintf(`
-	{"query":"mutation {\n    removeLabel (id: \"%s\"){\n        id\n    }\n}"}
+	{"query":"mutation {\n    removeLabel (input: {id: \"%s\", clientMutationId: \"1\"}){\n        status\n    }\n}"}
 	`, relay.ToGlobalID("Label", "1"))
 	body := strings.NewReader(json)
 	c, rec := prepareHTTP(echo.POST, "/graphql", body)
@@ -97,6 +111,9 @@ func TestHandlerMutation(t *testing.T) {
 
 	assert.Nil(t, err)
 	assert.Equal(t, 200, rec.Code)
+	assert.JSONEq(t, `{"data":{"removeLabel":{"status":true}},"extensions":{"complexity":{"depth":2,"cost":1,"maxDepth":12,"maxCost":1000}}}`, rec.Body.String())
+
+	lucm.AssertExpectations(t)
 }
 
 func TestHandlerMissingQuery(t *testing.T) {
@@ -104,12 +121,13 @@ func TestHandlerMissingQuery(t *testing.T) {
 	iucm := new(ucTesting.IssueUseCaseMock)
 	lucm := new(ucTesting.LabelUseCaseMock)
 	pucm := new(ucTesting.ProjectUseCaseMock)
+	nucm := new(ucTesting.NotificationUseCaseMock)
 
 	lucm.On("Remove", uint(1)).Return(true, nil)
 
-	schema := gql.PrepareGraphQL(iucm, lucm, pucm, cucm)
+	schema := gql.PrepareGraphQL(iucm, lucm, pucm, cucm, nucm, new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
 
-	gqlm := gql.NewRequestManager(schema)
+	gqlm := gql.NewRequestManager(schema, new(ucTesting.StreamUseCaseMock), gql.NewPersistedQueryRegistry(false))
 
 	json := fmt.Sprintf(`
 	{"query2":"query {\n    label (id: 1){\n        id\n        name\n        createdAt\n    }\n}"}
@@ -120,7 +138,8 @@ func TestHandlerMissingQuery(t *testing.T) {
 	err := gqlm.Handler(c)
 
 	assert.Nil(t, err)
-	assert.Equal(t, 500, rec.Code)
+	assert.Equal(t, 400, rec.Code)
+	assert.JSONEq(t, `{"errors":[{"message":"query not provided","locations":[],"extensions":{"code":"VALIDATION"}}]}`, rec.Body.String())
 }
 
 func TestHandlerExecutionErr(t *testing.T) {
@@ -128,7 +147,7 @@ func TestHandlerExecutionErr(t *testing.T) {
 		Query:    nil,
 		Mutation: nil,
 	})
-	gqlm := gql.NewRequestManager(gqlSchema)
+	gqlm := gql.NewRequestManager(gqlSchema, new(ucTesting.StreamUseCaseMock), gql.NewPersistedQueryRegistry(false))
 
 	json := fmt.Sprintf(`
 	{"query":"queryWRONG {\n    label (id: 1){\n        id\n        name\n        createdAt\n    }\n}"}
@@ -138,6 +157,178 @@ func TestHandlerExecutionErr(t *testing.T) {
 
 	err := gqlm.Handler(c)
 
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"VALIDATION"`)
+	assert.NotContains(t, rec.Body.String(), `"data"`)
+}
+
+func prepareLabelRequestManager() (*ucTesting.LabelUseCaseMock, gql.RequestManager) {
+	lucm := new(ucTesting.LabelUseCaseMock)
+	schema := gql.PrepareGraphQL(new(ucTesting.IssueUseCaseMock), lucm, new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock))
+	return lucm, gql.NewRequestManager(schema, new(ucTesting.StreamUseCaseMock), gql.NewPersistedQueryRegistry(false))
+}
+
+func TestHandlerGet(t *testing.T) {
+	lucm, gqlm := prepareLabelRequestManager()
+
+	lucm.On("FindByID", uint(1)).Return(domain.Label{ID: 1, Name: "Bug"}, nil)
+
+	query := url.Values{}
+	query.Set("query", `query Label($id: ID!) { label(id: $id) { name } } query Other { __typename }`)
+	query.Set("operationName", "Label")
+	query.Set("variables", fmt.Sprintf(`{"id": "%s"}`, relay.ToGlobalID("Label", "1")))
+	c, rec := prepareHTTP(echo.GET, "/graphql?"+query.Encode(), strings.NewReader(""))
+
+	err := gqlm.Handler(c)
+
 	assert.Nil(t, err)
 	assert.Equal(t, 200, rec.Code)
+	assert.JSONEq(t, `{"data":{"label":{"name":"Bug"}},"extensions":{"complexity":{"depth":2,"cost":1,"maxDepth":12,"maxCost":1000}}}`, rec.Body.String())
+
+	query.Set("operationName", "")
+	c, rec = prepareHTTP(echo.GET, "/graphql?"+query.Encode(), strings.NewReader(""))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+	assert.JSONEq(t, `{"errors":[{"message":"operation name not provided","locations":[],"extensions":{"code":"VALIDATION"}}]}`, rec.Body.String())
+
+	query = url.Values{}
+	query.Set("query", `mutation { removeLabel(input: {id: "1", clientMutationId: "1"}) { status } }`)
+	c, rec = prepareHTTP(echo.GET, "/graphql?"+query.Encode(), strings.NewReader(""))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 405, rec.Code)
+
+	query.Set("query", `{ __typename }`)
+	query.Set("variables", `wrong`)
+	c, rec = prepareHTTP(echo.GET, "/graphql?"+query.Encode(), strings.NewReader(""))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+
+	lucm.AssertExpectations(t)
+}
+
+func TestHandlerBatch(t *testing.T) {
+	_, gqlm := prepareLabelRequestManager()
+
+	c, rec := prepareHTTP(echo.POST, "/graphql", strings.NewReader(`[{"query":"{ __typename }"},{"query":"{ unknown }"}]`))
+
+	err := gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.JSONEq(t, `[
+		{"data":{"__typename":"Query"},"extensions":{"complexity":{"depth":0,"cost":0,"maxDepth":12,"maxCost":1000}}},
+		{"errors":[{"message":"Cannot query field \"unknown\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"VALIDATION"}}],"extensions":{"complexity":{"depth":1,"cost":0,"maxDepth":12,"maxCost":1000}}}
+	]`, rec.Body.String())
+
+	maxBatchSize := gql.MaxBatchSize
+	defer func() {
+		gql.MaxBatchSize = maxBatchSize
+	}()
+	gql.MaxBatchSize = 1
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(`[{"query":"{ __typename }"},{"query":"{ __typename }"}]`))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+	assert.JSONEq(t, `{"errors":[{"message":"batch of 2 requests exceeds max. batch size 1","locations":[],"extensions":{"code":"VALIDATION"}}]}`, rec.Body.String())
+
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(`[]`))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+
+	c, rec = prepareHTTP(echo.POST, "/graphql", strings.NewReader(`{"query":`))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+	assert.JSONEq(t, `{"errors":[{"message":"request not valid","locations":[],"extensions":{"code":"VALIDATION"}}]}`, rec.Body.String())
+}
+
+func TestHandlerErrorCodes(t *testing.T) {
+	lucm, gqlm := prepareLabelRequestManager()
+
+	lucm.On("FindByID", uint(1)).Return(domain.Label{}, errors.New("record not found"))
+	lucm.On("FindByID", uint(2)).Return(domain.Label{}, domain.NewForbiddenError("project is archived"))
+	lucm.On("FindByID", uint(3)).Return(domain.Label{}, errors.New("database is locked"))
+
+	body := fmt.Sprintf(`{"query":"{ a: label(id: \"%s\") { name } b: label(id: \"%s\") { name } c: label(id: \"%s\") { name } d: label(id: \"wrong\") { name } }"}`,
+		relay.ToGlobalID("Label", "1"), relay.ToGlobalID("Label", "2"), relay.ToGlobalID("Label", "3"))
+	c, rec := prepareHTTP(echo.POST, "/graphql", strings.NewReader(body))
+
+	err := gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	var response struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []struct {
+			Path       []string               `json:"path"`
+			Extensions map[string]interface{} `json:"extensions"`
+		} `json:"errors"`
+	}
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, map[string]interface{}{"a": nil, "b": nil, "c": nil, "d": nil}, response.Data)
+	codes := make(map[string]interface{})
+	for _, e := range response.Errors {
+		codes[e.Path[0]] = e.Extensions["code"]
+	}
+	assert.Equal(t, map[string]interface{}{"a": "NOT_FOUND", "b": "FORBIDDEN", "c": "INTERNAL", "d": "VALIDATION"}, codes)
+
+	lucm.AssertExpectations(t)
+}
+
+func TestHandlerSubscription(t *testing.T) {
+	_, gqlm := prepareLabelRequestManager()
+
+	c, rec := prepareHTTP(echo.POST, "/graphql", strings.NewReader(`{"query":"subscription { issueAdded { id } }"}`))
+
+	err := gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+}
+
+func TestHandlerWebhookSecret(t *testing.T) {
+	wucm := new(ucTesting.WebhookUseCaseMock)
+	schema := gql.PrepareGraphQL(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), wucm, new(ucTesting.AutomationUseCaseMock))
+	gqlm := gql.NewRequestManager(schema, new(ucTesting.StreamUseCaseMock), gql.NewPersistedQueryRegistry(false))
+
+	wucm.On("Find", uint(1)).Return([]domain.Webhook{{ID: 1, Secret: "secret"}, {ID: 2}}, nil)
+
+	query := url.Values{}
+	query.Set("query", fmt.Sprintf(`{ webhooks(projectId: "%s") { hasSecret } }`, relay.ToGlobalID("Project", "1")))
+	c, rec := prepareHTTP(echo.GET, "/graphql?"+query.Encode(), strings.NewReader(""))
+
+	err := gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"webhooks":[{"hasSecret":true},{"hasSecret":false}]`)
+
+	query.Set("query", fmt.Sprintf(`{ webhooks(projectId: "%s") { secret } }`, relay.ToGlobalID("Project", "1")))
+	c, rec = prepareHTTP(echo.GET, "/graphql?"+query.Encode(), strings.NewReader(""))
+
+	err = gqlm.Handler(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rec.Code)
+	assert.NotContains(t, rec.Body.String(), `"secret":"secret"`)
+
+	wucm.AssertExpectations(t)
 }
@@ -1,9 +1,12 @@
 package gql
 
 import (
+	"fmt"
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/relay"
+	"go-issue-tracker/pkg/domain"
 	"golang.org/x/net/context"
+	"strings"
 )
 
 // IssueType graphql type
@@ -12,9 +15,152 @@ var IssueType *graphql.Object
 // LabelType graphql type
 var LabelType *graphql.Object
 
+// LabelGroupType graphql type
+var LabelGroupType *graphql.Object
+
 // ProjectType graphql type
 var ProjectType *graphql.Object
 
+// ProjectSettingsType graphql type
+var ProjectSettingsType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ProjectSettings",
+	Fields: graphql.Fields{
+		"maxLabels":           &graphql.Field{Type: graphql.Int},
+		"requiredFields":      &graphql.Field{Type: graphql.String},
+		"defaultStatus":       &graphql.Field{Type: graphql.Int},
+		"allowedStatuses":     &graphql.Field{Type: graphql.String},
+		"allowedPriorities":   &graphql.Field{Type: graphql.String},
+		"allowedSeverities":   &graphql.Field{Type: graphql.String},
+		"slaPolicy":           &graphql.Field{Type: graphql.String},
+		"slaAtRisk":           &graphql.Field{Type: graphql.String},
+		"slaResolvedStatuses": &graphql.Field{Type: graphql.String},
+	},
+})
+
+// getLevelEnumValues to get enum values of levels, names are upper cased level names
+func getLevelEnumValues(levels []string) graphql.EnumValueConfigMap {
+	values := graphql.EnumValueConfigMap{}
+	for level, name := range levels {
+		values[strings.ToUpper(name)] = &graphql.EnumValueConfig{Value: level}
+	}
+	return values
+}
+
+// IssuePriorityType graphql enum type, values are configured priority levels
+var IssuePriorityType *graphql.Enum
+
+// IssueSeverityType graphql enum type, values are configured severity levels
+var IssueSeverityType *graphql.Enum
+
+// IssueSLAStatusType graphql enum type
+var IssueSLAStatusType = graphql.NewEnum(graphql.EnumConfig{
+	Name:        "IssueSLAStatus",
+	Description: "SLA statuses of Issue ordered by urgency",
+	Values:      getLevelEnumValues(domain.SLAStatusLevels),
+})
+
+// IssueSortType graphql enum type
+var IssueSortType = graphql.NewEnum(graphql.EnumConfig{
+	Name: "IssueSort",
+	Values: graphql.EnumValueConfigMap{
+		"PRIORITY": &graphql.EnumValueConfig{Value: domain.IssueSortPriority, Description: "Sort by priority from the highest, then by severity"},
+		"SEVERITY": &graphql.EnumValueConfig{Value: domain.IssueSortSeverity, Description: "Sort by severity from the highest, then by priority"},
+		"DUE":      &graphql.EnumValueConfig{Value: domain.IssueSortDue, Description: "Sort by due date from the earliest, issues without due date are last"},
+	},
+})
+
+// IssueTemplateType graphql type
+var IssueTemplateType *graphql.Object
+
+// CustomFieldType graphql type
+var CustomFieldType *graphql.Object
+
+// CustomFieldValueType graphql type
+var CustomFieldValueType *graphql.Object
+
+// WorkLogType graphql type
+var WorkLogType *graphql.Object
+
+// AttachmentType graphql type
+var AttachmentType *graphql.Object
+
+// WatcherType graphql type
+var WatcherType *graphql.Object
+
+// NotificationType graphql type
+var NotificationType *graphql.Object
+
+// MailPreferenceType graphql type
+var MailPreferenceType *graphql.Object
+
+// WebhookType graphql type
+var WebhookType *graphql.Object
+
+// WebhookDeliveryType graphql type
+var WebhookDeliveryType *graphql.Object
+
+// AutomationRuleType graphql type
+var AutomationRuleType *graphql.Object
+
+// AutomationExecutionType graphql type
+var AutomationExecutionType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "AutomationExecution",
+	Description: "Execution of AutomationRule for Issue",
+	Fields: graphql.Fields{
+		"id":        &graphql.Field{Type: graphql.Int},
+		"ruleId":    &graphql.Field{Type: graphql.Int},
+		"issueId":   &graphql.Field{Type: graphql.Int},
+		"event":     &graphql.Field{Type: graphql.String},
+		"status":    &graphql.Field{Type: graphql.String, Description: "One of applied, skipped or failed"},
+		"depth":     &graphql.Field{Type: graphql.Int, Description: "Number of nested executions caused by changes of Issue"},
+		"error":     &graphql.Field{Type: graphql.String},
+		"createdAt": &graphql.Field{Type: graphql.DateTime},
+		"updatedAt": &graphql.Field{Type: graphql.DateTime},
+	},
+})
+
+// TimeSummaryType graphql type
+var TimeSummaryType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "TimeSummary",
+	Description: "Estimated and spent time of Issues in minutes",
+	Fields: graphql.Fields{
+		"originalEstimate":  &graphql.Field{Type: graphql.Int},
+		"remainingEstimate": &graphql.Field{Type: graphql.Int},
+		"timeSpent":         &graphql.Field{Type: graphql.Int},
+	},
+})
+
+// TimesheetEntryType graphql type
+var TimesheetEntryType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "TimesheetEntry",
+	Description: "Time logged by author in ISO week in minutes",
+	Fields: graphql.Fields{
+		"author":    &graphql.Field{Type: graphql.String},
+		"week":      &graphql.Field{Type: graphql.String},
+		"weekStart": &graphql.Field{Type: graphql.DateTime},
+		"minutes":   &graphql.Field{Type: graphql.Int},
+	},
+})
+
+// IssueBulkResultType graphql type
+var IssueBulkResultType *graphql.Object
+
+// LabelMergeType graphql type
+var LabelMergeType *graphql.Object
+
+// ProjectRemovalModeType graphql enum type
+var ProjectRemovalModeType = graphql.NewEnum(graphql.EnumConfig{
+	Name: "ProjectRemovalMode",
+	Values: graphql.EnumValueConfigMap{
+		"CASCADE": &graphql.EnumValueConfig{Value: domain.ProjectRemovalCascade, Description: "Remove issues together with project"},
+		"MOVE":    &graphql.EnumValueConfig{Value: domain.ProjectRemovalMove, Description: "Move issues to target project"},
+		"ARCHIVE": &graphql.EnumValueConfig{Value: domain.ProjectRemovalArchive, Description: "Archive project keeping its issues read-only"},
+	},
+})
+
+// ProjectRemovalType graphql type
+var ProjectRemovalType *graphql.Object
+
 // NodeDefinitions graphql node definitions
 var NodeDefinitions *relay.NodeDefinitions
 
@@ -27,33 +173,266 @@ func SetTypesAndNodeDefinitions(resolver Resolver) {
 		TypeResolve: resolver.ResolveType,
 	})
 
+	IssuePriorityType = graphql.NewEnum(graphql.EnumConfig{
+		Name:        "IssuePriority",
+		Description: "Priority levels of Issue ordered from lowest to highest",
+		Values:      getLevelEnumValues(domain.PriorityLevels),
+	})
+
+	IssueSeverityType = graphql.NewEnum(graphql.EnumConfig{
+		Name:        "IssueSeverity",
+		Description: "Severity levels of Issue ordered from lowest to highest",
+		Values:      getLevelEnumValues(domain.SeverityLevels),
+	})
+
+	LabelGroupType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "LabelGroup",
+		Fields: graphql.Fields{
+			"id":        relay.GlobalIDField("LabelGroup", nil),
+			"name":      &graphql.Field{Type: graphql.String},
+			"exclusive": &graphql.Field{Type: graphql.Boolean},
+			"createdAt": &graphql.Field{Type: graphql.DateTime},
+			"updatedAt": &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
 	LabelType = graphql.NewObject(graphql.ObjectConfig{
 		Name: "Label",
 		Fields: graphql.Fields{
 			"id":           relay.GlobalIDField("Label", nil),
 			"name":         &graphql.Field{Type: graphql.String},
 			"colorHexCode": &graphql.Field{Type: graphql.String},
+			"projectId":    &graphql.Field{Type: graphql.Int},
+			"groupId":      &graphql.Field{Type: graphql.Int},
+			"group":        &graphql.Field{Type: LabelGroupType},
 			"createdAt":    &graphql.Field{Type: graphql.DateTime},
 			"updatedAt":    &graphql.Field{Type: graphql.DateTime},
 		},
 		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
 	})
 
+	LabelGroupType.AddFieldConfig("labels", &graphql.Field{Type: graphql.NewList(LabelType)})
+
+	labelConnectionDefinition := relay.ConnectionDefinitions(relay.ConnectionConfig{
+		Name:     "Label",
+		NodeType: LabelType,
+	})
+
+	IssueTemplateType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "IssueTemplate",
+		Fields: graphql.Fields{
+			"id":          relay.GlobalIDField("IssueTemplate", nil),
+			"projectId":   &graphql.Field{Type: graphql.Int},
+			"name":        &graphql.Field{Type: graphql.String},
+			"titlePrefix": &graphql.Field{Type: graphql.String},
+			"description": &graphql.Field{Type: graphql.String},
+			"status":      &graphql.Field{Type: graphql.Int},
+			"labels":      &graphql.Field{Type: graphql.NewList(LabelType)},
+			"createdAt":   &graphql.Field{Type: graphql.DateTime},
+			"updatedAt":   &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
+	CustomFieldType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "CustomField",
+		Fields: graphql.Fields{
+			"id":        relay.GlobalIDField("CustomField", nil),
+			"projectId": &graphql.Field{Type: graphql.Int},
+			"name":      &graphql.Field{Type: graphql.String},
+			"type":      &graphql.Field{Type: graphql.String},
+			"options":   &graphql.Field{Type: graphql.String},
+			"createdAt": &graphql.Field{Type: graphql.DateTime},
+			"updatedAt": &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
+	CustomFieldValueType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "CustomFieldValue",
+		Fields: graphql.Fields{
+			"field": &graphql.Field{Type: CustomFieldType},
+			"name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if source, ok := p.Source.(domain.CustomFieldValue); ok {
+						return source.Field.Name, nil
+					}
+					return nil, nil
+				},
+			},
+			"value": &graphql.Field{Type: graphql.String},
+		},
+	})
+
 	ProjectType = graphql.NewObject(graphql.ObjectConfig{
 		Name: "Project",
 		Fields: graphql.Fields{
 			"id":          relay.GlobalIDField("Project", nil),
 			"name":        &graphql.Field{Type: graphql.String},
 			"description": &graphql.Field{Type: graphql.String},
+			"archived":    &graphql.Field{Type: graphql.Boolean},
+			"labels": &graphql.Field{
+				Type:    labelConnectionDefinition.ConnectionType,
+				Args:    relay.ConnectionArgs,
+				Resolve: resolver.ResolveFieldProjectLabels,
+			},
+			"settings":      &graphql.Field{Type: ProjectSettingsType},
+			"defaultLabels": &graphql.Field{Type: graphql.NewList(LabelType)},
+			"templates": &graphql.Field{
+				Type:    graphql.NewList(IssueTemplateType),
+				Resolve: resolver.ResolveFieldProjectTemplates,
+			},
+			"customFields": &graphql.Field{
+				Type:    graphql.NewList(CustomFieldType),
+				Resolve: resolver.ResolveFieldProjectCustomFields,
+			},
+			"timeSummary": &graphql.Field{
+				Type:    TimeSummaryType,
+				Resolve: resolver.ResolveFieldProjectTimeSummary,
+			},
+			"createdAt": &graphql.Field{Type: graphql.DateTime},
+			"updatedAt": &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
+	WorkLogType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "WorkLog",
+		Fields: graphql.Fields{
+			"id":        relay.GlobalIDField("WorkLog", nil),
+			"issueId":   &graphql.Field{Type: graphql.Int},
+			"author":    &graphql.Field{Type: graphql.String},
+			"minutes":   &graphql.Field{Type: graphql.Int},
+			"loggedAt":  &graphql.Field{Type: graphql.DateTime},
+			"comment":   &graphql.Field{Type: graphql.String},
+			"createdAt": &graphql.Field{Type: graphql.DateTime},
+			"updatedAt": &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
+	AttachmentType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Attachment",
+		Fields: graphql.Fields{
+			"id":          relay.GlobalIDField("Attachment", nil),
+			"issueId":     &graphql.Field{Type: graphql.Int},
+			"filename":    &graphql.Field{Type: graphql.String},
+			"size":        &graphql.Field{Type: graphql.Int, Description: "Size in bytes"},
+			"contentType": &graphql.Field{Type: graphql.String},
+			"sha256":      &graphql.Field{Type: graphql.String, Description: "SHA-256 checksum of content"},
 			"createdAt":   &graphql.Field{Type: graphql.DateTime},
 			"updatedAt":   &graphql.Field{Type: graphql.DateTime},
 		},
 		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
 	})
 
-	labelConnectionDefinition := relay.ConnectionDefinitions(relay.ConnectionConfig{
-		Name:     "Label",
-		NodeType: LabelType,
+	WatcherType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Watcher",
+		Description: "User watching Issue or all Issues of Project",
+		Fields: graphql.Fields{
+			"id":        relay.GlobalIDField("Watcher", nil),
+			"user":      &graphql.Field{Type: graphql.String},
+			"issueId":   &graphql.Field{Type: graphql.Int},
+			"projectId": &graphql.Field{Type: graphql.Int},
+			"createdAt": &graphql.Field{Type: graphql.DateTime},
+			"updatedAt": &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
+	NotificationType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Notification",
+		Fields: graphql.Fields{
+			"id":        relay.GlobalIDField("Notification", nil),
+			"user":      &graphql.Field{Type: graphql.String},
+			"issueId":   &graphql.Field{Type: graphql.Int},
+			"projectId": &graphql.Field{Type: graphql.Int},
+			"event":     &graphql.Field{Type: graphql.String, Description: "One of updated, status_changed or commented"},
+			"message":   &graphql.Field{Type: graphql.String},
+			"changes":   &graphql.Field{Type: graphql.String, Description: "Changed fields of Issue, one per line"},
+			"read":      &graphql.Field{Type: graphql.Boolean},
+			"createdAt": &graphql.Field{Type: graphql.DateTime},
+			"updatedAt": &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
+	MailPreferenceType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "MailPreference",
+		Description: "Email and delivery of Notifications of user",
+		Fields: graphql.Fields{
+			"user":       &graphql.Field{Type: graphql.String},
+			"email":      &graphql.Field{Type: graphql.String},
+			"delivery":   &graphql.Field{Type: graphql.String, Description: "One of immediate, digest or none"},
+			"notifiedAt": &graphql.Field{Type: graphql.DateTime, Description: "Notifications created until this time were queued"},
+			"createdAt":  &graphql.Field{Type: graphql.DateTime},
+			"updatedAt":  &graphql.Field{Type: graphql.DateTime},
+		},
+	})
+
+	WebhookType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Webhook",
+		Description: "Subscription of URL to events of Project, payloads are signed with write-only secret",
+		Fields: graphql.Fields{
+			"id":        relay.GlobalIDField("Webhook", nil),
+			"projectId": &graphql.Field{Type: graphql.Int},
+			"url":       &graphql.Field{Type: graphql.String},
+			"hasSecret": &graphql.Field{
+				Type: graphql.Boolean,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					switch source := p.Source.(type) {
+					case domain.Webhook:
+						return source.HasSecret(), nil
+					case *domain.Webhook:
+						return source.HasSecret(), nil
+					}
+					return nil, nil
+				},
+			},
+			"events":    &graphql.Field{Type: graphql.String, Description: "Comma separated events, all events when empty"},
+			"active":    &graphql.Field{Type: graphql.Boolean},
+			"createdAt": &graphql.Field{Type: graphql.DateTime},
+			"updatedAt": &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
+	WebhookDeliveryType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "WebhookDelivery",
+		Fields: graphql.Fields{
+			"id":             relay.GlobalIDField("WebhookDelivery", nil),
+			"webhookId":      &graphql.Field{Type: graphql.Int},
+			"event":          &graphql.Field{Type: graphql.String},
+			"payload":        &graphql.Field{Type: graphql.String},
+			"status":         &graphql.Field{Type: graphql.String, Description: "One of queued, delivered or failed"},
+			"attempts":       &graphql.Field{Type: graphql.Int},
+			"responseStatus": &graphql.Field{Type: graphql.Int},
+			"lastError":      &graphql.Field{Type: graphql.String},
+			"sendAfter":      &graphql.Field{Type: graphql.DateTime},
+			"deliveredAt":    &graphql.Field{Type: graphql.DateTime},
+			"createdAt":      &graphql.Field{Type: graphql.DateTime},
+			"updatedAt":      &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
+	})
+
+	AutomationRuleType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "AutomationRule",
+		Description: "Rule of Project applying actions to Issue when trigger event matches all conditions",
+		Fields: graphql.Fields{
+			"id":         relay.GlobalIDField("AutomationRule", nil),
+			"projectId":  &graphql.Field{Type: graphql.Int},
+			"name":       &graphql.Field{Type: graphql.String},
+			"trigger":    &graphql.Field{Type: graphql.String, Description: "One of IssueCreated, IssueUpdated, IssueStatusChanged, LabelsChanged or IssueMoved"},
+			"conditions": &graphql.Field{Type: graphql.String, Description: "Comma separated name:value pairs"},
+			"actions":    &graphql.Field{Type: graphql.String, Description: "Comma separated name:value pairs"},
+			"active":     &graphql.Field{Type: graphql.Boolean},
+			"createdAt":  &graphql.Field{Type: graphql.DateTime},
+			"updatedAt":  &graphql.Field{Type: graphql.DateTime},
+		},
+		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
 	})
 
 	IssueType = graphql.NewObject(graphql.ObjectConfig{
@@ -63,6 +442,12 @@ func SetTypesAndNodeDefinitions(resolver Resolver) {
 			"title":       &graphql.Field{Type: graphql.String},
 			"description": &graphql.Field{Type: graphql.String},
 			"status":      &graphql.Field{Type: graphql.Int},
+			"priority":    &graphql.Field{Type: IssuePriorityType},
+			"severity":    &graphql.Field{Type: IssueSeverityType},
+			"reporter":    &graphql.Field{Type: graphql.String},
+			"assignee":    &graphql.Field{Type: graphql.String},
+			"dueAt":       &graphql.Field{Type: graphql.DateTime},
+			"slaStatus":   &graphql.Field{Type: IssueSLAStatusType},
 			"projectId":   &graphql.Field{Type: graphql.Int},
 			"project":     &graphql.Field{Type: ProjectType},
 			"labels": &graphql.Field{
@@ -70,9 +455,55 @@ func SetTypesAndNodeDefinitions(resolver Resolver) {
 				Args:    relay.ConnectionArgs,
 				Resolve: resolver.ResolveFieldLabels,
 			},
+			"customFields":      &graphql.Field{Type: graphql.NewList(CustomFieldValueType)},
+			"originalEstimate":  &graphql.Field{Type: graphql.Int, Description: "Original estimate in minutes"},
+			"remainingEstimate": &graphql.Field{Type: graphql.Int, Description: "Remaining estimate in minutes"},
+			"timeSpent":         &graphql.Field{Type: graphql.Int, Description: "Time logged in minutes"},
+			"workLogs": &graphql.Field{
+				Type:    graphql.NewList(WorkLogType),
+				Resolve: resolver.ResolveFieldIssueWorkLogs,
+			},
+			"attachments": &graphql.Field{
+				Type:    graphql.NewList(AttachmentType),
+				Resolve: resolver.ResolveFieldIssueAttachments,
+			},
 			"createdAt": &graphql.Field{Type: graphql.DateTime},
 			"updatedAt": &graphql.Field{Type: graphql.DateTime},
 		},
 		Interfaces: []*graphql.Interface{NodeDefinitions.NodeInterface},
 	})
+
+	IssueBulkResultType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "IssueBulkResult",
+		Fields: graphql.Fields{
+			"id":     relay.GlobalIDField("Issue", nil),
+			"status": &graphql.Field{Type: graphql.Boolean},
+			"error":  &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	LabelMergeType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "LabelMerge",
+		Fields: graphql.Fields{
+			"sourceId": relay.GlobalIDField("Label", func(obj interface{}, info graphql.ResolveInfo, ctx context.Context) (string, error) {
+				return fmt.Sprint(obj.(domain.LabelMerge).SourceID), nil
+			}),
+			"targetId": relay.GlobalIDField("Label", func(obj interface{}, info graphql.ResolveInfo, ctx context.Context) (string, error) {
+				return fmt.Sprint(obj.(domain.LabelMerge).TargetID), nil
+			}),
+			"issues":     &graphql.Field{Type: graphql.Int},
+			"duplicates": &graphql.Field{Type: graphql.Int},
+		},
+	})
+
+	ProjectRemovalType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProjectRemoval",
+		Fields: graphql.Fields{
+			"id": relay.GlobalIDField("Project", func(obj interface{}, info graphql.ResolveInfo, ctx context.Context) (string, error) {
+				return fmt.Sprint(obj.(domain.ProjectRemoval).ID), nil
+			}),
+			"mode":   &graphql.Field{Type: ProjectRemovalModeType},
+			"issues": &graphql.Field{Type: graphql.Int},
+		},
+	})
 }
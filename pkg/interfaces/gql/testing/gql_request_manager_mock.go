@@ -4,6 +4,7 @@ import (
 	"github.com/graphql-go/graphql"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/interfaces/gql"
 )
 
 // RequestManagerMock is a mock of RequestManager
@@ -17,8 +18,14 @@ func (m *RequestManagerMock) Handler(c echo.Context) error {
 	return args.Error(0)
 }
 
+// SubscriptionHandler mock
+func (m *RequestManagerMock) SubscriptionHandler(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
 // Execute mock
-func (m *RequestManagerMock) Execute(schema graphql.Schema, query string, variableValues map[string]interface{}) *graphql.Result {
-	args := m.Called(query, schema, variableValues)
+func (m *RequestManagerMock) Execute(schema graphql.Schema, request gql.Request) *graphql.Result {
+	args := m.Called(schema, request)
 	return args.Get(0).(*graphql.Result)
 }
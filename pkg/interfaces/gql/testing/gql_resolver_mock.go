@@ -41,6 +41,12 @@ func (m *ResolverMock) ResolveUpdateIssueQuery(p graphql.ResolveParams) (interfa
 	return args.Get(0).(interface{}), args.Error(1)
 }
 
+// ResolveFieldProjectLabels mock
+func (m *ResolverMock) ResolveFieldProjectLabels(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
 // ResolveFindIssueByIDQuery mock
 func (m *ResolverMock) ResolveFindIssueByIDQuery(p graphql.ResolveParams) (interface{}, error) {
 	args := m.Called(p)
@@ -95,6 +101,24 @@ func (m *ResolverMock) ResolveFindAllLabelsQuery(p graphql.ResolveParams) (inter
 	return args.Get(0).(interface{}), args.Error(1)
 }
 
+// ResolveLabelMergePreviewQuery mock
+func (m *ResolverMock) ResolveLabelMergePreviewQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindLabelGroupByIDQuery mock
+func (m *ResolverMock) ResolveFindLabelGroupByIDQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindAllLabelGroupsQuery mock
+func (m *ResolverMock) ResolveFindAllLabelGroupsQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
 // ResolveRemoveLabelQuery mock
 func (m *ResolverMock) ResolveRemoveLabelQuery(p graphql.ResolveParams) (interface{}, error) {
 	args := m.Called(p)
@@ -137,6 +161,24 @@ func (m *ResolverMock) ResolveRemoveProjectQuery(p graphql.ResolveParams) (inter
 	return args.Get(0).(interface{}), args.Error(1)
 }
 
+// ResolveFindArchivedProjectsQuery mock
+func (m *ResolverMock) ResolveFindArchivedProjectsQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindWatchersQuery mock
+func (m *ResolverMock) ResolveFindWatchersQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindNotificationsQuery mock
+func (m *ResolverMock) ResolveFindNotificationsQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
 // ResolveMutationOutputFieldItem mock
 func (m *ResolverMock) ResolveMutationOutputFieldItem(p graphql.ResolveParams) (interface{}, error) {
 	args := m.Called(p)
@@ -149,6 +191,12 @@ func (m *ResolverMock) ResolveMutationOutputFieldItemID(p graphql.ResolveParams)
 	return args.Get(0).(interface{}), args.Error(1)
 }
 
+// ResolveMutationOutputFieldItems mock
+func (m *ResolverMock) ResolveMutationOutputFieldItems(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
 // ResolveMutationOutputFieldStatus mock
 func (m *ResolverMock) ResolveMutationOutputFieldStatus(p graphql.ResolveParams) (interface{}, error) {
 	args := m.Called(p)
@@ -167,6 +215,18 @@ func (m *ResolverMock) MutateAndGetPayloadForUpdateIssueMutation(ctx context.Con
 	return args.Get(0).(map[string]interface{}), args.Error(1)
 }
 
+// MutateAndGetPayloadForBulkUpdateIssuesMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForBulkUpdateIssuesMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForMoveIssueMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForMoveIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
 // MutateAndGetPayloadForRemoveIssueMutation mock
 func (m *ResolverMock) MutateAndGetPayloadForRemoveIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
 	args := m.Called(ctx, inputMap, info)
@@ -185,6 +245,12 @@ func (m *ResolverMock) MutateAndGetPayloadForUpdateProjectMutation(ctx context.C
 	return args.Get(0).(map[string]interface{}), args.Error(1)
 }
 
+// MutateAndGetPayloadForUpdateProjectSettingsMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForUpdateProjectSettingsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
 // MutateAndGetPayloadForRemoveProjectMutation mock
 func (m *ResolverMock) MutateAndGetPayloadForRemoveProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
 	args := m.Called(ctx, inputMap, info)
@@ -208,3 +274,285 @@ func (m *ResolverMock) MutateAndGetPayloadForRemoveLabelMutation(ctx context.Con
 	args := m.Called(ctx, inputMap, info)
 	return args.Get(0).(map[string]interface{}), args.Error(1)
 }
+
+// MutateAndGetPayloadForMergeLabelsMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForMergeLabelsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForSetLabelGroupMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForSetLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForAddLabelGroupMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForAddLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForUpdateLabelGroupMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForUpdateLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForRemoveLabelGroupMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForRemoveLabelGroupMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// ResolveFieldProjectTemplates mock
+func (m *ResolverMock) ResolveFieldProjectTemplates(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindIssueTemplateByIDQuery mock
+func (m *ResolverMock) ResolveFindIssueTemplateByIDQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindIssueTemplatesQuery mock
+func (m *ResolverMock) ResolveFindIssueTemplatesQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForAddIssueTemplateMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForAddIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForUpdateIssueTemplateMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForUpdateIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForRemoveIssueTemplateMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForRemoveIssueTemplateMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// ResolveFieldProjectCustomFields mock
+func (m *ResolverMock) ResolveFieldProjectCustomFields(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindCustomFieldByIDQuery mock
+func (m *ResolverMock) ResolveFindCustomFieldByIDQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindCustomFieldsQuery mock
+func (m *ResolverMock) ResolveFindCustomFieldsQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForAddCustomFieldMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForAddCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForUpdateCustomFieldMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForUpdateCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForRemoveCustomFieldMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForRemoveCustomFieldMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForSetIssueCustomFieldsMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForSetIssueCustomFieldsMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// ResolveFieldProjectTimeSummary mock
+func (m *ResolverMock) ResolveFieldProjectTimeSummary(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFieldIssueWorkLogs mock
+func (m *ResolverMock) ResolveFieldIssueWorkLogs(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFieldIssueAttachments mock
+func (m *ResolverMock) ResolveFieldIssueAttachments(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveTimesheetQuery mock
+func (m *ResolverMock) ResolveTimesheetQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForSetIssueEstimatesMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForSetIssueEstimatesMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForAddWorkLogMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForAddWorkLogMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForRemoveWorkLogMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForRemoveWorkLogMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForRemoveAttachmentMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForRemoveAttachmentMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForWatchIssueMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForWatchIssueMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForWatchProjectMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForWatchProjectMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForUnwatchMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForUnwatchMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForMarkNotificationReadMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForMarkNotificationReadMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// ResolveFindMailPreferenceQuery mock
+func (m *ResolverMock) ResolveFindMailPreferenceQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForSetMailPreferenceMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForSetMailPreferenceMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForMarkAllNotificationsReadMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForMarkAllNotificationsReadMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// ResolveFindWebhooksQuery mock
+func (m *ResolverMock) ResolveFindWebhooksQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindWebhookDeliveriesQuery mock
+func (m *ResolverMock) ResolveFindWebhookDeliveriesQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindAutomationRulesQuery mock
+func (m *ResolverMock) ResolveFindAutomationRulesQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveFindAutomationExecutionsQuery mock
+func (m *ResolverMock) ResolveFindAutomationExecutionsQuery(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveIssueAddedSubscription mock
+func (m *ResolverMock) ResolveIssueAddedSubscription(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveIssueUpdatedSubscription mock
+func (m *ResolverMock) ResolveIssueUpdatedSubscription(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// ResolveIssueRemovedSubscription mock
+func (m *ResolverMock) ResolveIssueRemovedSubscription(p graphql.ResolveParams) (interface{}, error) {
+	args := m.Called(p)
+	return args.Get(0).(interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForAddWebhookMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForAddWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForUpdateWebhookMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForUpdateWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForRemoveWebhookMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForRemoveWebhookMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForRedeliverWebhookDeliveryMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForRedeliverWebhookDeliveryMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForAddAutomationRuleMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForAddAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForUpdateAutomationRuleMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForUpdateAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+// MutateAndGetPayloadForRemoveAutomationRuleMutation mock
+func (m *ResolverMock) MutateAndGetPayloadForRemoveAutomationRuleMutation(ctx context.Context, inputMap map[string]interface{}, info graphql.ResolveInfo) (map[string]interface{}, error) {
+	args := m.Called(ctx, inputMap, info)
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
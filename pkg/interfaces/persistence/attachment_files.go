@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"go-issue-tracker/pkg/domain"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// getAttachmentsIssueDirPath to get path of directory with attachment files of issue
+func getAttachmentsIssueDirPath(dirPath string, issueID uint) string {
+	return filepath.Join(dirPath, fmt.Sprint(issueID))
+}
+
+// getAttachmentFilePath to get path of attachment file, files are named by attachment ID so provided
+// filenames never reach filesystem
+func getAttachmentFilePath(dirPath string, attachment domain.Attachment) string {
+	return filepath.Join(getAttachmentsIssueDirPath(dirPath, attachment.IssueID), fmt.Sprint(attachment.ID))
+}
+
+// writeAttachmentFile to write content of attachment file
+func writeAttachmentFile(dirPath string, attachment domain.Attachment, content []byte) error {
+	if dirPath == "" {
+		return errors.New("attachments directory not set")
+	}
+	if err := os.MkdirAll(getAttachmentsIssueDirPath(dirPath, attachment.IssueID), 0750); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(getAttachmentFilePath(dirPath, attachment), content, 0640)
+}
+
+// readAttachmentFile to read content of attachment file
+func readAttachmentFile(dirPath string, attachment domain.Attachment) ([]byte, error) {
+	if dirPath == "" {
+		return nil, errors.New("attachments directory not set")
+	}
+	return ioutil.ReadFile(getAttachmentFilePath(dirPath, attachment))
+}
+
+// removeAttachmentFile to remove attachment file, missing file is not an error
+func removeAttachmentFile(dirPath string, attachment domain.Attachment) error {
+	if dirPath == "" {
+		return nil
+	}
+	if err := os.Remove(getAttachmentFilePath(dirPath, attachment)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// removeAttachmentsIssueDirs to remove directories with attachment files of issues
+func removeAttachmentsIssueDirs(dirPath string, issueIDs ...uint) error {
+	if dirPath == "" {
+		return nil
+	}
+	for _, issueID := range issueIDs {
+		if err := os.RemoveAll(getAttachmentsIssueDirPath(dirPath, issueID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
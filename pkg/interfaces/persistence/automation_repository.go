@@ -0,0 +1,95 @@
+package persistence
+
+import (
+	"github.com/jinzhu/gorm"
+	"go-issue-tracker/pkg/domain"
+)
+
+// SQLiteAutomationRepository is a repository
+type SQLiteAutomationRepository struct {
+	db *gorm.DB
+}
+
+// NewSQLiteAutomationRepository to create SQLiteAutomationRepository
+func NewSQLiteAutomationRepository(db *gorm.DB) *SQLiteAutomationRepository {
+	return &SQLiteAutomationRepository{
+		db: db,
+	}
+}
+
+// Add to add new rule
+func (r *SQLiteAutomationRepository) Add(rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	if err := r.db.Create(rule).Error; err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Update to update rule
+func (r *SQLiteAutomationRepository) Update(rule domain.AutomationRule) (domain.AutomationRule, error) {
+	if err := r.db.Save(&rule).Error; err != nil {
+		return rule, err
+	}
+	return rule, nil
+}
+
+// FindByID to find rule by ID
+func (r *SQLiteAutomationRepository) FindByID(id uint) (domain.AutomationRule, error) {
+	var item domain.AutomationRule
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Find to find rules of project
+func (r *SQLiteAutomationRepository) Find(projectID uint) ([]domain.AutomationRule, error) {
+	var items []domain.AutomationRule
+	if err := r.db.Where("project_id = ?", projectID).Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindActive to find active rules of project with trigger, trigger is quoted because it is SQL keyword
+func (r *SQLiteAutomationRepository) FindActive(projectID uint, trigger string) ([]domain.AutomationRule, error) {
+	var items []domain.AutomationRule
+	if err := r.db.Where("project_id = ? AND \"trigger\" = ? AND active = ?", projectID, trigger, true).Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Remove to remove rule with its executions
+func (r *SQLiteAutomationRepository) Remove(id uint) (bool, error) {
+	tx := r.db.Begin()
+	if err := tx.Exec("DELETE FROM \"automation_executions\" WHERE rule_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.AutomationRule{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddExecution to add execution of rule
+func (r *SQLiteAutomationRepository) AddExecution(execution *domain.AutomationExecution) (*domain.AutomationExecution, error) {
+	if err := r.db.Create(execution).Error; err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// FindExecutions to find latest executions of rule, newest first
+func (r *SQLiteAutomationRepository) FindExecutions(ruleID uint, limit int) ([]domain.AutomationExecution, error) {
+	var items []domain.AutomationExecution
+	if err := r.db.Where("rule_id = ?", ruleID).Order("id DESC").Limit(limit).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
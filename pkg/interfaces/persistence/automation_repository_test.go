@@ -0,0 +1,179 @@
+package persistence_test
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/persistence"
+	pTesting "go-issue-tracker/pkg/interfaces/persistence/testing"
+	"testing"
+)
+
+func TestPersistenceAutomationNewSQLiteAutomationRepository(t *testing.T) {
+	mockDB, _, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteAutomationRepository(gormDB)
+
+	assert.NotNil(t, r)
+}
+
+func TestPersistenceAutomationAddAndUpdate(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteAutomationRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"automation_rules\" (.+)$").WithArgs(1, "Security", "LabelsChanged", "addedLabel:security", "setPriority:high,addLabel:triage", true, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"automation_rules\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"automation_rules\" SET (.+)$").WithArgs(1, "Security", "LabelsChanged", "", "setPriority:high", false, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"automation_rules\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.Add(&domain.AutomationRule{ProjectID: 1, Name: "Security", Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Actions: "setPriority:high,addLabel:triage", Active: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	item, err = r.Add(&domain.AutomationRule{ProjectID: 1})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	rule := domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Security", Trigger: domain.EventLabelsChanged, Actions: "setPriority:high"}
+
+	updated, err := r.Update(rule)
+
+	assert.Nil(t, err)
+	assert.Equal(t, rule.Actions, updated.Actions)
+
+	_, err = r.Update(rule)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceAutomationFind(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteAutomationRepository(gormDB)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"automation_rules\" WHERE \\(ID = \\?\\) (.+)$").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"automation_rules\" WHERE \\(project_id = \\?\\) ORDER BY \"id\"").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(1, 1).AddRow(2, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"automation_rules\" WHERE (.+)$").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT (.+) FROM \"automation_rules\" WHERE \\(project_id = \\? AND \"trigger\" = \\? AND active = \\?\\) ORDER BY \"id\"").WithArgs(1, "LabelsChanged", true).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(2, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"automation_rules\" WHERE (.+)$").WithArgs(1, "IssueCreated", true).WillReturnError(errors.New("test error"))
+
+	item, err := r.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	items, err := r.Find(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	_, err = r.Find(2)
+
+	assert.NotNil(t, err)
+
+	items, err = r.FindActive(1, domain.EventLabelsChanged)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, uint(2), items[0].ID)
+
+	_, err = r.FindActive(1, domain.EventIssueCreated)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceAutomationRemove(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteAutomationRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"automation_executions\" WHERE rule_id = \\?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM \"automation_rules\" WHERE \\(ID = \\?\\)").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"automation_executions\" WHERE rule_id = \\?").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Remove(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = r.Remove(2)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceAutomationExecutions(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteAutomationRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"automation_executions\" (.+)$").WithArgs(1, 2, "LabelsChanged", "applied", 1, "", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"automation_executions\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+	mock.ExpectQuery("SELECT (.+) FROM \"automation_executions\" WHERE \\(rule_id = \\?\\) ORDER BY id DESC LIMIT 50").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "rule_id"}).AddRow(2, 1).AddRow(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"automation_executions\" WHERE (.+)$").WithArgs(2).WillReturnError(errors.New("test error"))
+
+	item, err := r.AddExecution(&domain.AutomationExecution{RuleID: 1, IssueID: 2, Event: domain.EventLabelsChanged, Status: domain.AutomationExecutionApplied, Depth: 1})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	item, err = r.AddExecution(&domain.AutomationExecution{RuleID: 1})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	items, err := r.FindExecutions(1, domain.AutomationExecutionsLimit)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	_, err = r.FindExecutions(2, domain.AutomationExecutionsLimit)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"github.com/jinzhu/gorm"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// SQLiteEventRepository is a repository
+type SQLiteEventRepository struct {
+	db *gorm.DB
+}
+
+// NewSQLiteEventRepository to create SQLiteEventRepository
+func NewSQLiteEventRepository(db *gorm.DB) *SQLiteEventRepository {
+	return &SQLiteEventRepository{
+		db: db,
+	}
+}
+
+// Begin to begin transaction shared by repositories bound to it by WithTransaction
+func (r *SQLiteEventRepository) Begin() (domain.Transaction, error) {
+	return beginTransaction(r.db)
+}
+
+// WithTransaction to get repository writing in transaction
+func (r *SQLiteEventRepository) WithTransaction(tx domain.Transaction) domain.EventRepository {
+	return &SQLiteEventRepository{
+		db: getTransactionDB(r.db, tx),
+	}
+}
+
+// AddOutboxEvents to add outbox events in single transaction
+func (r *SQLiteEventRepository) AddOutboxEvents(events []domain.OutboxEvent) (int, error) {
+	tx := begin(r.db)
+	for i := range events {
+		if err := tx.Create(&events[i]).Error; err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+// UpdateOutboxEvent to update outbox event
+func (r *SQLiteEventRepository) UpdateOutboxEvent(event domain.OutboxEvent) (domain.OutboxEvent, error) {
+	if err := r.db.Save(&event).Error; err != nil {
+		return event, err
+	}
+	return event, nil
+}
+
+// RemoveOutboxEvent to remove handled outbox event
+func (r *SQLiteEventRepository) RemoveOutboxEvent(id uint) (bool, error) {
+	if err := r.db.Where("ID = ?", id).Delete(domain.OutboxEvent{}).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// FindDueOutboxEvents to find pending outbox events which should be handled at provided time, oldest first
+func (r *SQLiteEventRepository) FindDueOutboxEvents(now time.Time, limit int) ([]domain.OutboxEvent, error) {
+	var items []domain.OutboxEvent
+	if err := r.db.Where("status = ? AND handle_after <= ?", domain.OutboxEventPending, now).Order("id").Limit(limit).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
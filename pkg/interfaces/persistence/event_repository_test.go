@@ -0,0 +1,178 @@
+package persistence_test
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/persistence"
+	pTesting "go-issue-tracker/pkg/interfaces/persistence/testing"
+	"testing"
+	"time"
+)
+
+func TestPersistenceEventNewSQLiteEventRepository(t *testing.T) {
+	mockDB, _, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteEventRepository(gormDB)
+
+	assert.NotNil(t, r)
+}
+
+func TestPersistenceEventOutbox(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteEventRepository(gormDB)
+
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	e := []domain.OutboxEvent{
+		{Subscriber: "webhooks", Name: domain.EventIssueCreated, ProjectID: 1, Payload: "{}", Status: domain.OutboxEventPending, HandleAfter: now},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"outbox_events\" (.+)$").WithArgs("webhooks", "IssueCreated", 1, "{}", "pending", 0, "", now, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"outbox_events\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"outbox_events\" SET (.+)$").WithArgs("webhooks", "IssueCreated", 1, "{}", "pending", 1, "test error", now.Add(time.Minute), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"outbox_events\" WHERE \\(ID = \\?\\)").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"outbox_events\" WHERE \\(ID = \\?\\)").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+	mock.ExpectQuery("SELECT (.+) FROM \"outbox_events\" WHERE \\(status = \\? AND handle_after <= \\?\\) ORDER BY \"id\" LIMIT 10").WithArgs("pending", now).WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(1, "pending"))
+	mock.ExpectQuery("SELECT (.+) FROM \"outbox_events\" WHERE (.+)$").WithArgs("pending", now).WillReturnError(errors.New("test error"))
+
+	count, err := r.AddOutboxEvents(e)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, uint(1), e[0].ID)
+
+	count, err = r.AddOutboxEvents([]domain.OutboxEvent{{Subscriber: "webhooks"}})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, count)
+
+	retried := e[0]
+	retried.Attempts = 1
+	retried.LastError = "test error"
+	retried.HandleAfter = now.Add(time.Minute)
+
+	item, err := r.UpdateOutboxEvent(retried)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item.Attempts)
+
+	status, err := r.RemoveOutboxEvent(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = r.RemoveOutboxEvent(2)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	items, err := r.FindDueOutboxEvents(now, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	_, err = r.FindDueOutboxEvents(now, 10)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceEventTransaction(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteEventRepository(gormDB)
+	lr := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT count(.+) FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"projects_default_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"outbox_events\" (.+)$").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	tx, err := r.Begin()
+
+	assert.Nil(t, err)
+
+	status, err := lr.WithTransaction(tx).Remove(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	count, err := r.WithTransaction(tx).AddOutboxEvents([]domain.OutboxEvent{{Subscriber: "webhooks", Name: domain.EventLabelRemoved}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Nil(t, tx.Commit())
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceEventTransactionRollback(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteEventRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"outbox_events\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	tx, err := r.Begin()
+
+	assert.Nil(t, err)
+
+	count, err := r.WithTransaction(tx).AddOutboxEvents([]domain.OutboxEvent{{Subscriber: "webhooks"}})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Nil(t, tx.Rollback())
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceEventBeginErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteEventRepository(gormDB)
+
+	mock.ExpectBegin().WillReturnError(errors.New("test error"))
+
+	tx, err := r.Begin()
+
+	assert.NotNil(t, err)
+	assert.Nil(t, tx)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
@@ -3,50 +3,234 @@ package persistence
 import (
 	"github.com/jinzhu/gorm"
 	"go-issue-tracker/pkg/domain"
+	"time"
 )
 
-// SQLiteIssueRepository is a repository
+// SQLiteIssueRepository is a repository, attachment files are stored in attachments directory
 type SQLiteIssueRepository struct {
-	db *gorm.DB
+	db                 *gorm.DB
+	attachmentsDirPath string
 }
 
 // NewSQLiteIssueRepository to create SQLiteIssueRepository
-func NewSQLiteIssueRepository(db *gorm.DB) *SQLiteIssueRepository {
+func NewSQLiteIssueRepository(db *gorm.DB, attachmentsDirPath string) *SQLiteIssueRepository {
 	return &SQLiteIssueRepository{
-		db: db,
+		db:                 db,
+		attachmentsDirPath: attachmentsDirPath,
 	}
 }
 
-// Add to add new issue
+// preload to preload project, labels and custom field values of issues
+func (r *SQLiteIssueRepository) preload() *gorm.DB {
+	return r.db.Preload("Project").Preload("Labels").Preload("Labels.Group").Preload("CustomFields").Preload("CustomFields.Field")
+}
+
+// WithTransaction to get repository writing in transaction
+func (r *SQLiteIssueRepository) WithTransaction(tx domain.Transaction) domain.IssueRepository {
+	return &SQLiteIssueRepository{
+		db:                 getTransactionDB(r.db, tx),
+		attachmentsDirPath: r.attachmentsDirPath,
+	}
+}
+
+// watch to add reporter and assignee of issue as its watchers unless they already watch it
+func (r *SQLiteIssueRepository) watch(db *gorm.DB, issue domain.Issue) error {
+	for _, user := range domain.GetIssueParticipants(issue) {
+		var count int
+		if err := db.Model(&domain.Watcher{}).Where("user = ? AND issue_id = ?", user, issue.ID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+		if err := db.Create(&domain.Watcher{User: user, IssueID: issue.ID}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add to add new issue, its reporter and assignee are added as watchers in single transaction
 func (r *SQLiteIssueRepository) Add(issue *domain.Issue) (*domain.Issue, error) {
-	if err := r.db.Create(issue).Error; err != nil {
+	tx := begin(r.db)
+	if err := tx.Create(issue).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := r.watch(tx.DB, *issue); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit().Error; err != nil {
 		return nil, err
 	}
 	return issue, nil
 }
 
-// Update to update issue
+// Update to update issue, its reporter and assignee are added as watchers in single transaction
 func (r *SQLiteIssueRepository) Update(issue domain.Issue) (domain.Issue, error) {
-	if err := r.db.Model(&issue).Association("Labels").Replace(issue.Labels).Error; err != nil {
+	tx := begin(r.db)
+	if err := tx.Model(&issue).Association("Labels").Replace(issue.Labels).Error; err != nil {
+		tx.Rollback()
+		return issue, err
+	}
+	if err := tx.Save(&issue).Error; err != nil {
+		tx.Rollback()
+		return issue, err
+	}
+	if err := r.watch(tx.DB, issue); err != nil {
+		tx.Rollback()
 		return issue, err
 	}
-	if err := r.db.Save(&issue).Error; err != nil {
+	if err := tx.Commit().Error; err != nil {
 		return issue, err
 	}
 	return issue, nil
 }
 
+// UpdateBulk to update issues in single transaction, issues moved to another project are recorded
+// the same way as by Move
+func (r *SQLiteIssueRepository) UpdateBulk(issues []domain.Issue, moves []domain.IssueMove) ([]domain.Issue, error) {
+	tx := begin(r.db)
+	for i := range issues {
+		if err := tx.Model(&issues[i]).Association("Labels").Replace(issues[i].Labels).Error; err != nil {
+			tx.Rollback()
+			return issues, err
+		}
+		if err := tx.Save(&issues[i]).Error; err != nil {
+			tx.Rollback()
+			return issues, err
+		}
+	}
+	for i := range moves {
+		if err := r.recordMove(tx.DB, &moves[i]); err != nil {
+			tx.Rollback()
+			return issues, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return issues, err
+	}
+	return issues, nil
+}
+
+// UpdateSLA to update due date and SLA status of issue, update time of issue is kept
+func (r *SQLiteIssueRepository) UpdateSLA(issue domain.Issue) (domain.Issue, error) {
+	if err := r.db.Model(&domain.Issue{}).Where("ID = ?", issue.ID).UpdateColumns(map[string]interface{}{
+		"due_at":     issue.DueAt,
+		"sla_status": issue.SLAStatus,
+	}).Error; err != nil {
+		return issue, err
+	}
+	return issue, nil
+}
+
+// SetEstimates to update original and remaining estimate of issue, other values of issue are kept
+func (r *SQLiteIssueRepository) SetEstimates(issue domain.Issue) (domain.Issue, error) {
+	if err := r.db.Model(&domain.Issue{}).Where("ID = ?", issue.ID).UpdateColumns(map[string]interface{}{
+		"original_estimate":  issue.OriginalEstimate,
+		"remaining_estimate": issue.RemainingEstimate,
+	}).Error; err != nil {
+		return issue, err
+	}
+	return issue, nil
+}
+
+// MigratePriority to raise priority of issue to issue priority and remove migrated labels from issue in
+// single transaction, higher priority set meanwhile and update time of issue are kept
+func (r *SQLiteIssueRepository) MigratePriority(issue domain.Issue, labels []domain.Label) (domain.Issue, error) {
+	tx := begin(r.db)
+	if err := tx.Model(&domain.Issue{}).Where("ID = ?", issue.ID).UpdateColumn("priority", gorm.Expr("MAX(priority, ?)", issue.Priority)).Error; err != nil {
+		tx.Rollback()
+		return issue, err
+	}
+	for _, label := range labels {
+		if err := tx.Exec("DELETE FROM \"issues_labels\" WHERE issue_id=? AND label_id=?", issue.ID, label.ID).Error; err != nil {
+			tx.Rollback()
+			return issue, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return issue, err
+	}
+	return issue, nil
+}
+
+// UpdateCustomFields to replace custom field values of issue
+func (r *SQLiteIssueRepository) UpdateCustomFields(issue domain.Issue) (domain.Issue, error) {
+	tx := begin(r.db)
+	if err := tx.Exec("DELETE FROM \"custom_field_values\" WHERE issue_id=?", issue.ID).Error; err != nil {
+		tx.Rollback()
+		return issue, err
+	}
+	for i := range issue.CustomFields {
+		issue.CustomFields[i].ID = 0
+		issue.CustomFields[i].IssueID = issue.ID
+		if err := tx.Create(&issue.CustomFields[i]).Error; err != nil {
+			tx.Rollback()
+			return issue, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return issue, err
+	}
+	return issue, nil
+}
+
+// Move to move issue to another project and record the move, custom field values of source project are removed
+func (r *SQLiteIssueRepository) Move(issue domain.Issue, project domain.Project) (domain.Issue, error) {
+	move := domain.IssueMove{
+		IssueID:       issue.ID,
+		FromProjectID: issue.ProjectID,
+		ToProjectID:   project.ID,
+	}
+	tx := begin(r.db)
+	if err := tx.Model(&domain.Issue{}).Where("ID = ?", issue.ID).Update("project_id", project.ID).Error; err != nil {
+		tx.Rollback()
+		return issue, err
+	}
+	if err := r.recordMove(tx.DB, &move); err != nil {
+		tx.Rollback()
+		return issue, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return issue, err
+	}
+	issue.ProjectID = project.ID
+	issue.Project = project
+	issue.CustomFields = []domain.CustomFieldValue{}
+	return issue, nil
+}
+
+// recordMove to record move of issue in transaction and remove custom field values of source project
+func (r *SQLiteIssueRepository) recordMove(tx *gorm.DB, move *domain.IssueMove) error {
+	if err := tx.Create(move).Error; err != nil {
+		return err
+	}
+	return tx.Exec("DELETE FROM \"custom_field_values\" WHERE issue_id=?", move.IssueID).Error
+}
+
+// FindMoves to find moves of issue
+func (r *SQLiteIssueRepository) FindMoves(id uint) ([]domain.IssueMove, error) {
+	var items []domain.IssueMove
+	if err := r.db.Where("issue_id = ?", id).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
 // FindByID to find issue by ID
 func (r *SQLiteIssueRepository) FindByID(id uint) (domain.Issue, error) {
 	var item domain.Issue
-	if err := r.db.Preload("Project").Preload("Labels").Where("ID = ?", id).First(&item).Error; err != nil {
+	if err := r.preload().Where("ID = ?", id).First(&item).Error; err != nil {
 		return item, err
 	}
 	return item, nil
 }
 
-// Find to find issues
-func (r *SQLiteIssueRepository) Find(title string, projectID uint, labels []string) ([]domain.Issue, error) {
+// Find to find issues, issues have to match all custom field filters and min. levels of options,
+// issues without due date are last when sorted by due date
+func (r *SQLiteIssueRepository) Find(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions) ([]domain.Issue, error) {
 	var items []domain.Issue
 	query := ""
 	args := []interface{}{}
@@ -70,16 +254,65 @@ func (r *SQLiteIssueRepository) Find(title string, projectID uint, labels []stri
 		}
 		args = append(args, labels)
 	}
+	for _, filter := range customFields {
+		if query != "" {
+			query += " AND "
+		}
+		if filter.Field.Type == domain.CustomFieldTypeMultiSelect {
+			query += "\"issues\".\"id\" IN (SELECT issue_id FROM \"custom_field_values\" WHERE field_id = ? AND (value = ? OR value LIKE ? OR value LIKE ? OR value LIKE ?))"
+			args = append(args, filter.Field.ID, filter.Value, filter.Value+",%", "%,"+filter.Value, "%,"+filter.Value+",%")
+		} else {
+			query += "\"issues\".\"id\" IN (SELECT issue_id FROM \"custom_field_values\" WHERE field_id = ? AND value = ?)"
+			args = append(args, filter.Field.ID, filter.Value)
+		}
+	}
+	if options.MinPriority > domain.PriorityNone {
+		if query != "" {
+			query += " AND "
+		}
+		query += "\"issues\".\"priority\" >= ?"
+		args = append(args, options.MinPriority)
+	}
+	if options.MinSeverity > domain.SeverityNone {
+		if query != "" {
+			query += " AND "
+		}
+		query += "\"issues\".\"severity\" >= ?"
+		args = append(args, options.MinSeverity)
+	}
+	if options.MinSLAStatus > domain.SLAStatusNone {
+		if query != "" {
+			query += " AND "
+		}
+		query += "\"issues\".\"sla_status\" >= ?"
+		args = append(args, options.MinSLAStatus)
+	}
+	if !options.UpdatedBefore.IsZero() {
+		if query != "" {
+			query += " AND "
+		}
+		query += "\"issues\".\"updated_at\" < ?"
+		args = append(args, options.UpdatedBefore)
+	}
+	db := r.preload()
+	switch options.Sort {
+	case domain.IssueSortPriority:
+		db = db.Order("\"issues\".\"priority\" DESC").Order("\"issues\".\"severity\" DESC").Order("\"issues\".\"id\"")
+	case domain.IssueSortSeverity:
+		db = db.Order("\"issues\".\"severity\" DESC").Order("\"issues\".\"priority\" DESC").Order("\"issues\".\"id\"")
+	case domain.IssueSortDue:
+		db = db.Order("\"issues\".\"due_at\" IS NULL").Order("\"issues\".\"due_at\"").Order("\"issues\".\"id\"")
+	}
 	if query == "" {
-		if err := r.db.Preload("Project").Preload("Labels").Find(&items).Error; err != nil {
+		if err := db.Find(&items).Error; err != nil {
 			return items, err
 		}
 	} else if len(labels) > 0 {
-		if err := r.db.Preload("Project").Preload("Labels").Joins("INNER JOIN \"issues_labels\" ON \"issues_labels\".\"issue_id\" = \"issues\".\"id\"").Where(query, args...).Select("DISTINCT \"issues\".*").Find(&items).Error; err != nil {
+		if err := db.Joins("INNER JOIN \"issues_labels\" ON \"issues_labels\".\"issue_id\" = \"issues\".\"id\"").Where(query, args...).Select("DISTINCT \"issues\".*").Find(&items).Error; err != nil {
 			return items, err
 		}
 	} else {
-		if err := r.db.Preload("Project").Preload("Labels").Where(query, args...).Find(&items).Error; err != nil {
+		if err := db.Where(query, args...).Find(&items).Error; err != nil {
 			return items, err
 		}
 	}
@@ -89,23 +322,187 @@ func (r *SQLiteIssueRepository) Find(title string, projectID uint, labels []stri
 // FindAll to find all issues
 func (r *SQLiteIssueRepository) FindAll() ([]domain.Issue, error) {
 	var items []domain.Issue
-	if err := r.db.Preload("Project").Preload("Labels").Find(&items).Error; err != nil {
+	if err := r.preload().Find(&items).Error; err != nil {
 		return items, err
 	}
 	return items, nil
 }
 
-// Remove to remove issue
+// Remove to remove issue together with its label links, custom field values, work logs, attachments,
+// watchers and moves in single transaction, attachment files are removed after commit, after commit of
+// transaction repository is bound to, so they are kept when the transaction is rolled back
 func (r *SQLiteIssueRepository) Remove(id uint) (bool, error) {
-	tx := r.db.Begin()
-	if err := r.db.Exec("DELETE FROM \"issues_labels\" WHERE issue_id=?", id).Error; err != nil {
+	tx := begin(r.db)
+	for _, table := range []string{"issues_labels", "custom_field_values", "work_logs", "attachments", "watchers", "issue_moves"} {
+		if err := tx.Exec("DELETE FROM \""+table+"\" WHERE issue_id=?", id).Error; err != nil {
+			tx.Rollback()
+			return false, err
+		}
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.Issue{}).Error; err != nil {
 		tx.Rollback()
 		return false, err
 	}
-	if err := r.db.Where("ID = ?", id).Delete(domain.Issue{}).Error; err != nil {
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	if err := tx.afterCommit(func() error {
+		return removeAttachmentsIssueDirs(r.attachmentsDirPath, id)
+	}); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// AddWorkLog to add work log and update time spent and remaining estimate of issue in single transaction
+func (r *SQLiteIssueRepository) AddWorkLog(workLog *domain.WorkLog, issue domain.Issue) (*domain.WorkLog, error) {
+	tx := begin(r.db)
+	if err := tx.Create(workLog).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Model(&domain.Issue{}).Where("ID = ?", issue.ID).UpdateColumns(map[string]interface{}{
+		"time_spent":         issue.TimeSpent,
+		"remaining_estimate": issue.RemainingEstimate,
+	}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	return workLog, nil
+}
+
+// RemoveWorkLog to remove work log and update time spent of issue in single transaction
+func (r *SQLiteIssueRepository) RemoveWorkLog(workLog domain.WorkLog, issue domain.Issue) (bool, error) {
+	tx := begin(r.db)
+	if err := tx.Where("ID = ?", workLog.ID).Delete(domain.WorkLog{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Model(&domain.Issue{}).Where("ID = ?", issue.ID).UpdateColumn("time_spent", issue.TimeSpent).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// FindWorkLogByID to find work log by ID
+func (r *SQLiteIssueRepository) FindWorkLogByID(id uint) (domain.WorkLog, error) {
+	var item domain.WorkLog
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindWorkLogs to find work logs of issue ordered by time of work
+func (r *SQLiteIssueRepository) FindWorkLogs(issueID uint) ([]domain.WorkLog, error) {
+	var items []domain.WorkLog
+	if err := r.db.Where("issue_id = ?", issueID).Order("logged_at").Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindProjectWorkLogs to find work logs of issues of project logged in time range, work logs of all
+// projects are found when project is not provided
+func (r *SQLiteIssueRepository) FindProjectWorkLogs(projectID uint, from time.Time, to time.Time) ([]domain.WorkLog, error) {
+	var items []domain.WorkLog
+	db := r.db.Where("logged_at >= ? AND logged_at < ?", from, to)
+	if projectID != uint(0) {
+		db = db.Where("issue_id IN (SELECT id FROM \"issues\" WHERE project_id = ?)", projectID)
+	}
+	if err := db.Order("logged_at").Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// SumTime to sum estimates and time spent of issues of project, issues of all projects are summed
+// when project is not provided
+func (r *SQLiteIssueRepository) SumTime(projectID uint) (domain.TimeSummary, error) {
+	var item domain.TimeSummary
+	db := r.db.Table("issues").Select("COALESCE(SUM(original_estimate), 0) AS original_estimate, COALESCE(SUM(remaining_estimate), 0) AS remaining_estimate, COALESCE(SUM(time_spent), 0) AS time_spent")
+	if projectID != uint(0) {
+		db = db.Where("project_id = ?", projectID)
+	}
+	if err := db.Scan(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// AddAttachment to add attachment and write its file, attachment is not added when file cannot be written
+// and file is removed when transaction repository is bound to is rolled back
+func (r *SQLiteIssueRepository) AddAttachment(attachment *domain.Attachment, content []byte) (*domain.Attachment, error) {
+	tx := begin(r.db)
+	if err := tx.Create(attachment).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := writeAttachmentFile(r.attachmentsDirPath, *attachment, content); err != nil {
+		tx.Rollback()
+		removeAttachmentFile(r.attachmentsDirPath, *attachment)
+		return nil, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		removeAttachmentFile(r.attachmentsDirPath, *attachment)
+		return nil, err
+	}
+	item := *attachment
+	tx.afterRollback(func() error {
+		return removeAttachmentFile(r.attachmentsDirPath, item)
+	})
+	return attachment, nil
+}
+
+// RemoveAttachment to remove attachment and its file, file is removed after commit of transaction
+// repository is bound to
+func (r *SQLiteIssueRepository) RemoveAttachment(attachment domain.Attachment) (bool, error) {
+	tx := begin(r.db)
+	if err := tx.Where("ID = ?", attachment.ID).Delete(domain.Attachment{}).Error; err != nil {
 		tx.Rollback()
 		return false, err
 	}
-	tx.Commit()
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	if err := tx.afterCommit(func() error {
+		return removeAttachmentFile(r.attachmentsDirPath, attachment)
+	}); err != nil {
+		return true, err
+	}
 	return true, nil
 }
+
+// FindAttachmentByID to find attachment by ID
+func (r *SQLiteIssueRepository) FindAttachmentByID(id uint) (domain.Attachment, error) {
+	var item domain.Attachment
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAttachments to find attachments of issue ordered by ID
+func (r *SQLiteIssueRepository) FindAttachments(issueID uint) ([]domain.Attachment, error) {
+	var items []domain.Attachment
+	if err := r.db.Where("issue_id = ?", issueID).Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// ReadAttachment to read content of attachment file
+func (r *SQLiteIssueRepository) ReadAttachment(attachment domain.Attachment) ([]byte, error) {
+	content, err := readAttachmentFile(r.attachmentsDirPath, attachment)
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
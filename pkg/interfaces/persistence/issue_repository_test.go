@@ -7,7 +7,11 @@ import (
 	"go-issue-tracker/pkg/domain"
 	"go-issue-tracker/pkg/interfaces/persistence"
 	pTesting "go-issue-tracker/pkg/interfaces/persistence/testing"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestPersistenceIssueNewSQLiteIssueRepository(t *testing.T) {
@@ -15,7 +19,7 @@ func TestPersistenceIssueNewSQLiteIssueRepository(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
 	assert.NotNil(t, r)
 }
@@ -25,10 +29,10 @@ func TestPersistenceIssueAdd(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO \"issues\" (.+)$").WithArgs("test-title", "test-description", 1, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"issues\" (.+)$").WithArgs("test-title", "test-description", 1, 0, 0, "", "", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	i := new(domain.Issue)
@@ -57,10 +61,10 @@ func TestPersistenceIssueAddErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO \"issues\" (.+)$").WithArgs("test-title", "test-description", 1, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
+	mock.ExpectExec("INSERT INTO \"issues\" (.+)$").WithArgs("test-title", "test-description", 1, 0, 0, "", "", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
 	i := new(domain.Issue)
@@ -84,14 +88,11 @@ func TestPersistenceIssueUpdate(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
 	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectCommit()
-
-	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title", "test-description", 1, 1, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title", "test-description", 1, 0, 0, "", "", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	i := domain.Issue{
@@ -117,12 +118,76 @@ func TestPersistenceIssueUpdate(t *testing.T) {
 	}
 }
 
+func TestPersistenceIssueUpdateWatchesParticipants(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title", "", 1, 0, 0, "alice", "bob", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("^SELECT count\\(\\*\\) FROM \"watchers\" WHERE (.+)$").WithArgs("alice", 1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("^SELECT count\\(\\*\\) FROM \"watchers\" WHERE (.+)$").WithArgs("bob", 1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("INSERT INTO \"watchers\" (.+)$").WithArgs("bob", 1, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	i := domain.Issue{
+		ID:        uint(1),
+		Title:     "test-title",
+		Status:    1,
+		Reporter:  "alice",
+		Assignee:  "bob",
+		ProjectID: 1,
+	}
+
+	_, err := r.Update(i)
+
+	assert.Nil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueAddWatchErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"issues\" (.+)$").WithArgs("test-title", "", 1, 0, 0, "alice", "alice", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("^SELECT count\\(\\*\\) FROM \"watchers\" WHERE (.+)$").WithArgs("alice", 1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("INSERT INTO \"watchers\" (.+)$").WithArgs("alice", 1, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	i := &domain.Issue{
+		Title:     "test-title",
+		Status:    1,
+		Reporter:  "alice",
+		Assignee:  "alice",
+		ProjectID: 1,
+	}
+
+	item, err := r.Add(i)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
 func TestPersistenceIssueUpdateReplaceErr(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
 	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
@@ -156,14 +221,11 @@ func TestPersistenceIssueUpdateErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
 	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectCommit()
-
-	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title", "test-description", 1, 1, sqlmock.AnyArg(), 1).WillReturnError(errors.New("test error"))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title", "test-description", 1, 0, 0, "", "", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), 1).WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
 	i := domain.Issue{
@@ -189,289 +251,1344 @@ func TestPersistenceIssueUpdateErr(t *testing.T) {
 	}
 }
 
-func TestPersistenceIssueFindByID(t *testing.T) {
+func TestPersistenceIssueUpdateBulk(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
-	issueData := sqlmock.NewRows([]string{
-		"id", "title", "description", "status", "project_id",
-	}).AddRow(uint(1), "test-title", "test-description", 1, 1)
-	mock.ExpectQuery("SELECT (.+) FROM \"issues\" (.+)$").WithArgs(1).WillReturnRows(issueData)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
-	projectData := sqlmock.NewRows([]string{
-		"id", "name", "description",
-	}).AddRow(uint(1), "test-name", "test-description")
-	mock.ExpectQuery("SELECT (.+) FROM \"projects\" (.+)$").WithArgs(1).WillReturnRows(projectData)
-	labelData := sqlmock.NewRows([]string{
-		"id", "name", "color_hex_code",
-	}).AddRow(uint(1), "test-name", "FFFFFF")
-	mock.ExpectQuery("SELECT (.+) FROM \"labels\" (.+)$").WithArgs(1).WillReturnRows(labelData)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title-1", "test-description", 2, 0, 0, "", "", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(2).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title-2", "test-description", 2, 0, 0, "", "", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), 2).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
-	item, err := r.FindByID(uint(1))
+	issues := []domain.Issue{
+		{
+			ID:          uint(1),
+			Title:       "test-title-1",
+			Description: "test-description",
+			Status:      2,
+			ProjectID:   1,
+		},
+		{
+			ID:          uint(2),
+			Title:       "test-title-2",
+			Description: "test-description",
+			Status:      2,
+			ProjectID:   1,
+		},
+	}
+
+	items, err := r.UpdateBulk(issues, nil)
 
 	assert.Nil(t, err)
-	assert.NotNil(t, item)
-	assert.Equal(t, uint(1), item.ID)
+	assert.Equal(t, 2, len(items))
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("expectations were not met %s", err)
 	}
 }
 
-func TestPersistenceIssueFindByIDErr(t *testing.T) {
+func TestPersistenceIssueUpdateBulkMove(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
-	mock.ExpectQuery("SELECT (.+) FROM \"issues\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title-1", "test-description", 2, 0, 0, "", "", nil, 0, 0, 0, 0, 2, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"issue_moves\" (.+)$").WithArgs(1, 1, 2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
-	item, err := r.FindByID(uint(1))
+	issues := []domain.Issue{
+		{
+			ID:          uint(1),
+			Title:       "test-title-1",
+			Description: "test-description",
+			Status:      2,
+			ProjectID:   2,
+		},
+	}
+	moves := []domain.IssueMove{
+		{IssueID: 1, FromProjectID: 1, ToProjectID: 2},
+	}
 
-	assert.NotNil(t, err)
-	assert.NotNil(t, item)
-	assert.Equal(t, uint(0), item.ID)
+	items, err := r.UpdateBulk(issues, moves)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("expectations were not met %s", err)
 	}
 }
 
-func TestPersistenceIssueFind(t *testing.T) {
-	tests := []struct {
-		title     string
-		projectID uint
-		labels    []string
-	}{
-		{
-			"test-title-1",
-			uint(1),
-			[]string{"test-name-1"},
-		},
-		{
-			"test-title-1",
-			uint(1),
-			[]string{},
-		},
-		{
-			"",
-			uint(1),
-			[]string{},
-		},
-		{
-			"test-title-1",
-			uint(0),
-			[]string{},
-		},
+func TestPersistenceIssueUpdateBulkErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs("test-title-1", "test-description", 2, 0, 0, "", "", nil, 0, 0, 0, 0, 1, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	issues := []domain.Issue{
 		{
-			"",
-			uint(0),
-			[]string{"test-name-1"},
+			ID:          uint(1),
+			Title:       "test-title-1",
+			Description: "test-description",
+			Status:      2,
+			ProjectID:   1,
 		},
 		{
-			"",
-			uint(0),
-			[]string{},
+			ID:          uint(2),
+			Title:       "test-title-2",
+			Description: "test-description",
+			Status:      2,
+			ProjectID:   1,
 		},
 	}
 
-	for _, ts := range tests {
-		mockDB, mock, gormDB := pTesting.GetMockedDB(t)
-		defer mockDB.Close()
-		defer gormDB.Close()
+	_, err := r.UpdateBulk(issues, nil)
 
-		r := persistence.NewSQLiteIssueRepository(gormDB)
+	assert.NotNil(t, err)
 
-		mock.MatchExpectationsInOrder(false)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
 
-		issueData := sqlmock.NewRows([]string{
-			"id", "title", "description", "status", "project_id",
-		}).AddRow(uint(1), "test-title-1", "test-description", 1, 1)
-		mock.ExpectQuery("SELECT (.+) FROM \"issues\"").WillReturnRows(issueData)
+func TestPersistenceIssueUpdateSLA(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
 
-		labelData := sqlmock.NewRows([]string{
-			"id", "name", "color_hex_code",
-		}).AddRow(uint(1), "test-name-1", "FFFFFF")
-		mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WithArgs(1).WillReturnRows(labelData)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
-		projectData := sqlmock.NewRows([]string{
-			"id", "name", "description",
-		}).AddRow(uint(1), "test-name-1", "test-description")
-		mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WillReturnRows(projectData)
+	dueAt := time.Date(2020, time.January, 6, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("^UPDATE \"issues\" SET \"due_at\" = (.+), \"sla_status\" = (.+) WHERE (.+)$").WithArgs(dueAt, domain.SLAStatusBreached, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
-		items, err := r.Find(ts.title, ts.projectID, ts.labels)
+	item, err := r.UpdateSLA(domain.Issue{ID: 1, Title: "test-title", DueAt: &dueAt, SLAStatus: domain.SLAStatusBreached})
 
-		assert.Nil(t, err)
-		assert.NotNil(t, items)
-		assert.Equal(t, 1, len(items))
+	assert.Nil(t, err)
+	assert.Equal(t, domain.SLAStatusBreached, item.SLAStatus)
 
-		if err := mock.ExpectationsWereMet(); err != nil {
-			t.Errorf("expectations were not met %s", err)
-		}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
 	}
 }
 
-func TestPersistenceIssueFindErr(t *testing.T) {
+func TestPersistenceIssueUpdateSLAErr(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
-
-	mock.ExpectQuery("SELECT (.+) FROM \"issues\"").WillReturnError(errors.New("test error"))
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
-	tests := []struct {
-		title     string
-		projectID uint
-		labels    []string
-	}{
-		{
-			"test-title",
-			uint(1),
-			[]string{"test-name"},
-		},
-		{
-			"",
-			uint(0),
-			[]string{},
-		},
-		{
-			"test-title",
-			uint(1),
-			[]string{},
-		},
-	}
+	mock.ExpectBegin()
+	mock.ExpectExec("^UPDATE \"issues\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
 
-	for _, ts := range tests {
-		items, err := r.Find(ts.title, ts.projectID, ts.labels)
+	_, err := r.UpdateSLA(domain.Issue{ID: 1})
 
-		assert.NotNil(t, err)
-		assert.NotNil(t, items)
-		assert.Equal(t, 0, len(items))
+	assert.NotNil(t, err)
 
-		if err := mock.ExpectationsWereMet(); err != nil {
-			t.Errorf("expectations were not met %s", err)
-		}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
 	}
 }
 
-func TestPersistenceIssueFindAll(t *testing.T) {
+func TestPersistenceIssueSetEstimates(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
-
-	mock.MatchExpectationsInOrder(false)
-
-	issueData := sqlmock.NewRows([]string{
-		"id", "title", "description", "status", "project_id",
-	}).AddRow(uint(1), "test-title-1", "test-description", 1, 1)
-	mock.ExpectQuery("SELECT (.+) FROM \"issues\"").WillReturnRows(issueData)
-
-	projectData := sqlmock.NewRows([]string{
-		"id", "name", "description",
-	}).AddRow(uint(1), "test-name", "test-description")
-	mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WithArgs(1).WillReturnRows(projectData)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
-	labelData := sqlmock.NewRows([]string{
-		"id", "name", "color_hex_code",
-	}).AddRow(uint(1), "test-name", "FFFFFF")
-	mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WithArgs(1).WillReturnRows(labelData)
+	mock.ExpectBegin()
+	mock.ExpectExec("^UPDATE \"issues\" SET \"original_estimate\" = (.+), \"remaining_estimate\" = (.+) WHERE (.+)$").WithArgs(480, 390, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
-	items, err := r.FindAll()
+	item, err := r.SetEstimates(domain.Issue{ID: 1, Title: "test-title", OriginalEstimate: 480, RemainingEstimate: 390})
 
 	assert.Nil(t, err)
-	assert.NotNil(t, items)
-	assert.Equal(t, 1, len(items))
+	assert.Equal(t, 390, item.RemainingEstimate)
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("expectations were not met %s", err)
 	}
 }
 
-func TestPersistenceIssueFindAllErr(t *testing.T) {
+func TestPersistenceIssueSetEstimatesErr(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
-	mock.ExpectQuery("SELECT (.+) FROM \"issues\"").WillReturnError(errors.New("test error"))
+	mock.ExpectBegin()
+	mock.ExpectExec("^UPDATE \"issues\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
 
-	items, err := r.FindAll()
+	_, err := r.SetEstimates(domain.Issue{ID: 1})
 
 	assert.NotNil(t, err)
-	assert.NotNil(t, items)
-	assert.Equal(t, 0, len(items))
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("expectations were not met %s", err)
 	}
 }
 
-func TestPersistenceIssueRemove(t *testing.T) {
+func TestPersistenceIssueMigratePriority(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
-
-	mock.ExpectExec("DELETE FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE FROM \"issues\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("^UPDATE \"issues\" SET \"priority\" = MAX\\(priority, (.+)\\) WHERE (.+)$").WithArgs(domain.PriorityHigh, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1, 3).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
-	status, err := r.Remove(uint(1))
+	_, err := r.MigratePriority(domain.Issue{ID: 1, Priority: domain.PriorityHigh}, []domain.Label{{ID: 2}, {ID: 3}})
 
 	assert.Nil(t, err)
-	assert.True(t, status)
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("expectations were not met %s", err)
 	}
 }
 
-func TestPersistenceIssueRemoveManyToManyDeleteErr(t *testing.T) {
+func TestPersistenceIssueMigratePriorityErr(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
-	mock.ExpectExec("DELETE FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectBegin()
+	mock.ExpectExec("^UPDATE \"issues\" SET (.+)$").WithArgs(domain.PriorityHigh, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1, 2).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
 
-	status, err := r.Remove(uint(1))
+	_, err := r.MigratePriority(domain.Issue{ID: 1, Priority: domain.PriorityHigh}, []domain.Label{{ID: 2}})
 
 	assert.NotNil(t, err)
-	assert.False(t, status)
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("expectations were not met %s", err)
 	}
 }
 
-func TestPersistenceIssueRemoveErr(t *testing.T) {
+func TestPersistenceIssueMove(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteIssueRepository(gormDB)
-
-	mock.ExpectExec("DELETE FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE FROM \"issues\" (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
-	mock.ExpectRollback()
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs(2, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"issue_moves\" (.+)$").WithArgs(1, 1, 2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
-	status, err := r.Remove(uint(1))
+	i := domain.Issue{
+		ID:        uint(1),
+		ProjectID: 1,
+	}
+	p := domain.Project{
+		ID: 2,
+	}
 
-	assert.NotNil(t, err)
-	assert.False(t, status)
+	item, err := r.Move(i, p)
+
+	assert.Nil(t, err)
+	assert.Equal(t, p.ID, item.ProjectID)
+	assert.Equal(t, p, item.Project)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueMoveErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs(2, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"issue_moves\" (.+)$").WithArgs(1, 1, 2, sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	i := domain.Issue{
+		ID:        uint(1),
+		ProjectID: 1,
+	}
+
+	item, err := r.Move(i, domain.Project{ID: 2})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, uint(1), item.ProjectID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueUpdateCustomFields(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO \"custom_field_values\" (.+)$").WithArgs(1, 1, "ACME").WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectExec("INSERT INTO \"custom_field_values\" (.+)$").WithArgs(1, 2, "prod").WillReturnResult(sqlmock.NewResult(4, 1))
+	mock.ExpectCommit()
+
+	i := domain.Issue{
+		ID: uint(1),
+		CustomFields: []domain.CustomFieldValue{
+			{ID: 1, FieldID: 1, Field: domain.CustomField{ID: 1, Name: "customer"}, Value: "ACME"},
+			{FieldID: 2, Field: domain.CustomField{ID: 2, Name: "environment"}, Value: "prod"},
+		},
+	}
+
+	item, err := r.UpdateCustomFields(i)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(item.CustomFields))
+	assert.Equal(t, uint(3), item.CustomFields[0].ID)
+	assert.Equal(t, uint(1), item.CustomFields[0].IssueID)
+	assert.Equal(t, uint(4), item.CustomFields[1].ID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueUpdateCustomFieldsErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO \"custom_field_values\" (.+)$").WithArgs(1, 1, "ACME").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	i := domain.Issue{
+		ID: uint(1),
+		CustomFields: []domain.CustomFieldValue{
+			{FieldID: 1, Value: "ACME"},
+		},
+	}
+
+	_, err := r.UpdateCustomFields(i)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindMoves(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	moveData := sqlmock.NewRows([]string{
+		"id", "issue_id", "from_project_id", "to_project_id",
+	}).AddRow(1, 1, 1, 2)
+	mock.ExpectQuery("SELECT (.+) FROM \"issue_moves\" WHERE (.+)$").WithArgs(1).WillReturnRows(moveData)
+
+	items, err := r.FindMoves(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, uint(2), items[0].ToProjectID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindMovesErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"issue_moves\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+
+	items, err := r.FindMoves(uint(1))
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(items))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindByID(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+	issueData := sqlmock.NewRows([]string{
+		"id", "title", "description", "status", "project_id",
+	}).AddRow(uint(1), "test-title", "test-description", 1, 1)
+	mock.ExpectQuery("SELECT (.+) FROM \"issues\" (.+)$").WithArgs(1).WillReturnRows(issueData)
+
+	projectData := sqlmock.NewRows([]string{
+		"id", "name", "description",
+	}).AddRow(uint(1), "test-name", "test-description")
+	mock.ExpectQuery("SELECT (.+) FROM \"projects\" (.+)$").WithArgs(1).WillReturnRows(projectData)
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "color_hex_code",
+	}).AddRow(uint(1), "test-name", "FFFFFF")
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" (.+)$").WithArgs(1).WillReturnRows(labelData)
+	valueData := sqlmock.NewRows([]string{
+		"id", "issue_id", "field_id", "value",
+	}).AddRow(uint(1), uint(1), uint(1), "ACME")
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_field_values\" (.+)$").WithArgs(1).WillReturnRows(valueData)
+	fieldData := sqlmock.NewRows([]string{
+		"id", "project_id", "name", "type",
+	}).AddRow(uint(1), uint(1), "customer", "text")
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_fields\" (.+)$").WithArgs(1).WillReturnRows(fieldData)
+
+	item, err := r.FindByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, item)
+	assert.Equal(t, uint(1), item.ID)
+	assert.Equal(t, 1, len(item.CustomFields))
+	assert.Equal(t, "customer", item.CustomFields[0].Field.Name)
+	assert.Equal(t, "ACME", item.CustomFields[0].Value)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindByIDErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"issues\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+
+	item, err := r.FindByID(uint(1))
+
+	assert.NotNil(t, err)
+	assert.NotNil(t, item)
+	assert.Equal(t, uint(0), item.ID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFind(t *testing.T) {
+	customer := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+	environment := domain.CustomField{ID: 2, ProjectID: 1, Name: "environment", Type: domain.CustomFieldTypeMultiSelect, Options: "dev,prod"}
+	tests := []struct {
+		title        string
+		projectID    uint
+		labels       []string
+		customFields []domain.CustomFieldFilter
+		options      domain.IssueFindOptions
+	}{
+		{
+			"test-title-1",
+			uint(1),
+			[]string{"test-name-1"},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{},
+		},
+		{
+			"test-title-1",
+			uint(1),
+			[]string{},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{},
+		},
+		{
+			"",
+			uint(1),
+			[]string{},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{},
+		},
+		{
+			"test-title-1",
+			uint(0),
+			[]string{},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{},
+		},
+		{
+			"",
+			uint(0),
+			[]string{"test-name-1"},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{},
+		},
+		{
+			"",
+			uint(0),
+			[]string{},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{},
+		},
+		{
+			"",
+			uint(1),
+			[]string{"test-name-1"},
+			[]domain.CustomFieldFilter{{Field: customer, Value: "ACME"}, {Field: environment, Value: "prod"}},
+			domain.IssueFindOptions{},
+		},
+		{
+			"",
+			uint(1),
+			[]string{"test-name-1"},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{MinPriority: domain.PriorityHigh, Sort: domain.IssueSortSeverity},
+		},
+		{
+			"",
+			uint(0),
+			[]string{},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{MinSeverity: domain.SeverityMajor},
+		},
+	}
+
+	for _, ts := range tests {
+		mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+		defer mockDB.Close()
+		defer gormDB.Close()
+
+		r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+		mock.MatchExpectationsInOrder(false)
+
+		issueData := sqlmock.NewRows([]string{
+			"id", "title", "description", "status", "project_id",
+		}).AddRow(uint(1), "test-title-1", "test-description", 1, 1)
+		mock.ExpectQuery("SELECT (.+) FROM \"issues\"").WillReturnRows(issueData)
+
+		labelData := sqlmock.NewRows([]string{
+			"id", "name", "color_hex_code",
+		}).AddRow(uint(1), "test-name-1", "FFFFFF")
+		mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WithArgs(1).WillReturnRows(labelData)
+
+		projectData := sqlmock.NewRows([]string{
+			"id", "name", "description",
+		}).AddRow(uint(1), "test-name-1", "test-description")
+		mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WillReturnRows(projectData)
+
+		mock.ExpectQuery("SELECT (.+) FROM \"custom_field_values\"").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		items, err := r.Find(ts.title, ts.projectID, ts.labels, ts.customFields, ts.options)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, items)
+		assert.Equal(t, 1, len(items))
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("expectations were not met %s", err)
+		}
+	}
+}
+
+func TestPersistenceIssueFindErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"issues\"").WillReturnError(errors.New("test error"))
+
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+	tests := []struct {
+		title        string
+		projectID    uint
+		labels       []string
+		customFields []domain.CustomFieldFilter
+		options      domain.IssueFindOptions
+	}{
+		{
+			"test-title",
+			uint(1),
+			[]string{"test-name"},
+			[]domain.CustomFieldFilter{{Field: f, Value: "ACME"}},
+			domain.IssueFindOptions{},
+		},
+		{
+			"",
+			uint(0),
+			[]string{},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{},
+		},
+		{
+			"test-title",
+			uint(1),
+			[]string{},
+			[]domain.CustomFieldFilter{},
+			domain.IssueFindOptions{},
+		},
+	}
+
+	for _, ts := range tests {
+		items, err := r.Find(ts.title, ts.projectID, ts.labels, ts.customFields, ts.options)
+
+		assert.NotNil(t, err)
+		assert.NotNil(t, items)
+		assert.Equal(t, 0, len(items))
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("expectations were not met %s", err)
+		}
+	}
+}
+
+func TestPersistenceIssueFindSortByPriority(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.MatchExpectationsInOrder(false)
+
+	issueData := sqlmock.NewRows([]string{
+		"id", "title", "status", "priority", "severity", "project_id",
+	}).AddRow(uint(2), "test-title-2", 1, domain.PriorityUrgent, domain.SeverityMinor, 1).AddRow(uint(1), "test-title-1", 1, domain.PriorityHigh, domain.SeverityBlocker, 1)
+	mock.ExpectQuery("SELECT (.+) FROM \"issues\" WHERE \\(project_id = \\? AND \"issues\".\"priority\" >= \\?\\) ORDER BY \"issues\".\"priority\" DESC,\"issues\".\"severity\" DESC,\"issues\".\"id\"").
+		WithArgs(1, domain.PriorityHigh).WillReturnRows(issueData)
+	mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint(1)))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_field_values\"").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	items, err := r.Find("", uint(1), []string{}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{MinPriority: domain.PriorityHigh, Sort: domain.IssueSortPriority})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, domain.PriorityUrgent, items[0].Priority)
+	assert.Equal(t, domain.SeverityBlocker, items[1].Severity)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindBreachedSortByDue(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.MatchExpectationsInOrder(false)
+
+	dueAt := time.Date(2020, time.January, 1, 10, 0, 0, 0, time.UTC)
+	issueData := sqlmock.NewRows([]string{
+		"id", "title", "status", "due_at", "sla_status", "project_id",
+	}).AddRow(uint(1), "test-title-1", 1, dueAt, domain.SLAStatusBreached, 1)
+	mock.ExpectQuery("SELECT (.+) FROM \"issues\" WHERE \\(\"issues\".\"sla_status\" >= \\?\\) ORDER BY \"issues\".\"due_at\" IS NULL,\"issues\".\"due_at\",\"issues\".\"id\"").
+		WithArgs(domain.SLAStatusBreached).WillReturnRows(issueData)
+	mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint(1)))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_field_values\"").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	items, err := r.Find("", uint(0), []string{}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{MinSLAStatus: domain.SLAStatusBreached, Sort: domain.IssueSortDue})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, dueAt, *items[0].DueAt)
+	assert.Equal(t, domain.SLAStatusBreached, items[0].SLAStatus)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindUpdatedBefore(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.MatchExpectationsInOrder(false)
+
+	before := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	issueData := sqlmock.NewRows([]string{
+		"id", "title", "status", "project_id",
+	}).AddRow(uint(1), "test-title-1", 1, 1)
+	mock.ExpectQuery("SELECT (.+) FROM \"issues\" WHERE \\(project_id = \\? AND \"issues\".\"updated_at\" < \\?\\)").
+		WithArgs(1, before).WillReturnRows(issueData)
+	mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint(1)))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_field_values\"").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	items, err := r.Find("", uint(1), []string{}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{UpdatedBefore: before})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindAll(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.MatchExpectationsInOrder(false)
+
+	issueData := sqlmock.NewRows([]string{
+		"id", "title", "description", "status", "project_id",
+	}).AddRow(uint(1), "test-title-1", "test-description", 1, 1)
+	mock.ExpectQuery("SELECT (.+) FROM \"issues\"").WillReturnRows(issueData)
+
+	projectData := sqlmock.NewRows([]string{
+		"id", "name", "description",
+	}).AddRow(uint(1), "test-name", "test-description")
+	mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WithArgs(1).WillReturnRows(projectData)
+
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "color_hex_code",
+	}).AddRow(uint(1), "test-name", "FFFFFF")
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WithArgs(1).WillReturnRows(labelData)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_field_values\"").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	items, err := r.FindAll()
+
+	assert.Nil(t, err)
+	assert.NotNil(t, items)
+	assert.Equal(t, 1, len(items))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindAllErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"issues\"").WillReturnError(errors.New("test error"))
+
+	items, err := r.FindAll()
+
+	assert.NotNil(t, err)
+	assert.NotNil(t, items)
+	assert.Equal(t, 0, len(items))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueRemove(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	dirPath := t.TempDir()
+	r := persistence.NewSQLiteIssueRepository(gormDB, dirPath)
+
+	if err := os.MkdirAll(filepath.Join(dirPath, "1"), 0750); err != nil {
+		t.Fatalf("attachments dir error %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dirPath, "1", "2"), []byte("log"), 0640); err != nil {
+		t.Fatalf("attachment file error %s", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"work_logs\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"attachments\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"watchers\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"issue_moves\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"issues\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	status, err := r.Remove(uint(1))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+	assert.NoDirExists(t, filepath.Join(dirPath, "1"))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueRemoveInTransaction(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	dirPath := t.TempDir()
+	r := persistence.NewSQLiteIssueRepository(gormDB, dirPath)
+	er := persistence.NewSQLiteEventRepository(gormDB)
+
+	if err := os.MkdirAll(filepath.Join(dirPath, "1"), 0750); err != nil {
+		t.Fatalf("attachments dir error %s", err)
+	}
+
+	for _, commit := range []bool{false, true} {
+		mock.ExpectBegin()
+		for _, table := range []string{"issues_labels", "custom_field_values", "work_logs", "attachments", "watchers", "issue_moves", "issues"} {
+			mock.ExpectExec("DELETE FROM \"" + table + "\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		if commit {
+			mock.ExpectCommit()
+		} else {
+			mock.ExpectRollback()
+		}
+	}
+
+	tx, err := er.Begin()
+
+	assert.Nil(t, err)
+
+	status, err := r.WithTransaction(tx).Remove(uint(1))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+	assert.DirExists(t, filepath.Join(dirPath, "1"))
+	assert.Nil(t, tx.Rollback())
+	assert.DirExists(t, filepath.Join(dirPath, "1"))
+
+	tx, err = er.Begin()
+
+	assert.Nil(t, err)
+
+	status, err = r.WithTransaction(tx).Remove(uint(1))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+	assert.DirExists(t, filepath.Join(dirPath, "1"))
+	assert.Nil(t, tx.Commit())
+	assert.NoDirExists(t, filepath.Join(dirPath, "1"))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueRemoveManyToManyDeleteErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Remove(uint(1))
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueRemoveErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"work_logs\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"attachments\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"watchers\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"issue_moves\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"issues\" (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Remove(uint(1))
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueRemoveCommitErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"work_logs\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"attachments\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"watchers\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"issue_moves\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"issues\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit().WillReturnError(errors.New("test error"))
+
+	status, err := r.Remove(uint(1))
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueAddWorkLog(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	loggedAt := time.Date(2020, time.January, 6, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"work_logs\" (.+)$").WithArgs(1, "alice", 90, loggedAt, "review", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs(30, 90, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	w := &domain.WorkLog{IssueID: 1, Author: "alice", Minutes: 90, LoggedAt: loggedAt, Comment: "review"}
+
+	item, err := r.AddWorkLog(w, domain.Issue{ID: 1, RemainingEstimate: 30, TimeSpent: 90})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueAddWorkLogErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"work_logs\" (.+)$").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.AddWorkLog(&domain.WorkLog{IssueID: 1, Author: "alice", Minutes: 90}, domain.Issue{ID: 1})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueRemoveWorkLog(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"work_logs\" WHERE (.+)$").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs(30, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	status, err := r.RemoveWorkLog(domain.WorkLog{ID: 2, IssueID: 1}, domain.Issue{ID: 1, TimeSpent: 30})
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueRemoveWorkLogErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"work_logs\" WHERE (.+)$").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.RemoveWorkLog(domain.WorkLog{ID: 2, IssueID: 1}, domain.Issue{ID: 1})
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindWorkLogs(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	workLogData := sqlmock.NewRows([]string{
+		"id", "issue_id", "author", "minutes",
+	}).AddRow(1, 1, "alice", 90)
+	mock.ExpectQuery("SELECT (.+) FROM \"work_logs\" WHERE \\(issue_id = \\?\\) ORDER BY logged_at,\"id\"").WithArgs(1).WillReturnRows(workLogData)
+	mock.ExpectQuery("SELECT (.+) FROM \"work_logs\" WHERE (.+)$").WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"id", "issue_id"}).AddRow(2, 1))
+
+	items, err := r.FindWorkLogs(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, 90, items[0].Minutes)
+
+	item, err := r.FindWorkLogByID(uint(2))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.IssueID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindWorkLogsErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"work_logs\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT (.+) FROM \"work_logs\" WHERE (.+)$").WithArgs(2).WillReturnError(errors.New("test error"))
+
+	items, err := r.FindWorkLogs(uint(1))
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(items))
+
+	_, err = r.FindWorkLogByID(uint(2))
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindProjectWorkLogs(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	from := time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	workLogData := sqlmock.NewRows([]string{
+		"id", "issue_id", "author", "minutes",
+	}).AddRow(1, 1, "alice", 90)
+	mock.ExpectQuery("SELECT (.+) FROM \"work_logs\" WHERE \\(logged_at >= \\? AND logged_at < \\?\\) AND \\(issue_id IN \\(SELECT id FROM \"issues\" WHERE project_id = \\?\\)\\)").
+		WithArgs(from, to, 1).WillReturnRows(workLogData)
+	mock.ExpectQuery("SELECT (.+) FROM \"work_logs\" WHERE \\(logged_at >= \\? AND logged_at < \\?\\) ORDER BY").
+		WithArgs(from, to).WillReturnError(errors.New("test error"))
+
+	items, err := r.FindProjectWorkLogs(uint(1), from, to)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	_, err = r.FindProjectWorkLogs(uint(0), from, to)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueSumTime(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	sumData := sqlmock.NewRows([]string{
+		"original_estimate", "remaining_estimate", "time_spent",
+	}).AddRow(480, 120, 390)
+	mock.ExpectQuery("SELECT COALESCE\\(SUM\\(original_estimate\\), 0\\) (.+) FROM \"issues\" WHERE \\(project_id = \\?\\)").WithArgs(1).WillReturnRows(sumData)
+	mock.ExpectQuery("SELECT COALESCE\\(SUM\\(original_estimate\\), 0\\) (.+) FROM \"issues\"$").WillReturnError(errors.New("test error"))
+
+	item, err := r.SumTime(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.TimeSummary{OriginalEstimate: 480, RemainingEstimate: 120, TimeSpent: 390}, item)
+
+	_, err = r.SumTime(uint(0))
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueAddAttachment(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	dirPath := t.TempDir()
+	r := persistence.NewSQLiteIssueRepository(gormDB, dirPath)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"attachments\" (.+)$").WithArgs(1, "log.txt", 3, "text/plain; charset=utf-8", "abc", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	a := &domain.Attachment{IssueID: 1, Filename: "log.txt", Size: 3, ContentType: "text/plain; charset=utf-8", SHA256: "abc"}
+
+	item, err := r.AddAttachment(a, []byte("log"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(2), item.ID)
+
+	content, err := r.ReadAttachment(*item)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("log"), content)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueAddAttachmentErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	dirPath := t.TempDir()
+	r := persistence.NewSQLiteIssueRepository(gormDB, dirPath)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"attachments\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"attachments\" (.+)$").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit().WillReturnError(errors.New("test error"))
+
+	item, err := r.AddAttachment(&domain.Attachment{IssueID: 1, Filename: "log.txt"}, []byte("log"))
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	item, err = r.AddAttachment(&domain.Attachment{IssueID: 1, Filename: "log.txt"}, []byte("log"))
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+	assert.NoFileExists(t, filepath.Join(dirPath, "1", "2"))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueAddAttachmentInTransaction(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	dirPath := t.TempDir()
+	r := persistence.NewSQLiteIssueRepository(gormDB, dirPath)
+	er := persistence.NewSQLiteEventRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"attachments\" (.+)$").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit().WillReturnError(errors.New("test error"))
+
+	tx, err := er.Begin()
+
+	assert.Nil(t, err)
+
+	item, err := r.WithTransaction(tx).AddAttachment(&domain.Attachment{IssueID: 1, Filename: "log.txt"}, []byte("log"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(2), item.ID)
+	assert.FileExists(t, filepath.Join(dirPath, "1", "2"))
+	assert.NotNil(t, tx.Commit())
+	assert.NoFileExists(t, filepath.Join(dirPath, "1", "2"))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueAddAttachmentDirErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, "")
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"attachments\" (.+)$").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectRollback()
+
+	item, err := r.AddAttachment(&domain.Attachment{IssueID: 1, Filename: "log.txt"}, []byte("log"))
+
+	assert.Equal(t, "attachments directory not set", err.Error())
+	assert.Nil(t, item)
+
+	content, err := r.ReadAttachment(domain.Attachment{ID: 2, IssueID: 1})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, content)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueRemoveAttachment(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	dirPath := t.TempDir()
+	r := persistence.NewSQLiteIssueRepository(gormDB, dirPath)
+
+	if err := os.MkdirAll(filepath.Join(dirPath, "1"), 0750); err != nil {
+		t.Fatalf("attachments dir error %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dirPath, "1", "2"), []byte("log"), 0640); err != nil {
+		t.Fatalf("attachment file error %s", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"attachments\" WHERE (.+)$").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"attachments\" WHERE (.+)$").WithArgs(3).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.RemoveAttachment(domain.Attachment{ID: 2, IssueID: 1})
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+	assert.NoFileExists(t, filepath.Join(dirPath, "1", "2"))
+
+	status, err = r.RemoveAttachment(domain.Attachment{ID: 3, IssueID: 1})
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceIssueFindAttachments(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteIssueRepository(gormDB, t.TempDir())
+
+	attachmentData := sqlmock.NewRows([]string{
+		"id", "issue_id", "filename", "size", "content_type", "sha256",
+	}).AddRow(2, 1, "log.txt", 3, "text/plain; charset=utf-8", "abc")
+	mock.ExpectQuery("SELECT (.+) FROM \"attachments\" WHERE \\(issue_id = \\?\\) ORDER BY \"id\"").WithArgs(1).WillReturnRows(attachmentData)
+	mock.ExpectQuery("SELECT (.+) FROM \"attachments\" WHERE (.+)$").WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"id", "issue_id", "sha256"}).AddRow(2, 1, "abc"))
+	mock.ExpectQuery("SELECT (.+) FROM \"attachments\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT (.+) FROM \"attachments\" WHERE (.+)$").WithArgs(3).WillReturnError(errors.New("record not found"))
+
+	items, err := r.FindAttachments(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, "log.txt", items[0].Filename)
+	assert.Equal(t, "abc", items[0].SHA256)
+
+	item, err := r.FindAttachmentByID(uint(2))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", item.SHA256)
+
+	_, err = r.FindAttachments(uint(1))
+
+	assert.NotNil(t, err)
+
+	_, err = r.FindAttachmentByID(uint(3))
+
+	assert.NotNil(t, err)
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("expectations were not met %s", err)
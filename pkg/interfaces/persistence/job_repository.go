@@ -0,0 +1,104 @@
+package persistence
+
+import (
+	"github.com/jinzhu/gorm"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// SQLiteJobRepository is a repository
+type SQLiteJobRepository struct {
+	db *gorm.DB
+}
+
+// NewSQLiteJobRepository to create SQLiteJobRepository
+func NewSQLiteJobRepository(db *gorm.DB) *SQLiteJobRepository {
+	return &SQLiteJobRepository{
+		db: db,
+	}
+}
+
+// Add to add new job
+func (r *SQLiteJobRepository) Add(job *domain.Job) (*domain.Job, error) {
+	if err := r.db.Create(job).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Update to update job
+func (r *SQLiteJobRepository) Update(job domain.Job) (domain.Job, error) {
+	if err := r.db.Save(&job).Error; err != nil {
+		return job, err
+	}
+	return job, nil
+}
+
+// FindByID to find job by ID
+func (r *SQLiteJobRepository) FindByID(id uint) (domain.Job, error) {
+	var item domain.Job
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAll to find all jobs
+func (r *SQLiteJobRepository) FindAll() ([]domain.Job, error) {
+	var items []domain.Job
+	if err := r.db.Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindDue to find active jobs which should run at provided time
+func (r *SQLiteJobRepository) FindDue(now time.Time) ([]domain.Job, error) {
+	var items []domain.Job
+	if err := r.db.Where("active = ? AND next_run_at <= ?", true, now).Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Lock to lock job for owner until provided time, lock is taken by single conditional update, so only
+// one of runs of server instances sharing database gets it. Lock is acquired only when job is not locked
+// or its lock expired, owner holding the lock does not get it again, so concurrent runs of the same
+// server instance are excluded too, due lock also requires job to be active and due at provided time
+func (r *SQLiteJobRepository) Lock(id uint, owner string, now time.Time, until time.Time, due bool) (bool, error) {
+	db := r.db.Model(&domain.Job{}).Where("id = ? AND (locked_by = '' OR locked_until < ?)", id, now)
+	if due {
+		db = db.Where("active = ? AND next_run_at <= ?", true, now)
+	}
+	result := db.UpdateColumns(map[string]interface{}{"locked_by": owner, "locked_until": until})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Finish to record result of run and next run of job and unlock it, only result, schedule and lock
+// columns are updated and only while owner holds the lock, so changes of job made during run are kept
+func (r *SQLiteJobRepository) Finish(job domain.Job, owner string) (bool, error) {
+	result := r.db.Model(&domain.Job{}).Where("id = ? AND locked_by = ?", job.ID, owner).UpdateColumns(map[string]interface{}{
+		"last_run_at":  job.LastRunAt,
+		"last_status":  job.LastStatus,
+		"last_error":   job.LastError,
+		"last_count":   job.LastCount,
+		"next_run_at":  job.NextRunAt,
+		"locked_by":    job.LockedBy,
+		"locked_until": job.LockedUntil,
+	})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Remove to remove job
+func (r *SQLiteJobRepository) Remove(id uint) (bool, error) {
+	if err := r.db.Where("ID = ?", id).Delete(domain.Job{}).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
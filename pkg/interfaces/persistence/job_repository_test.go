@@ -0,0 +1,251 @@
+package persistence_test
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/persistence"
+	pTesting "go-issue-tracker/pkg/interfaces/persistence/testing"
+	"testing"
+	"time"
+)
+
+func TestPersistenceJobNewSQLiteJobRepository(t *testing.T) {
+	mockDB, _, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteJobRepository(gormDB)
+
+	assert.NotNil(t, r)
+}
+
+func TestPersistenceJobAddAndUpdate(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteJobRepository(gormDB)
+
+	next := time.Date(2020, time.January, 16, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"jobs\" (.+)$").WithArgs("Stale", "staleLabel", 1, "@daily", "days:60,label:stale", true, next, sqlmock.AnyArg(), "", "", 0, "", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"jobs\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET (.+)$").WithArgs("Stale", "staleLabel", 1, "@daily", "days:30,label:stale", false, next, sqlmock.AnyArg(), "succeeded", "", 3, "", sqlmock.AnyArg(), sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.Add(&domain.Job{Name: "Stale", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: domain.JobScheduleDaily, Params: "days:60,label:stale", Active: true, NextRunAt: next})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	item, err = r.Add(&domain.Job{ProjectID: 1})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	job := domain.Job{ID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, ProjectID: 1, Schedule: domain.JobScheduleDaily, Params: "days:30,label:stale", NextRunAt: next, LastStatus: domain.JobRunSucceeded, LastCount: 3}
+
+	updated, err := r.Update(job)
+
+	assert.Nil(t, err)
+	assert.Equal(t, job.Params, updated.Params)
+
+	_, err = r.Update(job)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceJobFind(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteJobRepository(gormDB)
+
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"jobs\" WHERE \\(ID = \\?\\) (.+)$").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"jobs\" ORDER BY \"id\"").WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(1, 1).AddRow(2, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"jobs\" WHERE \\(active = \\? AND next_run_at <= \\?\\) ORDER BY \"id\"").WithArgs(true, now).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(2, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"jobs\" WHERE (.+)$").WithArgs(true, now).WillReturnError(errors.New("test error"))
+
+	item, err := r.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	items, err := r.FindAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	items, err = r.FindDue(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, uint(2), items[0].ID)
+
+	_, err = r.FindDue(now)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceJobLock(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteJobRepository(gormDB)
+
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+	until := now.Add(domain.JobLockDuration)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET \"locked_by\" = \\?, \"locked_until\" = \\? WHERE \\(id = \\? AND \\(locked_by = '' OR locked_until < \\?\\)\\) AND \\(active = \\? AND next_run_at <= \\?\\)").WithArgs("a", until, 1, now, true, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET \"locked_by\" = \\?, \"locked_until\" = \\? WHERE \\(id = \\? AND \\(locked_by = '' OR locked_until < \\?\\)\\)$").WithArgs("b", until, 1, now).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Lock(1, "a", now, until, true)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = r.Lock(1, "b", now, until, false)
+
+	assert.Nil(t, err)
+	assert.False(t, status)
+
+	status, err = r.Lock(1, "b", now, until, false)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceJobLockHeldByOwner(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteJobRepository(gormDB)
+
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+	until := now.Add(domain.JobLockDuration)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET (.+) WHERE \\(id = \\? AND \\(locked_by = '' OR locked_until < \\?\\)\\)$").WithArgs("a", until, 1, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET (.+) WHERE \\(id = \\? AND \\(locked_by = '' OR locked_until < \\?\\)\\)$").WithArgs("a", until.Add(time.Minute), 1, now.Add(time.Minute)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	status, err := r.Lock(1, "a", now, until, false)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = r.Lock(1, "a", now.Add(time.Minute), until.Add(time.Minute), false)
+
+	assert.Nil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceJobFinish(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteJobRepository(gormDB)
+
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+	next := time.Date(2020, time.January, 16, 0, 0, 0, 0, time.UTC)
+	j := domain.Job{ID: 1, Name: "Stale", Active: true, NextRunAt: next, LastRunAt: now, LastStatus: domain.JobRunSucceeded, LastCount: 3}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET \"last_count\" = \\?, \"last_error\" = \\?, \"last_run_at\" = \\?, \"last_status\" = \\?, \"locked_by\" = \\?, \"locked_until\" = \\?, \"next_run_at\" = \\? WHERE \\(id = \\? AND locked_by = \\?\\)$").WithArgs(3, "", now, domain.JobRunSucceeded, "", time.Time{}, next, 1, "a").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET (.+) WHERE \\(id = \\? AND locked_by = \\?\\)$").WithArgs(3, "", now, domain.JobRunSucceeded, "", time.Time{}, next, 1, "b").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"jobs\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Finish(j, "a")
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = r.Finish(j, "b")
+
+	assert.Nil(t, err)
+	assert.False(t, status)
+
+	status, err = r.Finish(j, "a")
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceJobRemove(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteJobRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"jobs\" WHERE \\(ID = \\?\\)").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"jobs\" WHERE \\(ID = \\?\\)").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Remove(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = r.Remove(2)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
@@ -5,6 +5,13 @@ import (
 	"go-issue-tracker/pkg/domain"
 )
 
+// labelLinkTables are join tables linking labels to issues, issue templates and projects by owner column
+var labelLinkTables = [][2]string{
+	{"issues_labels", "issue_id"},
+	{"issue_templates_labels", "issue_template_id"},
+	{"projects_default_labels", "project_id"},
+}
+
 // SQLiteLabelRepository is a repository
 type SQLiteLabelRepository struct {
 	db *gorm.DB
@@ -17,6 +24,13 @@ func NewSQLiteLabelRepository(db *gorm.DB) *SQLiteLabelRepository {
 	}
 }
 
+// WithTransaction to get repository writing in transaction
+func (r *SQLiteLabelRepository) WithTransaction(tx domain.Transaction) domain.LabelRepository {
+	return &SQLiteLabelRepository{
+		db: getTransactionDB(r.db, tx),
+	}
+}
+
 // Add to add new label
 func (r *SQLiteLabelRepository) Add(label *domain.Label) (*domain.Label, error) {
 	if err := r.db.Create(label).Error; err != nil {
@@ -36,25 +50,29 @@ func (r *SQLiteLabelRepository) Update(label domain.Label) (domain.Label, error)
 // FindByID to find label by ID
 func (r *SQLiteLabelRepository) FindByID(id uint) (domain.Label, error) {
 	var item domain.Label
-	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+	if err := r.db.Preload("Group").Where("ID = ?", id).First(&item).Error; err != nil {
 		return item, err
 	}
 	return item, nil
 }
 
-// FindByName to find label by name
-func (r *SQLiteLabelRepository) FindByName(name string) (domain.Label, error) {
+// FindByName to find label by name in project scope, global scope when project ID is 0
+func (r *SQLiteLabelRepository) FindByName(name string, projectID uint) (domain.Label, error) {
 	var item domain.Label
-	if err := r.db.Where("name = ?", name).First(&item).Error; err != nil {
+	if err := r.db.Preload("Group").Where("name = ? AND project_id = ?", name, projectID).First(&item).Error; err != nil {
 		return item, err
 	}
 	return item, nil
 }
 
-// Find to find labels
-func (r *SQLiteLabelRepository) Find(name string) ([]domain.Label, error) {
+// Find to find labels, limited to global and project labels when project ID is provided
+func (r *SQLiteLabelRepository) Find(name string, projectID uint) ([]domain.Label, error) {
 	var items []domain.Label
-	if err := r.db.Where("name LIKE ?", "%"+name+"%").Find(&items).Error; err != nil {
+	query := r.db.Preload("Group").Where("name LIKE ?", "%"+name+"%")
+	if projectID != 0 {
+		query = query.Where("project_id IN (?, 0)", projectID)
+	}
+	if err := query.Find(&items).Error; err != nil {
 		return items, err
 	}
 	return items, nil
@@ -63,20 +81,146 @@ func (r *SQLiteLabelRepository) Find(name string) ([]domain.Label, error) {
 // FindAll to find all labels
 func (r *SQLiteLabelRepository) FindAll() ([]domain.Label, error) {
 	var items []domain.Label
-	if err := r.db.Find(&items).Error; err != nil {
+	if err := r.db.Preload("Group").Find(&items).Error; err != nil {
 		return items, err
 	}
 	return items, nil
 }
 
-// Remove to remove label
+// Remove to remove label not assigned to any issue together with its links to issue templates and
+// default labels of projects
 func (r *SQLiteLabelRepository) Remove(id uint) (bool, error) {
+	tx := begin(r.db)
 	var c int
-	r.db.Table("issues_labels").Where("label_id = ?", id).Count(&c)
+	if err := tx.Table("issues_labels").Where("label_id = ?", id).Count(&c).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
 	if c > 0 {
+		tx.Rollback()
 		return false, nil
 	}
-	if err := r.db.Where("ID = ?", id).Delete(domain.Label{}).Error; err != nil {
+	for _, link := range labelLinkTables[1:] {
+		if err := tx.Exec("DELETE FROM \""+link[0]+"\" WHERE label_id = ?", id).Error; err != nil {
+			tx.Rollback()
+			return false, err
+		}
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.Label{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// FindMerge to count issues affected by merging source label into target label
+func (r *SQLiteLabelRepository) FindMerge(source domain.Label, target domain.Label) (domain.LabelMerge, error) {
+	item := domain.LabelMerge{
+		SourceID: source.ID,
+		TargetID: target.ID,
+	}
+	if err := r.db.Table("issues_labels").Where("label_id = ?", source.ID).Count(&item.Issues).Error; err != nil {
+		return item, err
+	}
+	if err := r.db.Table("issues_labels").Where("label_id = ? AND issue_id IN (SELECT issue_id FROM issues_labels WHERE label_id = ?)", source.ID, target.ID).Count(&item.Duplicates).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Merge to reassign issues, issue templates and default labels of projects from source label to target
+// label and remove source label
+func (r *SQLiteLabelRepository) Merge(source domain.Label, target domain.Label) (bool, error) {
+	tx := begin(r.db)
+	for _, link := range labelLinkTables {
+		table, owner := link[0], link[1]
+		if err := tx.Exec("DELETE FROM \""+table+"\" WHERE label_id = ? AND "+owner+" IN (SELECT "+owner+" FROM \""+table+"\" WHERE label_id = ?)", source.ID, target.ID).Error; err != nil {
+			tx.Rollback()
+			return false, err
+		}
+		if err := tx.Exec("UPDATE \""+table+"\" SET label_id = ? WHERE label_id = ?", target.ID, source.ID).Error; err != nil {
+			tx.Rollback()
+			return false, err
+		}
+	}
+	if err := tx.Where("ID = ?", source.ID).Delete(domain.Label{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CountGroupConflicts to count issues which would have more than one label of group when label joins it,
+// except label is not counted, e.g. target of merge which replaces the joining label
+func (r *SQLiteLabelRepository) CountGroupConflicts(groupID uint, labelID uint, exceptID uint) (int, error) {
+	var c int
+	if err := r.db.Table("issues_labels").Select("issue_id").Where("label_id <> ? AND (label_id = ? OR label_id IN (SELECT id FROM \"labels\" WHERE group_id = ?))", exceptID, labelID, groupID).Group("issue_id").Having("COUNT(*) > 1").Count(&c).Error; err != nil {
+		return 0, err
+	}
+	return c, nil
+}
+
+// AddGroup to add new label group
+func (r *SQLiteLabelRepository) AddGroup(group *domain.LabelGroup) (*domain.LabelGroup, error) {
+	if err := r.db.Create(group).Error; err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// UpdateGroup to update label group
+func (r *SQLiteLabelRepository) UpdateGroup(group domain.LabelGroup) (domain.LabelGroup, error) {
+	if err := r.db.Save(&group).Error; err != nil {
+		return group, err
+	}
+	return group, nil
+}
+
+// FindGroupByID to find label group by ID
+func (r *SQLiteLabelRepository) FindGroupByID(id uint) (domain.LabelGroup, error) {
+	var item domain.LabelGroup
+	if err := r.db.Preload("Labels").Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindGroupByName to find label group by name
+func (r *SQLiteLabelRepository) FindGroupByName(name string) (domain.LabelGroup, error) {
+	var item domain.LabelGroup
+	if err := r.db.Where("name = ?", name).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAllGroups to find all label groups
+func (r *SQLiteLabelRepository) FindAllGroups() ([]domain.LabelGroup, error) {
+	var items []domain.LabelGroup
+	if err := r.db.Preload("Labels").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveGroup to remove label group, labels of group are kept without group
+func (r *SQLiteLabelRepository) RemoveGroup(id uint) (bool, error) {
+	tx := begin(r.db)
+	if err := tx.Exec("UPDATE \"labels\" SET group_id = 0 WHERE group_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.LabelGroup{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
 		return false, err
 	}
 	return true, nil
@@ -28,7 +28,7 @@ func TestPersistenceLabelAdd(t *testing.T) {
 	r := persistence.NewSQLiteLabelRepository(gormDB)
 
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO \"labels\" (.+)$").WithArgs("test-name", "FFFFFF", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"labels\" (.+)$").WithArgs("test-name", "FFFFFF", 0, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	l := new(domain.Label)
@@ -56,7 +56,7 @@ func TestPersistenceLabelAddErr(t *testing.T) {
 	r := persistence.NewSQLiteLabelRepository(gormDB)
 
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO \"labels\" (.+)$").WithArgs("test-name", "FFFFFF", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
+	mock.ExpectExec("INSERT INTO \"labels\" (.+)$").WithArgs("test-name", "FFFFFF", 0, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
 	l := new(domain.Label)
@@ -81,7 +81,7 @@ func TestPersistenceLabelUpdate(t *testing.T) {
 	r := persistence.NewSQLiteLabelRepository(gormDB)
 
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE \"labels\" SET (.+)$").WithArgs("test-name", "FFFFFF", sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"labels\" SET (.+)$").WithArgs("test-name", "FFFFFF", 0, 0, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	l := domain.Label{
@@ -111,7 +111,7 @@ func TestPersistenceLabelUpdateErr(t *testing.T) {
 	r := persistence.NewSQLiteLabelRepository(gormDB)
 
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE \"labels\" SET (.+)$").WithArgs("test-name", "FFFFFF", sqlmock.AnyArg(), 1).WillReturnError(errors.New("test error"))
+	mock.ExpectExec("UPDATE \"labels\" SET (.+)$").WithArgs("test-name", "FFFFFF", 0, 0, sqlmock.AnyArg(), 1).WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
 	l := domain.Label{
@@ -186,9 +186,9 @@ func TestPersistenceLabelFindByName(t *testing.T) {
 	labelData := sqlmock.NewRows([]string{
 		"id", "name", "color_hex_code",
 	}).AddRow(uint(1), "test-name", "FFFFFF")
-	mock.ExpectQuery("SELECT (.+) FROM \"labels\" WHERE (.+)$").WithArgs("test-name").WillReturnRows(labelData)
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" WHERE (.+)$").WithArgs("test-name", 0).WillReturnRows(labelData)
 
-	item, err := r.FindByName("test-name")
+	item, err := r.FindByName("test-name", uint(0))
 
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
@@ -206,9 +206,9 @@ func TestPersistenceLabelFindByNameErr(t *testing.T) {
 
 	r := persistence.NewSQLiteLabelRepository(gormDB)
 
-	mock.ExpectQuery("SELECT (.+) FROM \"labels\" WHERE (.+)$").WithArgs("test-name").WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" WHERE (.+)$").WithArgs("test-name", 0).WillReturnError(errors.New("test error"))
 
-	item, err := r.FindByName("test-name")
+	item, err := r.FindByName("test-name", uint(0))
 
 	assert.NotNil(t, err)
 	assert.NotNil(t, item)
@@ -231,7 +231,7 @@ func TestPersistenceLabelFind(t *testing.T) {
 	}).AddRow("1", "test-name-1", "FFFFFF").AddRow("2", "test-name-2", "FFFFFF")
 	mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WillReturnRows(labelData)
 
-	items, err := r.Find("test")
+	items, err := r.Find("test", uint(0))
 
 	assert.Nil(t, err)
 	assert.NotNil(t, items)
@@ -251,7 +251,7 @@ func TestPersistenceLabelFindErr(t *testing.T) {
 
 	mock.ExpectQuery("SELECT (.+) FROM \"labels\"").WillReturnError(errors.New("test error"))
 
-	items, err := r.Find("test")
+	items, err := r.Find("test", uint(0))
 
 	assert.NotNil(t, err)
 	assert.NotNil(t, items)
@@ -315,9 +315,10 @@ func TestPersistenceLabelRemove(t *testing.T) {
 	cdata := sqlmock.NewRows([]string{
 		"count",
 	}).AddRow(0)
-	mock.ExpectQuery("SELECT count(.+) FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnRows(cdata)
-
 	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT count(.+) FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnRows(cdata)
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"projects_default_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
 	mock.ExpectExec("DELETE FROM \"labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -341,7 +342,9 @@ func TestPersistenceLabelRemoveIssueExistErr(t *testing.T) {
 	cdata := sqlmock.NewRows([]string{
 		"count",
 	}).AddRow(1)
+	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT count(.+) FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnRows(cdata)
+	mock.ExpectRollback()
 
 	status, err := r.Remove(uint(1))
 
@@ -363,13 +366,391 @@ func TestPersistenceLabelRemoveErr(t *testing.T) {
 	cdata := sqlmock.NewRows([]string{
 		"count",
 	}).AddRow(0)
+	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT count(.+) FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnRows(cdata)
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Remove(uint(1))
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindMerge(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectQuery("SELECT count(.+) FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery("SELECT count(.+) FROM \"issues_labels\" (.+)$").WithArgs(1, 2).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	item, err := r.FindMerge(domain.Label{ID: 1}, domain.Label{ID: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3, Duplicates: 1}, item)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelCountGroupConflicts(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectQuery("SELECT count(.+) FROM \\( SELECT issue_id FROM \"issues_labels\" WHERE (.+) GROUP BY issue_id HAVING \\(COUNT\\(\\*\\) > 1\\) \\) AS count_table$").WithArgs(3, 2, 1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	c, err := r.CountGroupConflicts(1, 2, 3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindMergeErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectQuery("SELECT count(.+) FROM \"issues_labels\" (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+
+	_, err := r.FindMerge(domain.Label{ID: 1}, domain.Label{ID: 2})
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelMerge(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
 
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE FROM \"labels\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues_labels\" SET (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" WHERE (.+)$").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE \"issue_templates_labels\" SET (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"projects_default_labels\" WHERE (.+)$").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE \"projects_default_labels\" SET (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	status, err := r.Merge(domain.Label{ID: 1}, domain.Label{ID: 2})
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelMergeErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues_labels\" SET (.+)$").WithArgs(2, 1).WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
-	status, err := r.Remove(uint(1))
+	status, err := r.Merge(domain.Label{ID: 1}, domain.Label{ID: 2})
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindInProject(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "color_hex_code", "project_id",
+	}).AddRow("1", "test-name-1", "FFFFFF", 0).AddRow("2", "test-name-2", "FFFFFF", 2)
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" WHERE (.+) AND \\(project_id IN (.+)\\)").WithArgs("%test%", 2).WillReturnRows(labelData)
+
+	items, err := r.Find("test", uint(2))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, uint(2), items[1].ProjectID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindByIDWithGroup(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "color_hex_code", "group_id",
+	}).AddRow(uint(1), "test-name", "FFFFFF", uint(2))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" WHERE (.+)$").WithArgs(1).WillReturnRows(labelData)
+	groupData := sqlmock.NewRows([]string{
+		"id", "name", "exclusive",
+	}).AddRow(uint(2), "priority", true)
+	mock.ExpectQuery("SELECT (.+) FROM \"label_groups\" WHERE (.+)$").WithArgs(2).WillReturnRows(groupData)
+
+	item, err := r.FindByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(2), item.GroupID)
+	assert.NotNil(t, item.Group)
+	assert.Equal(t, "priority", item.Group.Name)
+	assert.True(t, item.Group.Exclusive)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelAddGroup(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"label_groups\" (.+)$").WithArgs("priority", true, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	item, err := r.AddGroup(&domain.LabelGroup{Name: "priority", Exclusive: true})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, item)
+	assert.Equal(t, uint(1), item.ID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelAddGroupErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"label_groups\" (.+)$").WithArgs("priority", true, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.AddGroup(&domain.LabelGroup{Name: "priority", Exclusive: true})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelUpdateGroup(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"label_groups\" SET (.+)$").WithArgs("priority", false, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	item, err := r.UpdateGroup(domain.LabelGroup{ID: 1, Name: "priority"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "priority", item.Name)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindGroupByID(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	groupData := sqlmock.NewRows([]string{
+		"id", "name", "exclusive",
+	}).AddRow(uint(1), "priority", true)
+	mock.ExpectQuery("SELECT (.+) FROM \"label_groups\" WHERE (.+)$").WithArgs(1).WillReturnRows(groupData)
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "group_id",
+	}).AddRow(uint(1), "high", uint(1)).AddRow(uint(2), "low", uint(1))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" WHERE (.+)$").WithArgs(1).WillReturnRows(labelData)
+
+	item, err := r.FindGroupByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+	assert.Len(t, item.Labels, 2)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindGroupByIDErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"label_groups\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+
+	item, err := r.FindGroupByID(uint(1))
+
+	assert.NotNil(t, err)
+	assert.Equal(t, uint(0), item.ID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindGroupByName(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	groupData := sqlmock.NewRows([]string{
+		"id", "name", "exclusive",
+	}).AddRow(uint(1), "priority", true)
+	mock.ExpectQuery("SELECT (.+) FROM \"label_groups\" WHERE (.+)$").WithArgs("priority").WillReturnRows(groupData)
+
+	item, err := r.FindGroupByName("priority")
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindAllGroups(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	groupData := sqlmock.NewRows([]string{
+		"id", "name", "exclusive",
+	}).AddRow(uint(1), "priority", true).AddRow(uint(2), "area", false)
+	mock.ExpectQuery("SELECT (.+) FROM \"label_groups\"").WillReturnRows(groupData)
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "group_id",
+	}).AddRow(uint(1), "high", uint(1))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" WHERE (.+)$").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnRows(labelData)
+
+	items, err := r.FindAllGroups()
+
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+	assert.Len(t, items[0].Labels, 1)
+	assert.Len(t, items[1].Labels, 0)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelFindAllGroupsErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"label_groups\"").WillReturnError(errors.New("test error"))
+
+	_, err := r.FindAllGroups()
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelRemoveGroup(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"labels\" SET group_id = 0 WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM \"label_groups\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	status, err := r.RemoveGroup(uint(1))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceLabelRemoveGroupErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteLabelRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"labels\" SET group_id = 0 WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.RemoveGroup(uint(1))
 
 	assert.NotNil(t, err)
 	assert.False(t, status)
@@ -0,0 +1,96 @@
+package persistence
+
+import (
+	"github.com/jinzhu/gorm"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// SQLiteMailRepository is a repository
+type SQLiteMailRepository struct {
+	db *gorm.DB
+}
+
+// NewSQLiteMailRepository to create SQLiteMailRepository
+func NewSQLiteMailRepository(db *gorm.DB) *SQLiteMailRepository {
+	return &SQLiteMailRepository{
+		db: db,
+	}
+}
+
+// SavePreference to add new or update existing preference
+func (r *SQLiteMailRepository) SavePreference(preference *domain.MailPreference) (*domain.MailPreference, error) {
+	if preference.ID == 0 {
+		if err := r.db.Create(preference).Error; err != nil {
+			return nil, err
+		}
+		return preference, nil
+	}
+	if err := r.db.Save(preference).Error; err != nil {
+		return nil, err
+	}
+	return preference, nil
+}
+
+// FindPreference to find preference of user
+func (r *SQLiteMailRepository) FindPreference(user string) (domain.MailPreference, error) {
+	var item domain.MailPreference
+	if err := r.db.Where("user = ?", user).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindActivePreferences to find preferences of users receiving emails
+func (r *SQLiteMailRepository) FindActivePreferences() ([]domain.MailPreference, error) {
+	var items []domain.MailPreference
+	if err := r.db.Where("delivery <> ?", domain.MailDeliveryNone).Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindNotificationsBetween to find notifications of user created after from until to, oldest first
+func (r *SQLiteMailRepository) FindNotificationsBetween(user string, from time.Time, to time.Time) ([]domain.Notification, error) {
+	var items []domain.Notification
+	if err := r.db.Where("user = ? AND created_at > ? AND created_at <= ?", user, from, to).Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// QueueMessages to add messages to queue and save preference of their user in single transaction
+func (r *SQLiteMailRepository) QueueMessages(preference domain.MailPreference, messages []domain.MailMessage) (int, error) {
+	tx := r.db.Begin()
+	for i := range messages {
+		if err := tx.Create(&messages[i]).Error; err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if err := tx.Save(&preference).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	return len(messages), nil
+}
+
+// FindDueMessages to find queued messages which should be sent at provided time, oldest first
+func (r *SQLiteMailRepository) FindDueMessages(now time.Time, limit int) ([]domain.MailMessage, error) {
+	var items []domain.MailMessage
+	if err := r.db.Where("status = ? AND send_after <= ?", domain.MailStatusQueued, now).Order("id").Limit(limit).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// UpdateMessage to update message
+func (r *SQLiteMailRepository) UpdateMessage(message domain.MailMessage) (domain.MailMessage, error) {
+	if err := r.db.Save(&message).Error; err != nil {
+		return message, err
+	}
+	return message, nil
+}
@@ -0,0 +1,217 @@
+package persistence_test
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/persistence"
+	pTesting "go-issue-tracker/pkg/interfaces/persistence/testing"
+	"testing"
+	"time"
+)
+
+func TestPersistenceMailNewSQLiteMailRepository(t *testing.T) {
+	mockDB, _, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteMailRepository(gormDB)
+
+	assert.NotNil(t, r)
+}
+
+func TestPersistenceMailSavePreference(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteMailRepository(gormDB)
+
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"mail_preferences\" (.+)$").WithArgs("alice", "alice@example.com", "digest", now, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"mail_preferences\" SET (.+)$").WithArgs("alice", "alice@example.com", "immediate", now, sqlmock.AnyArg(), sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"mail_preferences\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	p := &domain.MailPreference{User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryDigest, NotifiedAt: now}
+
+	item, err := r.SavePreference(p)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	item.Delivery = domain.MailDeliveryImmediate
+	item, err = r.SavePreference(item)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.MailDeliveryImmediate, item.Delivery)
+
+	item, err = r.SavePreference(&domain.MailPreference{User: "bob"})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceMailFindPreferences(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteMailRepository(gormDB)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"mail_preferences\" WHERE \\(user = \\?\\) (.+)$").WithArgs("alice").WillReturnRows(sqlmock.NewRows([]string{"id", "user", "delivery"}).AddRow(1, "alice", "digest"))
+	mock.ExpectQuery("SELECT (.+) FROM \"mail_preferences\" WHERE \\(delivery <> \\?\\) ORDER BY \"id\"").WithArgs("none").WillReturnRows(sqlmock.NewRows([]string{"id", "user"}).AddRow(1, "alice").AddRow(2, "bob"))
+	mock.ExpectQuery("SELECT (.+) FROM \"mail_preferences\" WHERE \\(delivery <> \\?\\) ORDER BY \"id\"").WithArgs("none").WillReturnError(errors.New("test error"))
+
+	item, err := r.FindPreference("alice")
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.MailDeliveryDigest, item.Delivery)
+
+	items, err := r.FindActivePreferences()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	_, err = r.FindActivePreferences()
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceMailFindNotificationsBetween(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteMailRepository(gormDB)
+
+	to := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	from := to.Add(-time.Hour)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"notifications\" WHERE \\(user = \\? AND created_at > \\? AND created_at <= \\?\\) ORDER BY \"id\"").WithArgs("alice", from, to).WillReturnRows(sqlmock.NewRows([]string{"id", "user", "message"}).AddRow(1, "alice", "Issue #1 Crash was updated"))
+	mock.ExpectQuery("SELECT (.+) FROM \"notifications\" WHERE (.+)$").WithArgs("bob", from, to).WillReturnError(errors.New("test error"))
+
+	items, err := r.FindNotificationsBetween("alice", from, to)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, "Issue #1 Crash was updated", items[0].Message)
+
+	_, err = r.FindNotificationsBetween("bob", from, to)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceMailQueueMessages(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteMailRepository(gormDB)
+
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	p := domain.MailPreference{ID: 1, User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryImmediate, NotifiedAt: now, CreatedAt: now}
+	m := []domain.MailMessage{
+		{To: "alice@example.com", Subject: "Issue #1 Crash was updated", Body: "body", Status: domain.MailStatusQueued, SendAfter: now},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"mail_messages\" (.+)$").WithArgs("alice@example.com", "Issue #1 Crash was updated", "body", "queued", 0, "", now, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"mail_preferences\" SET (.+)$").WithArgs("alice", "alice@example.com", "immediate", now, now, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"mail_preferences\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	c, err := r.QueueMessages(p, m)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, c)
+	assert.Equal(t, uint(1), m[0].ID)
+
+	c, err = r.QueueMessages(p, []domain.MailMessage{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceMailFindDueMessages(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteMailRepository(gormDB)
+
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"mail_messages\" WHERE \\(status = \\? AND send_after <= \\?\\) ORDER BY \"id\" LIMIT 10").WithArgs("queued", now).WillReturnRows(sqlmock.NewRows([]string{"id", "to", "status"}).AddRow(1, "alice@example.com", "queued"))
+	mock.ExpectQuery("SELECT (.+) FROM \"mail_messages\" WHERE (.+)$").WithArgs("queued", now).WillReturnError(errors.New("test error"))
+
+	items, err := r.FindDueMessages(now, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, "alice@example.com", items[0].To)
+
+	_, err = r.FindDueMessages(now, 10)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceMailUpdateMessage(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteMailRepository(gormDB)
+
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	m := domain.MailMessage{ID: 1, To: "alice@example.com", Status: domain.MailStatusSent, Attempts: 1, SendAfter: now, SentAt: now}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"mail_messages\" SET (.+)$").WithArgs("alice@example.com", "", "", "sent", 1, "", now, now, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"mail_messages\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.UpdateMessage(m)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.MailStatusSent, item.Status)
+
+	_, err = r.UpdateMessage(m)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
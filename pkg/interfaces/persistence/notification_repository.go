@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"github.com/jinzhu/gorm"
+	"go-issue-tracker/pkg/domain"
+)
+
+// SQLiteNotificationRepository is a repository
+type SQLiteNotificationRepository struct {
+	db *gorm.DB
+}
+
+// NewSQLiteNotificationRepository to create SQLiteNotificationRepository
+func NewSQLiteNotificationRepository(db *gorm.DB) *SQLiteNotificationRepository {
+	return &SQLiteNotificationRepository{
+		db: db,
+	}
+}
+
+// AddWatcher to add new watcher
+func (r *SQLiteNotificationRepository) AddWatcher(watcher *domain.Watcher) (*domain.Watcher, error) {
+	if err := r.db.Create(watcher).Error; err != nil {
+		return nil, err
+	}
+	return watcher, nil
+}
+
+// FindWatcherByID to find watcher by ID
+func (r *SQLiteNotificationRepository) FindWatcherByID(id uint) (domain.Watcher, error) {
+	var item domain.Watcher
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindWatchers to find watchers by user, issue and project, filters are applied only when provided
+func (r *SQLiteNotificationRepository) FindWatchers(user string, issueID uint, projectID uint) ([]domain.Watcher, error) {
+	var items []domain.Watcher
+	db := r.db
+	if user != "" {
+		db = db.Where("user = ?", user)
+	}
+	if issueID != uint(0) {
+		db = db.Where("issue_id = ?", issueID)
+	}
+	if projectID != uint(0) {
+		db = db.Where("project_id = ?", projectID)
+	}
+	if err := db.Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindWatchingUsers to find distinct users watching issue or project, watcher has either issue or project set
+func (r *SQLiteNotificationRepository) FindWatchingUsers(issueID uint, projectID uint) ([]string, error) {
+	users := []string{}
+	if issueID == uint(0) && projectID == uint(0) {
+		return users, nil
+	}
+	if err := r.db.Table("watchers").Where("(issue_id = ? AND issue_id <> 0) OR (project_id = ? AND project_id <> 0)", issueID, projectID).Order("user").Pluck("DISTINCT user", &users).Error; err != nil {
+		return users, err
+	}
+	return users, nil
+}
+
+// RemoveWatcher to remove watcher
+func (r *SQLiteNotificationRepository) RemoveWatcher(id uint) (bool, error) {
+	if err := r.db.Where("ID = ?", id).Delete(domain.Watcher{}).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddNotifications to add notifications in single transaction
+func (r *SQLiteNotificationRepository) AddNotifications(notifications []domain.Notification) (int, error) {
+	tx := r.db.Begin()
+	for i := range notifications {
+		if err := tx.Create(&notifications[i]).Error; err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	return len(notifications), nil
+}
+
+// UpdateNotification to update notification
+func (r *SQLiteNotificationRepository) UpdateNotification(notification domain.Notification) (domain.Notification, error) {
+	if err := r.db.Save(&notification).Error; err != nil {
+		return notification, err
+	}
+	return notification, nil
+}
+
+// FindNotificationByID to find notification by ID
+func (r *SQLiteNotificationRepository) FindNotificationByID(id uint) (domain.Notification, error) {
+	var item domain.Notification
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindNotifications to find notifications of user newest first, only unread notifications are found when unread is set
+func (r *SQLiteNotificationRepository) FindNotifications(user string, unread bool) ([]domain.Notification, error) {
+	var items []domain.Notification
+	db := r.db.Where("user = ?", user)
+	if unread {
+		db = db.Where("read = ?", false)
+	}
+	if err := db.Order("id DESC").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// MarkAllNotificationsRead to mark unread notifications of user as read
+func (r *SQLiteNotificationRepository) MarkAllNotificationsRead(user string) (int, error) {
+	db := r.db.Model(&domain.Notification{}).Where("user = ? AND read = ?", user, false).Update("read", true)
+	if err := db.Error; err != nil {
+		return 0, err
+	}
+	return int(db.RowsAffected), nil
+}
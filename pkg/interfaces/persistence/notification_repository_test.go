@@ -0,0 +1,274 @@
+package persistence_test
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/persistence"
+	pTesting "go-issue-tracker/pkg/interfaces/persistence/testing"
+	"testing"
+)
+
+func TestPersistenceNotificationNewSQLiteNotificationRepository(t *testing.T) {
+	mockDB, _, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	assert.NotNil(t, r)
+}
+
+func TestPersistenceNotificationAddWatcher(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"watchers\" (.+)$").WithArgs("alice", 1, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"watchers\" (.+)$").WithArgs("bob", 0, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.AddWatcher(&domain.Watcher{User: "alice", IssueID: 1})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	item, err = r.AddWatcher(&domain.Watcher{User: "bob", ProjectID: 1})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceNotificationFindWatchers(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	watcherData := sqlmock.NewRows([]string{
+		"id", "user", "issue_id", "project_id",
+	}).AddRow(1, "alice", 1, 0)
+	mock.ExpectQuery("SELECT (.+) FROM \"watchers\" WHERE \\(user = \\?\\) AND \\(issue_id = \\?\\) ORDER BY \"id\"").WithArgs("alice", 1).WillReturnRows(watcherData)
+	mock.ExpectQuery("SELECT (.+) FROM \"watchers\" WHERE \\(project_id = \\?\\) ORDER BY \"id\"").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT (.+) FROM \"watchers\" WHERE (.+)$").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "user"}).AddRow(1, "alice"))
+
+	items, err := r.FindWatchers("alice", uint(1), uint(0))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, uint(1), items[0].IssueID)
+
+	_, err = r.FindWatchers("", uint(0), uint(2))
+
+	assert.NotNil(t, err)
+
+	item, err := r.FindWatcherByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", item.User)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceNotificationFindWatchingUsers(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	userData := sqlmock.NewRows([]string{
+		"user",
+	}).AddRow("alice").AddRow("bob")
+	mock.ExpectQuery("SELECT DISTINCT user FROM \"watchers\" WHERE (.+) ORDER BY \"user\"").WithArgs(1, 2).WillReturnRows(userData)
+	mock.ExpectQuery("SELECT DISTINCT user FROM \"watchers\" WHERE (.+)$").WithArgs(0, 2).WillReturnError(errors.New("test error"))
+
+	users, err := r.FindWatchingUsers(uint(1), uint(2))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, users)
+
+	_, err = r.FindWatchingUsers(uint(0), uint(2))
+
+	assert.NotNil(t, err)
+
+	users, err = r.FindWatchingUsers(uint(0), uint(0))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, users)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceNotificationRemoveWatcher(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"watchers\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"watchers\" WHERE (.+)$").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.RemoveWatcher(uint(1))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = r.RemoveWatcher(uint(2))
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceNotificationAddNotifications(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	n := []domain.Notification{
+		{User: "alice", IssueID: 1, ProjectID: 2, Event: domain.NotificationEventUpdated, Message: "Issue #1 Crash was updated", Changes: "title: Bug -> Crash"},
+		{User: "bob", IssueID: 1, ProjectID: 2, Event: domain.NotificationEventUpdated, Message: "Issue #1 Crash was updated", Changes: "title: Bug -> Crash"},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"notifications\" (.+)$").WithArgs("alice", 1, 2, "updated", "Issue #1 Crash was updated", "title: Bug -> Crash", false, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"notifications\" (.+)$").WithArgs("bob", 1, 2, "updated", "Issue #1 Crash was updated", "title: Bug -> Crash", false, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"notifications\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	c, err := r.AddNotifications(n)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, c)
+	assert.Equal(t, uint(2), n[1].ID)
+
+	c, err = r.AddNotifications([]domain.Notification{{User: "alice"}})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceNotificationUpdateNotification(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"notifications\" SET (.+)$").WithArgs("alice", 1, 2, "updated", "", "", true, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"notifications\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	n := domain.Notification{ID: 1, User: "alice", IssueID: 1, ProjectID: 2, Event: "updated", Read: true}
+
+	item, err := r.UpdateNotification(n)
+
+	assert.Nil(t, err)
+	assert.True(t, item.Read)
+
+	_, err = r.UpdateNotification(n)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceNotificationFindNotifications(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	notificationData := sqlmock.NewRows([]string{
+		"id", "user", "issue_id", "event", "read",
+	}).AddRow(2, "alice", 1, "updated", false)
+	mock.ExpectQuery("SELECT (.+) FROM \"notifications\" WHERE \\(user = \\?\\) AND \\(read = \\?\\) ORDER BY id DESC").WithArgs("alice", false).WillReturnRows(notificationData)
+	mock.ExpectQuery("SELECT (.+) FROM \"notifications\" WHERE \\(user = \\?\\) ORDER BY id DESC").WithArgs("bob").WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT (.+) FROM \"notifications\" WHERE (.+)$").WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"id", "user"}).AddRow(2, "alice"))
+
+	items, err := r.FindNotifications("alice", true)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, "updated", items[0].Event)
+
+	_, err = r.FindNotifications("bob", false)
+
+	assert.NotNil(t, err)
+
+	item, err := r.FindNotificationByID(uint(2))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", item.User)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceNotificationMarkAllNotificationsRead(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteNotificationRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"notifications\" SET \"read\" = \\?, \"updated_at\" = \\? WHERE \\(user = \\? AND read = \\?\\)").WithArgs(true, sqlmock.AnyArg(), "alice", false).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"notifications\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	c, err := r.MarkAllNotificationsRead("alice")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, c)
+
+	c, err = r.MarkAllNotificationsRead("alice")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
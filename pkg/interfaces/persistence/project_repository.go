@@ -3,17 +3,28 @@ package persistence
 import (
 	"github.com/jinzhu/gorm"
 	"go-issue-tracker/pkg/domain"
+	"strings"
 )
 
-// SQLiteProjectRepository is a repository
+// SQLiteProjectRepository is a repository, attachment files of issues are stored in attachments directory
 type SQLiteProjectRepository struct {
-	db *gorm.DB
+	db                 *gorm.DB
+	attachmentsDirPath string
 }
 
 // NewSQLiteProjectRepository to create SQLiteProjectRepository
-func NewSQLiteProjectRepository(db *gorm.DB) *SQLiteProjectRepository {
+func NewSQLiteProjectRepository(db *gorm.DB, attachmentsDirPath string) *SQLiteProjectRepository {
 	return &SQLiteProjectRepository{
-		db: db,
+		db:                 db,
+		attachmentsDirPath: attachmentsDirPath,
+	}
+}
+
+// WithTransaction to get repository writing in transaction
+func (r *SQLiteProjectRepository) WithTransaction(tx domain.Transaction) domain.ProjectRepository {
+	return &SQLiteProjectRepository{
+		db:                 getTransactionDB(r.db, tx),
+		attachmentsDirPath: r.attachmentsDirPath,
 	}
 }
 
@@ -33,10 +44,27 @@ func (r *SQLiteProjectRepository) Update(project domain.Project) (domain.Project
 	return project, nil
 }
 
-// FindByID to find project by ID
+// UpdateSettings to update project settings and replace default labels
+func (r *SQLiteProjectRepository) UpdateSettings(project domain.Project) (domain.Project, error) {
+	tx := begin(r.db)
+	if err := tx.Model(&project).Association("DefaultLabels").Replace(project.DefaultLabels).Error; err != nil {
+		tx.Rollback()
+		return project, err
+	}
+	if err := tx.Save(&project).Error; err != nil {
+		tx.Rollback()
+		return project, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return project, err
+	}
+	return project, nil
+}
+
+// FindByID to find project by ID with its default labels
 func (r *SQLiteProjectRepository) FindByID(id uint) (domain.Project, error) {
 	var item domain.Project
-	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+	if err := r.db.Preload("DefaultLabels").Where("ID = ?", id).First(&item).Error; err != nil {
 		return item, err
 	}
 	return item, nil
@@ -45,29 +73,328 @@ func (r *SQLiteProjectRepository) FindByID(id uint) (domain.Project, error) {
 // Find to find projects
 func (r *SQLiteProjectRepository) Find(name string) ([]domain.Project, error) {
 	var items []domain.Project
-	if err := r.db.Where("name LIKE ?", "%"+name+"%").Find(&items).Error; err != nil {
+	if err := r.db.Where("name LIKE ? AND archived = ?", "%"+name+"%", false).Find(&items).Error; err != nil {
 		return items, err
 	}
 	return items, nil
 }
 
-// FindAll to find all projects
+// FindAll to find all projects which are not archived
 func (r *SQLiteProjectRepository) FindAll() ([]domain.Project, error) {
 	var items []domain.Project
-	if err := r.db.Find(&items).Error; err != nil {
+	if err := r.db.Where("archived = ?", false).Find(&items).Error; err != nil {
 		return items, err
 	}
 	return items, nil
 }
 
-// Remove to remove project
+// FindArchived to find archived projects
+func (r *SQLiteProjectRepository) FindArchived() ([]domain.Project, error) {
+	var items []domain.Project
+	if err := r.db.Where("archived = ?", true).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// CountIssues to count issues of project
+func (r *SQLiteProjectRepository) CountIssues(id uint) (int, error) {
+	var c int
+	if err := r.db.Table("issues").Where("project_id = ?", id).Count(&c).Error; err != nil {
+		return 0, err
+	}
+	return c, nil
+}
+
+// projectItemsStatements remove labels of project with their links, issue templates and custom fields
+var projectItemsStatements = []string{
+	"DELETE FROM \"issues_labels\" WHERE label_id IN (SELECT id FROM \"labels\" WHERE project_id=?)",
+	"DELETE FROM \"issue_templates_labels\" WHERE label_id IN (SELECT id FROM \"labels\" WHERE project_id=?) OR issue_template_id IN (SELECT id FROM \"issue_templates\" WHERE project_id=?)",
+	"DELETE FROM \"projects_default_labels\" WHERE label_id IN (SELECT id FROM \"labels\" WHERE project_id=?)",
+	"DELETE FROM \"labels\" WHERE project_id=?",
+	"DELETE FROM \"issue_templates\" WHERE project_id=?",
+	"DELETE FROM \"custom_field_values\" WHERE field_id IN (SELECT id FROM \"custom_fields\" WHERE project_id=?)",
+	"DELETE FROM \"custom_fields\" WHERE project_id=?",
+}
+
+// projectConfigurationStatements remove default labels, webhooks with their deliveries, automation rules
+// with their executions and jobs of project
+var projectConfigurationStatements = []string{
+	"DELETE FROM \"projects_default_labels\" WHERE project_id=?",
+	"DELETE FROM \"webhook_deliveries\" WHERE webhook_id IN (SELECT id FROM \"webhooks\" WHERE project_id=?)",
+	"DELETE FROM \"webhooks\" WHERE project_id=?",
+	"DELETE FROM \"automation_executions\" WHERE rule_id IN (SELECT id FROM \"automation_rules\" WHERE project_id=?)",
+	"DELETE FROM \"automation_rules\" WHERE project_id=?",
+	"DELETE FROM \"jobs\" WHERE project_id=?",
+}
+
+// execProjectStatements to execute statements with project ID bound to all their parameters in transaction
+func execProjectStatements(tx *gorm.DB, id uint, statements []string) error {
+	for _, statement := range statements {
+		args := make([]interface{}, strings.Count(statement, "?"))
+		for i := range args {
+			args[i] = id
+		}
+		if err := tx.Exec(statement, args...).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove to remove project without issues together with its labels, issue templates, custom fields,
+// watchers and configuration in single transaction
 func (r *SQLiteProjectRepository) Remove(id uint) (bool, error) {
 	var c int
-	r.db.Table("issues").Where("project_id = ?", id).Count(&c)
+	tx := begin(r.db)
+	if err := tx.Table("issues").Where("project_id = ?", id).Count(&c).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
 	if c > 0 {
+		tx.Rollback()
 		return false, nil
 	}
-	if err := r.db.Where("ID = ?", id).Delete(domain.Project{}).Error; err != nil {
+	if err := tx.Exec("DELETE FROM \"watchers\" WHERE project_id=?", id).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := execProjectStatements(tx.DB, id, projectItemsStatements); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := execProjectStatements(tx.DB, id, projectConfigurationStatements); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.Project{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RemoveCascade to remove project together with its issues, their label links, moves, work logs and attachments,
+// project labels, issue templates, custom fields, watchers and configuration in single transaction, attachment
+// files are removed after commit of transaction repository is bound to
+func (r *SQLiteProjectRepository) RemoveCascade(id uint) (int, error) {
+	var ids []uint
+	tx := begin(r.db)
+	if err := tx.Table("issues").Where("project_id = ?", id).Pluck("id", &ids).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Exec("DELETE FROM \"issues_labels\" WHERE issue_id IN (SELECT id FROM \"issues\" WHERE project_id=?)", id).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Exec("DELETE FROM \"issue_moves\" WHERE issue_id IN (SELECT id FROM \"issues\" WHERE project_id=?)", id).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Exec("DELETE FROM \"work_logs\" WHERE issue_id IN (SELECT id FROM \"issues\" WHERE project_id=?)", id).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Exec("DELETE FROM \"attachments\" WHERE issue_id IN (SELECT id FROM \"issues\" WHERE project_id=?)", id).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Exec("DELETE FROM \"watchers\" WHERE project_id=? OR issue_id IN (SELECT id FROM \"issues\" WHERE project_id=?)", id, id).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Where("project_id = ?", id).Delete(domain.Issue{}).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := execProjectStatements(tx.DB, id, projectItemsStatements); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := execProjectStatements(tx.DB, id, projectConfigurationStatements); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.Project{}).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	if err := tx.afterCommit(func() error {
+		return removeAttachmentsIssueDirs(r.attachmentsDirPath, ids...)
+	}); err != nil {
+		return len(ids), err
+	}
+	return len(ids), nil
+}
+
+// RemoveMovingIssues to move issues, project labels, issue templates, custom fields and watchers to target project,
+// recording the moves, and remove project with its configuration in single transaction, default labels, webhooks,
+// automation rules and jobs are not moved as they are configured for removed project
+func (r *SQLiteProjectRepository) RemoveMovingIssues(id uint, target domain.Project) (int, error) {
+	var ids []uint
+	tx := begin(r.db)
+	if err := tx.Table("issues").Where("project_id = ?", id).Pluck("id", &ids).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	for _, issueID := range ids {
+		move := domain.IssueMove{
+			IssueID:       issueID,
+			FromProjectID: id,
+			ToProjectID:   target.ID,
+		}
+		if err := tx.Create(&move).Error; err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if err := tx.Table("issues").Where("project_id = ?", id).UpdateColumn("project_id", target.ID).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Table("labels").Where("project_id = ?", id).UpdateColumn("project_id", target.ID).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Table("issue_templates").Where("project_id = ?", id).UpdateColumn("project_id", target.ID).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Table("custom_fields").Where("project_id = ?", id).UpdateColumn("project_id", target.ID).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Table("watchers").Where("project_id = ?", id).UpdateColumn("project_id", target.ID).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := execProjectStatements(tx.DB, id, projectConfigurationStatements); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.Project{}).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// AddTemplate to add new issue template with its labels
+func (r *SQLiteProjectRepository) AddTemplate(template *domain.IssueTemplate) (*domain.IssueTemplate, error) {
+	if err := r.db.Create(template).Error; err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// UpdateTemplate to update issue template and replace its labels
+func (r *SQLiteProjectRepository) UpdateTemplate(template domain.IssueTemplate) (domain.IssueTemplate, error) {
+	tx := begin(r.db)
+	if err := tx.Model(&template).Association("Labels").Replace(template.Labels).Error; err != nil {
+		tx.Rollback()
+		return template, err
+	}
+	if err := tx.Omit("Labels").Save(&template).Error; err != nil {
+		tx.Rollback()
+		return template, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return template, err
+	}
+	return template, nil
+}
+
+// FindTemplateByID to find issue template by ID with its labels
+func (r *SQLiteProjectRepository) FindTemplateByID(id uint) (domain.IssueTemplate, error) {
+	var item domain.IssueTemplate
+	if err := r.db.Preload("Labels.Group").Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindTemplates to find issue templates of project with their labels
+func (r *SQLiteProjectRepository) FindTemplates(projectID uint) ([]domain.IssueTemplate, error) {
+	var items []domain.IssueTemplate
+	if err := r.db.Preload("Labels.Group").Where("project_id = ?", projectID).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveTemplate to remove issue template together with its label links
+func (r *SQLiteProjectRepository) RemoveTemplate(id uint) (bool, error) {
+	tx := begin(r.db)
+	if err := tx.Exec("DELETE FROM \"issue_templates_labels\" WHERE issue_template_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.IssueTemplate{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddCustomField to add new custom field
+func (r *SQLiteProjectRepository) AddCustomField(field *domain.CustomField) (*domain.CustomField, error) {
+	if err := r.db.Create(field).Error; err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// UpdateCustomField to update custom field
+func (r *SQLiteProjectRepository) UpdateCustomField(field domain.CustomField) (domain.CustomField, error) {
+	if err := r.db.Save(&field).Error; err != nil {
+		return field, err
+	}
+	return field, nil
+}
+
+// FindCustomFieldByID to find custom field by ID
+func (r *SQLiteProjectRepository) FindCustomFieldByID(id uint) (domain.CustomField, error) {
+	var item domain.CustomField
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindCustomFields to find custom fields of project
+func (r *SQLiteProjectRepository) FindCustomFields(projectID uint) ([]domain.CustomField, error) {
+	var items []domain.CustomField
+	if err := r.db.Where("project_id = ?", projectID).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveCustomField to remove custom field together with its values
+func (r *SQLiteProjectRepository) RemoveCustomField(id uint) (bool, error) {
+	tx := begin(r.db)
+	if err := tx.Exec("DELETE FROM \"custom_field_values\" WHERE field_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.CustomField{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
 		return false, err
 	}
 	return true, nil
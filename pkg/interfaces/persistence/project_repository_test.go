@@ -7,6 +7,8 @@ import (
 	"go-issue-tracker/pkg/domain"
 	"go-issue-tracker/pkg/interfaces/persistence"
 	pTesting "go-issue-tracker/pkg/interfaces/persistence/testing"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -15,7 +17,7 @@ func TestPersistenceProjectNewSQLiteProjectRepository(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	assert.NotNil(t, r)
 }
@@ -25,10 +27,10 @@ func TestPersistenceProjectAdd(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO \"projects\" (.+)$").WithArgs("test-name", "test-description", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"projects\" (.+)$").WithArgs("test-name", "test-description", false, 0, "", 0, "", "", "", "", "", "", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	p := new(domain.Project)
@@ -53,10 +55,10 @@ func TestPersistenceProjectAddErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO \"projects\" (.+)$").WithArgs("test-name", "test-description", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
+	mock.ExpectExec("INSERT INTO \"projects\" (.+)$").WithArgs("test-name", "test-description", false, 0, "", 0, "", "", "", "", "", "", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
 	p := new(domain.Project)
@@ -78,10 +80,10 @@ func TestPersistenceProjectUpdate(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE \"projects\" SET (.+)$").WithArgs("test-name", "test-description", sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"projects\" SET (.+)$").WithArgs("test-name", "test-description", false, 0, "", 0, "", "", "", "", "", "", sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	p := domain.Project{
@@ -108,10 +110,10 @@ func TestPersistenceProjectUpdateErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE \"projects\" SET (.+)$").WithArgs("test-name", "test-description", sqlmock.AnyArg(), 1).WillReturnError(errors.New("test error"))
+	mock.ExpectExec("UPDATE \"projects\" SET (.+)$").WithArgs("test-name", "test-description", false, 0, "", 0, "", "", "", "", "", "", sqlmock.AnyArg(), 1).WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
 	p := domain.Project{
@@ -138,18 +140,23 @@ func TestPersistenceProjectFindByID(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	projectData := sqlmock.NewRows([]string{
 		"id", "name", "description",
 	}).AddRow(uint(1), "test-name", "test-description")
 	mock.ExpectQuery("SELECT (.+) FROM \"projects\" WHERE (.+)$").WithArgs(1).WillReturnRows(projectData)
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "project_id", "project_id",
+	}).AddRow(uint(2), "bug", uint(0), uint(1))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" INNER JOIN \"projects_default_labels\" (.+)$").WithArgs(1).WillReturnRows(labelData)
 
 	item, err := r.FindByID(uint(1))
 
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
 	assert.Equal(t, uint(1), item.ID)
+	assert.Len(t, item.DefaultLabels, 1)
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("expectations were not met %s", err)
@@ -161,7 +168,7 @@ func TestPersistenceProjectFindByIDErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	mock.ExpectQuery("SELECT (.+) FROM \"projects\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
 
@@ -181,7 +188,7 @@ func TestPersistenceProjectFind(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	projectData := sqlmock.NewRows([]string{
 		"id", "name", "description",
@@ -204,7 +211,7 @@ func TestPersistenceProjectFindErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WillReturnError(errors.New("test error"))
 
@@ -224,7 +231,7 @@ func TestPersistenceProjectFindAll(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	projectData := sqlmock.NewRows([]string{
 		"id", "name", "description",
@@ -247,7 +254,7 @@ func TestPersistenceProjectFindAllErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	mock.ExpectQuery("SELECT (.+) FROM \"projects\"").WillReturnError(errors.New("test error"))
 
@@ -262,19 +269,40 @@ func TestPersistenceProjectFindAllErr(t *testing.T) {
 	}
 }
 
+func expectProjectItemsRemoval(mock sqlmock.Sqlmock) {
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE label_id (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" WHERE (.+)$").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"projects_default_labels\" WHERE label_id (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"issue_templates\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM \"custom_fields\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func expectProjectConfigurationRemoval(mock sqlmock.Sqlmock) {
+	mock.ExpectExec("DELETE FROM \"projects_default_labels\" WHERE project_id(.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"webhook_deliveries\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM \"webhooks\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"automation_executions\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM \"automation_rules\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"jobs\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
 func TestPersistenceProjectRemove(t *testing.T) {
 	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	cdata := sqlmock.NewRows([]string{
 		"count",
 	}).AddRow(0)
-	mock.ExpectQuery("SELECT count(.+) FROM \"issues\" (.+)$").WithArgs(1).WillReturnRows(cdata)
-
 	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT count(.+) FROM \"issues\" (.+)$").WithArgs(1).WillReturnRows(cdata)
+	mock.ExpectExec("DELETE FROM \"watchers\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectProjectItemsRemoval(mock)
+	expectProjectConfigurationRemoval(mock)
 	mock.ExpectExec("DELETE FROM \"projects\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -293,12 +321,14 @@ func TestPersistenceProjectRemoveIssueExistErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	cdata := sqlmock.NewRows([]string{
 		"count",
 	}).AddRow(1)
+	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT count(.+) FROM \"issues\" (.+)$").WithArgs(1).WillReturnRows(cdata)
+	mock.ExpectRollback()
 
 	status, err := r.Remove(uint(1))
 
@@ -315,18 +345,705 @@ func TestPersistenceProjectRemoveErr(t *testing.T) {
 	defer mockDB.Close()
 	defer gormDB.Close()
 
-	r := persistence.NewSQLiteProjectRepository(gormDB)
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
 
 	cdata := sqlmock.NewRows([]string{
 		"count",
 	}).AddRow(0)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT count(.+) FROM \"issues\" (.+)$").WithArgs(1).WillReturnRows(cdata)
+	mock.ExpectExec("DELETE FROM \"watchers\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Remove(uint(1))
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectFindArchived(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	projectData := sqlmock.NewRows([]string{
+		"id", "name", "description", "archived",
+	}).AddRow("1", "test-name-1", "test-description", true)
+	mock.ExpectQuery("SELECT (.+) FROM \"projects\" WHERE (.+)$").WithArgs(true).WillReturnRows(projectData)
+
+	items, err := r.FindArchived()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+	assert.True(t, items[0].Archived)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectFindArchivedErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"projects\" WHERE (.+)$").WithArgs(true).WillReturnError(errors.New("test error"))
+
+	items, err := r.FindArchived()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(items))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectCountIssues(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	cdata := sqlmock.NewRows([]string{
+		"count",
+	}).AddRow(3)
 	mock.ExpectQuery("SELECT count(.+) FROM \"issues\" (.+)$").WithArgs(1).WillReturnRows(cdata)
 
+	c, err := r.CountIssues(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectCountIssuesErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT count(.+) FROM \"issues\" (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+
+	c, err := r.CountIssues(uint(1))
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectRemoveCascade(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	dirPath := t.TempDir()
+	r := persistence.NewSQLiteProjectRepository(gormDB, dirPath)
+
+	if err := os.MkdirAll(filepath.Join(dirPath, "2"), 0750); err != nil {
+		t.Fatalf("attachments dir error %s", err)
+	}
+
+	idData := sqlmock.NewRows([]string{
+		"id",
+	}).AddRow(1).AddRow(2)
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE FROM \"projects\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT id FROM \"issues\" WHERE (.+)$").WithArgs(1).WillReturnRows(idData)
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM \"issue_moves\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"work_logs\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM \"attachments\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"watchers\" WHERE (.+)$").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"issues\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	expectProjectItemsRemoval(mock)
+	expectProjectConfigurationRemoval(mock)
+	mock.ExpectExec("DELETE FROM \"projects\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	c, err := r.RemoveCascade(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, c)
+	assert.NoDirExists(t, filepath.Join(dirPath, "2"))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectRemoveCascadeErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	idData := sqlmock.NewRows([]string{
+		"id",
+	}).AddRow(1).AddRow(2)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM \"issues\" WHERE (.+)$").WithArgs(1).WillReturnRows(idData)
+	mock.ExpectExec("DELETE FROM \"issues_labels\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
-	status, err := r.Remove(uint(1))
+	c, err := r.RemoveCascade(uint(1))
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectRemoveMovingIssues(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	idData := sqlmock.NewRows([]string{
+		"id",
+	}).AddRow(1).AddRow(2)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM \"issues\" WHERE (.+)$").WithArgs(1).WillReturnRows(idData)
+	mock.ExpectExec("INSERT INTO \"issue_moves\" (.+)$").WithArgs(1, 1, 2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"issue_moves\" (.+)$").WithArgs(2, 1, 2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE \"labels\" SET (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE \"issue_templates\" SET (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE \"custom_fields\" SET (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE \"watchers\" SET (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectProjectConfigurationRemoval(mock)
+	mock.ExpectExec("DELETE FROM \"projects\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	c, err := r.RemoveMovingIssues(uint(1), domain.Project{ID: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectRemoveMovingIssuesErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	idData := sqlmock.NewRows([]string{
+		"id",
+	}).AddRow(1)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM \"issues\" WHERE (.+)$").WithArgs(1).WillReturnRows(idData)
+	mock.ExpectExec("INSERT INTO \"issue_moves\" (.+)$").WithArgs(1, 1, 2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE \"issues\" SET (.+)$").WithArgs(2, 1).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	c, err := r.RemoveMovingIssues(uint(1), domain.Project{ID: 2})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, c)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectUpdateSettings(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"projects_default_labels\" (.+)$").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"projects_default_labels\" (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE \"projects\" SET (.+)$").WithArgs("test-name", "", false, 2, "labels", 1, "1,2", "", "", "", "", "", sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	p := domain.Project{
+		ID:   1,
+		Name: "test-name",
+		Settings: domain.ProjectSettings{
+			MaxLabels:       2,
+			RequiredFields:  "labels",
+			DefaultStatus:   1,
+			AllowedStatuses: "1,2",
+		},
+		DefaultLabels: []domain.Label{{ID: 2, Name: "bug"}},
+	}
+
+	item, err := r.UpdateSettings(p)
+
+	assert.Nil(t, err)
+	assert.Equal(t, p.Settings, item.Settings)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectUpdateSettingsErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"projects_default_labels\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE \"projects\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	p := domain.Project{
+		ID:       1,
+		Name:     "test-name",
+		Settings: domain.NewProjectSettings(),
+	}
+
+	_, err := r.UpdateSettings(p)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectAddTemplate(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"issue_templates\" (.+)$").WithArgs(1, "bug", "[Bug] ", "test-description", 2, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO \"issue_templates_labels\" (.+)$").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	status := 2
+	tpl := &domain.IssueTemplate{
+		ProjectID:   1,
+		Name:        "bug",
+		TitlePrefix: "[Bug] ",
+		Description: "test-description",
+		Status:      &status,
+		Labels:      []domain.Label{{ID: 2, Name: "bug"}},
+	}
+
+	item, err := r.AddTemplate(tpl)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectAddTemplateErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"issue_templates\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.AddTemplate(&domain.IssueTemplate{ProjectID: 1, Name: "bug"})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectUpdateTemplate(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"issue_templates_labels\" (.+)$").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" (.+)$").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE \"issue_templates\" SET (.+)$").WithArgs(1, "bug", "", "", nil, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	tpl := domain.IssueTemplate{
+		ID:        1,
+		ProjectID: 1,
+		Name:      "bug",
+		Labels:    []domain.Label{{ID: 2, Name: "bug"}},
+	}
+
+	item, err := r.UpdateTemplate(tpl)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tpl.Name, item.Name)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectUpdateTemplateErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE \"issue_templates\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	_, err := r.UpdateTemplate(domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "bug"})
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectFindTemplateByID(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	templateData := sqlmock.NewRows([]string{
+		"id", "project_id", "name",
+	}).AddRow(uint(1), uint(1), "bug")
+	mock.ExpectQuery("SELECT (.+) FROM \"issue_templates\" WHERE (.+)$").WithArgs(1).WillReturnRows(templateData)
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "issue_template_id",
+	}).AddRow(uint(2), "bug", uint(1))
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" INNER JOIN \"issue_templates_labels\" (.+)$").WithArgs(1).WillReturnRows(labelData)
+
+	item, err := r.FindTemplateByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+	assert.Len(t, item.Labels, 1)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectFindTemplateByIDErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"issue_templates\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+
+	_, err := r.FindTemplateByID(uint(1))
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectFindTemplates(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	templateData := sqlmock.NewRows([]string{
+		"id", "project_id", "name",
+	}).AddRow(uint(1), uint(1), "bug").AddRow(uint(2), uint(1), "feature")
+	mock.ExpectQuery("SELECT (.+) FROM \"issue_templates\" WHERE (.+)$").WithArgs(1).WillReturnRows(templateData)
+	labelData := sqlmock.NewRows([]string{
+		"id", "name", "issue_template_id",
+	})
+	mock.ExpectQuery("SELECT (.+) FROM \"labels\" INNER JOIN \"issue_templates_labels\" (.+)$").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnRows(labelData)
+
+	items, err := r.FindTemplates(uint(1))
+
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectFindTemplatesErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"issue_templates\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+
+	_, err := r.FindTemplates(uint(1))
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectRemoveTemplate(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"issue_templates\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	status, err := r.RemoveTemplate(uint(1))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectRemoveTemplateErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"issue_templates_labels\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM \"issue_templates\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.RemoveTemplate(uint(1))
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectAddCustomField(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"custom_fields\" (.+)$").WithArgs(1, "environment", "select", "dev,prod", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	item, err := r.AddCustomField(&domain.CustomField{ProjectID: 1, Name: "environment", Type: "select", Options: "dev,prod"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectAddCustomFieldErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"custom_fields\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.AddCustomField(&domain.CustomField{ProjectID: 1, Name: "customer", Type: "text"})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectUpdateCustomField(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"custom_fields\" SET (.+)$").WithArgs(1, "client", "text", "", sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "client", Type: "text"}
+
+	item, err := r.UpdateCustomField(f)
+
+	assert.Nil(t, err)
+	assert.Equal(t, f.Name, item.Name)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectUpdateCustomFieldErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"custom_fields\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	_, err := r.UpdateCustomField(domain.CustomField{ID: 1, ProjectID: 1, Name: "client", Type: "text"})
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectFindCustomFields(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	fieldData := sqlmock.NewRows([]string{
+		"id", "project_id", "name", "type",
+	}).AddRow(uint(1), uint(1), "customer", "text")
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_fields\" WHERE (.+)$").WithArgs(1).WillReturnRows(fieldData)
+	fieldsData := sqlmock.NewRows([]string{
+		"id", "project_id", "name", "type",
+	}).AddRow(uint(1), uint(1), "customer", "text").AddRow(uint(2), uint(1), "story points", "number")
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_fields\" WHERE (.+)$").WithArgs(1).WillReturnRows(fieldsData)
+
+	item, err := r.FindCustomFieldByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "customer", item.Name)
+
+	items, err := r.FindCustomFields(uint(1))
+
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectFindCustomFieldsErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_fields\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT (.+) FROM \"custom_fields\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+
+	_, err := r.FindCustomFieldByID(uint(1))
+
+	assert.NotNil(t, err)
+
+	_, err = r.FindCustomFields(uint(1))
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectRemoveCustomField(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM \"custom_fields\" WHERE (.+)$").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	status, err := r.RemoveCustomField(uint(1))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceProjectRemoveCustomFieldErr(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteProjectRepository(gormDB, t.TempDir())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"custom_field_values\" WHERE (.+)$").WithArgs(1).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.RemoveCustomField(uint(1))
 
 	assert.NotNil(t, err)
 	assert.False(t, status)
@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"database/sql"
+	"github.com/jinzhu/gorm"
+	"go-issue-tracker/pkg/domain"
+	"log"
+)
+
+// transactionKey is key of SQLiteTransaction stored in DB of transaction
+const transactionKey = "persistence:transaction"
+
+// SQLiteTransaction is transaction shared by repositories bound to it, it runs hooks registered by
+// repository methods after commit or rollback, e.g. to remove files only when removal is committed
+type SQLiteTransaction struct {
+	tx         *gorm.DB
+	committed  []func() error
+	rolledBack []func() error
+}
+
+// Commit to commit transaction, rollback hooks run when commit fails
+func (t *SQLiteTransaction) Commit() error {
+	if err := t.tx.Commit().Error; err != nil {
+		runTransactionHooks(t.rolledBack)
+		return err
+	}
+	runTransactionHooks(t.committed)
+	return nil
+}
+
+// Rollback to roll back transaction
+func (t *SQLiteTransaction) Rollback() error {
+	err := t.tx.Rollback().Error
+	runTransactionHooks(t.rolledBack)
+	return err
+}
+
+// runTransactionHooks to run hooks, transaction is already finished, so errors are only logged
+func runTransactionHooks(hooks []func() error) {
+	for _, hook := range hooks {
+		if err := hook(); err != nil {
+			log.Printf("Transaction hook failed: %v", err)
+		}
+	}
+}
+
+// beginTransaction to begin transaction shared by repositories
+func beginTransaction(db *gorm.DB) (domain.Transaction, error) {
+	tx := db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+	t := &SQLiteTransaction{}
+	t.tx = tx.Set(transactionKey, t)
+	return t, nil
+}
+
+// getTransactionDB to get DB of transaction, db is kept for transaction of other implementation
+func getTransactionDB(db *gorm.DB, tx domain.Transaction) *gorm.DB {
+	if t, ok := tx.(*SQLiteTransaction); ok {
+		return t.tx
+	}
+	return db
+}
+
+// repositoryTx is transaction of repository method, it joins transaction repository is bound to
+// and leaves commit or rollback of joined transaction to its owner
+type repositoryTx struct {
+	*gorm.DB
+	joined bool
+	owner  *SQLiteTransaction
+}
+
+// begin to begin transaction of repository method or to join transaction repository is bound to
+func begin(db *gorm.DB) repositoryTx {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		owner, _ := db.Get(transactionKey)
+		t, _ := owner.(*SQLiteTransaction)
+		return repositoryTx{DB: db, joined: true, owner: t}
+	}
+	return repositoryTx{DB: db.Begin()}
+}
+
+// Commit to commit transaction, joined transaction is committed by its owner
+func (t repositoryTx) Commit() *gorm.DB {
+	if t.joined {
+		return t.DB
+	}
+	return t.DB.Commit()
+}
+
+// Rollback to roll back transaction, joined transaction is rolled back by its owner when error of
+// repository method is returned
+func (t repositoryTx) Rollback() *gorm.DB {
+	if t.joined {
+		return t.DB
+	}
+	return t.DB.Rollback()
+}
+
+// afterCommit to run hook after transaction is committed, it runs at once after Commit of own transaction
+// and its error is returned, hook of joined transaction runs after its owner commits it
+func (t repositoryTx) afterCommit(hook func() error) error {
+	if t.owner != nil {
+		t.owner.committed = append(t.owner.committed, hook)
+		return nil
+	}
+	return hook()
+}
+
+// afterRollback to run hook when joined transaction is rolled back by its owner, repository method
+// rolling back its own transaction cleans up itself
+func (t repositoryTx) afterRollback(hook func() error) {
+	if t.owner != nil {
+		t.owner.rolledBack = append(t.owner.rolledBack, hook)
+	}
+}
@@ -0,0 +1,133 @@
+package persistence
+
+import (
+	"github.com/jinzhu/gorm"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// SQLiteWebhookRepository is a repository
+type SQLiteWebhookRepository struct {
+	db *gorm.DB
+}
+
+// NewSQLiteWebhookRepository to create SQLiteWebhookRepository
+func NewSQLiteWebhookRepository(db *gorm.DB) *SQLiteWebhookRepository {
+	return &SQLiteWebhookRepository{
+		db: db,
+	}
+}
+
+// Add to add new webhook
+func (r *SQLiteWebhookRepository) Add(webhook *domain.Webhook) (*domain.Webhook, error) {
+	if err := r.db.Create(webhook).Error; err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// Update to update webhook
+func (r *SQLiteWebhookRepository) Update(webhook domain.Webhook) (domain.Webhook, error) {
+	if err := r.db.Save(&webhook).Error; err != nil {
+		return webhook, err
+	}
+	return webhook, nil
+}
+
+// FindByID to find webhook by ID
+func (r *SQLiteWebhookRepository) FindByID(id uint) (domain.Webhook, error) {
+	var item domain.Webhook
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Find to find webhooks of project
+func (r *SQLiteWebhookRepository) Find(projectID uint) ([]domain.Webhook, error) {
+	var items []domain.Webhook
+	if err := r.db.Where("project_id = ?", projectID).Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindSubscribed to find active webhooks of project, active webhooks of all projects are found when project ID is 0
+func (r *SQLiteWebhookRepository) FindSubscribed(projectID uint) ([]domain.Webhook, error) {
+	var items []domain.Webhook
+	db := r.db.Where("active = ?", true)
+	if projectID != uint(0) {
+		db = db.Where("project_id = ?", projectID)
+	}
+	if err := db.Order("id").Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Remove to remove webhook and its deliveries
+func (r *SQLiteWebhookRepository) Remove(id uint) (bool, error) {
+	tx := r.db.Begin()
+	if err := tx.Exec("DELETE FROM \"webhook_deliveries\" WHERE webhook_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Where("ID = ?", id).Delete(domain.Webhook{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddDeliveries to add deliveries in single transaction
+func (r *SQLiteWebhookRepository) AddDeliveries(deliveries []domain.WebhookDelivery) (int, error) {
+	tx := r.db.Begin()
+	for i := range deliveries {
+		if err := tx.Create(&deliveries[i]).Error; err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	return len(deliveries), nil
+}
+
+// UpdateDelivery to update delivery
+func (r *SQLiteWebhookRepository) UpdateDelivery(delivery domain.WebhookDelivery) (domain.WebhookDelivery, error) {
+	if err := r.db.Save(&delivery).Error; err != nil {
+		return delivery, err
+	}
+	return delivery, nil
+}
+
+// FindDeliveryByID to find delivery by ID
+func (r *SQLiteWebhookRepository) FindDeliveryByID(id uint) (domain.WebhookDelivery, error) {
+	var item domain.WebhookDelivery
+	if err := r.db.Where("ID = ?", id).First(&item).Error; err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindDeliveries to find latest deliveries of webhook, newest first
+func (r *SQLiteWebhookRepository) FindDeliveries(webhookID uint, limit int) ([]domain.WebhookDelivery, error) {
+	var items []domain.WebhookDelivery
+	if err := r.db.Where("webhook_id = ?", webhookID).Order("id DESC").Limit(limit).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindDueDeliveries to find queued deliveries which should be sent at provided time, oldest first
+func (r *SQLiteWebhookRepository) FindDueDeliveries(now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	var items []domain.WebhookDelivery
+	if err := r.db.Where("status = ? AND send_after <= ?", domain.WebhookDeliveryQueued, now).Order("id").Limit(limit).Find(&items).Error; err != nil {
+		return items, err
+	}
+	return items, nil
+}
@@ -0,0 +1,217 @@
+package persistence_test
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/persistence"
+	pTesting "go-issue-tracker/pkg/interfaces/persistence/testing"
+	"testing"
+	"time"
+)
+
+func TestPersistenceWebhookNewSQLiteWebhookRepository(t *testing.T) {
+	mockDB, _, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteWebhookRepository(gormDB)
+
+	assert.NotNil(t, r)
+}
+
+func TestPersistenceWebhookAddAndUpdate(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteWebhookRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"webhooks\" (.+)$").WithArgs(1, "https://ci.example.com/hook", "secret", "issue.created", true, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"webhooks\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"webhooks\" SET (.+)$").WithArgs(1, "https://ci.example.com/hook", "secret", "", false, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"webhooks\" SET (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	item, err := r.Add(&domain.Webhook{ProjectID: 1, URL: "https://ci.example.com/hook", Secret: "secret", Events: "issue.created", Active: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	item, err = r.Add(&domain.Webhook{ProjectID: 1})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	w := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook", Secret: "secret"}
+
+	updated, err := r.Update(w)
+
+	assert.Nil(t, err)
+	assert.Equal(t, w.URL, updated.URL)
+
+	_, err = r.Update(w)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceWebhookFind(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteWebhookRepository(gormDB)
+
+	mock.ExpectQuery("SELECT (.+) FROM \"webhooks\" WHERE \\(ID = \\?\\) (.+)$").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"webhooks\" WHERE \\(project_id = \\?\\) ORDER BY \"id\"").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(1, 1).AddRow(2, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"webhooks\" WHERE \\(project_id = \\?\\) ORDER BY \"id\"").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectQuery("SELECT (.+) FROM \"webhooks\" WHERE \\(active = \\?\\) AND \\(project_id = \\?\\) ORDER BY \"id\"").WithArgs(true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"webhooks\" WHERE \\(active = \\?\\) ORDER BY \"id\"").WithArgs(true).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id"}).AddRow(1, 1).AddRow(2, 2))
+	mock.ExpectQuery("SELECT (.+) FROM \"webhooks\" WHERE (.+)$").WithArgs(true).WillReturnError(errors.New("test error"))
+
+	item, err := r.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	items, err := r.Find(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	_, err = r.Find(2)
+
+	assert.NotNil(t, err)
+
+	items, err = r.FindSubscribed(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	items, err = r.FindSubscribed(0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	_, err = r.FindSubscribed(0)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceWebhookRemove(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteWebhookRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"webhook_deliveries\" WHERE webhook_id = \\?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM \"webhooks\" WHERE \\(ID = \\?\\)").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM \"webhook_deliveries\" WHERE webhook_id = \\?").WithArgs(2).WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	status, err := r.Remove(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = r.Remove(2)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
+
+func TestPersistenceWebhookDeliveries(t *testing.T) {
+	mockDB, mock, gormDB := pTesting.GetMockedDB(t)
+	defer mockDB.Close()
+	defer gormDB.Close()
+
+	r := persistence.NewSQLiteWebhookRepository(gormDB)
+
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	d := []domain.WebhookDelivery{
+		{WebhookID: 1, Event: "issue.created", Payload: "{}", Status: domain.WebhookDeliveryQueued, SendAfter: now},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"webhook_deliveries\" (.+)$").WithArgs(1, "issue.created", "{}", "queued", 0, 0, "", now, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO \"webhook_deliveries\" (.+)$").WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"webhook_deliveries\" SET (.+)$").WithArgs(1, "issue.created", "{}", "delivered", 1, 200, "", now, now, sqlmock.AnyArg(), sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT (.+) FROM \"webhook_deliveries\" WHERE \\(ID = \\?\\) (.+)$").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "webhook_id"}).AddRow(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"webhook_deliveries\" WHERE \\(webhook_id = \\?\\) ORDER BY id DESC LIMIT 50").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "webhook_id"}).AddRow(2, 1).AddRow(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM \"webhook_deliveries\" WHERE \\(status = \\? AND send_after <= \\?\\) ORDER BY \"id\" LIMIT 10").WithArgs("queued", now).WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(1, "queued"))
+	mock.ExpectQuery("SELECT (.+) FROM \"webhook_deliveries\" WHERE (.+)$").WithArgs("queued", now).WillReturnError(errors.New("test error"))
+
+	count, err := r.AddDeliveries(d)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, uint(1), d[0].ID)
+
+	count, err = r.AddDeliveries([]domain.WebhookDelivery{{WebhookID: 1}})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, count)
+
+	delivered := d[0]
+	delivered.Status = domain.WebhookDeliveryDelivered
+	delivered.Attempts = 1
+	delivered.ResponseStatus = 200
+	delivered.DeliveredAt = now
+
+	item, err := r.UpdateDelivery(delivered)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.WebhookDeliveryDelivered, item.Status)
+
+	item, err = r.FindDeliveryByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), item.ID)
+
+	items, err := r.FindDeliveries(1, domain.WebhookDeliveriesLimit)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(items))
+
+	items, err = r.FindDueDeliveries(now, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	_, err = r.FindDueDeliveries(now, 10)
+
+	assert.NotNil(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectations were not met %s", err)
+	}
+}
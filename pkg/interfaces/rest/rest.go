@@ -1,10 +1,13 @@
 package rest
 
 import (
+	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"go-issue-tracker/pkg/infrastructure/helpers"
+	"net"
 	"path/filepath"
+	"strings"
 )
 
 // RootMessage is displayed in / path
@@ -21,6 +24,8 @@ func PrepareServer() *echo.Echo {
 
 	server.Use(middleware.Logger())
 
+	server.Use(ValidateRequest)
+
 	server.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"http://localhost:3000", "http://localhost:3001",
 			"http://127.0.0.1:3000", "http://127.0.0.1:3001",
@@ -30,6 +35,27 @@ func PrepareServer() *echo.Echo {
 	return server
 }
 
+// GetIPExtractor to get extractor of client IP address, address of connection is used when no trusted proxies are
+// provided, otherwise X-Forwarded-For header is used up to first address not in comma separated CIDR ranges
+func GetIPExtractor(trustedProxies string) (echo.IPExtractor, error) {
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, item := range strings.Split(trustedProxies, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		_, ipRange, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %s not valid", item)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	if len(options) == 3 {
+		return echo.ExtractIPDirect(), nil
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
 // PrepareEndpoints function to prepare endpoints
 func PrepareEndpoints(e *echo.Echo, m Manager, uiDirPath string) {
 	if helpers.PathExists(uiDirPath) {
@@ -52,14 +78,62 @@ func PrepareEndpoints(e *echo.Echo, m Manager, uiDirPath string) {
 		})
 	})
 
+	e.GET(OpenAPIPath, func(c echo.Context) error {
+		return c.JSON(200, GetOpenAPISpec())
+	})
+
 	api := e.Group("/api")
 
 	api.POST("/issues/new", m.AddIssue)
+	api.POST("/issues/bulk", m.BulkUpdateIssues)
 	api.POST("/issues/:id", m.UpdateIssue)
+	api.POST("/issues/:id/move", m.MoveIssue)
 	api.GET("/issues/:id", m.FindIssueByID)
 	api.GET("/issues/find", m.FindIssues)
 	api.GET("/issues", m.FindAllIssues)
 	api.DELETE("/issues/:id", m.RemoveIssue)
+	api.POST("/issues/:id/custom-fields", m.SetIssueCustomFields)
+	api.POST("/issues/:id/estimates", m.SetIssueEstimates)
+	api.POST("/issues/:id/work-logs/new", m.AddWorkLog)
+	api.GET("/issues/:id/work-logs", m.FindIssueWorkLogs)
+	api.POST("/issues/:id/attachments/new", m.AddAttachment)
+	api.GET("/issues/:id/attachments", m.FindIssueAttachments)
+	api.POST("/issues/:id/watchers/new", m.WatchIssue)
+
+	api.DELETE("/work-logs/:id", m.RemoveWorkLog)
+	api.GET("/timesheet", m.FindTimesheet)
+
+	api.GET("/attachments/:id/download", m.DownloadAttachment)
+	api.DELETE("/attachments/:id", m.RemoveAttachment)
+
+	api.GET("/watchers", m.FindWatchers)
+	api.DELETE("/watchers/:id", m.Unwatch)
+
+	api.GET("/notifications", m.FindNotifications)
+	api.POST("/notifications/read", m.MarkAllNotificationsRead)
+	api.POST("/notifications/:id/read", m.MarkNotificationRead)
+
+	api.GET("/mail-preferences", m.FindMailPreference)
+	api.POST("/mail-preferences", m.SetMailPreference)
+
+	api.POST("/webhooks/:id", m.UpdateWebhook)
+	api.GET("/webhooks/:id", m.FindWebhookByID)
+	api.DELETE("/webhooks/:id", m.RemoveWebhook)
+	api.GET("/webhooks/:id/deliveries", m.FindWebhookDeliveries)
+	api.POST("/webhook-deliveries/:id/redeliver", m.RedeliverWebhookDelivery)
+
+	api.POST("/automation-rules/:id", m.UpdateAutomationRule)
+	api.GET("/automation-rules/:id", m.FindAutomationRuleByID)
+	api.DELETE("/automation-rules/:id", m.RemoveAutomationRule)
+	api.GET("/automation-rules/:id/executions", m.FindAutomationExecutions)
+
+	api.POST("/jobs/:id", m.UpdateJob)
+	api.GET("/jobs/:id", m.FindJobByID)
+	api.GET("/jobs", m.FindAllJobs)
+	api.DELETE("/jobs/:id", m.RemoveJob)
+	api.POST("/jobs/:id/run", m.RunJob)
+
+	api.GET("/events", m.StreamEvents)
 
 	api.POST("/labels/new", m.AddLabel)
 	api.POST("/labels/:id", m.UpdateLabel)
@@ -67,11 +141,42 @@ func PrepareEndpoints(e *echo.Echo, m Manager, uiDirPath string) {
 	api.GET("/labels/find", m.FindLabels)
 	api.GET("/labels", m.FindAllLabels)
 	api.DELETE("/labels/:id", m.RemoveLabel)
+	api.GET("/labels/:id/merge", m.MergeLabelPreview)
+	api.POST("/labels/:id/merge", m.MergeLabel)
+	api.POST("/labels/:id/group", m.SetLabelGroup)
+
+	api.POST("/label-groups/new", m.AddLabelGroup)
+	api.POST("/label-groups/:id", m.UpdateLabelGroup)
+	api.GET("/label-groups/:id", m.FindLabelGroupByID)
+	api.GET("/label-groups", m.FindAllLabelGroups)
+	api.DELETE("/label-groups/:id", m.RemoveLabelGroup)
+
+	api.POST("/issue-templates/:id", m.UpdateIssueTemplate)
+	api.GET("/issue-templates/:id", m.FindIssueTemplateByID)
+	api.DELETE("/issue-templates/:id", m.RemoveIssueTemplate)
+
+	api.POST("/custom-fields/:id", m.UpdateCustomField)
+	api.GET("/custom-fields/:id", m.FindCustomFieldByID)
+	api.DELETE("/custom-fields/:id", m.RemoveCustomField)
 
 	api.POST("/projects/new", m.AddProject)
 	api.POST("/projects/:id", m.UpdateProject)
+	api.POST("/projects/:id/settings", m.UpdateProjectSettings)
+	api.POST("/projects/:id/templates/new", m.AddIssueTemplate)
+	api.GET("/projects/:id/templates", m.FindProjectIssueTemplates)
+	api.POST("/projects/:id/custom-fields/new", m.AddCustomField)
+	api.GET("/projects/:id/custom-fields", m.FindProjectCustomFields)
+	api.GET("/projects/:id/time", m.FindProjectTimeSummary)
+	api.POST("/projects/:id/watchers/new", m.WatchProject)
+	api.POST("/projects/:id/webhooks/new", m.AddWebhook)
+	api.GET("/projects/:id/webhooks", m.FindProjectWebhooks)
+	api.POST("/projects/:id/automation-rules/new", m.AddAutomationRule)
+	api.GET("/projects/:id/automation-rules", m.FindProjectAutomationRules)
+	api.POST("/projects/:id/jobs/new", m.AddJob)
 	api.GET("/projects/:id", m.FindProjectByID)
+	api.GET("/projects/:id/issues/:issueId", m.FindProjectIssueByID)
 	api.GET("/projects/find", m.FindProjects)
+	api.GET("/projects/archived", m.FindArchivedProjects)
 	api.GET("/projects", m.FindAllProjects)
 	api.DELETE("/projects/:id", m.RemoveProject)
 }
@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/domain"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+)
+
+// attachmentFormOverhead is size of multipart form parts other than attachment file allowed in request body
+const attachmentFormOverhead = 1 << 20
+
+// AddAttachment to attach file uploaded as multipart form file to issue, request body is limited
+// by domain.MaxAttachmentSize
+func (m *manager) AddAttachment(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	req := c.Request()
+	req.Body = http.MaxBytesReader(c.Response(), req.Body, domain.MaxAttachmentSize+attachmentFormOverhead)
+	fileHeader, err := c.FormFile("file")
+	if err == http.ErrMissingFile {
+		return errors.New("file not provided")
+	}
+	if err != nil {
+		return err
+	}
+	if fileHeader.Size > domain.MaxAttachmentSize {
+		return fmt.Errorf("attachment exceeds %d bytes", domain.MaxAttachmentSize)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	content, err := ioutil.ReadAll(io.LimitReader(file, domain.MaxAttachmentSize+1))
+	if err != nil {
+		return err
+	}
+
+	item, err := m.iuc.AddAttachment(id, fileHeader.Filename, content)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindIssueAttachments to find attachments of issue
+func (m *manager) FindIssueAttachments(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := m.iuc.FindAttachments(id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// DownloadAttachment to download attachment file, file is always served as download with sniffed
+// content type so browser does not render it
+func (m *manager) DownloadAttachment(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, content, err := m.iuc.ReadAttachment(id)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": item.Filename}))
+	c.Response().Header().Set("X-Content-Type-Options", "nosniff")
+	return c.Blob(200, item.ContentType, content)
+}
+
+// RemoveAttachment to remove attachment together with its file
+func (m *manager) RemoveAttachment(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.iuc.RemoveAttachment(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
@@ -0,0 +1,173 @@
+package rest_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+)
+
+func prepareMultipartHTTP(path string, filename string, content []byte) (echo.Context, *httptest.ResponseRecorder) {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	if filename != "" {
+		part, _ := w.CreateFormFile("file", filename)
+		_, _ = part.Write(content)
+	}
+	_ = w.Close()
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.POST, path, body)
+	req.Header.Add("Content-Type", w.FormDataContentType())
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestAddAttachment(t *testing.T) {
+	content := []byte("%PDF-1.4 test")
+	a := &domain.Attachment{ID: 1, IssueID: 1, Filename: "report.pdf", Size: 13, ContentType: "application/pdf"}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("AddAttachment", uint(1), "report.pdf", content).Return(a, nil)
+
+	c, rec := prepareMultipartHTTP("/api/issues/:id/attachments/new", "report.pdf", content)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.AddAttachment(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"contentType\":\"application/pdf\"")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddAttachmentErrs(t *testing.T) {
+	maxSize := domain.MaxAttachmentSize
+	domain.MaxAttachmentSize = 8
+	defer func() { domain.MaxAttachmentSize = maxSize }()
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("AddAttachment", uint(1), "empty.txt", []byte{}).Return((*domain.Attachment)(nil), errors.New("attachment is empty"))
+
+	tests := []struct {
+		id       string
+		filename string
+		content  []byte
+		err      string
+	}{
+		{"a", "a.txt", []byte("a"), "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "", nil, "file not provided"},
+		{"1", "big.txt", []byte("0123456789"), "attachment exceeds 8 bytes"},
+		{"1", "empty.txt", []byte{}, "attachment is empty"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareMultipartHTTP("/api/issues/:id/attachments/new", ts.filename, ts.content)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.AddAttachment(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindIssueAttachments(t *testing.T) {
+	a := []domain.Attachment{{ID: 1, IssueID: 1, Filename: "report.pdf"}}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindAttachments", uint(1)).Return(a, nil)
+	iucm.On("FindAttachments", uint(2)).Return([]domain.Attachment{}, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/issues/:id/attachments", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindIssueAttachments(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"filename\":\"report.pdf\"")
+
+	c, _ = prepareHTTP(echo.GET, "/api/issues/:id/attachments", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.FindIssueAttachments(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	content := []byte("<html></html>")
+	a := domain.Attachment{ID: 1, IssueID: 1, Filename: "page.html", ContentType: "text/html; charset=utf-8"}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("ReadAttachment", uint(1)).Return(a, content, nil)
+	iucm.On("ReadAttachment", uint(2)).Return(domain.Attachment{}, []byte(nil), errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/attachments/:id/download", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.DownloadAttachment(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, content, rec.Body.Bytes())
+	assert.Equal(t, "attachment; filename=page.html", rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	c, _ = prepareHTTP(echo.GET, "/api/attachments/:id/download", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.DownloadAttachment(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestRemoveAttachment(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("RemoveAttachment", uint(1)).Return(true, nil)
+	iucm.On("RemoveAttachment", uint(2)).Return(false, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/attachments/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveAttachment(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"status\":true")
+
+	c, _ = prepareHTTP(echo.DELETE, "/api/attachments/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.RemoveAttachment(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"github.com/labstack/echo/v4"
+	"strconv"
+	"strings"
+)
+
+// getAutomationItems to get conditions or actions provided as comma separated name:value pairs
+func getAutomationItems(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// AddAutomationRule to add new automation rule to project
+func (m *manager) AddAutomationRule(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+	project, err := m.puc.FindByID(projectID)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.auc.Add(project, c.FormValue("name"), c.FormValue("trigger"), getAutomationItems(c.FormValue("conditions")), getAutomationItems(c.FormValue("actions")))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// UpdateAutomationRule to update automation rule
+func (m *manager) UpdateAutomationRule(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	active := true
+	if c.FormValue("active") != "" {
+		if active, err = strconv.ParseBool(c.FormValue("active")); err != nil {
+			return err
+		}
+	}
+
+	item, err := m.auc.Update(id, c.FormValue("name"), c.FormValue("trigger"), getAutomationItems(c.FormValue("conditions")), getAutomationItems(c.FormValue("actions")), active)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindAutomationRuleByID to find automation rule by ID
+func (m *manager) FindAutomationRuleByID(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.auc.FindByID(id)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"item": nil,
+			})
+		}
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindProjectAutomationRules to find automation rules of project
+func (m *manager) FindProjectAutomationRules(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := m.auc.Find(projectID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RemoveAutomationRule to remove automation rule and its execution log
+func (m *manager) RemoveAutomationRule(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.auc.Remove(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// FindAutomationExecutions to find execution log of automation rule, newest first
+func (m *manager) FindAutomationExecutions(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := m.auc.FindExecutions(id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
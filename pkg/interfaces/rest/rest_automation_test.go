@@ -0,0 +1,213 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+)
+
+func TestAddAutomationRule(t *testing.T) {
+	p := domain.Project{ID: 1}
+	r := &domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Security", Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Actions: "setPriority:high,addLabel:triage", Active: true}
+
+	pucm, aucm, m := prepareAutomationMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	pucm.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	aucm.On("Add", p, "Security", "LabelsChanged", []string{"addedLabel:security"}, []string{"setPriority:high", "addLabel:triage"}).Return(r, nil)
+	aucm.On("Add", p, "Security", "LabelsChanged", []string{}, []string{}).Return((*domain.AutomationRule)(nil), errors.New("actions not provided"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/projects/:id/automation-rules/new", strings.NewReader("name=Security&trigger=LabelsChanged&conditions=addedLabel:security&actions=setPriority:high,+addLabel:triage"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.AddAutomationRule(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"actions\":\"setPriority:high,addLabel:triage\"")
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "name=Security", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"2", "name=Security", "record not found"},
+		{"1", "name=Security&trigger=LabelsChanged", "actions not provided"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/projects/:id/automation-rules/new", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.AddAutomationRule(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	pucm.AssertExpectations(t)
+	aucm.AssertExpectations(t)
+}
+
+func TestUpdateAutomationRule(t *testing.T) {
+	r := domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Urgent", Trigger: domain.EventIssueCreated, Actions: "setSeverity:major", Active: false}
+
+	_, aucm, m := prepareAutomationMocksAndRUC()
+
+	aucm.On("Update", uint(1), "Urgent", "IssueCreated", []string{}, []string{"setSeverity:major"}, false).Return(r, nil)
+	aucm.On("Update", uint(2), "Urgent", "IssueCreated", []string{}, []string{"setSeverity:major"}, true).Return(domain.AutomationRule{}, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/automation-rules/:id", strings.NewReader("name=Urgent&trigger=IssueCreated&actions=setSeverity:major&active=false"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateAutomationRule(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"active\":false")
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "name=Urgent", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "name=Urgent&active=maybe", "strconv.ParseBool: parsing \"maybe\": invalid syntax"},
+		{"2", "name=Urgent&trigger=IssueCreated&actions=setSeverity:major", "record not found"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/automation-rules/:id", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.UpdateAutomationRule(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	aucm.AssertExpectations(t)
+}
+
+func TestFindAutomationRules(t *testing.T) {
+	r := domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Security"}
+
+	_, aucm, m := prepareAutomationMocksAndRUC()
+
+	aucm.On("FindByID", uint(1)).Return(r, nil)
+	aucm.On("FindByID", uint(2)).Return(domain.AutomationRule{}, errors.New("record not found"))
+	aucm.On("FindByID", uint(3)).Return(domain.AutomationRule{}, errors.New("test error"))
+	aucm.On("Find", uint(1)).Return([]domain.AutomationRule{r}, nil)
+	aucm.On("Find", uint(2)).Return([]domain.AutomationRule{}, errors.New("test error"))
+
+	for _, ts := range []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"1", "\"name\":\"Security\"", ""},
+		{"2", "{\"item\":null}", ""},
+		{"3", "", "test error"},
+		{"a", "", "strconv.Atoi: parsing \"a\": invalid syntax"},
+	} {
+		c, rec := prepareHTTP(echo.GET, "/api/automation-rules/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.FindAutomationRuleByID(c)
+
+		if ts.err != "" {
+			assert.Equal(t, ts.err, err.Error())
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Contains(t, rec.Body.String(), ts.body)
+	}
+
+	c, rec := prepareHTTP(echo.GET, "/api/projects/:id/automation-rules", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindProjectAutomationRules(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"items\":[{\"id\":1")
+
+	for _, id := range []string{"2", "a"} {
+		c, _ := prepareHTTP(echo.GET, "/api/projects/:id/automation-rules", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.FindProjectAutomationRules(c)
+
+		assert.NotNil(t, err)
+	}
+
+	aucm.AssertExpectations(t)
+}
+
+func TestRemoveAutomationRule(t *testing.T) {
+	_, aucm, m := prepareAutomationMocksAndRUC()
+
+	aucm.On("Remove", uint(1)).Return(true, nil)
+	aucm.On("Remove", uint(2)).Return(false, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/automation-rules/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveAutomationRule(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"status\":true}\n", rec.Body.String())
+
+	for _, id := range []string{"2", "a"} {
+		c, _ := prepareHTTP(echo.DELETE, "/api/automation-rules/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.RemoveAutomationRule(c)
+
+		assert.NotNil(t, err)
+	}
+
+	aucm.AssertExpectations(t)
+}
+
+func TestFindAutomationExecutions(t *testing.T) {
+	e := domain.AutomationExecution{ID: 1, RuleID: 1, IssueID: 1, Event: domain.EventIssueUpdated, Status: domain.AutomationExecutionSkipped, Depth: 2, Error: "rule already applied in chain"}
+
+	_, aucm, m := prepareAutomationMocksAndRUC()
+
+	aucm.On("FindExecutions", uint(1)).Return([]domain.AutomationExecution{e}, nil)
+	aucm.On("FindExecutions", uint(2)).Return([]domain.AutomationExecution{}, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/automation-rules/:id/executions", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindAutomationExecutions(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"status\":\"skipped\"")
+
+	for _, id := range []string{"2", "a"} {
+		c, _ := prepareHTTP(echo.GET, "/api/automation-rules/:id/executions", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.FindAutomationExecutions(c)
+
+		assert.NotNil(t, err)
+	}
+
+	aucm.AssertExpectations(t)
+}
@@ -0,0 +1,180 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+)
+
+// getCustomFieldValues to get custom field values provided as name:value pairs
+func getCustomFieldValues(pairs []string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return values, fmt.Errorf("custom field value %s is not valid", pair)
+		}
+		values[strings.TrimSpace(parts[0])] = parts[1]
+	}
+	return values, nil
+}
+
+// getCustomFieldFilters to get filters of custom fields of project provided as name:value pairs
+func (m *manager) getCustomFieldFilters(pairs []string, projectID uint) ([]domain.CustomFieldFilter, error) {
+	filters := []domain.CustomFieldFilter{}
+	if len(pairs) == 0 {
+		return filters, nil
+	}
+	if projectID == 0 {
+		return filters, errors.New("project not provided for custom field filter")
+	}
+	values, err := getCustomFieldValues(pairs)
+	if err != nil {
+		return filters, err
+	}
+	fields, err := m.puc.FindCustomFields(projectID)
+	if err != nil {
+		return filters, err
+	}
+	for name, value := range values {
+		found := false
+		for _, field := range fields {
+			if field.Name == name {
+				filters = append(filters, domain.CustomFieldFilter{Field: field, Value: value})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return filters, fmt.Errorf("custom field %s not found", name)
+		}
+	}
+	return filters, nil
+}
+
+// AddCustomField to add new custom field to project
+func (m *manager) AddCustomField(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.puc.AddCustomField(projectID, c.FormValue("name"), c.FormValue("type"), c.FormValue("options"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// UpdateCustomField to update name and options of custom field
+func (m *manager) UpdateCustomField(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.puc.UpdateCustomField(id, c.FormValue("name"), c.FormValue("options"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindCustomFieldByID to find custom field by ID
+func (m *manager) FindCustomFieldByID(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.puc.FindCustomFieldByID(id)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"item": nil,
+			})
+		}
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindProjectCustomFields to find custom fields of project
+func (m *manager) FindProjectCustomFields(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := m.puc.FindCustomFields(projectID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RemoveCustomField to remove custom field and its values
+func (m *manager) RemoveCustomField(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.puc.RemoveCustomField(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// SetIssueCustomFields to set custom field values of issue provided as repeated customField name:value pairs,
+// empty value removes value of field
+func (m *manager) SetIssueCustomFields(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	params, err := c.FormParams()
+	if err != nil {
+		return err
+	}
+	values, err := getCustomFieldValues(params["customField"])
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return errors.New("custom field values not provided")
+	}
+	issue, err := m.iuc.FindByID(id)
+	if err != nil {
+		return errors.New("issue not found")
+	}
+	fields, err := m.puc.FindCustomFields(issue.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.iuc.SetCustomFields(id, fields, values)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
@@ -0,0 +1,252 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+)
+
+func TestAddCustomField(t *testing.T) {
+	f := &domain.CustomField{
+		ID:        1,
+		ProjectID: 1,
+		Name:      "environment",
+		Type:      domain.CustomFieldTypeMultiSelect,
+		Options:   "dev,prod",
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("AddCustomField", uint(1), "environment", "multiselect", "dev,prod").Return(f, nil)
+
+	body := strings.NewReader("name=environment&type=multiselect&options=dev,prod")
+	c, rec := prepareHTTP(echo.POST, "/api/projects/:id/custom-fields/new", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.AddCustomField(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"type\":\"multiselect\"")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddCustomFieldErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("AddCustomField", uint(1), "test", "test", "").Return((*domain.CustomField)(nil), errors.New("custom field type test not valid"))
+
+	c, _ := prepareHTTP(echo.POST, "/api/projects/:id/custom-fields/new", strings.NewReader("name=test&type=test"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.AddCustomField(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "custom field type test not valid", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateCustomField(t *testing.T) {
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "client", Type: domain.CustomFieldTypeText}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("UpdateCustomField", uint(1), "client", "").Return(f, nil)
+
+	c, rec := prepareHTTP(echo.POST, "/api/custom-fields/:id", strings.NewReader("name=client"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateCustomField(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"name\":\"client\"")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateCustomFieldErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("UpdateCustomField", uint(1), "", "").Return(domain.CustomField{}, errors.New("name not provided"))
+
+	c, _ := prepareHTTP(echo.POST, "/api/custom-fields/:id", strings.NewReader("name="))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateCustomField(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "name not provided", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindCustomFieldByID(t *testing.T) {
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindCustomFieldByID", uint(1)).Return(f, nil)
+	pucm.On("FindCustomFieldByID", uint(2)).Return(domain.CustomField{}, errors.New("record not found"))
+	pucm.On("FindCustomFieldByID", uint(3)).Return(domain.CustomField{}, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/custom-fields/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindCustomFieldByID(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"name\":\"customer\"")
+
+	c, rec = prepareHTTP(echo.GET, "/api/custom-fields/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.FindCustomFieldByID(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"item\":null")
+
+	c, _ = prepareHTTP(echo.GET, "/api/custom-fields/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	err = m.FindCustomFieldByID(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindProjectCustomFields(t *testing.T) {
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindCustomFields", uint(1)).Return([]domain.CustomField{f}, nil)
+	pucm.On("FindCustomFields", uint(2)).Return([]domain.CustomField{}, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/projects/:id/custom-fields", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindProjectCustomFields(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"name\":\"customer\"")
+
+	c, _ = prepareHTTP(echo.GET, "/api/projects/:id/custom-fields", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.FindProjectCustomFields(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestRemoveCustomField(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("RemoveCustomField", uint(1)).Return(true, nil)
+	pucm.On("RemoveCustomField", uint(2)).Return(false, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/custom-fields/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveCustomField(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"status\":true")
+
+	c, _ = prepareHTTP(echo.DELETE, "/api/custom-fields/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.RemoveCustomField(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestSetIssueCustomFields(t *testing.T) {
+	fields := []domain.CustomField{
+		{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText},
+		{ID: 2, ProjectID: 1, Name: "story points", Type: domain.CustomFieldTypeNumber},
+	}
+	i := domain.Issue{ID: 1, ProjectID: 1}
+	updated := domain.Issue{
+		ID:           1,
+		ProjectID:    1,
+		CustomFields: []domain.CustomFieldValue{{IssueID: 1, FieldID: 1, Field: fields[0], Value: "ACME"}},
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindByID", uint(1)).Return(i, nil)
+	pucm.On("FindCustomFields", uint(1)).Return(fields, nil)
+	iucm.On("SetCustomFields", uint(1), fields, map[string]string{"customer": "ACME", "story points": ""}).Return(updated, nil)
+
+	body := strings.NewReader("customField=customer:ACME&customField=story points:")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/:id/custom-fields", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.SetIssueCustomFields(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"customFields\":[{")
+	assert.Contains(t, rec.Body.String(), "\"value\":\"ACME\"")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestSetIssueCustomFieldsErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindByID", uint(1)).Return(domain.Issue{ID: 1, ProjectID: 1}, nil)
+	iucm.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	iucm.On("FindByID", uint(3)).Return(domain.Issue{ID: 3, ProjectID: 3}, nil)
+	pucm.On("FindCustomFields", uint(1)).Return([]domain.CustomField{}, nil)
+	pucm.On("FindCustomFields", uint(3)).Return([]domain.CustomField{}, errors.New("test error"))
+	iucm.On("SetCustomFields", uint(1), []domain.CustomField{}, map[string]string{"customer": "ACME"}).Return(domain.Issue{}, errors.New("custom field customer does not belong to issue project"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"1", "", "custom field values not provided"},
+		{"1", "customField=:ACME", "custom field value :ACME is not valid"},
+		{"2", "customField=customer:ACME", "issue not found"},
+		{"3", "customField=customer:ACME", "test error"},
+		{"1", "customField=customer:ACME", "custom field customer does not belong to issue project"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/issues/:id/custom-fields", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.SetIssueCustomFields(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
@@ -0,0 +1,96 @@
+package rest
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/domain"
+	"strconv"
+	"time"
+)
+
+// StreamRetry is reconnection time sent to clients of event stream
+var StreamRetry = 3 * time.Second
+
+// writeStreamEvent to write stream event as Server-Sent Event
+func writeStreamEvent(c echo.Context, item domain.StreamEvent) error {
+	_, err := fmt.Fprintf(c.Response(), "id: %d\nevent: %s\ndata: %s\n\n", item.ID, item.Name, item.Payload)
+	return err
+}
+
+// StreamEvents to stream issue, label and project changes as Server-Sent Events, events are filtered by
+// projectId query param. Client resuming after Last-Event-ID header or lastEventId query param gets missed
+// events from event log or reset event when some of them are lost, slow client gets disconnect event
+// and reconnects after last event it received
+func (m *manager) StreamEvents(c echo.Context) error {
+	var projectID uint
+	if c.QueryParam("projectId") != "" {
+		id, err := strconv.Atoi(c.QueryParam("projectId"))
+		if err != nil {
+			return err
+		}
+		projectID = uint(id)
+	}
+	var lastEventID uint64
+	value := c.Request().Header.Get("Last-Event-ID")
+	if value == "" {
+		value = c.QueryParam("lastEventId")
+	}
+	if value != "" {
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		lastEventID = id
+	}
+
+	subscription, items, complete := m.suc.Subscribe(projectID, lastEventID)
+	defer m.suc.Unsubscribe(subscription)
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set("Cache-Control", "no-cache")
+	response.Header().Set("Connection", "keep-alive")
+	response.WriteHeader(200)
+
+	if _, err := fmt.Fprintf(response, "retry: %d\n\n", StreamRetry.Milliseconds()); err != nil {
+		return nil
+	}
+	if !complete {
+		if _, err := fmt.Fprint(response, "event: reset\ndata: {\"reason\":\"events lost\"}\n\n"); err != nil {
+			return nil
+		}
+	}
+	for _, item := range items {
+		if err := writeStreamEvent(c, item); err != nil {
+			return nil
+		}
+	}
+	response.Flush()
+
+	heartbeat := time.NewTicker(domain.StreamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case item := <-subscription.Events:
+			if err := writeStreamEvent(c, item); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(response, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		case <-subscription.Done:
+			for len(subscription.Events) > 0 {
+				if err := writeStreamEvent(c, <-subscription.Events); err != nil {
+					return nil
+				}
+			}
+			fmt.Fprint(response, "event: disconnect\ndata: {\"reason\":\"slow consumer\"}\n\n")
+			response.Flush()
+			return nil
+		}
+		response.Flush()
+	}
+}
@@ -0,0 +1,82 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"testing"
+	"time"
+)
+
+func TestStreamEvents(t *testing.T) {
+	s := domain.NewStreamSubscription(1)
+	s.Events <- domain.StreamEvent{ID: 6, Name: domain.EventIssueUpdated, Payload: json.RawMessage("{\"issue\":{\"id\":2}}")}
+	close(s.Done)
+	replayed := []domain.StreamEvent{{ID: 5, Name: domain.EventIssueCreated, Payload: json.RawMessage("{\"issue\":{\"id\":1}}")}}
+
+	sucm, m := prepareStreamMocksAndRUC()
+
+	sucm.On("Subscribe", uint(1), uint64(4)).Return(s, replayed, false)
+	sucm.On("Unsubscribe", s).Return()
+
+	c, rec := prepareHTTP(echo.GET, "/api/events?projectId=1", nil)
+	c.Request().Header.Set("Last-Event-ID", "4")
+
+	err := m.StreamEvents(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "retry: 3000\n\n"+
+		"event: reset\ndata: {\"reason\":\"events lost\"}\n\n"+
+		"id: 5\nevent: IssueCreated\ndata: {\"issue\":{\"id\":1}}\n\n"+
+		"id: 6\nevent: IssueUpdated\ndata: {\"issue\":{\"id\":2}}\n\n"+
+		"event: disconnect\ndata: {\"reason\":\"slow consumer\"}\n\n", rec.Body.String())
+
+	sucm.AssertExpectations(t)
+}
+
+func TestStreamEventsHeartbeat(t *testing.T) {
+	domain.StreamHeartbeatInterval = time.Millisecond
+	defer func() {
+		domain.StreamHeartbeatInterval = 15 * time.Second
+	}()
+	s := domain.NewStreamSubscription(0)
+
+	sucm, m := prepareStreamMocksAndRUC()
+
+	sucm.On("Subscribe", uint(0), uint64(7)).Return(s, []domain.StreamEvent{}, true)
+	sucm.On("Unsubscribe", s).Return()
+
+	c, rec := prepareHTTP(echo.GET, "/api/events?lastEventId=7", nil)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 50*time.Millisecond)
+	defer cancel()
+	c.SetRequest(c.Request().WithContext(ctx))
+
+	err := m.StreamEvents(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), ": heartbeat\n\n")
+	assert.NotContains(t, rec.Body.String(), "reset")
+
+	sucm.AssertExpectations(t)
+}
+
+func TestStreamEventsNotValid(t *testing.T) {
+	_, m := prepareStreamMocksAndRUC()
+
+	c, _ := prepareHTTP(echo.GET, "/api/events?projectId=a", nil)
+
+	err := m.StreamEvents(c)
+
+	assert.Equal(t, "strconv.Atoi: parsing \"a\": invalid syntax", err.Error())
+
+	c, _ = prepareHTTP(echo.GET, "/api/events", nil)
+	c.Request().Header.Set("Last-Event-ID", "-1")
+
+	err = m.StreamEvents(c)
+
+	assert.Equal(t, "strconv.ParseUint: parsing \"-1\": invalid syntax", err.Error())
+}
@@ -0,0 +1,141 @@
+package rest
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/domain"
+	"strconv"
+)
+
+// issueTemplateData describes issue template values provided in request
+type issueTemplateData struct {
+	name        string
+	titlePrefix string
+	description string
+	status      *int
+	labels      []domain.Label
+}
+
+// getIssueTemplateData to get/validate issue template values and labels available in project from echo.Context
+func (m *manager) getIssueTemplateData(c echo.Context, projectID uint) (issueTemplateData, error) {
+	data := issueTemplateData{
+		name:        c.FormValue("name"),
+		titlePrefix: c.FormValue("titlePrefix"),
+		description: c.FormValue("description"),
+	}
+	if data.name == "" {
+		return data, errors.New("name not provided")
+	}
+	var err error
+	if c.FormValue("status") != "" {
+		status, err := strconv.Atoi(c.FormValue("status"))
+		if err != nil {
+			return data, err
+		}
+		data.status = &status
+	}
+	if data.labels, err = m.findLabelsByNames(c.FormValue("labels"), projectID); err != nil {
+		return data, err
+	}
+	return data, nil
+}
+
+// AddIssueTemplate to add new issue template to project
+func (m *manager) AddIssueTemplate(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+	data, err := m.getIssueTemplateData(c, projectID)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.puc.AddTemplate(projectID, data.name, data.titlePrefix, data.description, data.status, data.labels)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// UpdateIssueTemplate to update issue template
+func (m *manager) UpdateIssueTemplate(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	template, err := m.puc.FindTemplateByID(id)
+	if err != nil {
+		return errors.New("template not found")
+	}
+	data, err := m.getIssueTemplateData(c, template.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.puc.UpdateTemplate(id, data.name, data.titlePrefix, data.description, data.status, data.labels)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindIssueTemplateByID to find issue template by ID
+func (m *manager) FindIssueTemplateByID(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.puc.FindTemplateByID(id)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"item": nil,
+			})
+		}
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindProjectIssueTemplates to find issue templates of project
+func (m *manager) FindProjectIssueTemplates(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := m.puc.FindTemplates(projectID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RemoveIssueTemplate to remove issue template
+func (m *manager) RemoveIssueTemplate(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.puc.RemoveTemplate(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
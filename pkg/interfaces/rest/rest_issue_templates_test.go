@@ -0,0 +1,239 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+)
+
+func TestAddIssueTemplate(t *testing.T) {
+	l := domain.Label{ID: 2, Name: "bug", ProjectID: 1}
+	status := 1
+	tpl := &domain.IssueTemplate{
+		ID:          1,
+		ProjectID:   1,
+		Name:        "bug",
+		TitlePrefix: "[Bug] ",
+		Description: "## Steps",
+		Status:      &status,
+		Labels:      []domain.Label{l},
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindByName", "bug", uint(1)).Return(l, nil)
+	pucm.On("AddTemplate", uint(1), "bug", "[Bug] ", "## Steps", &status, []domain.Label{l}).Return(tpl, nil)
+
+	body := strings.NewReader("name=bug&titlePrefix=[Bug] &description=## Steps&status=1&labels=bug")
+	c, rec := prepareHTTP(echo.POST, "/api/projects/:id/templates/new", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.AddIssueTemplate(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"titlePrefix\":\"[Bug] \"")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddIssueTemplateErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindByName", "test", uint(1)).Return(domain.Label{}, errors.New("record not found"))
+	pucm.On("AddTemplate", uint(1), "bug", "", "", (*int)(nil), []domain.Label{}).Return((*domain.IssueTemplate)(nil), errors.New("project is archived"))
+
+	tests := []struct {
+		body string
+		err  string
+	}{
+		{"name=", "name not provided"},
+		{"name=bug&status=x", "strconv.Atoi: parsing \"x\": invalid syntax"},
+		{"name=bug&labels=test", "label test is not valid"},
+		{"name=bug", "project is archived"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/projects/:id/templates/new", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := m.AddIssueTemplate(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateIssueTemplate(t *testing.T) {
+	status := 2
+	tpl := domain.IssueTemplate{ID: 1, ProjectID: 2, Name: "feature", Status: &status}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindTemplateByID", uint(1)).Return(domain.IssueTemplate{ID: 1, ProjectID: 2, Name: "bug"}, nil)
+	pucm.On("UpdateTemplate", uint(1), "feature", "", "", &status, []domain.Label{}).Return(tpl, nil)
+
+	body := strings.NewReader("name=feature&status=2")
+	c, rec := prepareHTTP(echo.POST, "/api/issue-templates/:id", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateIssueTemplate(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"name\":\"feature\"")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateIssueTemplateErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindTemplateByID", uint(1)).Return(domain.IssueTemplate{ID: 1, ProjectID: 2, Name: "bug"}, nil)
+	pucm.On("FindTemplateByID", uint(2)).Return(domain.IssueTemplate{}, errors.New("record not found"))
+	status := 3
+	pucm.On("UpdateTemplate", uint(1), "bug", "", "", &status, []domain.Label{}).Return(domain.IssueTemplate{}, errors.New("status 3 is not allowed in project"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"x", "name=bug", "strconv.Atoi: parsing \"x\": invalid syntax"},
+		{"2", "name=bug", "template not found"},
+		{"1", "name=", "name not provided"},
+		{"1", "name=bug&status=3", "status 3 is not allowed in project"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/issue-templates/:id", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.UpdateIssueTemplate(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindIssueTemplateByID(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindTemplateByID", uint(1)).Return(domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "bug"}, nil)
+	pucm.On("FindTemplateByID", uint(2)).Return(domain.IssueTemplate{}, errors.New("record not found"))
+
+	tests := []struct {
+		id       string
+		expected string
+	}{
+		{"1", "\"name\":\"bug\""},
+		{"2", "\"item\":null"},
+	}
+
+	for _, ts := range tests {
+		c, rec := prepareHTTP(echo.GET, "/api/issue-templates/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.FindIssueTemplateByID(c)
+
+		assert.Nil(t, err)
+		assert.Contains(t, rec.Body.String(), ts.expected)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindIssueTemplateByIDErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindTemplateByID", uint(1)).Return(domain.IssueTemplate{}, errors.New("test error"))
+
+	c, _ := prepareHTTP(echo.GET, "/api/issue-templates/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindIssueTemplateByID(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindProjectIssueTemplates(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindTemplates", uint(1)).Return([]domain.IssueTemplate{{ID: 1, ProjectID: 1, Name: "bug"}}, nil)
+
+	c, rec := prepareHTTP(echo.GET, "/api/projects/:id/templates", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindProjectIssueTemplates(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"name\":\"bug\"")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindProjectIssueTemplatesErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindTemplates", uint(1)).Return([]domain.IssueTemplate{}, errors.New("test error"))
+
+	c, _ := prepareHTTP(echo.GET, "/api/projects/:id/templates", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindProjectIssueTemplates(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestRemoveIssueTemplate(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("RemoveTemplate", uint(1)).Return(true, nil)
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/issue-templates/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveIssueTemplate(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"status\":true")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestRemoveIssueTemplateErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("RemoveTemplate", uint(1)).Return(false, errors.New("test error"))
+
+	c, _ := prepareHTTP(echo.DELETE, "/api/issue-templates/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveIssueTemplate(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
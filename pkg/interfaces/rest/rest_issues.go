@@ -9,37 +9,96 @@ import (
 	"strings"
 )
 
-// getLabels to get/validate labels from echo.Context
-func (m *manager) getLabels(c echo.Context) (map[string]domain.Label, error) {
+// getLabels to get/validate labels available in project from echo.Context, required labels are validated by project settings
+func (m *manager) getLabels(c echo.Context, projectID uint) (map[string]domain.Label, error) {
 	labelsRaw := strings.Split(strings.Trim(c.FormValue("labels"), " "), ",")
 	labels := make(map[string]domain.Label)
 	for _, lR := range labelsRaw {
 		if labels[lR].ID == 0 && lR != "" {
-			label, err := m.luc.FindByName(lR)
+			label, err := m.luc.FindByName(lR, projectID)
 			if err != nil {
 				return labels, fmt.Errorf("label %s is not valid", lR)
 			}
 			labels[lR] = label
 		}
 	}
-	if len(labels) == 0 {
-		return labels, errors.New("no labels assigned")
-	}
 
 	return labels, nil
 }
 
-// AddIssue to add new issue
+// findLabelsByNames to find labels available in project by comma separated names
+func (m *manager) findLabelsByNames(values string, projectID uint) ([]domain.Label, error) {
+	labels := []domain.Label{}
+	for _, lR := range strings.Split(strings.Trim(values, " "), ",") {
+		if lR == "" {
+			continue
+		}
+		label, err := m.luc.FindByName(lR, projectID)
+		if err != nil {
+			return labels, fmt.Errorf("label %s is not valid", lR)
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
+// getLevel to get priority or severity parsed by parse func, default level is returned when value is not provided
+func getLevel(value string, defaultLevel int, parse func(string) (int, error)) (int, error) {
+	if value == "" {
+		return defaultLevel, nil
+	}
+	return parse(value)
+}
+
+// getFormValue to get form value, current value is kept when field is not provided
+func getFormValue(c echo.Context, name string, current string) (string, error) {
+	params, err := c.FormParams()
+	if err != nil {
+		return current, err
+	}
+	if _, ok := params[name]; !ok {
+		return current, nil
+	}
+	return c.FormValue(name), nil
+}
+
+// getFindOptions to get min. priority, severity and SLA status filters and sort order of found issues
+func getFindOptions(minPriority string, minSeverity string, minSLAStatus string, sort string) (domain.IssueFindOptions, error) {
+	options := domain.IssueFindOptions{Sort: sort}
+	var err error
+	if options.MinPriority, err = getLevel(minPriority, domain.PriorityNone, domain.ParsePriority); err != nil {
+		return options, err
+	}
+	if options.MinSeverity, err = getLevel(minSeverity, domain.SeverityNone, domain.ParseSeverity); err != nil {
+		return options, err
+	}
+	if options.MinSLAStatus, err = getLevel(minSLAStatus, domain.SLAStatusNone, domain.ParseSLAStatus); err != nil {
+		return options, err
+	}
+	return options, nil
+}
+
+// AddIssue to add new issue, project default status is used when status is not provided, priority
+// and severity are level names or numbers, values of issue template are merged with provided values
+// when templateId is provided, reporter and assignee watch new issue
 func (m *manager) AddIssue(c echo.Context) error {
 	title := c.FormValue("title")
 	if title == "" {
 		return errors.New("title not provided")
 	}
 	description := c.FormValue("description")
-	if description == "" {
-		return errors.New("description not provided")
+	status := domain.IssueStatusDefault
+	if c.FormValue("status") != "" {
+		var err error
+		if status, err = strconv.Atoi(c.FormValue("status")); err != nil {
+			return err
+		}
 	}
-	status, err := strconv.Atoi(c.FormValue("status"))
+	priority, err := getLevel(c.FormValue("priority"), domain.PriorityNone, domain.ParsePriority)
+	if err != nil {
+		return err
+	}
+	severity, err := getLevel(c.FormValue("severity"), domain.SeverityNone, domain.ParseSeverity)
 	if err != nil {
 		return err
 	}
@@ -51,13 +110,25 @@ func (m *manager) AddIssue(c echo.Context) error {
 	if err != nil {
 		return errors.New("project not found")
 	}
-	labels, err := m.getLabels(c)
+	labels, err := m.getLabels(c, project.ID)
 	if err != nil {
 		return err
 	}
 
-	item, err := m.iuc.Add(title, description, status, project, labels)
-	if err != nil {
+	var item *domain.Issue
+	if c.FormValue("templateId") != "" {
+		templateID, err := strconv.Atoi(c.FormValue("templateId"))
+		if err != nil {
+			return err
+		}
+		template, err := m.puc.FindTemplateByID(uint(templateID))
+		if err != nil {
+			return errors.New("template not found")
+		}
+		if item, err = m.iuc.AddFromTemplate(title, description, status, priority, severity, c.FormValue("reporter"), c.FormValue("assignee"), project, labels, template); err != nil {
+			return err
+		}
+	} else if item, err = m.iuc.Add(title, description, status, priority, severity, c.FormValue("reporter"), c.FormValue("assignee"), project, labels); err != nil {
 		return err
 	}
 
@@ -66,7 +137,8 @@ func (m *manager) AddIssue(c echo.Context) error {
 	})
 }
 
-// UpdateIssue to update issue
+// UpdateIssue to update issue, priority, severity, reporter and assignee are kept when not provided,
+// empty reporter or assignee clears it
 func (m *manager) UpdateIssue(c echo.Context) error {
 	id, err := getID(c)
 	if err != nil {
@@ -78,23 +150,199 @@ func (m *manager) UpdateIssue(c echo.Context) error {
 		return errors.New("title not provided")
 	}
 	description := c.FormValue("description")
-	if description == "" {
-		return errors.New("description not provided")
-	}
 	status, err := strconv.Atoi(c.FormValue("status"))
 	if err != nil {
 		return err
 	}
-	labels, err := m.getLabels(c)
+	issue, err := m.iuc.FindByID(id)
+	if err != nil {
+		return err
+	}
+	labels, err := m.getLabels(c, issue.ProjectID)
+	if err != nil {
+		return err
+	}
+	priority, err := getLevel(c.FormValue("priority"), issue.Priority, domain.ParsePriority)
+	if err != nil {
+		return err
+	}
+	severity, err := getLevel(c.FormValue("severity"), issue.Severity, domain.ParseSeverity)
+	if err != nil {
+		return err
+	}
+	reporter, err := getFormValue(c, "reporter", issue.Reporter)
+	if err != nil {
+		return err
+	}
+	assignee, err := getFormValue(c, "assignee", issue.Assignee)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.iuc.Update(id, title, description, status, priority, severity, reporter, assignee, labels)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// getBulkOperation to get bulk operation from echo.Context, label names are resolved in target or filtered project
+func (m *manager) getBulkOperation(c echo.Context) (domain.IssueBulkOperation, error) {
+	operation := domain.IssueBulkOperation{}
+	if c.FormValue("setProjectId") != "" {
+		projectID, err := strconv.Atoi(c.FormValue("setProjectId"))
+		if err != nil {
+			return operation, err
+		}
+		project, err := m.puc.FindByID(uint(projectID))
+		if err != nil {
+			return operation, errors.New("project not found")
+		}
+		operation.Project = &project
+	}
+	labelsProjectID := uint(0)
+	if operation.Project != nil {
+		labelsProjectID = operation.Project.ID
+	} else if projectID, err := strconv.Atoi(c.FormValue("projectId")); err == nil {
+		labelsProjectID = uint(projectID)
+	}
+	for _, field := range []string{"addLabels", "removeLabels"} {
+		for _, lR := range strings.Split(strings.Trim(c.FormValue(field), " "), ",") {
+			if lR == "" {
+				continue
+			}
+			label, err := m.luc.FindByName(lR, labelsProjectID)
+			if err != nil {
+				return operation, fmt.Errorf("label %s is not valid", lR)
+			}
+			if field == "addLabels" {
+				operation.AddLabels = append(operation.AddLabels, label)
+			} else {
+				operation.RemoveLabels = append(operation.RemoveLabels, label)
+			}
+		}
+	}
+	if c.FormValue("setStatus") != "" {
+		status, err := strconv.Atoi(c.FormValue("setStatus"))
+		if err != nil {
+			return operation, err
+		}
+		operation.Status = &status
+	}
+	return operation, nil
+}
+
+// BulkUpdateIssues to apply operation to list of issues or issues matching filter
+func (m *manager) BulkUpdateIssues(c echo.Context) error {
+	operation, err := m.getBulkOperation(c)
+	if err != nil {
+		return err
+	}
+
+	var results []domain.IssueBulkResult
+	if c.FormValue("ids") != "" {
+		ids := []uint{}
+		for _, idRaw := range strings.Split(strings.Trim(c.FormValue("ids"), " "), ",") {
+			if idRaw == "" {
+				continue
+			}
+			id, err := strconv.Atoi(idRaw)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, uint(id))
+		}
+		results, err = m.iuc.BulkUpdate(ids, operation)
+	} else {
+		projectID := 0
+		if c.FormValue("projectId") != "" {
+			projectID, err = strconv.Atoi(c.FormValue("projectId"))
+			if err != nil {
+				return err
+			}
+		}
+		labels := []string{}
+		for _, lR := range strings.Split(strings.Trim(c.FormValue("labels"), " "), ",") {
+			if lR != "" {
+				labels = append(labels, lR)
+			}
+		}
+		var customFields []domain.CustomFieldFilter
+		if params, err := c.FormParams(); err != nil {
+			return err
+		} else if customFields, err = m.getCustomFieldFilters(params["customField"], uint(projectID)); err != nil {
+			return err
+		}
+		var options domain.IssueFindOptions
+		if options, err = getFindOptions(c.FormValue("minPriority"), c.FormValue("minSeverity"), c.FormValue("minSlaStatus"), ""); err != nil {
+			return err
+		}
+		if c.FormValue("title") == "" && projectID == 0 && len(labels) == 0 {
+			return errors.New("ids or filter not provided")
+		}
+		results, err = m.iuc.BulkUpdateFound(c.FormValue("title"), uint(projectID), labels, customFields, options, operation)
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": err == nil,
+		"items":  results,
+	})
+}
+
+// MoveIssue to move issue to another project
+func (m *manager) MoveIssue(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	projectID, err := strconv.Atoi(c.FormValue("projectId"))
+	if err != nil {
+		return err
+	}
+	project, err := m.puc.FindByID(uint(projectID))
+	if err != nil {
+		return errors.New("project not found")
+	}
+
+	item, err := m.iuc.Move(id, project)
 	if err != nil {
 		return err
 	}
 
-	item, err := m.iuc.Update(id, title, description, status, labels)
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindProjectIssueByID to find issue by project and ID, redirecting temporarily when issue was moved, because it
+// may be moved again
+func (m *manager) FindProjectIssueByID(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+	id, err := strconv.Atoi(c.Param("issueId"))
 	if err != nil {
 		return err
 	}
 
+	item, err := m.iuc.FindByProjectAndID(projectID, uint(id))
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"item": nil,
+			})
+		}
+		return err
+	}
+	if item.ProjectID != projectID {
+		return c.Redirect(307, fmt.Sprintf("/api/projects/%d/issues/%d", item.ProjectID, item.ID))
+	}
+
 	return c.JSON(200, map[string]interface{}{
 		"item": item,
 	})
@@ -122,7 +370,8 @@ func (m *manager) FindIssueByID(c echo.Context) error {
 	})
 }
 
-// FindIssues to find issues
+// FindIssues to find issues, sort by priority or severity orders issues from the highest level, sort
+// by due orders issues from the earliest due date, minSlaStatus at_risk finds also breached issues
 func (m *manager) FindIssues(c echo.Context) error {
 	title := c.QueryParam("title")
 	projectID, err := strconv.Atoi(c.QueryParam("projectId"))
@@ -136,8 +385,16 @@ func (m *manager) FindIssues(c echo.Context) error {
 			labels = append(labels, lR)
 		}
 	}
+	customFields, err := m.getCustomFieldFilters(c.QueryParams()["customField"], uint(projectID))
+	if err != nil {
+		return err
+	}
+	options, err := getFindOptions(c.QueryParam("minPriority"), c.QueryParam("minSeverity"), c.QueryParam("minSlaStatus"), c.QueryParam("sort"))
+	if err != nil {
+		return err
+	}
 
-	items, err := m.iuc.Find(title, uint(projectID), labels)
+	items, err := m.iuc.Find(title, uint(projectID), labels, customFields, options)
 	if err != nil {
 		return err
 	}
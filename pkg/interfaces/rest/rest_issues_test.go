@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go-issue-tracker/pkg/domain"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -27,17 +28,59 @@ func TestAddIssue(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	iucm.On("Add", i.Title, i.Description, i.Status, p, labels).Return(i, nil)
-	lucm.On("FindByName", mock.AnythingOfType("string")).Return(domain.Label{}, nil)
+	iucm.On("Add", i.Title, i.Description, i.Status, i.Priority, i.Severity, "alice", "bob", p, labels).Return(i, nil)
+	lucm.On("FindByName", mock.AnythingOfType("string"), mock.AnythingOfType("uint")).Return(domain.Label{}, nil)
 	pucm.On("FindByID", mock.AnythingOfType("uint")).Return(p, nil)
 
-	body := strings.NewReader("projectId=1&title=test-title&description=test-description&status=1&labels=test1,test2,test3")
+	body := strings.NewReader("projectId=1&title=test-title&description=test-description&status=1&reporter=alice&assignee=bob&labels=test1,test2,test3")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/new", body)
+
+	err := m.AddIssue(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddIssuePriority(t *testing.T) {
+	p := domain.Project{ID: 1}
+	i := &domain.Issue{
+		Title:     "test-title",
+		Status:    domain.IssueStatusDefault,
+		Priority:  domain.PriorityHigh,
+		Severity:  domain.SeverityBlocker,
+		ProjectID: 1,
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	iucm.On("Add", i.Title, "", i.Status, i.Priority, i.Severity, "", "", p, map[string]domain.Label{}).Return(i, nil)
+
+	body := strings.NewReader("projectId=1&title=test-title&priority=high&severity=4")
 	c, rec := prepareHTTP(echo.POST, "/api/issues/new", body)
 
 	err := m.AddIssue(c)
 
 	assert.Nil(t, err)
 	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"priority\":3,\"severity\":4")
+
+	for _, ts := range []struct {
+		body string
+		err  string
+	}{
+		{"projectId=1&title=test-title&priority=asap", "priority asap not valid"},
+		{"projectId=1&title=test-title&severity=5", "severity 5 not valid"},
+	} {
+		c, _ = prepareHTTP(echo.POST, "/api/issues/new", strings.NewReader(ts.body))
+
+		err = m.AddIssue(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
 
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
@@ -53,10 +96,6 @@ func TestAddIssueValueErrs(t *testing.T) {
 			strings.NewReader("projectId=1&title=&description=test-description&status=1&labels=test1,test2,test3"),
 			errors.New("title not provided"),
 		},
-		{
-			strings.NewReader("projectId=1&title=test-title&description=&status=1&labels=test1,test2,test3"),
-			errors.New("description not provided"),
-		},
 		{
 			strings.NewReader("projectId=1&title=test-title&description=test-description&status=test&labels=test1,test2,test3"),
 			fmt.Errorf("strconv.Atoi: parsing \"%s\": invalid syntax", "test"),
@@ -109,11 +148,6 @@ func TestAddIssueValueLabelErrs(t *testing.T) {
 		err    error
 		mockOn bool
 	}{
-		{
-			strings.NewReader("projectId=1&title=test-title&description=test-description&status=1&labels="),
-			errors.New("no labels assigned"),
-			false,
-		},
 		{
 			strings.NewReader("projectId=1&title=test-title&description=test-description&status=1&labels=test1,test2,test3"),
 			errors.New("label test1 is not valid"),
@@ -123,7 +157,7 @@ func TestAddIssueValueLabelErrs(t *testing.T) {
 
 	for _, ts := range tests {
 		if ts.mockOn {
-			lucm.On("FindByName", mock.AnythingOfType("string")).Return(domain.Label{}, ts.err)
+			lucm.On("FindByName", mock.AnythingOfType("string"), mock.AnythingOfType("uint")).Return(domain.Label{}, ts.err)
 		}
 		c, _ := prepareHTTP(echo.POST, "/api/issues/new", ts.body)
 
@@ -155,8 +189,8 @@ func TestAddIssueErr(t *testing.T) {
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
 	pucm.On("FindByID", mock.AnythingOfType("uint")).Return(p, nil)
-	lucm.On("FindByName", mock.AnythingOfType("string")).Return(domain.Label{}, nil)
-	iucm.On("Add", i.Title, i.Description, i.Status, p, labels).Return(i, errors.New("test error"))
+	lucm.On("FindByName", mock.AnythingOfType("string"), mock.AnythingOfType("uint")).Return(domain.Label{}, nil)
+	iucm.On("Add", i.Title, i.Description, i.Status, i.Priority, i.Severity, "", "", p, labels).Return(i, errors.New("test error"))
 
 	body := strings.NewReader("projectId=1&title=test-title&description=test-description&status=1&labels=test1,test2,test3")
 	c, _ := prepareHTTP(echo.POST, "/api/issues/new", body)
@@ -185,8 +219,9 @@ func TestUpdateIssue(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	lucm.On("FindByName", mock.AnythingOfType("string")).Return(domain.Label{}, nil)
-	iucm.On("Update", i.ID, i.Title, i.Description, i.Status, labels).Return(i, nil)
+	iucm.On("FindByID", uint(1)).Return(i, nil)
+	lucm.On("FindByName", mock.AnythingOfType("string"), uint(1)).Return(domain.Label{}, nil)
+	iucm.On("Update", i.ID, i.Title, i.Description, i.Status, i.Priority, i.Severity, "", "", labels).Return(i, nil)
 
 	body := strings.NewReader("title=test-title&description=test-description&status=1&labels=test1,test2,test3")
 	c, rec := prepareHTTP(echo.POST, "/api/issues/:id", body)
@@ -201,6 +236,72 @@ func TestUpdateIssue(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
+func TestUpdateIssueReporterAndAssignee(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		Title:     "test-title",
+		Status:    1,
+		Reporter:  "alice",
+		Assignee:  "bob",
+		ProjectID: 1,
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindByID", uint(1)).Return(i, nil)
+	iucm.On("Update", i.ID, i.Title, "", i.Status, i.Priority, i.Severity, "alice", "", map[string]domain.Label{}).Return(i, nil)
+
+	body := strings.NewReader("title=test-title&status=1&assignee=")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/:id", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateIssue(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateIssuePriority(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		Title:     "test-title",
+		Status:    1,
+		Priority:  domain.PriorityLow,
+		Severity:  domain.SeverityMajor,
+		ProjectID: 1,
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindByID", uint(1)).Return(i, nil)
+	iucm.On("Update", i.ID, i.Title, "", i.Status, domain.PriorityUrgent, domain.SeverityMajor, "", "", map[string]domain.Label{}).Return(i, nil)
+
+	body := strings.NewReader("title=test-title&status=1&priority=urgent")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/:id", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateIssue(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	body = strings.NewReader("title=test-title&status=1&severity=fatal")
+	c, _ = prepareHTTP(echo.POST, "/api/issues/:id", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err = m.UpdateIssue(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "severity fatal not valid", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestUpdateIssueIDErr(t *testing.T) {
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
@@ -228,10 +329,6 @@ func TestUpdateIssueValueErrs(t *testing.T) {
 			strings.NewReader("title=&description=test-description&status=1&labels=test1,test2,test3"),
 			errors.New("title not provided"),
 		},
-		{
-			strings.NewReader("title=test-title&description=&status=1&labels=test1,test2,test3"),
-			errors.New("description not provided"),
-		},
 		{
 			strings.NewReader("title=test-title&description=test-description&status=test&labels=test1,test2,test3"),
 			fmt.Errorf("strconv.Atoi: parsing \"%s\": invalid syntax", "test"),
@@ -260,11 +357,6 @@ func TestUpdateIssueValueLabelErrs(t *testing.T) {
 		err    error
 		mockOn bool
 	}{
-		{
-			strings.NewReader("title=test-title&description=test-description&status=1&labels="),
-			errors.New("no labels assigned"),
-			false,
-		},
 		{
 			strings.NewReader("title=test-title&description=test-description&status=1&labels=test1,test2,test3"),
 			errors.New("label test1 is not valid"),
@@ -272,9 +364,11 @@ func TestUpdateIssueValueLabelErrs(t *testing.T) {
 		},
 	}
 
+	iucm.On("FindByID", uint(1)).Return(domain.Issue{ID: 1, ProjectID: 1}, nil)
+
 	for _, ts := range tests {
 		if ts.mockOn {
-			lucm.On("FindByName", mock.AnythingOfType("string")).Return(domain.Label{}, ts.err)
+			lucm.On("FindByName", mock.AnythingOfType("string"), mock.AnythingOfType("uint")).Return(domain.Label{}, ts.err)
 		}
 		c, _ := prepareHTTP(echo.POST, "/api/issues/:id", ts.body)
 		c.SetParamNames("id")
@@ -305,8 +399,9 @@ func TestUpdateIssueErr(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	lucm.On("FindByName", mock.AnythingOfType("string")).Return(domain.Label{}, nil)
-	iucm.On("Update", i.ID, i.Title, i.Description, i.Status, labels).Return(i, errors.New("test error"))
+	iucm.On("FindByID", uint(1)).Return(i, nil)
+	lucm.On("FindByName", mock.AnythingOfType("string"), mock.AnythingOfType("uint")).Return(domain.Label{}, nil)
+	iucm.On("Update", i.ID, i.Title, i.Description, i.Status, i.Priority, i.Severity, "", "", labels).Return(i, errors.New("test error"))
 
 	body := strings.NewReader("title=test-title&description=test-description&status=1&labels=test1,test2,test3")
 	c, _ := prepareHTTP(echo.POST, "/api/issues/:id", body)
@@ -321,6 +416,300 @@ func TestUpdateIssueErr(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
+func TestBulkUpdateIssues(t *testing.T) {
+	p := domain.Project{
+		ID: 2,
+	}
+	l := domain.Label{
+		ID:   1,
+		Name: "test1",
+	}
+	status := 2
+	op := domain.IssueBulkOperation{
+		AddLabels: []domain.Label{l},
+		Status:    &status,
+		Project:   &p,
+	}
+	results := []domain.IssueBulkResult{
+		{ID: 1, Status: true},
+		{ID: 2, Status: true},
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindByName", "test1", mock.AnythingOfType("uint")).Return(l, nil)
+	pucm.On("FindByID", uint(2)).Return(p, nil)
+	iucm.On("BulkUpdate", []uint{1, 2}, op).Return(results, nil)
+
+	body := strings.NewReader("ids=1,2&addLabels=test1&setStatus=2&setProjectId=2")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/bulk", body)
+
+	err := m.BulkUpdateIssues(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"status\":true")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestBulkUpdateIssuesFound(t *testing.T) {
+	l := domain.Label{
+		ID:   1,
+		Name: "test1",
+	}
+	op := domain.IssueBulkOperation{
+		RemoveLabels: []domain.Label{l},
+	}
+	results := []domain.IssueBulkResult{
+		{ID: 1, Status: false, Error: "test error"},
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindByName", "test1", mock.AnythingOfType("uint")).Return(l, nil)
+	iucm.On("BulkUpdateFound", "test", uint(1), []string{"3"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}, op).Return(results, errors.New("test error"))
+
+	body := strings.NewReader("title=test&projectId=1&labels=3&removeLabels=test1")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/bulk", body)
+
+	err := m.BulkUpdateIssues(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"status\":false")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestBulkUpdateIssuesFoundCustomFields(t *testing.T) {
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "environment", Type: domain.CustomFieldTypeMultiSelect, Options: "dev,prod"}
+	status := 2
+	op := domain.IssueBulkOperation{
+		Status: &status,
+	}
+	results := []domain.IssueBulkResult{
+		{ID: 1, Status: true},
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindCustomFields", uint(1)).Return([]domain.CustomField{f}, nil)
+	iucm.On("BulkUpdateFound", "", uint(1), []string{}, []domain.CustomFieldFilter{{Field: f, Value: "prod"}}, domain.IssueFindOptions{}, op).Return(results, nil)
+
+	body := strings.NewReader("projectId=1&customField=environment:prod&setStatus=2")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/bulk", body)
+
+	err := m.BulkUpdateIssues(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"status\":true")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestBulkUpdateIssuesValueErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	tests := []struct {
+		body *strings.Reader
+		err  error
+	}{
+		{
+			strings.NewReader("setStatus=2"),
+			errors.New("ids or filter not provided"),
+		},
+		{
+			strings.NewReader("ids=1,test&setStatus=2"),
+			fmt.Errorf("strconv.Atoi: parsing \"%s\": invalid syntax", "test"),
+		},
+		{
+			strings.NewReader("ids=1&setStatus=test"),
+			fmt.Errorf("strconv.Atoi: parsing \"%s\": invalid syntax", "test"),
+		},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/issues/bulk", ts.body)
+
+		err := m.BulkUpdateIssues(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err.Error(), err.Error())
+
+		checkAssertions(t, cucm, iucm, lucm, pucm)
+	}
+}
+
+func TestBulkUpdateIssuesLabelErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindByName", "test1", mock.AnythingOfType("uint")).Return(domain.Label{}, errors.New("record not found"))
+
+	body := strings.NewReader("ids=1&addLabels=test1")
+	c, _ := prepareHTTP(echo.POST, "/api/issues/bulk", body)
+
+	err := m.BulkUpdateIssues(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "label test1 is not valid", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMoveIssue(t *testing.T) {
+	p := domain.Project{
+		ID: 2,
+	}
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 2,
+		Project:   p,
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", p.ID).Return(p, nil)
+	iucm.On("Move", i.ID, p).Return(i, nil)
+
+	body := strings.NewReader("projectId=2")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/:id/move", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.MoveIssue(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMoveIssueValueErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", uint(3)).Return(domain.Project{}, errors.New("record not found"))
+
+	tests := []struct {
+		id   string
+		body *strings.Reader
+		err  error
+	}{
+		{
+			"test",
+			strings.NewReader("projectId=2"),
+			fmt.Errorf("strconv.Atoi: parsing \"%s\": invalid syntax", "test"),
+		},
+		{
+			"1",
+			strings.NewReader("projectId=test"),
+			fmt.Errorf("strconv.Atoi: parsing \"%s\": invalid syntax", "test"),
+		},
+		{
+			"1",
+			strings.NewReader("projectId=3"),
+			errors.New("project not found"),
+		},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/issues/:id/move", ts.body)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.MoveIssue(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err.Error(), err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMoveIssueErr(t *testing.T) {
+	p := domain.Project{
+		ID: 1,
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", p.ID).Return(p, nil)
+	iucm.On("Move", uint(1), p).Return(domain.Issue{}, errors.New("test error"))
+
+	body := strings.NewReader("projectId=1")
+	c, _ := prepareHTTP(echo.POST, "/api/issues/:id/move", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.MoveIssue(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "test error", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindProjectIssueByID(t *testing.T) {
+	tests := []struct {
+		projectID string
+		issue     domain.Issue
+		err       error
+		code      int
+		location  string
+	}{
+		{"2", domain.Issue{ID: 1, ProjectID: 2}, nil, 200, ""},
+		{"1", domain.Issue{ID: 1, ProjectID: 2}, nil, 307, "/api/projects/2/issues/1"},
+		{"3", domain.Issue{}, errors.New("record not found"), 200, ""},
+	}
+
+	for _, ts := range tests {
+		cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+		projectID, _ := strconv.Atoi(ts.projectID)
+		iucm.On("FindByProjectAndID", uint(projectID), uint(1)).Return(ts.issue, ts.err)
+
+		c, rec := prepareHTTP(echo.GET, "/api/projects/:id/issues/:issueId", nil)
+		c.SetParamNames("id", "issueId")
+		c.SetParamValues(ts.projectID, "1")
+
+		err := m.FindProjectIssueByID(c)
+
+		assert.Nil(t, err)
+		assert.Equal(t, ts.code, rec.Code)
+		assert.Equal(t, ts.location, rec.Header().Get("Location"))
+
+		checkAssertions(t, cucm, iucm, lucm, pucm)
+	}
+}
+
+func TestFindProjectIssueByIDErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindByProjectAndID", uint(1), uint(1)).Return(domain.Issue{}, errors.New("test error"))
+
+	tests := []struct {
+		projectID string
+		issueID   string
+	}{
+		{"test", "1"},
+		{"1", "test"},
+		{"1", "1"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.GET, "/api/projects/:id/issues/:issueId", nil)
+		c.SetParamNames("id", "issueId")
+		c.SetParamValues(ts.projectID, ts.issueID)
+
+		err := m.FindProjectIssueByID(c)
+
+		assert.NotNil(t, err)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestFindIssueByID(t *testing.T) {
 	i := domain.Issue{
 		ID:          1,
@@ -415,7 +804,7 @@ func TestFindIssues(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	iucm.On("Find", "test", uint(1), []string{"test1", "test2"}).Return(i, nil)
+	iucm.On("Find", "test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(i, nil)
 
 	c, rec := prepareHTTP(echo.GET, "/api/issues/find?title=test&projectId=1&labels=test1,test2", nil)
 
@@ -427,6 +816,111 @@ func TestFindIssues(t *testing.T) {
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
 
+func TestFindIssuesPriority(t *testing.T) {
+	i := []domain.Issue{}
+	o := domain.IssueFindOptions{MinPriority: domain.PriorityHigh, MinSeverity: domain.SeverityMinor, Sort: domain.IssueSortPriority}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("Find", "", uint(1), []string{}, []domain.CustomFieldFilter{}, o).Return(i, nil)
+
+	c, rec := prepareHTTP(echo.GET, "/api/issues/find?projectId=1&minPriority=high&minSeverity=1&sort=priority", nil)
+
+	err := m.FindIssues(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	for _, ts := range []struct {
+		path string
+		err  string
+	}{
+		{"/api/issues/find?projectId=1&minPriority=p1", "priority p1 not valid"},
+		{"/api/issues/find?projectId=1&minSeverity=-1", "severity -1 not valid"},
+	} {
+		c, _ = prepareHTTP(echo.GET, ts.path, nil)
+
+		err = m.FindIssues(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindIssuesSLA(t *testing.T) {
+	i := []domain.Issue{}
+	o := domain.IssueFindOptions{MinSLAStatus: domain.SLAStatusAtRisk, Sort: domain.IssueSortDue}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("Find", "", uint(1), []string{}, []domain.CustomFieldFilter{}, o).Return(i, nil)
+
+	c, rec := prepareHTTP(echo.GET, "/api/issues/find?projectId=1&minSlaStatus=at_risk&sort=due", nil)
+
+	err := m.FindIssues(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	c, _ = prepareHTTP(echo.GET, "/api/issues/find?projectId=1&minSlaStatus=late", nil)
+
+	err = m.FindIssues(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "sla status late not valid", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindIssuesCustomFields(t *testing.T) {
+	i := []domain.Issue{}
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindCustomFields", uint(1)).Return([]domain.CustomField{f}, nil)
+	iucm.On("Find", "", uint(1), []string{}, []domain.CustomFieldFilter{{Field: f, Value: "ACME"}}, domain.IssueFindOptions{}).Return(i, nil)
+
+	c, rec := prepareHTTP(echo.GET, "/api/issues/find?projectId=1&customField=customer:ACME", nil)
+
+	err := m.FindIssues(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindIssuesCustomFieldsErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindCustomFields", uint(1)).Return([]domain.CustomField{}, nil)
+	pucm.On("FindCustomFields", uint(2)).Return([]domain.CustomField{}, errors.New("test error"))
+
+	tests := []struct {
+		path string
+		err  string
+	}{
+		{"/api/issues/find?projectId=0&customField=customer:ACME", "project not provided for custom field filter"},
+		{"/api/issues/find?projectId=1&customField=customer", "custom field value customer is not valid"},
+		{"/api/issues/find?projectId=1&customField=customer:ACME", "custom field customer not found"},
+		{"/api/issues/find?projectId=2&customField=customer:ACME", "test error"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.GET, ts.path, nil)
+
+		err := m.FindIssues(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
 func TestFindIssuesProjectIDErr(t *testing.T) {
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
@@ -445,7 +939,7 @@ func TestFindIssuesErr(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	iucm.On("Find", "test", uint(1), []string{"test1", "test2"}).Return(i, errors.New("test error"))
+	iucm.On("Find", "test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(i, errors.New("test error"))
 
 	c, _ := prepareHTTP(echo.GET, "/api/issues/find?title=test&projectId=1&labels=test1,test2", nil)
 
@@ -555,3 +1049,143 @@ func TestRemoveIssueIDErr(t *testing.T) {
 
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
+
+func TestUpdateIssueNotFoundErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindByID", uint(1)).Return(domain.Issue{}, errors.New("record not found"))
+
+	body := strings.NewReader("title=test-title&description=test-description&status=1&labels=test1")
+	c, _ := prepareHTTP(echo.POST, "/api/issues/:id", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateIssue(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "record not found", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddIssueProjectDefaults(t *testing.T) {
+	p := domain.Project{ID: 1}
+	i := &domain.Issue{
+		Title:     "test-title",
+		Status:    2,
+		ProjectID: 1,
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	iucm.On("Add", i.Title, "", domain.IssueStatusDefault, domain.PriorityNone, domain.SeverityNone, "", "", p, map[string]domain.Label{}).Return(i, nil)
+
+	body := strings.NewReader("projectId=1&title=test-title")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/new", body)
+
+	err := m.AddIssue(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"status\":2")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddIssueFromTemplate(t *testing.T) {
+	p := domain.Project{ID: 1}
+	tpl := domain.IssueTemplate{ID: 3, ProjectID: 1, TitlePrefix: "[Bug] "}
+	i := &domain.Issue{
+		Title:     "[Bug] test-title",
+		Status:    2,
+		ProjectID: 1,
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	pucm.On("FindTemplateByID", uint(3)).Return(tpl, nil)
+	iucm.On("AddFromTemplate", "test-title", "", domain.IssueStatusDefault, domain.PriorityNone, domain.SeverityNone, "", "", p, map[string]domain.Label{}, tpl).Return(i, nil)
+
+	body := strings.NewReader("projectId=1&title=test-title&templateId=3")
+	c, rec := prepareHTTP(echo.POST, "/api/issues/new", body)
+
+	err := m.AddIssue(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "[Bug] test-title")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddIssueFromTemplateErrs(t *testing.T) {
+	p := domain.Project{ID: 1}
+	tpl := domain.IssueTemplate{ID: 3, ProjectID: 2}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	pucm.On("FindTemplateByID", uint(3)).Return(tpl, nil)
+	pucm.On("FindTemplateByID", uint(4)).Return(domain.IssueTemplate{}, errors.New("record not found"))
+	iucm.On("AddFromTemplate", "test-title", "", domain.IssueStatusDefault, domain.PriorityNone, domain.SeverityNone, "", "", p, map[string]domain.Label{}, tpl).Return((*domain.Issue)(nil), errors.New("template does not belong to issue project"))
+
+	tests := []struct {
+		body string
+		err  string
+	}{
+		{"projectId=1&title=test-title&templateId=x", "strconv.Atoi: parsing \"x\": invalid syntax"},
+		{"projectId=1&title=test-title&templateId=4", "template not found"},
+		{"projectId=1&title=test-title&templateId=3", "template does not belong to issue project"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/issues/new", strings.NewReader(ts.body))
+
+		err := m.AddIssue(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddIssueProjectSettingsErrs(t *testing.T) {
+	p := domain.Project{ID: 1}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	iucm.On("Add", "test-title", "", 1, domain.PriorityNone, domain.SeverityNone, "", "", p, map[string]domain.Label{}).Return((*domain.Issue)(nil), errors.New("description not provided"))
+
+	body := strings.NewReader("projectId=1&title=test-title&status=1&labels=")
+	c, _ := prepareHTTP(echo.POST, "/api/issues/new", body)
+
+	err := m.AddIssue(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "description not provided", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateIssueProjectSettingsErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindByID", uint(1)).Return(domain.Issue{ID: 1, ProjectID: 1}, nil)
+	iucm.On("Update", uint(1), "test-title", "test-description", 1, domain.PriorityNone, domain.SeverityNone, "", "", map[string]domain.Label{}).Return(domain.Issue{}, errors.New("no labels assigned"))
+
+	body := strings.NewReader("title=test-title&description=test-description&status=1&labels=")
+	c, _ := prepareHTTP(echo.POST, "/api/issues/:id", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateIssue(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "no labels assigned", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
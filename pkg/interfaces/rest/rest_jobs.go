@@ -0,0 +1,116 @@
+package rest
+
+import (
+	"github.com/labstack/echo/v4"
+	"strconv"
+)
+
+// AddJob to add new scheduled job to project
+func (m *manager) AddJob(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+	project, err := m.puc.FindByID(projectID)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.juc.Add(project, c.FormValue("name"), c.FormValue("kind"), c.FormValue("schedule"), getAutomationItems(c.FormValue("params")))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// UpdateJob to update scheduled job
+func (m *manager) UpdateJob(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	active := true
+	if c.FormValue("active") != "" {
+		if active, err = strconv.ParseBool(c.FormValue("active")); err != nil {
+			return err
+		}
+	}
+
+	item, err := m.juc.Update(id, c.FormValue("name"), c.FormValue("schedule"), getAutomationItems(c.FormValue("params")), active)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindJobByID to find scheduled job by ID
+func (m *manager) FindJobByID(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.juc.FindByID(id)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"item": nil,
+			})
+		}
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindAllJobs to find all scheduled jobs
+func (m *manager) FindAllJobs(c echo.Context) error {
+	items, err := m.juc.FindAll()
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RemoveJob to remove scheduled job
+func (m *manager) RemoveJob(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.juc.Remove(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// RunJob to run scheduled job now, result of run is in last run fields of returned job
+func (m *manager) RunJob(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.juc.Run(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
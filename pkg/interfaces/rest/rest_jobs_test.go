@@ -0,0 +1,207 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+)
+
+func TestAddJob(t *testing.T) {
+	p := domain.Project{ID: 1}
+	j := &domain.Job{ID: 1, ProjectID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, Schedule: domain.JobScheduleDaily, Params: "days:60,label:stale", Active: true}
+
+	pucm, jucm, m := prepareJobMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	pucm.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	jucm.On("Add", p, "Stale", "staleLabel", "@daily", []string{"days:60", "label:stale"}).Return(j, nil)
+	jucm.On("Add", p, "Stale", "staleLabel", "", []string{}).Return((*domain.Job)(nil), errors.New("schedule  not valid"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/projects/:id/jobs/new", strings.NewReader("name=Stale&kind=staleLabel&schedule=@daily&params=days:60,+label:stale"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.AddJob(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"params\":\"days:60,label:stale\"")
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "name=Stale", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"2", "name=Stale", "record not found"},
+		{"1", "name=Stale&kind=staleLabel", "schedule  not valid"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/projects/:id/jobs/new", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.AddJob(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	pucm.AssertExpectations(t)
+	jucm.AssertExpectations(t)
+}
+
+func TestUpdateJob(t *testing.T) {
+	j := domain.Job{ID: 1, ProjectID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, Schedule: domain.JobScheduleWeekly, Params: "days:30,label:stale", Active: false}
+
+	_, jucm, m := prepareJobMocksAndRUC()
+
+	jucm.On("Update", uint(1), "Stale", "@weekly", []string{"days:30", "label:stale"}, false).Return(j, nil)
+	jucm.On("Update", uint(2), "Stale", "@weekly", []string{}, true).Return(domain.Job{}, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/jobs/:id", strings.NewReader("name=Stale&schedule=@weekly&params=days:30,label:stale&active=false"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateJob(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"active\":false")
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "name=Stale", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "name=Stale&active=maybe", "strconv.ParseBool: parsing \"maybe\": invalid syntax"},
+		{"2", "name=Stale&schedule=@weekly", "record not found"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/jobs/:id", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.UpdateJob(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	jucm.AssertExpectations(t)
+}
+
+func TestFindJobs(t *testing.T) {
+	j := domain.Job{ID: 1, ProjectID: 1, Name: "Stale", LastStatus: domain.JobRunSucceeded, LastCount: 3}
+
+	_, jucm, m := prepareJobMocksAndRUC()
+
+	jucm.On("FindByID", uint(1)).Return(j, nil)
+	jucm.On("FindByID", uint(2)).Return(domain.Job{}, errors.New("record not found"))
+	jucm.On("FindByID", uint(3)).Return(domain.Job{}, errors.New("test error"))
+	jucm.On("FindAll").Return([]domain.Job{j}, nil).Once()
+	jucm.On("FindAll").Return([]domain.Job{}, errors.New("test error")).Once()
+
+	for _, ts := range []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"1", "\"lastCount\":3", ""},
+		{"2", "{\"item\":null}", ""},
+		{"3", "", "test error"},
+		{"a", "", "strconv.Atoi: parsing \"a\": invalid syntax"},
+	} {
+		c, rec := prepareHTTP(echo.GET, "/api/jobs/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.FindJobByID(c)
+
+		if ts.err != "" {
+			assert.Equal(t, ts.err, err.Error())
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Contains(t, rec.Body.String(), ts.body)
+	}
+
+	c, rec := prepareHTTP(echo.GET, "/api/jobs", nil)
+
+	err := m.FindAllJobs(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"items\":[{\"id\":1")
+
+	c, _ = prepareHTTP(echo.GET, "/api/jobs", nil)
+
+	err = m.FindAllJobs(c)
+
+	assert.NotNil(t, err)
+
+	jucm.AssertExpectations(t)
+}
+
+func TestRemoveJob(t *testing.T) {
+	_, jucm, m := prepareJobMocksAndRUC()
+
+	jucm.On("Remove", uint(1)).Return(true, nil)
+	jucm.On("Remove", uint(2)).Return(false, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/jobs/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveJob(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"status\":true}\n", rec.Body.String())
+
+	for _, id := range []string{"2", "a"} {
+		c, _ := prepareHTTP(echo.DELETE, "/api/jobs/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.RemoveJob(c)
+
+		assert.NotNil(t, err)
+	}
+
+	jucm.AssertExpectations(t)
+}
+
+func TestRunJob(t *testing.T) {
+	j := domain.Job{ID: 1, ProjectID: 1, Name: "Stale", LastStatus: domain.JobRunFailed, LastError: "label stale: record not found"}
+
+	_, jucm, m := prepareJobMocksAndRUC()
+
+	jucm.On("Run", uint(1)).Return(j, nil)
+	jucm.On("Run", uint(2)).Return(domain.Job{ID: 2}, errors.New("job Close is running"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/jobs/:id/run", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RunJob(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"lastStatus\":\"failed\"")
+
+	for _, id := range []string{"2", "a"} {
+		c, _ := prepareHTTP(echo.POST, "/api/jobs/:id/run", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.RunJob(c)
+
+		assert.NotNil(t, err)
+	}
+
+	jucm.AssertExpectations(t)
+}
@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"strconv"
+)
+
+// getLabelGroupData to get/validate label group name and exclusive flag from echo.Context
+func getLabelGroupData(c echo.Context) (string, bool, error) {
+	name := c.FormValue("name")
+	if name == "" {
+		return name, false, errors.New("name not provided")
+	}
+	exclusive := false
+	if c.FormValue("exclusive") != "" {
+		var err error
+		if exclusive, err = strconv.ParseBool(c.FormValue("exclusive")); err != nil {
+			return name, false, err
+		}
+	}
+	return name, exclusive, nil
+}
+
+// AddLabelGroup to add new label group
+func (m *manager) AddLabelGroup(c echo.Context) error {
+	name, exclusive, err := getLabelGroupData(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.luc.AddGroup(name, exclusive)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// UpdateLabelGroup to update label group
+func (m *manager) UpdateLabelGroup(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	name, exclusive, err := getLabelGroupData(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.luc.UpdateGroup(id, name, exclusive)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindLabelGroupByID to find label group by ID
+func (m *manager) FindLabelGroupByID(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.luc.FindGroupByID(id)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"item": nil,
+			})
+		}
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindAllLabelGroups to find all label groups
+func (m *manager) FindAllLabelGroups(c echo.Context) error {
+	items, err := m.luc.FindAllGroups()
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RemoveLabelGroup to remove label group
+func (m *manager) RemoveLabelGroup(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.luc.RemoveGroup(id)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"status": false,
+			})
+		}
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
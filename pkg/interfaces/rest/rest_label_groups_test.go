@@ -0,0 +1,200 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+)
+
+func TestAddLabelGroup(t *testing.T) {
+	g := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("AddGroup", "priority", true).Return(g, nil)
+	lucm.On("AddGroup", "area", false).Return(&domain.LabelGroup{ID: 2, Name: "area"}, nil)
+
+	tests := []string{"name=priority&exclusive=true", "name=area"}
+
+	for _, body := range tests {
+		c, rec := prepareHTTP(echo.POST, "/api/label-groups/new", strings.NewReader(body))
+
+		err := m.AddLabelGroup(c)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 200, rec.Code)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddLabelGroupErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("AddGroup", "priority", false).Return((*domain.LabelGroup)(nil), errors.New("priority label group already exists"))
+
+	tests := []struct {
+		body string
+		err  string
+	}{
+		{"name=", "name not provided"},
+		{"name=priority&exclusive=maybe", "strconv.ParseBool: parsing \"maybe\": invalid syntax"},
+		{"name=priority", "priority label group already exists"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/label-groups/new", strings.NewReader(ts.body))
+
+		err := m.AddLabelGroup(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateLabelGroup(t *testing.T) {
+	g := domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("UpdateGroup", uint(1), "priority", true).Return(g, nil)
+
+	body := strings.NewReader("name=priority&exclusive=true")
+	c, rec := prepareHTTP(echo.POST, "/api/label-groups/:id", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateLabelGroup(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"exclusive\":true")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateLabelGroupErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("UpdateGroup", uint(1), "priority", false).Return(domain.LabelGroup{}, errors.New("test error"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "name=priority", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "name=", "name not provided"},
+		{"1", "name=priority", "test error"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/label-groups/:id", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.UpdateLabelGroup(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindLabelGroupByID(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindGroupByID", uint(1)).Return(domain.LabelGroup{ID: 1, Name: "priority"}, nil)
+	lucm.On("FindGroupByID", uint(2)).Return(domain.LabelGroup{}, errors.New("record not found"))
+	lucm.On("FindGroupByID", uint(3)).Return(domain.LabelGroup{}, errors.New("test error"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  bool
+	}{
+		{"1", "\"name\":\"priority\"", false},
+		{"2", "{\"item\":null}", false},
+		{"3", "", true},
+	}
+
+	for _, ts := range tests {
+		c, rec := prepareHTTP(echo.GET, "/api/label-groups/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.FindLabelGroupByID(c)
+
+		if ts.err {
+			assert.NotNil(t, err)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Contains(t, rec.Body.String(), ts.body)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindAllLabelGroups(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindAllGroups").Return([]domain.LabelGroup{{ID: 1, Name: "priority"}}, nil).Once()
+	lucm.On("FindAllGroups").Return([]domain.LabelGroup{}, errors.New("test error")).Once()
+
+	c, rec := prepareHTTP(echo.GET, "/api/label-groups", nil)
+
+	err := m.FindAllLabelGroups(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"name\":\"priority\"")
+
+	c, _ = prepareHTTP(echo.GET, "/api/label-groups", nil)
+
+	err = m.FindAllLabelGroups(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestRemoveLabelGroup(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("RemoveGroup", uint(1)).Return(true, nil)
+	lucm.On("RemoveGroup", uint(2)).Return(false, errors.New("record not found"))
+	lucm.On("RemoveGroup", uint(3)).Return(false, errors.New("test error"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  bool
+	}{
+		{"1", "{\"status\":true}", false},
+		{"2", "{\"status\":false}", false},
+		{"3", "", true},
+	}
+
+	for _, ts := range tests {
+		c, rec := prepareHTTP(echo.DELETE, "/api/label-groups/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.RemoveLabelGroup(c)
+
+		if ts.err {
+			assert.NotNil(t, err)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Contains(t, rec.Body.String(), ts.body)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
@@ -3,14 +3,35 @@ package rest
 import (
 	"errors"
 	"github.com/labstack/echo/v4"
+	"strconv"
 )
 
-// AddLabel to add new label
+// getLabelProjectID to get/validate optional label project ID from echo.Context
+func (m *manager) getLabelProjectID(value string) (uint, error) {
+	if value == "" {
+		return 0, nil
+	}
+	projectID, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	project, err := m.puc.FindByID(uint(projectID))
+	if err != nil {
+		return 0, errors.New("project not found")
+	}
+	return project.ID, nil
+}
+
+// AddLabel to add new label, owned by project when project ID is provided
 func (m *manager) AddLabel(c echo.Context) error {
 	name := c.FormValue("name")
 	if name == "" {
 		return errors.New("name not provided")
 	}
+	projectID, err := m.getLabelProjectID(c.FormValue("projectId"))
+	if err != nil {
+		return err
+	}
 
 	colorHexCode := c.FormValue("color_hex_code")
 	if colorHexCode == "" {
@@ -21,7 +42,7 @@ func (m *manager) AddLabel(c echo.Context) error {
 		colorHexCode = cl.HexCode
 	}
 
-	item, err := m.luc.Add(name, colorHexCode)
+	item, err := m.luc.Add(name, colorHexCode, projectID)
 	if err != nil {
 		return err
 	}
@@ -83,10 +104,17 @@ func (m *manager) FindLabelByID(c echo.Context) error {
 	})
 }
 
-// FindLabels to find labels
+// FindLabels to find labels, limited to global and project labels when project ID is provided
 func (m *manager) FindLabels(c echo.Context) error {
 	name := c.QueryParam("name")
-	items, err := m.luc.Find(name)
+	projectID := 0
+	if c.QueryParam("projectId") != "" {
+		var err error
+		if projectID, err = strconv.Atoi(c.QueryParam("projectId")); err != nil {
+			return err
+		}
+	}
+	items, err := m.luc.Find(name, uint(projectID))
 	if err != nil {
 		return err
 	}
@@ -127,3 +155,72 @@ func (m *manager) RemoveLabel(c echo.Context) error {
 		"status": status,
 	})
 }
+
+// getMergeIDs to get source and target label IDs from echo.Context
+func getMergeIDs(c echo.Context, targetID string) (uint, uint, error) {
+	id, err := getID(c)
+	if err != nil {
+		return id, 0, err
+	}
+	target, err := strconv.Atoi(targetID)
+	if err != nil {
+		return id, 0, err
+	}
+	return id, uint(target), nil
+}
+
+// MergeLabelPreview to get number of issues affected by merging label into target label
+func (m *manager) MergeLabelPreview(c echo.Context) error {
+	id, targetID, err := getMergeIDs(c, c.QueryParam("targetId"))
+	if err != nil {
+		return err
+	}
+
+	item, err := m.luc.MergePreview(id, targetID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// MergeLabel to merge label into target label
+func (m *manager) MergeLabel(c echo.Context) error {
+	id, targetID, err := getMergeIDs(c, c.FormValue("targetId"))
+	if err != nil {
+		return err
+	}
+
+	item, err := m.luc.Merge(id, targetID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": true,
+		"item":   item,
+	})
+}
+
+// SetLabelGroup to assign label to group, groupId 0 removes label from its group
+func (m *manager) SetLabelGroup(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	groupID, err := strconv.Atoi(c.FormValue("groupId"))
+	if err != nil {
+		return err
+	}
+
+	item, err := m.luc.SetGroup(id, uint(groupID))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
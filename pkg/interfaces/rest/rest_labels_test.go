@@ -18,7 +18,7 @@ func TestAddLabel(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	lucm.On("Add", l.Name, l.ColorHexCode).Return(l, nil)
+	lucm.On("Add", l.Name, l.ColorHexCode, uint(0)).Return(l, nil)
 
 	body := strings.NewReader("name=test-name&color_hex_code=FFFFFF")
 	c, rec := prepareHTTP(echo.POST, "/api/labels/new", body)
@@ -65,7 +65,7 @@ func TestAddLabelColorFromExternalApi(t *testing.T) {
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
 	cucm.On("GetColor").Return(domain.Color{HexCode: "FFFFFF"}, nil)
-	lucm.On("Add", l.Name, l.ColorHexCode).Return(l, nil)
+	lucm.On("Add", l.Name, l.ColorHexCode, uint(0)).Return(l, nil)
 
 	body := strings.NewReader("name=test-name&color_hex_code=")
 	c, rec := prepareHTTP(echo.POST, "/api/labels/new", body)
@@ -102,7 +102,7 @@ func TestAddLabelErr(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	lucm.On("Add", p.Name, p.ColorHexCode).Return(p, errors.New("test error"))
+	lucm.On("Add", p.Name, p.ColorHexCode, uint(0)).Return(p, errors.New("test error"))
 
 	body := strings.NewReader("name=test-name&color_hex_code=FFFFFF")
 	c, _ := prepareHTTP(echo.POST, "/api/labels/new", body)
@@ -348,7 +348,7 @@ func TestFindLabels(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	lucm.On("Find", "test").Return(l, nil)
+	lucm.On("Find", "test", uint(0)).Return(l, nil)
 
 	c, rec := prepareHTTP(echo.GET, "/api/labels/find?name=test", nil)
 
@@ -365,7 +365,7 @@ func TestFindLabelsOtherErr(t *testing.T) {
 
 	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
 
-	lucm.On("Find", "test").Return(l, errors.New("test error"))
+	lucm.On("Find", "test", uint(0)).Return(l, errors.New("test error"))
 
 	c, _ := prepareHTTP(echo.GET, "/api/labels/find?name=test", nil)
 
@@ -475,3 +475,213 @@ func TestRemoveLabelIDErr(t *testing.T) {
 
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
+
+func TestMergeLabelPreview(t *testing.T) {
+	lm := domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3, Duplicates: 1}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("MergePreview", uint(1), uint(2)).Return(lm, nil)
+
+	c, rec := prepareHTTP(echo.GET, "/api/labels/:id/merge?targetId=2", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.MergeLabelPreview(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"issues\":3")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMergeLabelPreviewErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("MergePreview", uint(1), uint(1)).Return(domain.LabelMerge{}, errors.New("label cannot be merged into itself"))
+
+	tests := []struct {
+		id   string
+		path string
+	}{
+		{"test", "/api/labels/:id/merge?targetId=2"},
+		{"1", "/api/labels/:id/merge?targetId=test"},
+		{"1", "/api/labels/:id/merge?targetId=1"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.GET, ts.path, nil)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.MergeLabelPreview(c)
+
+		assert.NotNil(t, err)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMergeLabel(t *testing.T) {
+	lm := domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3, Duplicates: 1}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("Merge", uint(1), uint(2)).Return(lm, nil)
+
+	body := strings.NewReader("targetId=2")
+	c, rec := prepareHTTP(echo.POST, "/api/labels/:id/merge", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.MergeLabel(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"status\":true")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestMergeLabelErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("Merge", uint(1), uint(2)).Return(domain.LabelMerge{}, errors.New("test error"))
+
+	body := strings.NewReader("targetId=2")
+	c, _ := prepareHTTP(echo.POST, "/api/labels/:id/merge", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.MergeLabel(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "test error", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddProjectLabel(t *testing.T) {
+	l := &domain.Label{
+		Name:         "test-name",
+		ColorHexCode: "FFFFFF",
+		ProjectID:    2,
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", uint(2)).Return(domain.Project{ID: 2}, nil)
+	lucm.On("Add", l.Name, l.ColorHexCode, uint(2)).Return(l, nil)
+
+	body := strings.NewReader("name=test-name&color_hex_code=FFFFFF&projectId=2")
+	c, rec := prepareHTTP(echo.POST, "/api/labels/new", body)
+
+	err := m.AddLabel(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"projectId\":2")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddProjectLabelErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindByID", uint(3)).Return(domain.Project{}, errors.New("record not found"))
+
+	tests := []struct {
+		body string
+		err  string
+	}{
+		{"name=test-name&projectId=test", "strconv.Atoi: parsing \"test\": invalid syntax"},
+		{"name=test-name&projectId=3", "project not found"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/labels/new", strings.NewReader(ts.body))
+
+		err := m.AddLabel(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindProjectLabels(t *testing.T) {
+	l := []domain.Label{
+		domain.Label{ID: 1, Name: "test-name", ProjectID: 2},
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("Find", "test", uint(2)).Return(l, nil)
+
+	c, rec := prepareHTTP(echo.GET, "/api/labels/find?name=test&projectId=2", nil)
+
+	err := m.FindLabels(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+
+	c, _ = prepareHTTP(echo.GET, "/api/labels/find?name=test&projectId=test", nil)
+
+	err = m.FindLabels(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestSetLabelGroup(t *testing.T) {
+	l := domain.Label{ID: 1, Name: "high", GroupID: 2}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("SetGroup", uint(1), uint(2)).Return(l, nil)
+
+	body := strings.NewReader("groupId=2")
+	c, rec := prepareHTTP(echo.POST, "/api/labels/:id/group", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.SetLabelGroup(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"groupId\":2")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestSetLabelGroupErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("SetGroup", uint(1), uint(3)).Return(domain.Label{}, errors.New("record not found"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "groupId=2", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "groupId=", "strconv.Atoi: parsing \"\": invalid syntax"},
+		{"1", "groupId=3", "record not found"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/labels/:id/group", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.SetLabelGroup(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
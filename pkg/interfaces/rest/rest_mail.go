@@ -0,0 +1,34 @@
+package rest
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// SetMailPreference to set email and delivery of notifications of user
+func (m *manager) SetMailPreference(c echo.Context) error {
+	item, err := m.muc.SetPreference(c.FormValue("user"), c.FormValue("email"), c.FormValue("delivery"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindMailPreference to find email and delivery of notifications of user, item is nil when user has no preference
+func (m *manager) FindMailPreference(c echo.Context) error {
+	item, err := m.muc.FindPreference(c.QueryParam("user"))
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"item": nil,
+			})
+		}
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
@@ -0,0 +1,69 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+)
+
+func TestSetMailPreference(t *testing.T) {
+	p := &domain.MailPreference{ID: 1, User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryDigest}
+
+	mucm, m := prepareMailMocksAndRUC()
+
+	mucm.On("SetPreference", "alice", "alice@example.com", "digest").Return(p, nil)
+	mucm.On("SetPreference", "alice", "alice@example.com", "weekly").Return((*domain.MailPreference)(nil), errors.New("delivery weekly not valid"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/mail-preferences", strings.NewReader("user=alice&email=alice@example.com&delivery=digest"))
+
+	err := m.SetMailPreference(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"delivery\":\"digest\"")
+
+	c, _ = prepareHTTP(echo.POST, "/api/mail-preferences", strings.NewReader("user=alice&email=alice@example.com&delivery=weekly"))
+
+	err = m.SetMailPreference(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "delivery weekly not valid", err.Error())
+
+	mucm.AssertExpectations(t)
+}
+
+func TestFindMailPreference(t *testing.T) {
+	p := domain.MailPreference{ID: 1, User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryImmediate}
+
+	mucm, m := prepareMailMocksAndRUC()
+
+	mucm.On("FindPreference", "alice").Return(p, nil)
+	mucm.On("FindPreference", "bob").Return(domain.MailPreference{}, errors.New("record not found"))
+	mucm.On("FindPreference", "carol").Return(domain.MailPreference{}, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/mail-preferences?user=alice", nil)
+
+	err := m.FindMailPreference(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"email\":\"alice@example.com\"")
+
+	c, rec = prepareHTTP(echo.GET, "/api/mail-preferences?user=bob", nil)
+
+	err = m.FindMailPreference(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"item\":null}\n", rec.Body.String())
+
+	c, _ = prepareHTTP(echo.GET, "/api/mail-preferences?user=carol", nil)
+
+	err = m.FindMailPreference(c)
+
+	assert.Equal(t, "test error", err.Error())
+
+	mucm.AssertExpectations(t)
+}
@@ -9,21 +9,84 @@ import (
 type Manager interface {
 	AddIssue(c echo.Context) error
 	UpdateIssue(c echo.Context) error
+	BulkUpdateIssues(c echo.Context) error
+	MoveIssue(c echo.Context) error
 	FindIssueByID(c echo.Context) error
+	FindProjectIssueByID(c echo.Context) error
 	FindIssues(c echo.Context) error
 	FindAllIssues(c echo.Context) error
 	RemoveIssue(c echo.Context) error
+	SetIssueCustomFields(c echo.Context) error
+	SetIssueEstimates(c echo.Context) error
+	AddWorkLog(c echo.Context) error
+	FindIssueWorkLogs(c echo.Context) error
+	RemoveWorkLog(c echo.Context) error
+	FindProjectTimeSummary(c echo.Context) error
+	FindTimesheet(c echo.Context) error
+	AddAttachment(c echo.Context) error
+	FindIssueAttachments(c echo.Context) error
+	DownloadAttachment(c echo.Context) error
+	RemoveAttachment(c echo.Context) error
+	WatchIssue(c echo.Context) error
+	WatchProject(c echo.Context) error
+	FindWatchers(c echo.Context) error
+	Unwatch(c echo.Context) error
+	FindNotifications(c echo.Context) error
+	MarkNotificationRead(c echo.Context) error
+	MarkAllNotificationsRead(c echo.Context) error
+	SetMailPreference(c echo.Context) error
+	FindMailPreference(c echo.Context) error
+	AddWebhook(c echo.Context) error
+	UpdateWebhook(c echo.Context) error
+	FindWebhookByID(c echo.Context) error
+	FindProjectWebhooks(c echo.Context) error
+	RemoveWebhook(c echo.Context) error
+	FindWebhookDeliveries(c echo.Context) error
+	RedeliverWebhookDelivery(c echo.Context) error
+	AddAutomationRule(c echo.Context) error
+	UpdateAutomationRule(c echo.Context) error
+	FindAutomationRuleByID(c echo.Context) error
+	FindProjectAutomationRules(c echo.Context) error
+	RemoveAutomationRule(c echo.Context) error
+	FindAutomationExecutions(c echo.Context) error
+	AddJob(c echo.Context) error
+	UpdateJob(c echo.Context) error
+	FindJobByID(c echo.Context) error
+	FindAllJobs(c echo.Context) error
+	RemoveJob(c echo.Context) error
+	RunJob(c echo.Context) error
+	StreamEvents(c echo.Context) error
 	AddLabel(c echo.Context) error
 	UpdateLabel(c echo.Context) error
 	FindLabelByID(c echo.Context) error
 	FindLabels(c echo.Context) error
 	FindAllLabels(c echo.Context) error
 	RemoveLabel(c echo.Context) error
+	MergeLabelPreview(c echo.Context) error
+	MergeLabel(c echo.Context) error
+	SetLabelGroup(c echo.Context) error
+	AddLabelGroup(c echo.Context) error
+	UpdateLabelGroup(c echo.Context) error
+	FindLabelGroupByID(c echo.Context) error
+	FindAllLabelGroups(c echo.Context) error
+	RemoveLabelGroup(c echo.Context) error
 	AddProject(c echo.Context) error
 	UpdateProject(c echo.Context) error
+	UpdateProjectSettings(c echo.Context) error
+	AddIssueTemplate(c echo.Context) error
+	UpdateIssueTemplate(c echo.Context) error
+	FindIssueTemplateByID(c echo.Context) error
+	FindProjectIssueTemplates(c echo.Context) error
+	RemoveIssueTemplate(c echo.Context) error
+	AddCustomField(c echo.Context) error
+	UpdateCustomField(c echo.Context) error
+	FindCustomFieldByID(c echo.Context) error
+	FindProjectCustomFields(c echo.Context) error
+	RemoveCustomField(c echo.Context) error
 	FindProjectByID(c echo.Context) error
 	FindProjects(c echo.Context) error
 	FindAllProjects(c echo.Context) error
+	FindArchivedProjects(c echo.Context) error
 	RemoveProject(c echo.Context) error
 }
 
@@ -33,14 +96,26 @@ type manager struct {
 	luc usecases.LabelUseCase
 	puc usecases.ProjectUseCase
 	cuc usecases.ColorUseCase
+	nuc usecases.NotificationUseCase
+	muc usecases.MailUseCase
+	wuc usecases.WebhookUseCase
+	auc usecases.AutomationUseCase
+	juc usecases.JobUseCase
+	suc usecases.StreamUseCase
 }
 
 // NewManager to init Manager
-func NewManager(iuc usecases.IssueUseCase, luc usecases.LabelUseCase, puc usecases.ProjectUseCase, cuc usecases.ColorUseCase) Manager {
+func NewManager(iuc usecases.IssueUseCase, luc usecases.LabelUseCase, puc usecases.ProjectUseCase, cuc usecases.ColorUseCase, nuc usecases.NotificationUseCase, muc usecases.MailUseCase, wuc usecases.WebhookUseCase, auc usecases.AutomationUseCase, juc usecases.JobUseCase, suc usecases.StreamUseCase) Manager {
 	return &manager{
 		iuc: iuc,
 		luc: luc,
 		puc: puc,
 		cuc: cuc,
+		nuc: nuc,
+		muc: muc,
+		wuc: wuc,
+		auc: auc,
+		juc: juc,
+		suc: suc,
 	}
 }
@@ -12,8 +12,14 @@ func TestNewManager(t *testing.T) {
 	iucm := new(ucTesting.IssueUseCaseMock)
 	lucm := new(ucTesting.LabelUseCaseMock)
 	pucm := new(ucTesting.ProjectUseCaseMock)
+	nucm := new(ucTesting.NotificationUseCaseMock)
+	mucm := new(ucTesting.MailUseCaseMock)
+	wucm := new(ucTesting.WebhookUseCaseMock)
+	aucm := new(ucTesting.AutomationUseCaseMock)
+	jucm := new(ucTesting.JobUseCaseMock)
+	sucm := new(ucTesting.StreamUseCaseMock)
 
-	m := rest.NewManager(iucm, lucm, pucm, cucm)
+	m := rest.NewManager(iucm, lucm, pucm, cucm, nucm, mucm, wucm, aucm, jucm, sucm)
 
 	assert.NotNil(t, m)
 }
@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"github.com/labstack/echo/v4"
+	"strconv"
+)
+
+// getQueryID to get optional ID from query parameter, 0 is returned when it is not provided
+func getQueryID(c echo.Context, name string) (uint, error) {
+	if c.QueryParam(name) == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(c.QueryParam(name))
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// WatchIssue to subscribe user to notifications of issue
+func (m *manager) WatchIssue(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	issue, err := m.iuc.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.nuc.WatchIssue(c.FormValue("user"), issue)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// WatchProject to subscribe user to notifications of project and its issues
+func (m *manager) WatchProject(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	project, err := m.puc.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.nuc.WatchProject(c.FormValue("user"), project)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindWatchers to find watchers filtered by user, issue and project
+func (m *manager) FindWatchers(c echo.Context) error {
+	issueID, err := getQueryID(c, "issueId")
+	if err != nil {
+		return err
+	}
+	projectID, err := getQueryID(c, "projectId")
+	if err != nil {
+		return err
+	}
+
+	items, err := m.nuc.FindWatchers(c.QueryParam("user"), issueID, projectID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// Unwatch to unsubscribe user by removing watcher
+func (m *manager) Unwatch(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.nuc.Unwatch(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// FindNotifications to find notifications in inbox of user, only unread notifications are found when unread is true
+func (m *manager) FindNotifications(c echo.Context) error {
+	unread := false
+	if c.QueryParam("unread") != "" {
+		var err error
+		if unread, err = strconv.ParseBool(c.QueryParam("unread")); err != nil {
+			return err
+		}
+	}
+
+	items, err := m.nuc.FindNotifications(c.QueryParam("user"), unread)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// MarkNotificationRead to mark notification as read, or unread when read is false
+func (m *manager) MarkNotificationRead(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	read := true
+	if c.FormValue("read") != "" {
+		if read, err = strconv.ParseBool(c.FormValue("read")); err != nil {
+			return err
+		}
+	}
+
+	item, err := m.nuc.MarkNotificationRead(id, read)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// MarkAllNotificationsRead to mark all notifications in inbox of user as read
+func (m *manager) MarkAllNotificationsRead(c echo.Context) error {
+	count, err := m.nuc.MarkAllNotificationsRead(c.FormValue("user"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"count": count,
+	})
+}
@@ -0,0 +1,255 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+)
+
+func TestWatchIssue(t *testing.T) {
+	i := domain.Issue{ID: 1}
+	w := &domain.Watcher{ID: 1, User: "alice", IssueID: 1}
+
+	cucm, iucm, lucm, pucm, nucm, m := prepareNotificationMocksAndRUC()
+
+	iucm.On("FindByID", uint(1)).Return(i, nil)
+	iucm.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	nucm.On("WatchIssue", "alice", i).Return(w, nil)
+	nucm.On("WatchIssue", "", i).Return((*domain.Watcher)(nil), errors.New("user not provided"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/issues/:id/watchers/new", strings.NewReader("user=alice"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.WatchIssue(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"user\":\"alice\"")
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "user=alice", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"2", "user=alice", "record not found"},
+		{"1", "", "user not provided"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/issues/:id/watchers/new", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.WatchIssue(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestWatchProject(t *testing.T) {
+	p := domain.Project{ID: 1}
+	w := &domain.Watcher{ID: 1, User: "alice", ProjectID: 1}
+
+	cucm, iucm, lucm, pucm, nucm, m := prepareNotificationMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	pucm.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	nucm.On("WatchProject", "alice", p).Return(w, nil)
+
+	c, rec := prepareHTTP(echo.POST, "/api/projects/:id/watchers/new", strings.NewReader("user=alice"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.WatchProject(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"projectId\":1")
+
+	c, _ = prepareHTTP(echo.POST, "/api/projects/:id/watchers/new", strings.NewReader("user=alice"))
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.WatchProject(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestFindWatchers(t *testing.T) {
+	w := []domain.Watcher{{ID: 1, User: "alice", IssueID: 2}}
+
+	cucm, iucm, lucm, pucm, nucm, m := prepareNotificationMocksAndRUC()
+
+	nucm.On("FindWatchers", "alice", uint(2), uint(0)).Return(w, nil)
+	nucm.On("FindWatchers", "", uint(0), uint(3)).Return([]domain.Watcher{}, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/watchers?user=alice&issueId=2", nil)
+
+	err := m.FindWatchers(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"issueId\":2")
+
+	for _, path := range []string{"/api/watchers?issueId=a", "/api/watchers?projectId=b", "/api/watchers?projectId=3"} {
+		c, _ := prepareHTTP(echo.GET, path, nil)
+
+		err := m.FindWatchers(c)
+
+		assert.NotNil(t, err)
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestUnwatch(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, m := prepareNotificationMocksAndRUC()
+
+	nucm.On("Unwatch", uint(1)).Return(true, nil)
+	nucm.On("Unwatch", uint(2)).Return(false, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/watchers/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.Unwatch(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"status\":true")
+
+	c, _ = prepareHTTP(echo.DELETE, "/api/watchers/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.Unwatch(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestFindNotifications(t *testing.T) {
+	n := []domain.Notification{{ID: 1, User: "alice", IssueID: 2, Event: domain.NotificationEventStatusChanged}}
+
+	cucm, iucm, lucm, pucm, nucm, m := prepareNotificationMocksAndRUC()
+
+	nucm.On("FindNotifications", "alice", true).Return(n, nil)
+	nucm.On("FindNotifications", "alice", false).Return(n, nil)
+	nucm.On("FindNotifications", "", false).Return([]domain.Notification{}, errors.New("user not provided"))
+
+	for _, path := range []string{"/api/notifications?user=alice&unread=true", "/api/notifications?user=alice"} {
+		c, rec := prepareHTTP(echo.GET, path, nil)
+
+		err := m.FindNotifications(c)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), "\"event\":\"status_changed\"")
+	}
+
+	tests := []struct {
+		path string
+		err  string
+	}{
+		{"/api/notifications?user=alice&unread=maybe", "strconv.ParseBool: parsing \"maybe\": invalid syntax"},
+		{"/api/notifications", "user not provided"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.GET, ts.path, nil)
+
+		err := m.FindNotifications(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestMarkNotificationRead(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, m := prepareNotificationMocksAndRUC()
+
+	nucm.On("MarkNotificationRead", uint(1), true).Return(domain.Notification{ID: 1, Read: true}, nil)
+	nucm.On("MarkNotificationRead", uint(1), false).Return(domain.Notification{ID: 1}, nil)
+	nucm.On("MarkNotificationRead", uint(2), true).Return(domain.Notification{}, errors.New("record not found"))
+
+	for _, ts := range []struct {
+		body string
+		read string
+	}{{"", "true"}, {"read=false", "false"}} {
+		c, rec := prepareHTTP(echo.POST, "/api/notifications/:id/read", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := m.MarkNotificationRead(c)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), "\"read\":"+ts.read)
+	}
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "read=maybe", "strconv.ParseBool: parsing \"maybe\": invalid syntax"},
+		{"2", "", "record not found"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/notifications/:id/read", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.MarkNotificationRead(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
+
+func TestMarkAllNotificationsRead(t *testing.T) {
+	cucm, iucm, lucm, pucm, nucm, m := prepareNotificationMocksAndRUC()
+
+	nucm.On("MarkAllNotificationsRead", "alice").Return(3, nil)
+	nucm.On("MarkAllNotificationsRead", "").Return(0, errors.New("user not provided"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/notifications/read", strings.NewReader("user=alice"))
+
+	err := m.MarkAllNotificationsRead(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"count\":3")
+
+	c, _ = prepareHTTP(echo.POST, "/api/notifications/read", strings.NewReader(""))
+
+	err = m.MarkAllNotificationsRead(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+	nucm.AssertExpectations(t)
+}
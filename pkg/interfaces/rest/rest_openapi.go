@@ -0,0 +1,999 @@
+package rest
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	version "go-issue-tracker/pkg"
+	"go-issue-tracker/pkg/domain"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OpenAPIPath is path where OpenAPI document is served
+var OpenAPIPath = "/api/openapi.json"
+
+// openAPIParameter describes single request parameter
+type openAPIParameter struct {
+	Name     string
+	In       string
+	Type     string
+	Required bool
+}
+
+// openAPIOperation describes single REST operation
+type openAPIOperation struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Parameters  []openAPIParameter
+	Response    map[string]interface{}
+}
+
+// Parameter helpers
+func pathID() openAPIParameter {
+	return openAPIParameter{Name: "id", In: "path", Type: "integer", Required: true}
+}
+
+func formParam(name string, paramType string, required bool) openAPIParameter {
+	return openAPIParameter{Name: name, In: "formData", Type: paramType, Required: required}
+}
+
+func queryParam(name string, paramType string, required bool) openAPIParameter {
+	return openAPIParameter{Name: name, In: "query", Type: paramType, Required: required}
+}
+
+func fileParam(name string, required bool) openAPIParameter {
+	return openAPIParameter{Name: name, In: "formData", Type: "file", Required: required}
+}
+
+// Response schema helpers
+func itemResponse(schema string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"item": schemaRef(schema),
+		},
+	}
+}
+
+func itemsResponse(schema string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"items": map[string]interface{}{
+				"type":  "array",
+				"items": schemaRef(schema),
+			},
+		},
+	}
+}
+
+func statusResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"status": map[string]interface{}{"type": "boolean"},
+		},
+	}
+}
+
+func fileSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":   "string",
+		"format": "binary",
+	}
+}
+
+func eventStreamSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":   "string",
+		"format": "event-stream",
+	}
+}
+
+func schemaRef(schema string) map[string]interface{} {
+	return map[string]interface{}{
+		"$ref": "#/components/schemas/" + schema,
+	}
+}
+
+// openAPIOperations lists every operation registered in PrepareEndpoints
+var openAPIOperations = []openAPIOperation{
+	{
+		Method: http.MethodGet, Path: "/api", OperationID: "apiRoot", Summary: "API root message",
+		Response: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"message": map[string]interface{}{"type": "string"},
+			},
+		},
+	},
+	{
+		Method: http.MethodGet, Path: OpenAPIPath, OperationID: "openAPI", Summary: "OpenAPI document",
+		Response: map[string]interface{}{"type": "object"},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/new", OperationID: "addIssue", Summary: "Add issue, priority and severity are level names or numbers, reporter and assignee watch issue",
+		Parameters: []openAPIParameter{
+			formParam("title", "string", true),
+			formParam("description", "string", false),
+			formParam("status", "integer", false),
+			formParam("priority", "string", false),
+			formParam("severity", "string", false),
+			formParam("reporter", "string", false),
+			formParam("assignee", "string", false),
+			formParam("projectId", "integer", true),
+			formParam("labels", "string", false),
+			formParam("templateId", "integer", false),
+		},
+		Response: itemResponse("Issue"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/:id", OperationID: "updateIssue", Summary: "Update issue, priority, severity, reporter and assignee are kept when not provided",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("title", "string", true),
+			formParam("description", "string", false),
+			formParam("status", "integer", true),
+			formParam("priority", "string", false),
+			formParam("severity", "string", false),
+			formParam("reporter", "string", false),
+			formParam("assignee", "string", false),
+			formParam("labels", "string", false),
+		},
+		Response: itemResponse("Issue"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/bulk", OperationID: "bulkUpdateIssues", Summary: "Apply operation to multiple issues",
+		Parameters: []openAPIParameter{
+			formParam("ids", "string", false),
+			formParam("title", "string", false),
+			formParam("projectId", "integer", false),
+			formParam("labels", "string", false),
+			formParam("customField", "string", false),
+			formParam("minPriority", "string", false),
+			formParam("minSeverity", "string", false),
+			formParam("minSlaStatus", "string", false),
+			formParam("addLabels", "string", false),
+			formParam("removeLabels", "string", false),
+			formParam("setStatus", "integer", false),
+			formParam("setProjectId", "integer", false),
+		},
+		Response: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"status": map[string]interface{}{"type": "boolean"},
+				"items": map[string]interface{}{
+					"type":  "array",
+					"items": schemaRef("IssueBulkResult"),
+				},
+			},
+		},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/:id/move", OperationID: "moveIssue", Summary: "Move issue to another project",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("projectId", "integer", true),
+		},
+		Response: itemResponse("Issue"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/issues/:id", OperationID: "findIssueByID", Summary: "Find issue by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("Issue"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/issues/find", OperationID: "findIssues", Summary: "Find issues, customField is repeated name:value filter of project custom field, sort is priority, severity or due, minSlaStatus is at_risk or breached",
+		Parameters: []openAPIParameter{
+			queryParam("title", "string", false),
+			queryParam("projectId", "integer", true),
+			queryParam("labels", "string", false),
+			queryParam("customField", "string", false),
+			queryParam("minPriority", "string", false),
+			queryParam("minSeverity", "string", false),
+			queryParam("minSlaStatus", "string", false),
+			queryParam("sort", "string", false),
+		},
+		Response: itemsResponse("Issue"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/issues", OperationID: "findAllIssues", Summary: "Find all issues",
+		Response: itemsResponse("Issue"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/issues/:id", OperationID: "removeIssue", Summary: "Remove issue",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/:id/custom-fields", OperationID: "setIssueCustomFields", Summary: "Set custom field values of issue, customField is repeated name:value pair, empty value removes value",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("customField", "string", true),
+		},
+		Response: itemResponse("Issue"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/:id/estimates", OperationID: "setIssueEstimates", Summary: "Set estimates of issue as minutes or duration, remainingEstimate defaults to originalEstimate reduced by time spent",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("originalEstimate", "string", true),
+			formParam("remainingEstimate", "string", false),
+		},
+		Response: itemResponse("Issue"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/:id/work-logs/new", OperationID: "addWorkLog", Summary: "Log time spent on issue as minutes or duration, loggedAt is RFC 3339 time or date and defaults to now",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("author", "string", true),
+			formParam("timeSpent", "string", true),
+			formParam("loggedAt", "string", false),
+			formParam("comment", "string", false),
+		},
+		Response: itemResponse("WorkLog"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/issues/:id/work-logs", OperationID: "findIssueWorkLogs", Summary: "Find work logs of issue",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemsResponse("WorkLog"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/work-logs/:id", OperationID: "removeWorkLog", Summary: "Remove work log, time is subtracted from time spent of issue",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/timesheet", OperationID: "findTimesheet", Summary: "Sum time logged per author and ISO week, from and to are RFC 3339 times or dates, last 28 days when not provided",
+		Parameters: []openAPIParameter{
+			queryParam("projectId", "integer", false),
+			queryParam("from", "string", false),
+			queryParam("to", "string", false),
+		},
+		Response: itemsResponse("TimesheetEntry"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/:id/attachments/new", OperationID: "addAttachment", Summary: "Attach file to issue, content type is sniffed from content and size is limited",
+		Parameters: []openAPIParameter{
+			pathID(),
+			fileParam("file", true),
+		},
+		Response: itemResponse("Attachment"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/issues/:id/attachments", OperationID: "findIssueAttachments", Summary: "Find attachments of issue",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemsResponse("Attachment"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/attachments/:id/download", OperationID: "downloadAttachment", Summary: "Download attachment file",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   fileSchema(),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/attachments/:id", OperationID: "removeAttachment", Summary: "Remove attachment together with its file",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issues/:id/watchers/new", OperationID: "watchIssue", Summary: "Subscribe user to notifications of issue",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("user", "string", true),
+		},
+		Response: itemResponse("Watcher"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/:id/watchers/new", OperationID: "watchProject", Summary: "Subscribe user to notifications of project and all its issues",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("user", "string", true),
+		},
+		Response: itemResponse("Watcher"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/watchers", OperationID: "findWatchers", Summary: "Find watchers filtered by user, issue and project",
+		Parameters: []openAPIParameter{
+			queryParam("user", "string", false),
+			queryParam("issueId", "integer", false),
+			queryParam("projectId", "integer", false),
+		},
+		Response: itemsResponse("Watcher"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/watchers/:id", OperationID: "unwatch", Summary: "Unsubscribe user by removing watcher",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/notifications", OperationID: "findNotifications", Summary: "Find notifications in inbox of user newest first, only unread notifications when unread is true",
+		Parameters: []openAPIParameter{
+			queryParam("user", "string", true),
+			queryParam("unread", "boolean", false),
+		},
+		Response: itemsResponse("Notification"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/notifications/read", OperationID: "markAllNotificationsRead", Summary: "Mark all notifications in inbox of user as read",
+		Parameters: []openAPIParameter{
+			formParam("user", "string", true),
+		},
+		Response: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"count": map[string]interface{}{"type": "integer"},
+			},
+		},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/notifications/:id/read", OperationID: "markNotificationRead", Summary: "Mark notification as read, or unread when read is false",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("read", "boolean", false),
+		},
+		Response: itemResponse("Notification"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/mail-preferences", OperationID: "findMailPreference", Summary: "Find email and delivery of notifications of user, item is null when user has no preference",
+		Parameters: []openAPIParameter{
+			queryParam("user", "string", true),
+		},
+		Response: itemResponse("MailPreference"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/mail-preferences", OperationID: "setMailPreference", Summary: "Set email and delivery of notifications of user, delivery is immediate, digest (daily) or none",
+		Parameters: []openAPIParameter{
+			formParam("user", "string", true),
+			formParam("email", "string", false),
+			formParam("delivery", "string", false),
+		},
+		Response: itemResponse("MailPreference"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/webhooks/:id", OperationID: "updateWebhook", Summary: "Update webhook, events are comma separated (all events when empty), secret is kept when not provided",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("url", "string", true),
+			formParam("secret", "string", false),
+			formParam("events", "string", false),
+			formParam("active", "boolean", false),
+		},
+		Response: itemResponse("Webhook"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/webhooks/:id", OperationID: "findWebhookByID", Summary: "Find webhook by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("Webhook"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/webhooks/:id", OperationID: "removeWebhook", Summary: "Remove webhook and its delivery log",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/webhooks/:id/deliveries", OperationID: "findWebhookDeliveries", Summary: "Find latest deliveries of webhook, newest first",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemsResponse("WebhookDelivery"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/webhook-deliveries/:id/redeliver", OperationID: "redeliverWebhookDelivery", Summary: "Queue new delivery with payload of delivery",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("WebhookDelivery"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/automation-rules/:id", OperationID: "updateAutomationRule", Summary: "Update automation rule, conditions and actions are comma separated name:value pairs",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("trigger", "string", true),
+			formParam("conditions", "string", false),
+			formParam("actions", "string", true),
+			formParam("active", "boolean", false),
+		},
+		Response: itemResponse("AutomationRule"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/automation-rules/:id", OperationID: "findAutomationRuleByID", Summary: "Find automation rule by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("AutomationRule"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/automation-rules/:id", OperationID: "removeAutomationRule", Summary: "Remove automation rule and its execution log",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/automation-rules/:id/executions", OperationID: "findAutomationExecutions", Summary: "Find latest executions of automation rule, newest first",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemsResponse("AutomationExecution"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/jobs/:id", OperationID: "updateJob", Summary: "Update scheduled job, params are comma separated name:value pairs, next run is scheduled again",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("schedule", "string", true),
+			formParam("params", "string", false),
+			formParam("active", "boolean", false),
+		},
+		Response: itemResponse("Job"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/jobs/:id", OperationID: "findJobByID", Summary: "Find scheduled job by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("Job"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/jobs", OperationID: "findAllJobs", Summary: "Find all scheduled jobs with their last run",
+		Response: itemsResponse("Job"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/jobs/:id", OperationID: "removeJob", Summary: "Remove scheduled job",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/jobs/:id/run", OperationID: "runJob", Summary: "Run scheduled job now without changing its schedule",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("Job"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/events", OperationID: "streamEvents", Summary: "Stream issue, label and project events as Server-Sent Events, Last-Event-ID header or lastEventId resumes stream",
+		Parameters: []openAPIParameter{
+			queryParam("projectId", "integer", false),
+			queryParam("lastEventId", "integer", false),
+		},
+		Response: eventStreamSchema(),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/labels/new", OperationID: "addLabel", Summary: "Add label",
+		Parameters: []openAPIParameter{
+			formParam("name", "string", true),
+			formParam("color_hex_code", "string", false),
+			formParam("projectId", "integer", false),
+		},
+		Response: itemResponse("Label"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/labels/:id", OperationID: "updateLabel", Summary: "Update label",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("color_hex_code", "string", false),
+		},
+		Response: itemResponse("Label"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/labels/:id", OperationID: "findLabelByID", Summary: "Find label by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("Label"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/labels/find", OperationID: "findLabels", Summary: "Find labels, global and project labels when projectId is provided",
+		Parameters: []openAPIParameter{
+			queryParam("name", "string", false),
+			queryParam("projectId", "integer", false),
+		},
+		Response: itemsResponse("Label"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/labels", OperationID: "findAllLabels", Summary: "Find all labels",
+		Response: itemsResponse("Label"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/labels/:id", OperationID: "removeLabel", Summary: "Remove label",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/labels/:id/merge", OperationID: "mergeLabelPreview", Summary: "Preview issues affected by label merge",
+		Parameters: []openAPIParameter{
+			pathID(),
+			queryParam("targetId", "integer", true),
+		},
+		Response: itemResponse("LabelMerge"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/labels/:id/merge", OperationID: "mergeLabel", Summary: "Merge label into target label",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("targetId", "integer", true),
+		},
+		Response: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"status": map[string]interface{}{"type": "boolean"},
+				"item":   schemaRef("LabelMerge"),
+			},
+		},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/labels/:id/group", OperationID: "setLabelGroup", Summary: "Assign label to group, groupId 0 removes label from its group",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("groupId", "integer", true),
+		},
+		Response: itemResponse("Label"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/label-groups/new", OperationID: "addLabelGroup", Summary: "Add label group",
+		Parameters: []openAPIParameter{
+			formParam("name", "string", true),
+			formParam("exclusive", "boolean", false),
+		},
+		Response: itemResponse("LabelGroup"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/label-groups/:id", OperationID: "updateLabelGroup", Summary: "Update label group",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("exclusive", "boolean", false),
+		},
+		Response: itemResponse("LabelGroup"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/label-groups/:id", OperationID: "findLabelGroupByID", Summary: "Find label group by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("LabelGroup"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/label-groups", OperationID: "findAllLabelGroups", Summary: "Find all label groups",
+		Response: itemsResponse("LabelGroup"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/label-groups/:id", OperationID: "removeLabelGroup", Summary: "Remove label group, its labels are kept without group",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/issue-templates/:id", OperationID: "updateIssueTemplate", Summary: "Update issue template, labels are label names",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("titlePrefix", "string", false),
+			formParam("description", "string", false),
+			formParam("status", "integer", false),
+			formParam("labels", "string", false),
+		},
+		Response: itemResponse("IssueTemplate"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/issue-templates/:id", OperationID: "findIssueTemplateByID", Summary: "Find issue template by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("IssueTemplate"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/issue-templates/:id", OperationID: "removeIssueTemplate", Summary: "Remove issue template",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/custom-fields/:id", OperationID: "updateCustomField", Summary: "Update name and options of custom field, options are comma separated",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("options", "string", false),
+		},
+		Response: itemResponse("CustomField"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/custom-fields/:id", OperationID: "findCustomFieldByID", Summary: "Find custom field by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("CustomField"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/custom-fields/:id", OperationID: "removeCustomField", Summary: "Remove custom field together with its values",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   statusResponse(),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/new", OperationID: "addProject", Summary: "Add project",
+		Parameters: []openAPIParameter{
+			formParam("name", "string", true),
+			formParam("description", "string", false),
+		},
+		Response: itemResponse("Project"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/:id", OperationID: "updateProject", Summary: "Update project",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("description", "string", false),
+		},
+		Response: itemResponse("Project"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/:id/settings", OperationID: "updateProjectSettings", Summary: "Update project settings validating its issues, defaultLabels are label names",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("maxLabels", "integer", true),
+			formParam("requiredFields", "string", false),
+			formParam("defaultStatus", "integer", false),
+			formParam("allowedStatuses", "string", false),
+			formParam("allowedPriorities", "string", false),
+			formParam("allowedSeverities", "string", false),
+			formParam("slaPolicy", "string", false),
+			formParam("slaAtRisk", "string", false),
+			formParam("slaResolvedStatuses", "string", false),
+			formParam("defaultLabels", "string", false),
+		},
+		Response: itemResponse("Project"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/:id/templates/new", OperationID: "addIssueTemplate", Summary: "Add issue template to project, labels are label names",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("titlePrefix", "string", false),
+			formParam("description", "string", false),
+			formParam("status", "integer", false),
+			formParam("labels", "string", false),
+		},
+		Response: itemResponse("IssueTemplate"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/:id/templates", OperationID: "findProjectIssueTemplates", Summary: "Find issue templates of project",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemsResponse("IssueTemplate"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/:id/custom-fields/new", OperationID: "addCustomField", Summary: "Add custom field to project, type is text, number, date, select, multiselect or user, options are comma separated",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("type", "string", true),
+			formParam("options", "string", false),
+		},
+		Response: itemResponse("CustomField"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/:id/custom-fields", OperationID: "findProjectCustomFields", Summary: "Find custom fields of project",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemsResponse("CustomField"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/:id/webhooks/new", OperationID: "addWebhook", Summary: "Add webhook to project, events are comma separated (all events when empty), secret is write-only",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("url", "string", true),
+			formParam("secret", "string", true),
+			formParam("events", "string", false),
+		},
+		Response: itemResponse("Webhook"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/:id/webhooks", OperationID: "findProjectWebhooks", Summary: "Find webhooks of project",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemsResponse("Webhook"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/:id/automation-rules/new", OperationID: "addAutomationRule", Summary: "Add automation rule to project, trigger is issue event, conditions and actions are comma separated name:value pairs",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("trigger", "string", true),
+			formParam("conditions", "string", false),
+			formParam("actions", "string", true),
+		},
+		Response: itemResponse("AutomationRule"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/:id/automation-rules", OperationID: "findProjectAutomationRules", Summary: "Find automation rules of project",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemsResponse("AutomationRule"),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/projects/:id/jobs/new", OperationID: "addJob", Summary: "Add scheduled job to project, schedule is @hourly, @daily, @weekly, @monthly or @every duration, params are comma separated name:value pairs",
+		Parameters: []openAPIParameter{
+			pathID(),
+			formParam("name", "string", true),
+			formParam("kind", "string", true),
+			formParam("schedule", "string", true),
+			formParam("params", "string", true),
+		},
+		Response: itemResponse("Job"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/:id/time", OperationID: "findProjectTimeSummary", Summary: "Sum estimates and time spent of issues of project in minutes",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("TimeSummary"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/:id", OperationID: "findProjectByID", Summary: "Find project by ID",
+		Parameters: []openAPIParameter{pathID()},
+		Response:   itemResponse("Project"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/:id/issues/:issueId", OperationID: "findProjectIssueByID", Summary: "Find issue by project and ID, redirecting moved issues",
+		Parameters: []openAPIParameter{
+			pathID(),
+			{Name: "issueId", In: "path", Type: "integer", Required: true},
+		},
+		Response: itemResponse("Issue"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/find", OperationID: "findProjects", Summary: "Find projects",
+		Parameters: []openAPIParameter{queryParam("name", "string", false)},
+		Response:   itemsResponse("Project"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects/archived", OperationID: "findArchivedProjects", Summary: "Find archived projects",
+		Response: itemsResponse("Project"),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/projects", OperationID: "findAllProjects", Summary: "Find all projects which are not archived",
+		Response: itemsResponse("Project"),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/projects/:id", OperationID: "removeProject", Summary: "Remove project, mode cascade, move or archive applies to its issues",
+		Parameters: []openAPIParameter{
+			pathID(),
+			queryParam("mode", "string", false),
+			queryParam("targetProjectId", "integer", false),
+		},
+		Response: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"status": map[string]interface{}{"type": "boolean"},
+				"item":   schemaRef("ProjectRemoval"),
+			},
+		},
+	},
+}
+
+// openAPISchemas lists domain entities described in components section
+var openAPISchemas = map[string]interface{}{
+	"Attachment":          domain.Attachment{},
+	"AutomationExecution": domain.AutomationExecution{},
+	"AutomationRule":      domain.AutomationRule{},
+	"CustomField":         domain.CustomField{},
+	"CustomFieldValue":    domain.CustomFieldValue{},
+	"Issue":               domain.Issue{},
+	"IssueBulkResult":     domain.IssueBulkResult{},
+	"IssueTemplate":       domain.IssueTemplate{},
+	"Job":                 domain.Job{},
+	"Label":               domain.Label{},
+	"LabelGroup":          domain.LabelGroup{},
+	"LabelMerge":          domain.LabelMerge{},
+	"MailPreference":      domain.MailPreference{},
+	"Notification":        domain.Notification{},
+	"Project":             domain.Project{},
+	"ProjectRemoval":      domain.ProjectRemoval{},
+	"ProjectSettings":     domain.ProjectSettings{},
+	"TimeSummary":         domain.TimeSummary{},
+	"TimesheetEntry":      domain.TimesheetEntry{},
+	"Watcher":             domain.Watcher{},
+	"Webhook":             domain.Webhook{},
+	"WebhookDelivery":     domain.WebhookDelivery{},
+	"WorkLog":             domain.WorkLog{},
+}
+
+// findOpenAPIOperation to find operation by method and echo route path
+func findOpenAPIOperation(method string, path string) (openAPIOperation, bool) {
+	for _, op := range openAPIOperations {
+		if op.Method == method && op.Path == path {
+			return op, true
+		}
+	}
+	return openAPIOperation{}, false
+}
+
+// toOpenAPIPath converts echo route path (/api/issues/:id) to OpenAPI path (/api/issues/{id})
+func toOpenAPIPath(path string) string {
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		if strings.HasPrefix(part, ":") {
+			parts[i] = "{" + strings.TrimPrefix(part, ":") + "}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// getSchemaForType to get OpenAPI schema for Go type
+func getSchemaForType(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": getSchemaForType(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": getSchemaForType(t.Elem())}
+	case reflect.Struct:
+		if _, ok := openAPISchemas[t.Name()]; ok && t.PkgPath() == reflect.TypeOf(domain.Issue{}).PkgPath() {
+			return schemaRef(t.Name())
+		}
+		return getSchemaForStruct(t)
+	}
+	return map[string]interface{}{}
+}
+
+// getSchemaForStruct to get OpenAPI object schema based on json tags
+func getSchemaForStruct(t reflect.Type) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" || f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		properties[name] = getSchemaForType(f.Type)
+	}
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+}
+
+// GetOpenAPISpec to get OpenAPI 3 document describing REST API
+func GetOpenAPISpec() map[string]interface{} {
+	paths := map[string]interface{}{}
+	for _, op := range openAPIOperations {
+		path := toOpenAPIPath(op.Path)
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			item = map[string]interface{}{}
+			paths[path] = item
+		}
+
+		parameters := []interface{}{}
+		formProperties := map[string]interface{}{}
+		formRequired := []string{}
+		formMediaType := "application/x-www-form-urlencoded"
+		for _, p := range op.Parameters {
+			if p.In == "formData" {
+				formProperties[p.Name] = map[string]interface{}{"type": p.Type}
+				if p.Type == "file" {
+					formProperties[p.Name] = fileSchema()
+					formMediaType = "multipart/form-data"
+				}
+				if p.Required {
+					formRequired = append(formRequired, p.Name)
+				}
+				continue
+			}
+			parameters = append(parameters, map[string]interface{}{
+				"name":     p.Name,
+				"in":       p.In,
+				"required": p.Required,
+				"schema":   map[string]interface{}{"type": p.Type},
+			})
+		}
+
+		responseMediaType := "application/json"
+		switch op.Response["format"] {
+		case "binary":
+			responseMediaType = "application/octet-stream"
+		case "event-stream":
+			responseMediaType = "text/event-stream"
+		}
+		operation := map[string]interface{}{
+			"operationId": op.OperationID,
+			"summary":     op.Summary,
+			"parameters":  parameters,
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "OK",
+					"content": map[string]interface{}{
+						responseMediaType: map[string]interface{}{
+							"schema": op.Response,
+						},
+					},
+				},
+				"400": map[string]interface{}{
+					"description": "Request not valid",
+				},
+			},
+		}
+		if len(formProperties) > 0 {
+			operation["requestBody"] = map[string]interface{}{
+				"required": len(formRequired) > 0,
+				"content": map[string]interface{}{
+					formMediaType: map[string]interface{}{
+						"schema": map[string]interface{}{
+							"type":       "object",
+							"properties": formProperties,
+							"required":   formRequired,
+						},
+					},
+				},
+			}
+		}
+		item[strings.ToLower(op.Method)] = operation
+	}
+
+	schemas := map[string]interface{}{}
+	for name, entity := range openAPISchemas {
+		schemas[name] = getSchemaForStruct(reflect.TypeOf(entity))
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   APIRootMessage,
+			"version": version.Version,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}
+}
+
+// validateParameter to validate single parameter value
+func validateParameter(p openAPIParameter, value string) error {
+	if value == "" {
+		if p.Required {
+			return fmt.Errorf("%s not provided", p.Name)
+		}
+		return nil
+	}
+	switch p.Type {
+	case "integer":
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%s must be integer", p.Name)
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%s must be number", p.Name)
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%s must be boolean", p.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateRequest middleware to validate incoming requests against OpenAPI document
+func ValidateRequest(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		op, ok := findOpenAPIOperation(c.Request().Method, c.Path())
+		if !ok {
+			return next(c)
+		}
+		for _, p := range op.Parameters {
+			if p.Type == "file" {
+				// files are validated by handler which limits size of request body before reading it
+				continue
+			}
+			var value string
+			switch p.In {
+			case "path":
+				value = c.Param(p.Name)
+			case "query":
+				value = c.QueryParam(p.Name)
+			case "formData":
+				value = c.FormValue(p.Name)
+			}
+			if err := validateParameter(p, value); err != nil {
+				return c.JSON(400, map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+		}
+		return next(c)
+	}
+}
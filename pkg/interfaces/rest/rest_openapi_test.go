@@ -0,0 +1,124 @@
+package rest_test
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/infrastructure/helpers"
+	"go-issue-tracker/pkg/interfaces/rest"
+	restTesting "go-issue-tracker/pkg/interfaces/rest/testing"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGetOpenAPISpec(t *testing.T) {
+	spec := rest.GetOpenAPISpec()
+
+	assert.Equal(t, "3.0.3", spec["openapi"])
+
+	schemas := spec["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	for _, name := range []string{"Issue", "Label", "Project"} {
+		assert.Contains(t, schemas, name)
+	}
+
+	issue := schemas["Issue"].(map[string]interface{})["properties"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "string"}, issue["title"])
+	assert.Equal(t, map[string]interface{}{"type": "integer"}, issue["status"])
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "date-time"}, issue["createdAt"])
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/schemas/Project"}, issue["project"])
+	assert.Equal(t, map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"$ref": "#/components/schemas/Label"},
+	}, issue["labels"])
+
+	paths := spec["paths"].(map[string]interface{})
+	assert.Contains(t, paths, "/api/issues/{id}")
+	assert.Contains(t, paths["/api/issues/{id}"], "get")
+	assert.Contains(t, paths["/api/issues/{id}"], "post")
+	assert.Contains(t, paths["/api/issues/{id}"], "delete")
+}
+
+func TestOpenAPISpecCoversAllRoutes(t *testing.T) {
+	e := echo.New()
+
+	rm := new(restTesting.ManagerMock)
+
+	rootDirPath, _ := helpers.GetProjectDirPath()
+	uiDirPath := filepath.Join(rootDirPath, "ui")
+	rest.PrepareEndpoints(e, rm, uiDirPath)
+
+	paths := rest.GetOpenAPISpec()["paths"].(map[string]interface{})
+	paramRe := regexp.MustCompile(`:([^/]+)`)
+
+	for _, route := range e.Routes() {
+		if !strings.HasPrefix(route.Path, "/api") {
+			continue
+		}
+		path := paramRe.ReplaceAllString(route.Path, "{$1}")
+		item, ok := paths[path].(map[string]interface{})
+		if !assert.True(t, ok, "route %s %s has no OpenAPI path entry", route.Method, route.Path) {
+			continue
+		}
+		assert.Contains(t, item, strings.ToLower(route.Method), "route %s %s has no OpenAPI operation", route.Method, route.Path)
+	}
+}
+
+func TestOpenAPIEndpoint(t *testing.T) {
+	e := echo.New()
+
+	rm := new(restTesting.ManagerMock)
+
+	rootDirPath, _ := helpers.GetProjectDirPath()
+	uiDirPath := filepath.Join(rootDirPath, "ui")
+	rest.PrepareEndpoints(e, rm, uiDirPath)
+
+	request := httptest.NewRequest(echo.GET, rest.OpenAPIPath, nil)
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, request)
+
+	assert.Equal(t, 200, recorder.Code)
+	var actual map[string]interface{}
+	json.NewDecoder(recorder.Body).Decode(&actual)
+	assert.Equal(t, "3.0.3", actual["openapi"])
+}
+
+func TestValidateRequest(t *testing.T) {
+	e := echo.New()
+	e.Use(rest.ValidateRequest)
+
+	rm := new(restTesting.ManagerMock)
+	rm.On("AddProject", mock.AnythingOfType("*echo.context")).Return(nil)
+	rm.On("FindIssueByID", mock.AnythingOfType("*echo.context")).Return(nil)
+
+	rootDirPath, _ := helpers.GetProjectDirPath()
+	uiDirPath := filepath.Join(rootDirPath, "ui")
+	rest.PrepareEndpoints(e, rm, uiDirPath)
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		code   int
+	}{
+		{echo.POST, "/api/projects/new", "name=test-name", 200},
+		{echo.POST, "/api/projects/new", "description=test-description", 400},
+		{echo.GET, "/api/issues/1", "", 200},
+		{echo.GET, "/api/issues/test", "", 400},
+		{echo.GET, "/api/issues/find?projectId=test", "", 400},
+	}
+
+	for _, ts := range tests {
+		request := httptest.NewRequest(ts.method, ts.path, strings.NewReader(ts.body))
+		request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+
+		assert.Equal(t, ts.code, recorder.Code, "%s %s", ts.method, ts.path)
+	}
+
+	rm.AssertExpectations(t)
+}
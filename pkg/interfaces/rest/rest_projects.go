@@ -3,6 +3,8 @@ package rest
 import (
 	"errors"
 	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/domain"
+	"strconv"
 )
 
 // RemoveLabel to add new project
@@ -46,6 +48,45 @@ func (m *manager) UpdateProject(c echo.Context) error {
 	})
 }
 
+// UpdateProjectSettings to update project settings, default labels are resolved by name in project
+func (m *manager) UpdateProjectSettings(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	settings := domain.ProjectSettings{
+		RequiredFields:      c.FormValue("requiredFields"),
+		AllowedStatuses:     c.FormValue("allowedStatuses"),
+		AllowedPriorities:   c.FormValue("allowedPriorities"),
+		AllowedSeverities:   c.FormValue("allowedSeverities"),
+		SLAPolicy:           c.FormValue("slaPolicy"),
+		SLAAtRisk:           c.FormValue("slaAtRisk"),
+		SLAResolvedStatuses: c.FormValue("slaResolvedStatuses"),
+	}
+	if settings.MaxLabels, err = strconv.Atoi(c.FormValue("maxLabels")); err != nil {
+		return err
+	}
+	if c.FormValue("defaultStatus") != "" {
+		if settings.DefaultStatus, err = strconv.Atoi(c.FormValue("defaultStatus")); err != nil {
+			return err
+		}
+	}
+	defaultLabels, err := m.findLabelsByNames(c.FormValue("defaultLabels"), id)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.puc.UpdateSettings(id, settings, defaultLabels)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
 // FindProjectByID to find project by ID
 func (m *manager) FindProjectByID(c echo.Context) error {
 	id, err := getID(c)
@@ -92,13 +133,44 @@ func (m *manager) FindAllProjects(c echo.Context) error {
 	})
 }
 
-// RemoveProject to remove project
+// FindArchivedProjects to find archived projects
+func (m *manager) FindArchivedProjects(c echo.Context) error {
+	items, err := m.puc.FindArchived()
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RemoveProject to remove project, cascade, move or archive mode applies to its issues
 func (m *manager) RemoveProject(c echo.Context) error {
 	id, err := getID(c)
 	if err != nil {
 		return err
 	}
 
+	if mode := c.QueryParam("mode"); mode != "" {
+		targetID := 0
+		if targetIDValue := c.QueryParam("targetProjectId"); targetIDValue != "" {
+			if targetID, err = strconv.Atoi(targetIDValue); err != nil {
+				return err
+			}
+		}
+
+		item, err := m.puc.RemoveWithMode(id, mode, uint(targetID))
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(200, map[string]interface{}{
+			"status": true,
+			"item":   item,
+		})
+	}
+
 	status, err := m.puc.Remove(id)
 	if err != nil {
 		if err.Error() == "record not found" {
@@ -383,3 +383,151 @@ func TestRemoveProjectIDErr(t *testing.T) {
 
 	checkAssertions(t, cucm, iucm, lucm, pucm)
 }
+
+func TestFindArchivedProjects(t *testing.T) {
+	p := []domain.Project{
+		domain.Project{ID: 1, Name: "test-name", Archived: true},
+	}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindArchived").Return(p, nil)
+
+	c, rec := prepareHTTP(echo.GET, "/api/projects/archived", nil)
+
+	err := m.FindArchivedProjects(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"archived\":true")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindArchivedProjectsErr(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("FindArchived").Return([]domain.Project{}, errors.New("test error"))
+
+	c, _ := prepareHTTP(echo.GET, "/api/projects/archived", nil)
+
+	err := m.FindArchivedProjects(c)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "test error", err.Error())
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestRemoveProjectWithMode(t *testing.T) {
+	removal := domain.ProjectRemoval{ID: 1, Mode: domain.ProjectRemovalMove, Issues: 2}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("RemoveWithMode", uint(1), domain.ProjectRemovalMove, uint(2)).Return(removal, nil)
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/projects/:id?mode=move&targetProjectId=2", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveProject(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"issues\":2")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestRemoveProjectWithModeErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	pucm.On("RemoveWithMode", uint(1), "test", uint(0)).Return(domain.ProjectRemoval{}, errors.New("removal mode not valid"))
+
+	tests := []struct {
+		path string
+		err  string
+	}{
+		{"/api/projects/:id?mode=move&targetProjectId=test", "strconv.Atoi: parsing \"test\": invalid syntax"},
+		{"/api/projects/:id?mode=test", "removal mode not valid"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.DELETE, ts.path, nil)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := m.RemoveProject(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateProjectSettings(t *testing.T) {
+	settings := domain.ProjectSettings{
+		MaxLabels:           2,
+		RequiredFields:      "description",
+		DefaultStatus:       1,
+		AllowedStatuses:     "1,2",
+		AllowedPriorities:   "low,high",
+		AllowedSeverities:   "major",
+		SLAPolicy:           "high:24h",
+		SLAAtRisk:           "2h",
+		SLAResolvedStatuses: "2",
+	}
+	labels := []domain.Label{{ID: 2, Name: "bug"}}
+	p := domain.Project{ID: 1, Settings: settings, DefaultLabels: labels}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindByName", "bug", uint(1)).Return(labels[0], nil)
+	pucm.On("UpdateSettings", uint(1), settings, labels).Return(p, nil)
+
+	body := strings.NewReader("maxLabels=2&requiredFields=description&defaultStatus=1&allowedStatuses=1,2&allowedPriorities=low,high&allowedSeverities=major&slaPolicy=high:24h&slaAtRisk=2h&slaResolvedStatuses=2&defaultLabels=bug")
+	c, rec := prepareHTTP(echo.POST, "/api/projects/:id/settings", body)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateProjectSettings(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"maxLabels\":2")
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestUpdateProjectSettingsErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	lucm.On("FindByName", "test", uint(1)).Return(domain.Label{}, errors.New("record not found"))
+	pucm.On("UpdateSettings", uint(1), domain.ProjectSettings{MaxLabels: 0}, []domain.Label{}).Return(domain.Project{}, errors.New("max labels not valid"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "maxLabels=1", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "maxLabels=", "strconv.Atoi: parsing \"\": invalid syntax"},
+		{"1", "maxLabels=1&defaultStatus=a", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "maxLabels=1&defaultLabels=test", "label test is not valid"},
+		{"1", "maxLabels=0", "max labels not valid"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/projects/:id/settings", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.UpdateProjectSettings(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
@@ -24,6 +24,31 @@ func TestPrepareServer(t *testing.T) {
 	assert.True(t, httpServer.HideBanner)
 }
 
+func TestGetIPExtractor(t *testing.T) {
+	req := httptest.NewRequest(echo.GET, "/", nil)
+	req.RemoteAddr = "10.0.0.2:1234"
+	req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.9, 198.51.100.7")
+
+	extractor, err := rest.GetIPExtractor("")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.0.2", extractor(req))
+
+	extractor, err = rest.GetIPExtractor("10.0.0.0/8")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "198.51.100.7", extractor(req))
+
+	extractor, err = rest.GetIPExtractor("10.0.0.0/8, 198.51.100.0/24")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "203.0.113.9", extractor(req))
+
+	_, err = rest.GetIPExtractor("10.0.0.1")
+
+	assert.EqualError(t, err, "trusted proxy 10.0.0.1 not valid")
+}
+
 func TestPrepareEndpoints(t *testing.T) {
 	e := echo.New()
 
@@ -47,9 +72,15 @@ func TestPrepareEndpoints(t *testing.T) {
 	// /api/issues/new POST
 	checkPath(t, rm, e, echo.POST, "/api/issues/new", "AddIssue")
 
+	// /api/issues/bulk POST
+	checkPath(t, rm, e, echo.POST, "/api/issues/bulk", "BulkUpdateIssues")
+
 	// /api/issues/:id POST
 	checkPath(t, rm, e, echo.POST, "/api/issues/:id", "UpdateIssue")
 
+	// /api/issues/:id/move POST
+	checkPath(t, rm, e, echo.POST, "/api/issues/:id/move", "MoveIssue")
+
 	// /api/issues/:id GET
 	checkPath(t, rm, e, echo.GET, "/api/issues/:id", "FindIssueByID")
 
@@ -62,6 +93,123 @@ func TestPrepareEndpoints(t *testing.T) {
 	// /api/issues/:id DELETE
 	checkPath(t, rm, e, echo.DELETE, "/api/issues/:id", "RemoveIssue")
 
+	// /api/issues/:id/custom-fields POST
+	checkPath(t, rm, e, echo.POST, "/api/issues/:id/custom-fields", "SetIssueCustomFields")
+
+	// /api/issues/:id/estimates POST
+	checkPath(t, rm, e, echo.POST, "/api/issues/:id/estimates", "SetIssueEstimates")
+
+	// /api/issues/:id/work-logs/new POST
+	checkPath(t, rm, e, echo.POST, "/api/issues/:id/work-logs/new", "AddWorkLog")
+
+	// /api/issues/:id/work-logs GET
+	checkPath(t, rm, e, echo.GET, "/api/issues/:id/work-logs", "FindIssueWorkLogs")
+
+	// /api/work-logs/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/work-logs/:id", "RemoveWorkLog")
+
+	// /api/timesheet GET
+	checkPath(t, rm, e, echo.GET, "/api/timesheet", "FindTimesheet")
+
+	// /api/issues/:id/attachments/new POST
+	checkPath(t, rm, e, echo.POST, "/api/issues/:id/attachments/new", "AddAttachment")
+
+	// /api/issues/:id/attachments GET
+	checkPath(t, rm, e, echo.GET, "/api/issues/:id/attachments", "FindIssueAttachments")
+
+	// /api/attachments/:id/download GET
+	checkPath(t, rm, e, echo.GET, "/api/attachments/:id/download", "DownloadAttachment")
+
+	// /api/attachments/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/attachments/:id", "RemoveAttachment")
+
+	// /api/issues/:id/watchers/new POST
+	checkPath(t, rm, e, echo.POST, "/api/issues/:id/watchers/new", "WatchIssue")
+
+	// /api/projects/:id/watchers/new POST
+	checkPath(t, rm, e, echo.POST, "/api/projects/:id/watchers/new", "WatchProject")
+
+	// /api/projects/:id/webhooks/new POST
+	checkPath(t, rm, e, echo.POST, "/api/projects/:id/webhooks/new", "AddWebhook")
+
+	// /api/projects/:id/webhooks GET
+	checkPath(t, rm, e, echo.GET, "/api/projects/:id/webhooks", "FindProjectWebhooks")
+
+	// /api/projects/:id/automation-rules/new POST
+	checkPath(t, rm, e, echo.POST, "/api/projects/:id/automation-rules/new", "AddAutomationRule")
+
+	// /api/projects/:id/automation-rules GET
+	checkPath(t, rm, e, echo.GET, "/api/projects/:id/automation-rules", "FindProjectAutomationRules")
+
+	// /api/projects/:id/jobs/new POST
+	checkPath(t, rm, e, echo.POST, "/api/projects/:id/jobs/new", "AddJob")
+
+	// /api/watchers GET
+	checkPath(t, rm, e, echo.GET, "/api/watchers", "FindWatchers")
+
+	// /api/watchers/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/watchers/:id", "Unwatch")
+
+	// /api/notifications GET
+	checkPath(t, rm, e, echo.GET, "/api/notifications", "FindNotifications")
+
+	// /api/notifications/read POST
+	checkPath(t, rm, e, echo.POST, "/api/notifications/read", "MarkAllNotificationsRead")
+
+	// /api/notifications/:id/read POST
+	checkPath(t, rm, e, echo.POST, "/api/notifications/:id/read", "MarkNotificationRead")
+
+	// /api/mail-preferences GET
+	checkPath(t, rm, e, echo.GET, "/api/mail-preferences", "FindMailPreference")
+
+	// /api/mail-preferences POST
+	checkPath(t, rm, e, echo.POST, "/api/mail-preferences", "SetMailPreference")
+
+	// /api/webhooks/:id POST
+	checkPath(t, rm, e, echo.POST, "/api/webhooks/:id", "UpdateWebhook")
+
+	// /api/webhooks/:id GET
+	checkPath(t, rm, e, echo.GET, "/api/webhooks/:id", "FindWebhookByID")
+
+	// /api/webhooks/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/webhooks/:id", "RemoveWebhook")
+
+	// /api/webhooks/:id/deliveries GET
+	checkPath(t, rm, e, echo.GET, "/api/webhooks/:id/deliveries", "FindWebhookDeliveries")
+
+	// /api/webhook-deliveries/:id/redeliver POST
+	checkPath(t, rm, e, echo.POST, "/api/webhook-deliveries/:id/redeliver", "RedeliverWebhookDelivery")
+
+	// /api/automation-rules/:id POST
+	checkPath(t, rm, e, echo.POST, "/api/automation-rules/:id", "UpdateAutomationRule")
+
+	// /api/automation-rules/:id GET
+	checkPath(t, rm, e, echo.GET, "/api/automation-rules/:id", "FindAutomationRuleByID")
+
+	// /api/automation-rules/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/automation-rules/:id", "RemoveAutomationRule")
+
+	// /api/automation-rules/:id/executions GET
+	checkPath(t, rm, e, echo.GET, "/api/automation-rules/:id/executions", "FindAutomationExecutions")
+
+	// /api/jobs/:id POST
+	checkPath(t, rm, e, echo.POST, "/api/jobs/:id", "UpdateJob")
+
+	// /api/jobs/:id GET
+	checkPath(t, rm, e, echo.GET, "/api/jobs/:id", "FindJobByID")
+
+	// /api/jobs GET
+	checkPath(t, rm, e, echo.GET, "/api/jobs", "FindAllJobs")
+
+	// /api/jobs/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/jobs/:id", "RemoveJob")
+
+	// /api/jobs/:id/run POST
+	checkPath(t, rm, e, echo.POST, "/api/jobs/:id/run", "RunJob")
+
+	// /api/events GET
+	checkPath(t, rm, e, echo.GET, "/api/events", "StreamEvents")
+
 	// /api/labels/new POST
 	checkPath(t, rm, e, echo.POST, "/api/labels/new", "AddLabel")
 
@@ -80,15 +228,81 @@ func TestPrepareEndpoints(t *testing.T) {
 	// /api/labels/:id DELETE
 	checkPath(t, rm, e, echo.DELETE, "/api/labels/:id", "RemoveLabel")
 
+	// /api/labels/:id/merge GET
+	checkPath(t, rm, e, echo.GET, "/api/labels/:id/merge", "MergeLabelPreview")
+
+	// /api/labels/:id/merge POST
+	checkPath(t, rm, e, echo.POST, "/api/labels/:id/merge", "MergeLabel")
+
+	// /api/labels/:id/group POST
+	checkPath(t, rm, e, echo.POST, "/api/labels/:id/group", "SetLabelGroup")
+
+	// /api/label-groups/new POST
+	checkPath(t, rm, e, echo.POST, "/api/label-groups/new", "AddLabelGroup")
+
+	// /api/label-groups/:id POST
+	checkPath(t, rm, e, echo.POST, "/api/label-groups/:id", "UpdateLabelGroup")
+
+	// /api/label-groups/:id GET
+	checkPath(t, rm, e, echo.GET, "/api/label-groups/:id", "FindLabelGroupByID")
+
+	// /api/label-groups GET
+	checkPath(t, rm, e, echo.GET, "/api/label-groups", "FindAllLabelGroups")
+
+	// /api/label-groups/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/label-groups/:id", "RemoveLabelGroup")
+
+	// /api/projects/archived GET
+	checkPath(t, rm, e, echo.GET, "/api/projects/archived", "FindArchivedProjects")
+
 	// /api/projects/new POST
 	checkPath(t, rm, e, echo.POST, "/api/projects/new", "AddProject")
 
 	// /api/projects/:id POST
 	checkPath(t, rm, e, echo.POST, "/api/projects/:id", "UpdateProject")
 
+	// /api/projects/:id/settings POST
+	checkPath(t, rm, e, echo.POST, "/api/projects/:id/settings", "UpdateProjectSettings")
+
+	// /api/projects/:id/templates/new POST
+	checkPath(t, rm, e, echo.POST, "/api/projects/:id/templates/new", "AddIssueTemplate")
+
+	// /api/projects/:id/templates GET
+	checkPath(t, rm, e, echo.GET, "/api/projects/:id/templates", "FindProjectIssueTemplates")
+
+	// /api/issue-templates/:id POST
+	checkPath(t, rm, e, echo.POST, "/api/issue-templates/:id", "UpdateIssueTemplate")
+
+	// /api/issue-templates/:id GET
+	checkPath(t, rm, e, echo.GET, "/api/issue-templates/:id", "FindIssueTemplateByID")
+
+	// /api/issue-templates/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/issue-templates/:id", "RemoveIssueTemplate")
+
+	// /api/projects/:id/custom-fields/new POST
+	checkPath(t, rm, e, echo.POST, "/api/projects/:id/custom-fields/new", "AddCustomField")
+
+	// /api/projects/:id/custom-fields GET
+	checkPath(t, rm, e, echo.GET, "/api/projects/:id/custom-fields", "FindProjectCustomFields")
+
+	// /api/projects/:id/time GET
+	checkPath(t, rm, e, echo.GET, "/api/projects/:id/time", "FindProjectTimeSummary")
+
+	// /api/custom-fields/:id POST
+	checkPath(t, rm, e, echo.POST, "/api/custom-fields/:id", "UpdateCustomField")
+
+	// /api/custom-fields/:id GET
+	checkPath(t, rm, e, echo.GET, "/api/custom-fields/:id", "FindCustomFieldByID")
+
+	// /api/custom-fields/:id DELETE
+	checkPath(t, rm, e, echo.DELETE, "/api/custom-fields/:id", "RemoveCustomField")
+
 	// /api/projects/:id GET
 	checkPath(t, rm, e, echo.GET, "/api/projects/:id", "FindProjectByID")
 
+	// /api/projects/:id/issues/:issueId GET
+	checkPath(t, rm, e, echo.GET, "/api/projects/:id/issues/:issueId", "FindProjectIssueByID")
+
 	// /api/projects/find GET
 	checkPath(t, rm, e, echo.GET, "/api/projects/find", "FindProjects")
 
@@ -169,11 +383,55 @@ func prepareHTTP(method string, path string, body *strings.Reader) (echo.Context
 }
 
 func prepareMocksAndRUC() (*ucTesting.ColorUseCaseMock, *ucTesting.IssueUseCaseMock, *ucTesting.LabelUseCaseMock, *ucTesting.ProjectUseCaseMock, rest.Manager) {
+	cucm, iucm, lucm, pucm, _, m := prepareNotificationMocksAndRUC()
+	return cucm, iucm, lucm, pucm, m
+}
+
+func prepareNotificationMocksAndRUC() (*ucTesting.ColorUseCaseMock, *ucTesting.IssueUseCaseMock, *ucTesting.LabelUseCaseMock, *ucTesting.ProjectUseCaseMock, *ucTesting.NotificationUseCaseMock, rest.Manager) {
 	cucm := new(ucTesting.ColorUseCaseMock)
 	iucm := new(ucTesting.IssueUseCaseMock)
 	lucm := new(ucTesting.LabelUseCaseMock)
 	pucm := new(ucTesting.ProjectUseCaseMock)
-	return cucm, iucm, lucm, pucm, rest.NewManager(iucm, lucm, pucm, cucm)
+	nucm := new(ucTesting.NotificationUseCaseMock)
+	mucm := new(ucTesting.MailUseCaseMock)
+	wucm := new(ucTesting.WebhookUseCaseMock)
+	aucm := new(ucTesting.AutomationUseCaseMock)
+	jucm := new(ucTesting.JobUseCaseMock)
+	sucm := new(ucTesting.StreamUseCaseMock)
+	return cucm, iucm, lucm, pucm, nucm, rest.NewManager(iucm, lucm, pucm, cucm, nucm, mucm, wucm, aucm, jucm, sucm)
+}
+
+func prepareMailMocksAndRUC() (*ucTesting.MailUseCaseMock, rest.Manager) {
+	mucm := new(ucTesting.MailUseCaseMock)
+	m := rest.NewManager(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), mucm, new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock), new(ucTesting.JobUseCaseMock), new(ucTesting.StreamUseCaseMock))
+	return mucm, m
+}
+
+func prepareWebhookMocksAndRUC() (*ucTesting.ProjectUseCaseMock, *ucTesting.WebhookUseCaseMock, rest.Manager) {
+	pucm := new(ucTesting.ProjectUseCaseMock)
+	wucm := new(ucTesting.WebhookUseCaseMock)
+	m := rest.NewManager(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), pucm, new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), wucm, new(ucTesting.AutomationUseCaseMock), new(ucTesting.JobUseCaseMock), new(ucTesting.StreamUseCaseMock))
+	return pucm, wucm, m
+}
+
+func prepareAutomationMocksAndRUC() (*ucTesting.ProjectUseCaseMock, *ucTesting.AutomationUseCaseMock, rest.Manager) {
+	pucm := new(ucTesting.ProjectUseCaseMock)
+	aucm := new(ucTesting.AutomationUseCaseMock)
+	m := rest.NewManager(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), pucm, new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), aucm, new(ucTesting.JobUseCaseMock), new(ucTesting.StreamUseCaseMock))
+	return pucm, aucm, m
+}
+
+func prepareJobMocksAndRUC() (*ucTesting.ProjectUseCaseMock, *ucTesting.JobUseCaseMock, rest.Manager) {
+	pucm := new(ucTesting.ProjectUseCaseMock)
+	jucm := new(ucTesting.JobUseCaseMock)
+	m := rest.NewManager(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), pucm, new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock), jucm, new(ucTesting.StreamUseCaseMock))
+	return pucm, jucm, m
+}
+
+func prepareStreamMocksAndRUC() (*ucTesting.StreamUseCaseMock, rest.Manager) {
+	sucm := new(ucTesting.StreamUseCaseMock)
+	m := rest.NewManager(new(ucTesting.IssueUseCaseMock), new(ucTesting.LabelUseCaseMock), new(ucTesting.ProjectUseCaseMock), new(ucTesting.ColorUseCaseMock), new(ucTesting.NotificationUseCaseMock), new(ucTesting.MailUseCaseMock), new(ucTesting.WebhookUseCaseMock), new(ucTesting.AutomationUseCaseMock), new(ucTesting.JobUseCaseMock), sucm)
+	return sucm, m
 }
 
 func checkAssertions(t *testing.T, cucm *ucTesting.ColorUseCaseMock, iucm *ucTesting.IssueUseCaseMock, lucm *ucTesting.LabelUseCaseMock, pucm *ucTesting.ProjectUseCaseMock) {
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"github.com/labstack/echo/v4"
+	"strconv"
+	"strings"
+)
+
+// getWebhookEvents to get events provided as comma separated list, empty list subscribes to all events
+func getWebhookEvents(value string) []string {
+	events := []string{}
+	for _, event := range strings.Split(value, ",") {
+		if event = strings.TrimSpace(event); event != "" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// AddWebhook to add new webhook to project
+func (m *manager) AddWebhook(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+	project, err := m.puc.FindByID(projectID)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.wuc.Add(project, c.FormValue("url"), c.FormValue("secret"), getWebhookEvents(c.FormValue("events")))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// UpdateWebhook to update webhook, secret is kept when it is not provided
+func (m *manager) UpdateWebhook(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	active := true
+	if c.FormValue("active") != "" {
+		if active, err = strconv.ParseBool(c.FormValue("active")); err != nil {
+			return err
+		}
+	}
+
+	item, err := m.wuc.Update(id, c.FormValue("url"), c.FormValue("secret"), getWebhookEvents(c.FormValue("events")), active)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindWebhookByID to find webhook by ID
+func (m *manager) FindWebhookByID(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.wuc.FindByID(id)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return c.JSON(200, map[string]interface{}{
+				"item": nil,
+			})
+		}
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindProjectWebhooks to find webhooks of project
+func (m *manager) FindProjectWebhooks(c echo.Context) error {
+	projectID, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := m.wuc.Find(projectID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RemoveWebhook to remove webhook and its delivery log
+func (m *manager) RemoveWebhook(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.wuc.Remove(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// FindWebhookDeliveries to find delivery log of webhook, newest first
+func (m *manager) FindWebhookDeliveries(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := m.wuc.FindDeliveries(id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RedeliverWebhookDelivery to queue delivery again with its original payload
+func (m *manager) RedeliverWebhookDelivery(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.wuc.Redeliver(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
@@ -0,0 +1,236 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+)
+
+func TestAddWebhook(t *testing.T) {
+	p := domain.Project{ID: 1}
+	w := &domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook", Secret: "secret", Events: "issue.created,issue.deleted", Active: true}
+
+	pucm, wucm, m := prepareWebhookMocksAndRUC()
+
+	pucm.On("FindByID", uint(1)).Return(p, nil)
+	pucm.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	wucm.On("Add", p, "https://ci.example.com/hook", "secret", []string{"issue.created", "issue.deleted"}).Return(w, nil)
+	wucm.On("Add", p, "ftp://ci.example.com", "", []string{}).Return((*domain.Webhook)(nil), errors.New("url ftp://ci.example.com not valid"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/projects/:id/webhooks/new", strings.NewReader("url=https://ci.example.com/hook&secret=secret&events=issue.created,+issue.deleted"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.AddWebhook(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"events\":\"issue.created,issue.deleted\"")
+	assert.NotContains(t, rec.Body.String(), "secret")
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "url=https://ci.example.com/hook", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"2", "url=https://ci.example.com/hook", "record not found"},
+		{"1", "url=ftp://ci.example.com", "url ftp://ci.example.com not valid"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/projects/:id/webhooks/new", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.AddWebhook(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	pucm.AssertExpectations(t)
+	wucm.AssertExpectations(t)
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	w := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/v2", Active: false}
+
+	_, wucm, m := prepareWebhookMocksAndRUC()
+
+	wucm.On("Update", uint(1), "https://ci.example.com/v2", "other", []string{}, false).Return(w, nil)
+	wucm.On("Update", uint(2), "https://ci.example.com/v2", "", []string{}, true).Return(domain.Webhook{}, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.POST, "/api/webhooks/:id", strings.NewReader("url=https://ci.example.com/v2&secret=other&active=false"))
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.UpdateWebhook(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"active\":false")
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "url=https://ci.example.com/v2", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "url=https://ci.example.com/v2&active=maybe", "strconv.ParseBool: parsing \"maybe\": invalid syntax"},
+		{"2", "url=https://ci.example.com/v2", "record not found"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/webhooks/:id", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.UpdateWebhook(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	wucm.AssertExpectations(t)
+}
+
+func TestFindWebhooks(t *testing.T) {
+	w := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook"}
+
+	_, wucm, m := prepareWebhookMocksAndRUC()
+
+	wucm.On("FindByID", uint(1)).Return(w, nil)
+	wucm.On("FindByID", uint(2)).Return(domain.Webhook{}, errors.New("record not found"))
+	wucm.On("FindByID", uint(3)).Return(domain.Webhook{}, errors.New("test error"))
+	wucm.On("Find", uint(1)).Return([]domain.Webhook{w}, nil)
+	wucm.On("Find", uint(2)).Return([]domain.Webhook{}, errors.New("test error"))
+
+	for _, ts := range []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"1", "\"url\":\"https://ci.example.com/hook\"", ""},
+		{"2", "{\"item\":null}", ""},
+		{"3", "", "test error"},
+		{"a", "", "strconv.Atoi: parsing \"a\": invalid syntax"},
+	} {
+		c, rec := prepareHTTP(echo.GET, "/api/webhooks/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.FindWebhookByID(c)
+
+		if ts.err != "" {
+			assert.Equal(t, ts.err, err.Error())
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Contains(t, rec.Body.String(), ts.body)
+	}
+
+	c, rec := prepareHTTP(echo.GET, "/api/projects/:id/webhooks", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindProjectWebhooks(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"items\":[{\"id\":1")
+
+	for _, id := range []string{"2", "a"} {
+		c, _ := prepareHTTP(echo.GET, "/api/projects/:id/webhooks", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.FindProjectWebhooks(c)
+
+		assert.NotNil(t, err)
+	}
+
+	wucm.AssertExpectations(t)
+}
+
+func TestRemoveWebhook(t *testing.T) {
+	_, wucm, m := prepareWebhookMocksAndRUC()
+
+	wucm.On("Remove", uint(1)).Return(true, nil)
+	wucm.On("Remove", uint(2)).Return(false, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/webhooks/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveWebhook(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"status\":true}\n", rec.Body.String())
+
+	for _, id := range []string{"2", "a"} {
+		c, _ := prepareHTTP(echo.DELETE, "/api/webhooks/:id", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.RemoveWebhook(c)
+
+		assert.NotNil(t, err)
+	}
+
+	wucm.AssertExpectations(t)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	d := domain.WebhookDelivery{ID: 1, WebhookID: 1, Event: "issue.created", Status: domain.WebhookDeliveryFailed}
+	redelivery := &domain.WebhookDelivery{ID: 2, WebhookID: 1, Event: "issue.created", Status: domain.WebhookDeliveryQueued}
+
+	_, wucm, m := prepareWebhookMocksAndRUC()
+
+	wucm.On("FindDeliveries", uint(1)).Return([]domain.WebhookDelivery{d}, nil)
+	wucm.On("FindDeliveries", uint(2)).Return([]domain.WebhookDelivery{}, errors.New("test error"))
+	wucm.On("Redeliver", uint(1)).Return(redelivery, nil)
+	wucm.On("Redeliver", uint(3)).Return((*domain.WebhookDelivery)(nil), errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/webhooks/:id/deliveries", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindWebhookDeliveries(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"status\":\"failed\"")
+
+	for _, id := range []string{"2", "a"} {
+		c, _ := prepareHTTP(echo.GET, "/api/webhooks/:id/deliveries", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.FindWebhookDeliveries(c)
+
+		assert.NotNil(t, err)
+	}
+
+	c, rec = prepareHTTP(echo.POST, "/api/webhook-deliveries/:id/redeliver", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err = m.RedeliverWebhookDelivery(c)
+
+	assert.Nil(t, err)
+	assert.Contains(t, rec.Body.String(), "\"status\":\"queued\"")
+
+	for _, id := range []string{"3", "a"} {
+		c, _ := prepareHTTP(echo.POST, "/api/webhook-deliveries/:id/redeliver", nil)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		err := m.RedeliverWebhookDelivery(c)
+
+		assert.NotNil(t, err)
+	}
+
+	wucm.AssertExpectations(t)
+}
@@ -0,0 +1,167 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"go-issue-tracker/pkg/domain"
+	"strconv"
+	"time"
+)
+
+// getTime to get time provided as RFC 3339 time or date, date of end of time range includes whole day
+func getTime(value string, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return t, fmt.Errorf("time %s not valid", value)
+	}
+	if end {
+		return t.AddDate(0, 0, 1), nil
+	}
+	return t, nil
+}
+
+// SetIssueEstimates to set original and remaining estimate of issue provided as minutes or duration,
+// remaining estimate defaults to original estimate reduced by time spent
+func (m *manager) SetIssueEstimates(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	if c.FormValue("originalEstimate") == "" {
+		return errors.New("original estimate not provided")
+	}
+	originalEstimate, err := domain.ParseMinutes(c.FormValue("originalEstimate"))
+	if err != nil {
+		return err
+	}
+	remainingEstimate := domain.EstimateDefault
+	if c.FormValue("remainingEstimate") != "" {
+		if remainingEstimate, err = domain.ParseMinutes(c.FormValue("remainingEstimate")); err != nil {
+			return err
+		}
+	}
+
+	item, err := m.iuc.SetEstimates(id, originalEstimate, remainingEstimate)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// AddWorkLog to log time spent on issue provided as minutes or duration, work is logged now when
+// loggedAt is not provided
+func (m *manager) AddWorkLog(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+	minutes, err := domain.ParseMinutes(c.FormValue("timeSpent"))
+	if err != nil {
+		return err
+	}
+	var loggedAt time.Time
+	if c.FormValue("loggedAt") != "" {
+		if loggedAt, err = getTime(c.FormValue("loggedAt"), false); err != nil {
+			return err
+		}
+	}
+
+	item, err := m.iuc.AddWorkLog(id, c.FormValue("author"), minutes, loggedAt, c.FormValue("comment"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindIssueWorkLogs to find work logs of issue
+func (m *manager) FindIssueWorkLogs(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := m.iuc.FindWorkLogs(id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// RemoveWorkLog to remove work log, time is subtracted from time spent of its issue
+func (m *manager) RemoveWorkLog(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := m.iuc.RemoveWorkLog(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// FindProjectTimeSummary to sum estimates and time spent of issues of project
+func (m *manager) FindProjectTimeSummary(c echo.Context) error {
+	id, err := getID(c)
+	if err != nil {
+		return err
+	}
+
+	item, err := m.iuc.FindTimeSummary(id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"item": item,
+	})
+}
+
+// FindTimesheet to sum time logged per author and week, from and to are RFC 3339 times or dates,
+// last domain.DefaultTimesheetDays days are included when not provided
+func (m *manager) FindTimesheet(c echo.Context) error {
+	projectID := 0
+	if c.QueryParam("projectId") != "" {
+		var err error
+		if projectID, err = strconv.Atoi(c.QueryParam("projectId")); err != nil {
+			return err
+		}
+	}
+	to := time.Now()
+	if c.QueryParam("to") != "" {
+		var err error
+		if to, err = getTime(c.QueryParam("to"), true); err != nil {
+			return err
+		}
+	}
+	from := to.AddDate(0, 0, -domain.DefaultTimesheetDays)
+	if c.QueryParam("from") != "" {
+		var err error
+		if from, err = getTime(c.QueryParam("from"), false); err != nil {
+			return err
+		}
+	}
+
+	items, err := m.iuc.FindTimesheet(uint(projectID), from, to)
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, map[string]interface{}{
+		"items": items,
+	})
+}
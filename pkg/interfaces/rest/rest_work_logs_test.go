@@ -0,0 +1,255 @@
+package rest_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSetIssueEstimates(t *testing.T) {
+	i := domain.Issue{ID: 1, OriginalEstimate: 480, RemainingEstimate: 480}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("SetEstimates", uint(1), 480, domain.EstimateDefault).Return(i, nil)
+	iucm.On("SetEstimates", uint(1), 480, 90).Return(i, nil)
+
+	for _, body := range []string{"originalEstimate=8h", "originalEstimate=480&remainingEstimate=1h30m"} {
+		c, rec := prepareHTTP(echo.POST, "/api/issues/:id/estimates", strings.NewReader(body))
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := m.SetIssueEstimates(c)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), "\"originalEstimate\":480")
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestSetIssueEstimatesErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("SetEstimates", uint(1), 60, domain.EstimateDefault).Return(domain.Issue{}, errors.New("project is archived"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "originalEstimate=60", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "", "original estimate not provided"},
+		{"1", "originalEstimate=1w", "time 1w not valid"},
+		{"1", "originalEstimate=60&remainingEstimate=x", "time x not valid"},
+		{"1", "originalEstimate=60", "project is archived"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/issues/:id/estimates", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.SetIssueEstimates(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddWorkLog(t *testing.T) {
+	loggedAt := time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)
+	w := &domain.WorkLog{ID: 1, IssueID: 1, Author: "alice", Minutes: 90, LoggedAt: loggedAt, Comment: "review"}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("AddWorkLog", uint(1), "alice", 90, loggedAt, "review").Return(w, nil)
+	iucm.On("AddWorkLog", uint(1), "alice", 30, time.Time{}, "").Return(w, nil)
+
+	for _, body := range []string{"author=alice&timeSpent=1h30m&loggedAt=2020-01-06&comment=review", "author=alice&timeSpent=30"} {
+		c, rec := prepareHTTP(echo.POST, "/api/issues/:id/work-logs/new", strings.NewReader(body))
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := m.AddWorkLog(c)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), "\"minutes\":90")
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestAddWorkLogErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("AddWorkLog", uint(1), "", 30, time.Time{}, "").Return((*domain.WorkLog)(nil), errors.New("author not provided"))
+
+	tests := []struct {
+		id   string
+		body string
+		err  string
+	}{
+		{"a", "author=alice&timeSpent=30", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"1", "author=alice", "time  not valid"},
+		{"1", "author=alice&timeSpent=30&loggedAt=yesterday", "time yesterday not valid"},
+		{"1", "timeSpent=30", "author not provided"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.POST, "/api/issues/:id/work-logs/new", strings.NewReader(ts.body))
+		c.SetParamNames("id")
+		c.SetParamValues(ts.id)
+
+		err := m.AddWorkLog(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindIssueWorkLogs(t *testing.T) {
+	w := []domain.WorkLog{{ID: 1, IssueID: 1, Author: "alice", Minutes: 90}}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindWorkLogs", uint(1)).Return(w, nil)
+	iucm.On("FindWorkLogs", uint(2)).Return([]domain.WorkLog{}, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/issues/:id/work-logs", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindIssueWorkLogs(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"author\":\"alice\"")
+
+	c, _ = prepareHTTP(echo.GET, "/api/issues/:id/work-logs", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.FindIssueWorkLogs(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestRemoveWorkLog(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("RemoveWorkLog", uint(1)).Return(true, nil)
+	iucm.On("RemoveWorkLog", uint(2)).Return(false, errors.New("record not found"))
+
+	c, rec := prepareHTTP(echo.DELETE, "/api/work-logs/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.RemoveWorkLog(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"status\":true")
+
+	c, _ = prepareHTTP(echo.DELETE, "/api/work-logs/:id", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.RemoveWorkLog(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindProjectTimeSummary(t *testing.T) {
+	summary := domain.TimeSummary{OriginalEstimate: 480, RemainingEstimate: 120, TimeSpent: 390}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindTimeSummary", uint(1)).Return(summary, nil)
+	iucm.On("FindTimeSummary", uint(2)).Return(domain.TimeSummary{}, errors.New("test error"))
+
+	c, rec := prepareHTTP(echo.GET, "/api/projects/:id/time", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := m.FindProjectTimeSummary(c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"timeSpent\":390")
+
+	c, _ = prepareHTTP(echo.GET, "/api/projects/:id/time", nil)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	err = m.FindProjectTimeSummary(c)
+
+	assert.NotNil(t, err)
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindTimesheet(t *testing.T) {
+	from := time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, time.January, 13, 0, 0, 0, 0, time.UTC)
+	entries := []domain.TimesheetEntry{{Author: "alice", Week: "2020-W02", WeekStart: from, Minutes: 90}}
+
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindTimesheet", uint(1), from, to).Return(entries, nil)
+	iucm.On("FindTimesheet", uint(0), mock.Anything, mock.Anything).Return(entries, nil)
+
+	for _, path := range []string{"/api/timesheet?projectId=1&from=2020-01-06&to=2020-01-12", "/api/timesheet"} {
+		c, rec := prepareHTTP(echo.GET, path, nil)
+
+		err := m.FindTimesheet(c)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 200, rec.Code)
+		assert.Contains(t, rec.Body.String(), "\"week\":\"2020-W02\"")
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
+
+func TestFindTimesheetErrs(t *testing.T) {
+	cucm, iucm, lucm, pucm, m := prepareMocksAndRUC()
+
+	iucm.On("FindTimesheet", uint(1), mock.Anything, mock.Anything).Return([]domain.TimesheetEntry{}, errors.New("time range not valid"))
+
+	tests := []struct {
+		path string
+		err  string
+	}{
+		{"/api/timesheet?projectId=a", "strconv.Atoi: parsing \"a\": invalid syntax"},
+		{"/api/timesheet?to=tomorrow", "time tomorrow not valid"},
+		{"/api/timesheet?from=2020-13-01", "time 2020-13-01 not valid"},
+		{"/api/timesheet?projectId=1&from=2020-01-12&to=2020-01-06", "time range not valid"},
+	}
+
+	for _, ts := range tests {
+		c, _ := prepareHTTP(echo.GET, ts.path, nil)
+
+		err := m.FindTimesheet(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	checkAssertions(t, cucm, iucm, lucm, pucm)
+}
@@ -22,6 +22,24 @@ func (m *ManagerMock) UpdateIssue(c echo.Context) error {
 	return args.Error(0)
 }
 
+// BulkUpdateIssues mock
+func (m *ManagerMock) BulkUpdateIssues(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MoveIssue mock
+func (m *ManagerMock) MoveIssue(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindProjectIssueByID mock
+func (m *ManagerMock) FindProjectIssueByID(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
 // FindIssueByID mock
 func (m *ManagerMock) FindIssueByID(c echo.Context) error {
 	args := m.Called(c)
@@ -82,6 +100,54 @@ func (m *ManagerMock) RemoveLabel(c echo.Context) error {
 	return args.Error(0)
 }
 
+// MergeLabelPreview mock
+func (m *ManagerMock) MergeLabelPreview(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MergeLabel mock
+func (m *ManagerMock) MergeLabel(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// SetLabelGroup mock
+func (m *ManagerMock) SetLabelGroup(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// AddLabelGroup mock
+func (m *ManagerMock) AddLabelGroup(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// UpdateLabelGroup mock
+func (m *ManagerMock) UpdateLabelGroup(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindLabelGroupByID mock
+func (m *ManagerMock) FindLabelGroupByID(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindAllLabelGroups mock
+func (m *ManagerMock) FindAllLabelGroups(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RemoveLabelGroup mock
+func (m *ManagerMock) RemoveLabelGroup(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
 // AddProject mock
 func (m *ManagerMock) AddProject(c echo.Context) error {
 	args := m.Called(c)
@@ -94,6 +160,12 @@ func (m *ManagerMock) UpdateProject(c echo.Context) error {
 	return args.Error(0)
 }
 
+// UpdateProjectSettings mock
+func (m *ManagerMock) UpdateProjectSettings(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
 // FindProjectByID mock
 func (m *ManagerMock) FindProjectByID(c echo.Context) error {
 	args := m.Called(c)
@@ -112,8 +184,314 @@ func (m *ManagerMock) FindAllProjects(c echo.Context) error {
 	return args.Error(0)
 }
 
+// FindArchivedProjects mock
+func (m *ManagerMock) FindArchivedProjects(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
 // RemoveProject mock
 func (m *ManagerMock) RemoveProject(c echo.Context) error {
 	args := m.Called(c)
 	return args.Error(0)
 }
+
+// AddIssueTemplate mock
+func (m *ManagerMock) AddIssueTemplate(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// UpdateIssueTemplate mock
+func (m *ManagerMock) UpdateIssueTemplate(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindIssueTemplateByID mock
+func (m *ManagerMock) FindIssueTemplateByID(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindProjectIssueTemplates mock
+func (m *ManagerMock) FindProjectIssueTemplates(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RemoveIssueTemplate mock
+func (m *ManagerMock) RemoveIssueTemplate(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// SetIssueCustomFields mock
+func (m *ManagerMock) SetIssueCustomFields(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// SetIssueEstimates mock
+func (m *ManagerMock) SetIssueEstimates(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// AddWorkLog mock
+func (m *ManagerMock) AddWorkLog(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindIssueWorkLogs mock
+func (m *ManagerMock) FindIssueWorkLogs(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RemoveWorkLog mock
+func (m *ManagerMock) RemoveWorkLog(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindProjectTimeSummary mock
+func (m *ManagerMock) FindProjectTimeSummary(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindTimesheet mock
+func (m *ManagerMock) FindTimesheet(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// AddAttachment mock
+func (m *ManagerMock) AddAttachment(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindIssueAttachments mock
+func (m *ManagerMock) FindIssueAttachments(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// DownloadAttachment mock
+func (m *ManagerMock) DownloadAttachment(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RemoveAttachment mock
+func (m *ManagerMock) RemoveAttachment(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// AddCustomField mock
+func (m *ManagerMock) AddCustomField(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// UpdateCustomField mock
+func (m *ManagerMock) UpdateCustomField(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindCustomFieldByID mock
+func (m *ManagerMock) FindCustomFieldByID(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindProjectCustomFields mock
+func (m *ManagerMock) FindProjectCustomFields(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RemoveCustomField mock
+func (m *ManagerMock) RemoveCustomField(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// WatchIssue mock
+func (m *ManagerMock) WatchIssue(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// WatchProject mock
+func (m *ManagerMock) WatchProject(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindWatchers mock
+func (m *ManagerMock) FindWatchers(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// Unwatch mock
+func (m *ManagerMock) Unwatch(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindNotifications mock
+func (m *ManagerMock) FindNotifications(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MarkNotificationRead mock
+func (m *ManagerMock) MarkNotificationRead(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MarkAllNotificationsRead mock
+func (m *ManagerMock) MarkAllNotificationsRead(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// SetMailPreference mock
+func (m *ManagerMock) SetMailPreference(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindMailPreference mock
+func (m *ManagerMock) FindMailPreference(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// AddWebhook mock
+func (m *ManagerMock) AddWebhook(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// UpdateWebhook mock
+func (m *ManagerMock) UpdateWebhook(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindWebhookByID mock
+func (m *ManagerMock) FindWebhookByID(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindProjectWebhooks mock
+func (m *ManagerMock) FindProjectWebhooks(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RemoveWebhook mock
+func (m *ManagerMock) RemoveWebhook(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindWebhookDeliveries mock
+func (m *ManagerMock) FindWebhookDeliveries(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RedeliverWebhookDelivery mock
+func (m *ManagerMock) RedeliverWebhookDelivery(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// AddAutomationRule mock
+func (m *ManagerMock) AddAutomationRule(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// UpdateAutomationRule mock
+func (m *ManagerMock) UpdateAutomationRule(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindAutomationRuleByID mock
+func (m *ManagerMock) FindAutomationRuleByID(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindProjectAutomationRules mock
+func (m *ManagerMock) FindProjectAutomationRules(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RemoveAutomationRule mock
+func (m *ManagerMock) RemoveAutomationRule(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindAutomationExecutions mock
+func (m *ManagerMock) FindAutomationExecutions(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// AddJob mock
+func (m *ManagerMock) AddJob(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// UpdateJob mock
+func (m *ManagerMock) UpdateJob(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindJobByID mock
+func (m *ManagerMock) FindJobByID(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// FindAllJobs mock
+func (m *ManagerMock) FindAllJobs(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RemoveJob mock
+func (m *ManagerMock) RemoveJob(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// RunJob mock
+func (m *ManagerMock) RunJob(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// StreamEvents mock
+func (m *ManagerMock) StreamEvents(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
@@ -0,0 +1,57 @@
+package smtp
+
+import (
+	"fmt"
+	"go-issue-tracker/pkg/domain"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// MailSender delivers messages through SMTP server
+type MailSender struct {
+	Address  string
+	Username string
+	Password string
+	From     string
+}
+
+// NewMailSender to create MailSender, authentication is used only when username is provided
+func NewMailSender(address string, username string, password string, from string) *MailSender {
+	return &MailSender{
+		Address:  address,
+		Username: username,
+		Password: password,
+		From:     from,
+	}
+}
+
+// Send to send plain text message, STARTTLS is used when server supports it
+func (s *MailSender) Send(message domain.MailMessage) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		host, _, err := net.SplitHostPort(s.Address)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", s.Username, s.Password, host)
+	}
+	return smtp.SendMail(s.Address, auth, s.From, []string{message.To}, s.getContent(message, time.Now()))
+}
+
+// getContent to get headers and body of message with CRLF line endings
+func (s *MailSender) getContent(message domain.MailMessage, now time.Time) []byte {
+	headers := []string{
+		fmt.Sprintf("From: %s", s.From),
+		fmt.Sprintf("To: %s", message.To),
+		fmt.Sprintf("Subject: %s", mime.QEncoding.Encode("utf-8", message.Subject)),
+		fmt.Sprintf("Date: %s", now.Format(time.RFC1123Z)),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: 8bit",
+	}
+	body := strings.Replace(strings.Replace(message.Body, "\r\n", "\n", -1), "\n", "\r\n", -1)
+	return []byte(strings.Join(headers, "\r\n") + "\r\n\r\n" + body)
+}
@@ -0,0 +1,62 @@
+package smtp_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/smtp"
+	smtpTesting "go-issue-tracker/pkg/interfaces/smtp/testing"
+	"testing"
+)
+
+func TestNewMailSender(t *testing.T) {
+	s := smtp.NewMailSender("localhost:25", "user", "password", "tracker@example.com")
+
+	assert.Equal(t, "localhost:25", s.Address)
+	assert.Equal(t, "user", s.Username)
+	assert.Equal(t, "password", s.Password)
+	assert.Equal(t, "tracker@example.com", s.From)
+}
+
+func TestMailSenderSend(t *testing.T) {
+	fs, err := smtpTesting.NewFakeServer()
+	assert.Nil(t, err)
+	defer fs.Close()
+
+	s := smtp.NewMailSender(fs.Address(), "", "", "tracker@example.com")
+
+	err = s.Send(domain.MailMessage{
+		To:      "alice@example.com",
+		Subject: "Issue #1 Crash was updated",
+		Body:    "Hello alice,\n\nhttp://localhost:3001/issues/1\n",
+	})
+
+	assert.Nil(t, err)
+
+	messages := fs.Messages()
+
+	assert.Equal(t, 1, len(messages))
+	assert.Equal(t, "tracker@example.com", messages[0].From)
+	assert.Equal(t, []string{"alice@example.com"}, messages[0].To)
+	assert.Contains(t, messages[0].Data, "To: alice@example.com\n")
+	assert.Contains(t, messages[0].Data, "Subject: Issue #1 Crash was updated\n")
+	assert.Contains(t, messages[0].Data, "Content-Type: text/plain; charset=utf-8\n")
+	assert.Contains(t, messages[0].Data, "\n\nHello alice,\n\nhttp://localhost:3001/issues/1\n")
+}
+
+func TestMailSenderSendErr(t *testing.T) {
+	fs, err := smtpTesting.NewFakeServer()
+	assert.Nil(t, err)
+	defer fs.Close()
+	fs.RejectRecipients = true
+
+	s := smtp.NewMailSender(fs.Address(), "", "", "tracker@example.com")
+
+	err = s.Send(domain.MailMessage{To: "alice@example.com", Subject: "Issue #1 Crash was updated"})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(fs.Messages()))
+
+	err = smtp.NewMailSender("localhost", "user", "password", "tracker@example.com").Send(domain.MailMessage{To: "alice@example.com"})
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,111 @@
+package testing
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// FakeMessage is a message received by FakeServer
+type FakeMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// FakeServer is a local SMTP server keeping received messages in memory, recipients are rejected
+// when RejectRecipients is set
+type FakeServer struct {
+	RejectRecipients bool
+	listener         net.Listener
+	mutex            sync.Mutex
+	messages         []FakeMessage
+}
+
+// NewFakeServer to start FakeServer on random local port
+func NewFakeServer() (*FakeServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &FakeServer{
+		listener: listener,
+	}
+	go s.serve()
+	return s, nil
+}
+
+// Address to get address of server
+func (s *FakeServer) Address() string {
+	return s.listener.Addr().String()
+}
+
+// Messages to get received messages
+func (s *FakeServer) Messages() []FakeMessage {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]FakeMessage{}, s.messages...)
+}
+
+// Close to stop server
+func (s *FakeServer) Close() error {
+	return s.listener.Close()
+}
+
+// serve to accept connections until server is closed
+func (s *FakeServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+// handle to run SMTP session of single connection
+func (s *FakeServer) handle(conn net.Conn) {
+	c := textproto.NewConn(conn)
+	defer c.Close()
+
+	message := FakeMessage{}
+	c.PrintfLine("220 localhost fake SMTP server")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			c.PrintfLine("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			message = FakeMessage{From: strings.Trim(line[len("MAIL FROM:"):], " <>")}
+			c.PrintfLine("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			if s.RejectRecipients {
+				c.PrintfLine("550 mailbox unavailable")
+				continue
+			}
+			message.To = append(message.To, strings.Trim(line[len("RCPT TO:"):], " <>"))
+			c.PrintfLine("250 OK")
+		case command == "DATA":
+			c.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			message.Data = string(data)
+			s.mutex.Lock()
+			s.messages = append(s.messages, message)
+			s.mutex.Unlock()
+			c.PrintfLine("250 OK")
+		case command == "QUIT":
+			c.PrintfLine("221 bye")
+			return
+		default:
+			c.PrintfLine("250 OK")
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"bytes"
+	"fmt"
+	"go-issue-tracker/pkg/domain"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+// UserAgent is sent with every delivery
+var UserAgent = "go-issue-tracker-webhook"
+
+// HTTPClient interface
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Sender posts webhook deliveries over HTTP
+type Sender struct {
+	HTTPClient HTTPClient
+}
+
+// NewSender to create Sender
+func NewSender(httpClient HTTPClient) *Sender {
+	return &Sender{
+		HTTPClient: httpClient,
+	}
+}
+
+// Send to post payload of delivery signed with secret of webhook, response with status other than 2xx fails delivery
+func (s *Sender) Send(webhook domain.Webhook, delivery domain.WebhookDelivery) (int, error) {
+	payload := []byte(delivery.Payload)
+	request, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", UserAgent)
+	request.Header.Set("X-Webhook-Event", delivery.Event)
+	request.Header.Set("X-Webhook-Delivery", strconv.FormatUint(uint64(delivery.ID), 10))
+	request.Header.Set(domain.WebhookSignatureHeader, domain.GetWebhookSignature(webhook.Secret, payload))
+
+	response, err := s.HTTPClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+	io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
@@ -0,0 +1,58 @@
+package webhook_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/interfaces/webhook"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhookSenderSend(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = ioutil.ReadAll(r.Body)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(500)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	s := webhook.NewSender(server.Client())
+	w := domain.Webhook{ID: 1, URL: server.URL + "/hook", Secret: "secret"}
+	d := domain.WebhookDelivery{ID: 7, WebhookID: 1, Event: "issue.created", Payload: "{\"event\":\"issue.created\"}"}
+
+	status, err := s.Send(w, d)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 204, status)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "issue.created", received.Header.Get("X-Webhook-Event"))
+	assert.Equal(t, "7", received.Header.Get("X-Webhook-Delivery"))
+	assert.Equal(t, domain.GetWebhookSignature("secret", []byte(d.Payload)), received.Header.Get(domain.WebhookSignatureHeader))
+	assert.Equal(t, d.Payload, string(body))
+
+	w.URL = server.URL + "/fail"
+	status, err = s.Send(w, d)
+
+	assert.Equal(t, 500, status)
+	assert.Equal(t, "unexpected status 500", err.Error())
+
+	w.URL = "http://127.0.0.1:0/hook"
+	status, err = s.Send(w, d)
+
+	assert.Equal(t, 0, status)
+	assert.NotNil(t, err)
+
+	w.URL = ":"
+	_, err = s.Send(w, d)
+
+	assert.NotNil(t, err)
+}
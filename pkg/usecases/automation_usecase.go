@@ -0,0 +1,253 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"go-issue-tracker/pkg/domain"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// AutomationUseCase interface
+type AutomationUseCase interface {
+	Add(project domain.Project, name string, trigger string, conditions []string, actions []string) (*domain.AutomationRule, error)
+	Update(id uint, name string, trigger string, conditions []string, actions []string, active bool) (domain.AutomationRule, error)
+	FindByID(id uint) (domain.AutomationRule, error)
+	Find(projectID uint) ([]domain.AutomationRule, error)
+	Remove(id uint) (bool, error)
+	FindExecutions(ruleID uint) ([]domain.AutomationExecution, error)
+	HandleEvent(event domain.DomainEvent) error
+}
+
+// automationUseCase struct
+type automationUseCase struct {
+	service       domain.AutomationService
+	issues        IssueUseCase
+	labels        LabelUseCase
+	projects      ProjectUseCase
+	notifications NotificationUseCase
+}
+
+// NewAutomationUseCase to create new AutomationUseCase, actions of rules are applied through issues,
+// labels and projects use cases, so changes made by rules publish domain events like any other change,
+// comments of rules are sent to watchers of issue through notifications use case
+func NewAutomationUseCase(repository domain.AutomationRepository, issues IssueUseCase, labels LabelUseCase, projects ProjectUseCase, notifications NotificationUseCase) AutomationUseCase {
+	return &automationUseCase{
+		service:       domain.GetDefaultAutomationService(repository),
+		issues:        issues,
+		labels:        labels,
+		projects:      projects,
+		notifications: notifications,
+	}
+}
+
+// Add to add new active rule to project
+func (uc *automationUseCase) Add(project domain.Project, name string, trigger string, conditions []string, actions []string) (*domain.AutomationRule, error) {
+	item := new(domain.AutomationRule)
+	item.ProjectID = project.ID
+	item.Name = name
+	item.Trigger = trigger
+	item.Conditions = strings.Join(conditions, ",")
+	item.Actions = strings.Join(actions, ",")
+	item.Active = true
+
+	itemAdded, err := uc.service.Add(item)
+	if err != nil {
+		return nil, err
+	}
+	return itemAdded, nil
+}
+
+// Update to update rule
+func (uc *automationUseCase) Update(id uint, name string, trigger string, conditions []string, actions []string, active bool) (domain.AutomationRule, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	item.Name = name
+	item.Trigger = trigger
+	item.Conditions = strings.Join(conditions, ",")
+	item.Actions = strings.Join(actions, ",")
+	item.Active = active
+
+	itemUpdated, err := uc.service.Update(item)
+	if err != nil {
+		return itemUpdated, err
+	}
+	return itemUpdated, nil
+}
+
+// FindByID to find rule by ID
+func (uc *automationUseCase) FindByID(id uint) (domain.AutomationRule, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Find to find rules of project
+func (uc *automationUseCase) Find(projectID uint) ([]domain.AutomationRule, error) {
+	items, err := uc.service.Find(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Remove to remove rule together with its execution log
+func (uc *automationUseCase) Remove(id uint) (bool, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := uc.service.Remove(item)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// FindExecutions to find execution log of rule
+func (uc *automationUseCase) FindExecutions(ruleID uint) ([]domain.AutomationExecution, error) {
+	items, err := uc.service.FindExecutions(ruleID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// HandleEvent to apply matching rules of project to issue of event, it is subscribed synchronously to event
+// bus, so events published by actions are handled before it returns. Changes made by rules carry chain of
+// event which caused them, each rule is applied at most once per chain and chain is stopped at
+// domain.AutomationMaxDepth, stopped executions are logged as skipped
+func (uc *automationUseCase) HandleEvent(event domain.DomainEvent) error {
+	issue, ok := domain.GetEventIssue(event)
+	if !ok {
+		return nil
+	}
+	rules, err := uc.service.FindMatching(event)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	chain := domain.GetEventChain(event)
+	if chain == nil {
+		chain = domain.NewAutomationChain()
+	}
+	chain = chain.Next()
+	depth := chain.Depth
+
+	for _, rule := range rules {
+		fired := chain.Fired[rule.ID]
+		chain.Fired[rule.ID] = true
+
+		execution := &domain.AutomationExecution{
+			RuleID:  rule.ID,
+			IssueID: issue.ID,
+			Event:   event.EventName(),
+			Status:  domain.AutomationExecutionApplied,
+			Depth:   depth,
+		}
+		switch {
+		case depth > domain.AutomationMaxDepth:
+			execution.Status = domain.AutomationExecutionSkipped
+			execution.Error = fmt.Sprintf("max. depth %d exceeded", domain.AutomationMaxDepth)
+		case fired:
+			execution.Status = domain.AutomationExecutionSkipped
+			execution.Error = "rule already applied in chain"
+		default:
+			if err := uc.apply(rule, issue.ID, chain); err != nil {
+				execution.Status = domain.AutomationExecutionFailed
+				execution.Error = err.Error()
+			}
+		}
+		if _, err := uc.service.AddExecution(execution); err != nil {
+			log.Printf("Logging execution of rule %d failed: %v", rule.ID, err)
+		}
+	}
+	return nil
+}
+
+// apply to apply actions of rule to current state of issue as part of chain, issue is updated only when
+// actions changed it, comments are sent to watchers of issue after the changes
+func (uc *automationUseCase) apply(rule domain.AutomationRule, issueID uint, chain *domain.AutomationChain) error {
+	actions, err := rule.GetActions()
+	if err != nil {
+		return err
+	}
+	item, err := uc.issues.FindByID(issueID)
+	if err != nil {
+		return err
+	}
+
+	status, priority, severity := item.Status, item.Priority, item.Severity
+	labels := make(map[string]domain.Label)
+	for _, label := range item.Labels {
+		labels[label.Name] = label
+	}
+	changed := false
+	var projectID uint
+	comments := []string{}
+	for _, action := range actions {
+		switch action.Name {
+		case domain.AutomationActionSetStatus:
+			status, _ = strconv.Atoi(action.Value)
+		case domain.AutomationActionSetPriority:
+			priority, _ = domain.ParsePriority(action.Value)
+		case domain.AutomationActionSetSeverity:
+			severity, _ = domain.ParseSeverity(action.Value)
+		case domain.AutomationActionAddLabel:
+			if _, ok := labels[action.Value]; ok {
+				continue
+			}
+			label, err := uc.labels.FindByName(action.Value, item.ProjectID)
+			if err != nil {
+				return fmt.Errorf("label %s: %v", action.Value, err)
+			}
+			labels[label.Name] = label
+			changed = true
+		case domain.AutomationActionRemoveLabel:
+			if _, ok := labels[action.Value]; ok {
+				delete(labels, action.Value)
+				changed = true
+			}
+		case domain.AutomationActionMoveProject:
+			id, _ := strconv.Atoi(action.Value)
+			projectID = uint(id)
+		case domain.AutomationActionComment:
+			comments = append(comments, action.Value)
+		}
+	}
+	changed = changed || status != item.Status || priority != item.Priority || severity != item.Severity
+
+	if changed {
+		if item, err = uc.issues.WithChain(chain).Update(item.ID, item.Title, item.Description, status, priority, severity, item.Reporter, item.Assignee, labels); err != nil {
+			return err
+		}
+	}
+	if projectID != 0 && projectID != item.ProjectID {
+		project, err := uc.projects.FindByID(projectID)
+		if err != nil {
+			return fmt.Errorf("project %d: %v", projectID, err)
+		}
+		if item, err = uc.issues.WithChain(chain).Move(item.ID, project); err != nil {
+			return err
+		}
+	}
+	for _, comment := range comments {
+		if uc.notifications == nil {
+			return errors.New("comments not supported, notifications not configured")
+		}
+		if _, err := uc.notifications.NotifyIssue(item, domain.NotificationEventCommented, []domain.IssueChange{{Field: "comment", Current: comment}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package usecases_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"go-issue-tracker/pkg/usecases"
+	ucTesting "go-issue-tracker/pkg/usecases/testing"
+	"testing"
+)
+
+func prepareAutomationServiceMock() *dTesting.AutomationServiceMock {
+	ms := new(dTesting.AutomationServiceMock)
+	domain.GetDefaultAutomationService = func(r domain.AutomationRepository) domain.AutomationService {
+		return ms
+	}
+	return ms
+}
+
+func TestUseCaseAutomationNewAutomationUseCase(t *testing.T) {
+	prepareAutomationServiceMock()
+	defer domain.ResetDefaultAutomationService()
+
+	uc := usecases.NewAutomationUseCase(new(dTesting.AutomationRepositoryMock), nil, nil, nil, nil)
+
+	assert.NotNil(t, uc)
+}
+
+func TestUseCaseAutomationAddAndUpdate(t *testing.T) {
+	r := domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Security", Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Actions: "setPriority:high,addLabel:triage", Active: true}
+	updated := domain.AutomationRule{ID: 1, ProjectID: 1, Name: "Urgent", Trigger: domain.EventIssueCreated, Actions: "setSeverity:major", Active: false}
+
+	ms := prepareAutomationServiceMock()
+	defer domain.ResetDefaultAutomationService()
+	ms.On("Add", &domain.AutomationRule{ProjectID: 1, Name: "Security", Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Actions: "setPriority:high,addLabel:triage", Active: true}).Return(&r, nil)
+	ms.On("Add", &domain.AutomationRule{ProjectID: 1, Name: "Security", Trigger: "IssueRemoved", Active: true}).Return((*domain.AutomationRule)(nil), errors.New("trigger IssueRemoved not valid"))
+	ms.On("FindByID", uint(1)).Return(r, nil)
+	ms.On("FindByID", uint(2)).Return(domain.AutomationRule{}, errors.New("record not found"))
+	ms.On("Update", updated).Return(updated, nil)
+
+	uc := usecases.NewAutomationUseCase(new(dTesting.AutomationRepositoryMock), nil, nil, nil, nil)
+
+	item, err := uc.Add(domain.Project{ID: 1}, "Security", domain.EventLabelsChanged, []string{"addedLabel:security"}, []string{"setPriority:high", "addLabel:triage"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &r, item)
+
+	item, err = uc.Add(domain.Project{ID: 1}, "Security", "IssueRemoved", nil, nil)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	rule, err := uc.Update(1, "Urgent", domain.EventIssueCreated, nil, []string{"setSeverity:major"}, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, updated, rule)
+
+	_, err = uc.Update(2, "Urgent", domain.EventIssueCreated, nil, []string{"setSeverity:major"}, false)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseAutomationFindAndRemove(t *testing.T) {
+	r := domain.AutomationRule{ID: 1, ProjectID: 1}
+
+	ms := prepareAutomationServiceMock()
+	defer domain.ResetDefaultAutomationService()
+	ms.On("FindByID", uint(1)).Return(r, nil)
+	ms.On("FindByID", uint(2)).Return(domain.AutomationRule{}, errors.New("record not found"))
+	ms.On("Find", uint(1)).Return([]domain.AutomationRule{r}, nil)
+	ms.On("FindExecutions", uint(1)).Return([]domain.AutomationExecution{{ID: 1, RuleID: 1}}, nil)
+	ms.On("Remove", r).Return(true, nil)
+
+	uc := usecases.NewAutomationUseCase(new(dTesting.AutomationRepositoryMock), nil, nil, nil, nil)
+
+	item, err := uc.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, r, item)
+
+	items, err := uc.Find(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	executions, err := uc.FindExecutions(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(executions))
+
+	status, err := uc.Remove(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = uc.Remove(2)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseAutomationHandleEvent(t *testing.T) {
+	security := domain.Label{ID: 1, Name: "security"}
+	triage := domain.Label{ID: 2, Name: "triage", ProjectID: 1}
+	i := domain.Issue{ID: 1, Title: "XSS", Status: 1, ProjectID: 1, Labels: []domain.Label{security}}
+	e := domain.LabelsChanged{Issue: i, Added: []domain.Label{security}, Removed: []domain.Label{}}
+	r := domain.AutomationRule{ID: 1, ProjectID: 1, Trigger: domain.EventLabelsChanged, Conditions: "addedLabel:security", Actions: "setPriority:high,addLabel:triage,moveProject:2,comment:Moved to security", Active: true}
+	updated := i
+	updated.Priority = domain.PriorityHigh
+	updated.Labels = []domain.Label{security, triage}
+	moved := updated
+	moved.ProjectID = 2
+
+	ms := prepareAutomationServiceMock()
+	defer domain.ResetDefaultAutomationService()
+	ms.On("FindMatching", e).Return([]domain.AutomationRule{r}, nil)
+	ms.On("AddExecution", &domain.AutomationExecution{RuleID: 1, IssueID: 1, Event: domain.EventLabelsChanged, Status: domain.AutomationExecutionApplied, Depth: 1}).Return(&domain.AutomationExecution{ID: 1}, nil)
+
+	miuc := new(ucTesting.IssueUseCaseMock)
+	miuc.On("FindByID", uint(1)).Return(i, nil)
+	miuc.On("WithChain", &domain.AutomationChain{Depth: 1, Fired: map[uint]bool{1: true}}).Return(miuc)
+	miuc.On("Update", uint(1), "XSS", "", 1, domain.PriorityHigh, 0, "", "", map[string]domain.Label{"security": security, "triage": triage}).Return(updated, nil)
+	miuc.On("Move", uint(1), domain.Project{ID: 2}).Return(moved, nil)
+	mluc := new(ucTesting.LabelUseCaseMock)
+	mluc.On("FindByName", "triage", uint(1)).Return(triage, nil)
+	mpuc := new(ucTesting.ProjectUseCaseMock)
+	mpuc.On("FindByID", uint(2)).Return(domain.Project{ID: 2}, nil)
+	mnuc := new(ucTesting.NotificationUseCaseMock)
+	mnuc.On("NotifyIssue", moved, domain.NotificationEventCommented, []domain.IssueChange{{Field: "comment", Current: "Moved to security"}}).Return(1, nil)
+
+	uc := usecases.NewAutomationUseCase(new(dTesting.AutomationRepositoryMock), miuc, mluc, mpuc, mnuc)
+
+	err := uc.HandleEvent(e)
+
+	assert.Nil(t, err)
+
+	err = uc.HandleEvent(domain.ProjectCreated{Project: domain.Project{ID: 1}})
+
+	assert.Nil(t, err)
+
+	ms.AssertExpectations(t)
+	miuc.AssertExpectations(t)
+	mluc.AssertExpectations(t)
+	mpuc.AssertExpectations(t)
+	mnuc.AssertExpectations(t)
+}
+
+func TestUseCaseAutomationHandleEventFailed(t *testing.T) {
+	i := domain.Issue{ID: 1, Title: "XSS", ProjectID: 1}
+	e := domain.IssueCreated{Issue: i}
+	r := domain.AutomationRule{ID: 1, ProjectID: 1, Trigger: domain.EventIssueCreated, Actions: "addLabel:triage", Active: true}
+
+	ms := prepareAutomationServiceMock()
+	defer domain.ResetDefaultAutomationService()
+	ms.On("FindMatching", e).Return([]domain.AutomationRule{r}, nil).Once()
+	ms.On("FindMatching", e).Return([]domain.AutomationRule{}, errors.New("test error")).Once()
+	ms.On("AddExecution", &domain.AutomationExecution{RuleID: 1, IssueID: 1, Event: domain.EventIssueCreated, Status: domain.AutomationExecutionFailed, Depth: 1, Error: "label triage: record not found"}).Return((*domain.AutomationExecution)(nil), errors.New("test error"))
+
+	miuc := new(ucTesting.IssueUseCaseMock)
+	miuc.On("FindByID", uint(1)).Return(i, nil)
+	mluc := new(ucTesting.LabelUseCaseMock)
+	mluc.On("FindByName", "triage", uint(1)).Return(domain.Label{}, errors.New("record not found"))
+
+	uc := usecases.NewAutomationUseCase(new(dTesting.AutomationRepositoryMock), miuc, mluc, nil, nil)
+
+	err := uc.HandleEvent(e)
+
+	assert.Nil(t, err)
+
+	err = uc.HandleEvent(e)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	miuc.AssertExpectations(t)
+	mluc.AssertExpectations(t)
+}
+
+func TestUseCaseAutomationHandleEventLoop(t *testing.T) {
+	i := domain.Issue{ID: 1, Title: "XSS", Status: 1, ProjectID: 1}
+	e := domain.IssueUpdated{Issue: i}
+	r := domain.AutomationRule{ID: 1, ProjectID: 1, Trigger: domain.EventIssueUpdated, Actions: "setStatus:2", Active: true}
+	updated := i
+	updated.Status = 2
+
+	ms := prepareAutomationServiceMock()
+	defer domain.ResetDefaultAutomationService()
+	ms.On("FindMatching", mock.AnythingOfType("domain.IssueUpdated")).Return([]domain.AutomationRule{r}, nil)
+	ms.On("AddExecution", &domain.AutomationExecution{RuleID: 1, IssueID: 1, Event: domain.EventIssueUpdated, Status: domain.AutomationExecutionSkipped, Depth: 2, Error: "rule already applied in chain"}).Return(&domain.AutomationExecution{ID: 1}, nil).Once()
+	ms.On("AddExecution", &domain.AutomationExecution{RuleID: 1, IssueID: 1, Event: domain.EventIssueUpdated, Status: domain.AutomationExecutionApplied, Depth: 1}).Return(&domain.AutomationExecution{ID: 2}, nil).Once()
+
+	miuc := new(ucTesting.IssueUseCaseMock)
+	uc := usecases.NewAutomationUseCase(new(dTesting.AutomationRepositoryMock), miuc, nil, nil, nil)
+	var chain *domain.AutomationChain
+	miuc.On("FindByID", uint(1)).Return(i, nil)
+	miuc.On("WithChain", mock.Anything).Return(miuc).Run(func(args mock.Arguments) {
+		chain = args.Get(0).(*domain.AutomationChain)
+	})
+	miuc.On("Update", uint(1), "XSS", "", 2, 0, 0, "", "", map[string]domain.Label{}).Return(updated, nil).Run(func(args mock.Arguments) {
+		assert.Nil(t, uc.HandleEvent(domain.IssueUpdated{Issue: updated, Chain: chain}))
+	})
+
+	err := uc.HandleEvent(e)
+
+	assert.Nil(t, err)
+
+	ms.AssertExpectations(t)
+	miuc.AssertExpectations(t)
+}
+
+func TestUseCaseAutomationHandleEventSeparateChains(t *testing.T) {
+	i := domain.Issue{ID: 1, Title: "XSS", Status: 1, ProjectID: 1}
+	r := domain.AutomationRule{ID: 1, ProjectID: 1, Trigger: domain.EventIssueUpdated, Actions: "setStatus:2", Active: true}
+	updated := i
+	updated.Status = 2
+
+	ms := prepareAutomationServiceMock()
+	defer domain.ResetDefaultAutomationService()
+	ms.On("FindMatching", domain.IssueUpdated{Issue: i}).Return([]domain.AutomationRule{r}, nil)
+	ms.On("AddExecution", &domain.AutomationExecution{RuleID: 1, IssueID: 1, Event: domain.EventIssueUpdated, Status: domain.AutomationExecutionApplied, Depth: 1}).Return(&domain.AutomationExecution{}, nil).Twice()
+
+	miuc := new(ucTesting.IssueUseCaseMock)
+	uc := usecases.NewAutomationUseCase(new(dTesting.AutomationRepositoryMock), miuc, nil, nil, nil)
+	miuc.On("FindByID", uint(1)).Return(i, nil)
+	miuc.On("WithChain", &domain.AutomationChain{Depth: 1, Fired: map[uint]bool{1: true}}).Return(miuc)
+	miuc.On("Update", uint(1), "XSS", "", 2, 0, 0, "", "", map[string]domain.Label{}).Return(updated, nil).Once().Run(func(args mock.Arguments) {
+		// concurrent change of the same issue made by user starts its own chain
+		assert.Nil(t, uc.HandleEvent(domain.IssueUpdated{Issue: i}))
+	})
+	miuc.On("Update", uint(1), "XSS", "", 2, 0, 0, "", "", map[string]domain.Label{}).Return(updated, nil).Once()
+
+	err := uc.HandleEvent(domain.IssueUpdated{Issue: i})
+
+	assert.Nil(t, err)
+
+	ms.AssertExpectations(t)
+	miuc.AssertExpectations(t)
+}
+
+func TestUseCaseAutomationHandleEventMaxDepth(t *testing.T) {
+	ms := prepareAutomationServiceMock()
+	defer domain.ResetDefaultAutomationService()
+
+	miuc := new(ucTesting.IssueUseCaseMock)
+	uc := usecases.NewAutomationUseCase(new(dTesting.AutomationRepositoryMock), miuc, nil, nil, nil)
+	var chain *domain.AutomationChain
+	miuc.On("WithChain", mock.Anything).Return(miuc).Run(func(args mock.Arguments) {
+		chain = args.Get(0).(*domain.AutomationChain)
+	})
+
+	for depth := 1; depth <= domain.AutomationMaxDepth+1; depth++ {
+		i := domain.Issue{ID: 1, Status: depth, ProjectID: 1}
+		r := domain.AutomationRule{ID: uint(depth), ProjectID: 1, Trigger: domain.EventIssueUpdated, Actions: "setStatus:0", Active: true}
+		status := depth
+		ms.On("FindMatching", mock.MatchedBy(func(e domain.IssueUpdated) bool {
+			return e.Issue.Status == status
+		})).Return([]domain.AutomationRule{r}, nil)
+		if depth > domain.AutomationMaxDepth {
+			break
+		}
+		miuc.On("FindByID", uint(1)).Return(i, nil).Once()
+		next := domain.Issue{ID: 1, Status: depth + 1, ProjectID: 1}
+		miuc.On("Update", uint(1), "", "", 0, 0, 0, "", "", map[string]domain.Label{}).Return(next, nil).Once().Run(func(args mock.Arguments) {
+			assert.Nil(t, uc.HandleEvent(domain.IssueUpdated{Issue: next, Chain: chain}))
+		})
+	}
+	ms.On("AddExecution", mock.MatchedBy(func(e *domain.AutomationExecution) bool {
+		return e.Status == domain.AutomationExecutionApplied
+	})).Return(&domain.AutomationExecution{}, nil).Times(domain.AutomationMaxDepth)
+	ms.On("AddExecution", &domain.AutomationExecution{RuleID: domain.AutomationMaxDepth + 1, IssueID: 1, Event: domain.EventIssueUpdated, Status: domain.AutomationExecutionSkipped, Depth: domain.AutomationMaxDepth + 1, Error: "max. depth 5 exceeded"}).Return(&domain.AutomationExecution{}, nil).Once()
+
+	err := uc.HandleEvent(domain.IssueUpdated{Issue: domain.Issue{ID: 1, Status: 1, ProjectID: 1}})
+
+	assert.Nil(t, err)
+
+	ms.AssertExpectations(t)
+}
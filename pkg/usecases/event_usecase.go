@@ -0,0 +1,157 @@
+package usecases
+
+import (
+	"fmt"
+	"go-issue-tracker/pkg/domain"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EventUseCase interface is in-process bus of domain events
+type EventUseCase interface {
+	Subscribe(handler domain.EventHandler, names ...string)
+	SubscribeAsync(subscriber string, handler domain.EventHandler, names ...string) error
+	Transaction(change EventChange) error
+	ProcessOutbox(now time.Time) (int, error)
+}
+
+// EventChange is change written by repositories bound to transaction, it returns domain events of change
+type EventChange func(tx domain.Transaction) ([]domain.DomainEvent, error)
+
+// eventSubscription struct
+type eventSubscription struct {
+	names   []string
+	handler domain.EventHandler
+}
+
+// matches to check whether subscription is subscribed to event, subscription without names matches all events
+func (s eventSubscription) matches(event domain.DomainEvent) bool {
+	if len(s.names) == 0 {
+		return true
+	}
+	for _, name := range s.names {
+		if name == event.EventName() {
+			return true
+		}
+	}
+	return false
+}
+
+// eventUseCase struct
+type eventUseCase struct {
+	repository  domain.EventRepository
+	service     domain.EventService
+	mutex       sync.RWMutex
+	subscribers []eventSubscription
+	outbox      map[string]eventSubscription
+}
+
+// NewEventUseCase to create new EventUseCase
+func NewEventUseCase(repository domain.EventRepository) EventUseCase {
+	return &eventUseCase{
+		repository: repository,
+		service:    domain.GetDefaultEventService(repository),
+		outbox:     make(map[string]eventSubscription),
+	}
+}
+
+// Subscribe to register handler called synchronously after Transaction is committed, handler is subscribed to all events
+// when names are not provided and its errors are only logged
+func (uc *eventUseCase) Subscribe(handler domain.EventHandler, names ...string) {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+	uc.subscribers = append(uc.subscribers, eventSubscription{names: names, handler: handler})
+}
+
+// SubscribeAsync to register handler called by ProcessOutbox, events are stored in outbox for subscriber
+// until they are handled, so they survive restart of process and failed handling is retried
+func (uc *eventUseCase) SubscribeAsync(subscriber string, handler domain.EventHandler, names ...string) error {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+	if _, ok := uc.outbox[subscriber]; ok {
+		return fmt.Errorf("subscriber %s already registered", subscriber)
+	}
+	uc.outbox[subscriber] = eventSubscription{names: names, handler: handler}
+	return nil
+}
+
+// Transaction to run change in transaction, events of change are stored in outbox for asynchronous
+// subscribers in the same transaction, so they are stored only when change is committed, and synchronous
+// subscribers are called after commit
+func (uc *eventUseCase) Transaction(change EventChange) error {
+	tx, err := uc.repository.Begin()
+	if err != nil {
+		return err
+	}
+	events, err := change(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	service := domain.GetDefaultEventService(uc.repository.WithTransaction(tx))
+	now := time.Now()
+	for _, event := range events {
+		if _, err := service.AddToOutbox(event, uc.getAsyncSubscribers(event), now); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		for _, handler := range uc.getHandlers(event) {
+			if err := handler(event); err != nil {
+				log.Printf("Handling %s failed: %v", event.EventName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// getHandlers to get synchronous handlers subscribed to event
+func (uc *eventUseCase) getHandlers(event domain.DomainEvent) []domain.EventHandler {
+	uc.mutex.RLock()
+	defer uc.mutex.RUnlock()
+	handlers := []domain.EventHandler{}
+	for _, subscription := range uc.subscribers {
+		if subscription.matches(event) {
+			handlers = append(handlers, subscription.handler)
+		}
+	}
+	return handlers
+}
+
+// getAsyncSubscribers to get sorted names of asynchronous subscribers subscribed to event
+func (uc *eventUseCase) getAsyncSubscribers(event domain.DomainEvent) []string {
+	uc.mutex.RLock()
+	defer uc.mutex.RUnlock()
+	subscribers := []string{}
+	for subscriber, subscription := range uc.outbox {
+		if subscription.matches(event) {
+			subscribers = append(subscribers, subscriber)
+		}
+	}
+	sort.Strings(subscribers)
+	return subscribers
+}
+
+// ProcessOutbox to pass due outbox events to asynchronous subscribers
+func (uc *eventUseCase) ProcessOutbox(now time.Time) (int, error) {
+	uc.mutex.RLock()
+	handlers := make(map[string]domain.EventHandler)
+	for subscriber, subscription := range uc.outbox {
+		handlers[subscriber] = subscription.handler
+	}
+	uc.mutex.RUnlock()
+
+	count, err := uc.service.ProcessOutbox(now, handlers)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
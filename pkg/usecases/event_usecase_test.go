@@ -0,0 +1,359 @@
+package usecases_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"go-issue-tracker/pkg/usecases"
+	ucTesting "go-issue-tracker/pkg/usecases/testing"
+	"testing"
+	"time"
+)
+
+func prepareEventServiceMock() *dTesting.EventServiceMock {
+	ms := new(dTesting.EventServiceMock)
+	domain.GetDefaultEventService = func(r domain.EventRepository) domain.EventService {
+		return ms
+	}
+	return ms
+}
+
+func TestUseCaseEventNewEventUseCase(t *testing.T) {
+	uc := usecases.NewEventUseCase(new(dTesting.EventRepositoryMock))
+
+	assert.NotNil(t, uc)
+}
+
+func TestUseCaseEventTransaction(t *testing.T) {
+	created := domain.IssueCreated{Issue: domain.Issue{ID: 1, ProjectID: 1}}
+	removed := domain.ProjectRemoved{Project: domain.Project{ID: 1}}
+
+	ms := prepareEventServiceMock()
+	defer domain.ResetDefaultEventService()
+	ms.On("AddToOutbox", created, []string{"audit", "webhooks"}, mock.AnythingOfType("time.Time")).Return(2, nil)
+	ms.On("AddToOutbox", removed, []string{"webhooks"}, mock.AnythingOfType("time.Time")).Return(0, errors.New("test error"))
+
+	tx := new(dTesting.TransactionMock)
+	tx.On("Commit").Return(nil).Once()
+	tx.On("Rollback").Return(nil).Twice()
+
+	mr := new(dTesting.EventRepositoryMock)
+	mr.On("Begin").Return(tx, nil).Times(3)
+	mr.On("Begin").Return(nil, errors.New("begin error")).Once()
+
+	uc := usecases.NewEventUseCase(mr)
+
+	all := []string{}
+	issues := []string{}
+	uc.Subscribe(func(event domain.DomainEvent) error {
+		all = append(all, event.EventName())
+		return errors.New("handler error")
+	})
+	uc.Subscribe(func(event domain.DomainEvent) error {
+		issues = append(issues, event.EventName())
+		return nil
+	}, domain.EventIssueCreated, domain.EventIssueRemoved)
+
+	handler := func(event domain.DomainEvent) error {
+		return nil
+	}
+	assert.Nil(t, uc.SubscribeAsync("webhooks", handler))
+	assert.Nil(t, uc.SubscribeAsync("audit", handler, domain.EventIssueCreated))
+	assert.Equal(t, "subscriber audit already registered", uc.SubscribeAsync("audit", handler).Error())
+
+	err := uc.Transaction(func(change domain.Transaction) ([]domain.DomainEvent, error) {
+		assert.Equal(t, tx, change)
+		return []domain.DomainEvent{created}, nil
+	})
+
+	assert.Nil(t, err)
+
+	err = uc.Transaction(func(change domain.Transaction) ([]domain.DomainEvent, error) {
+		return []domain.DomainEvent{removed}, nil
+	})
+
+	assert.Equal(t, "test error", err.Error())
+
+	err = uc.Transaction(func(change domain.Transaction) ([]domain.DomainEvent, error) {
+		return nil, errors.New("change error")
+	})
+
+	assert.Equal(t, "change error", err.Error())
+
+	err = uc.Transaction(func(change domain.Transaction) ([]domain.DomainEvent, error) {
+		t.Error("change must not run without transaction")
+		return nil, nil
+	})
+
+	assert.Equal(t, "begin error", err.Error())
+	assert.Equal(t, []string{domain.EventIssueCreated}, all)
+	assert.Equal(t, []string{domain.EventIssueCreated}, issues)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+func TestUseCaseEventTransactionCommitErr(t *testing.T) {
+	created := domain.IssueCreated{Issue: domain.Issue{ID: 1, ProjectID: 1}}
+
+	ms := prepareEventServiceMock()
+	defer domain.ResetDefaultEventService()
+	ms.On("AddToOutbox", created, []string{}, mock.AnythingOfType("time.Time")).Return(0, nil)
+
+	tx := new(dTesting.TransactionMock)
+	tx.On("Commit").Return(errors.New("commit error"))
+
+	mr := new(dTesting.EventRepositoryMock)
+	mr.On("Begin").Return(tx, nil)
+
+	uc := usecases.NewEventUseCase(mr)
+	called := false
+	uc.Subscribe(func(event domain.DomainEvent) error {
+		called = true
+		return nil
+	})
+
+	err := uc.Transaction(func(change domain.Transaction) ([]domain.DomainEvent, error) {
+		return []domain.DomainEvent{created}, nil
+	})
+
+	assert.Equal(t, "commit error", err.Error())
+	assert.False(t, called)
+
+	ms.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+func TestUseCaseEventProcessOutbox(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	ms := prepareEventServiceMock()
+	defer domain.ResetDefaultEventService()
+	ms.On("ProcessOutbox", now, mock.MatchedBy(func(handlers map[string]domain.EventHandler) bool {
+		return len(handlers) == 1 && handlers["webhooks"] != nil
+	})).Return(3, nil).Once()
+	ms.On("ProcessOutbox", now, mock.Anything).Return(0, errors.New("test error")).Once()
+
+	uc := usecases.NewEventUseCase(new(dTesting.EventRepositoryMock))
+	uc.SubscribeAsync("webhooks", func(event domain.DomainEvent) error {
+		return nil
+	})
+
+	count, err := uc.ProcessOutbox(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+
+	_, err = uc.ProcessOutbox(now)
+
+	assert.Equal(t, "test error", err.Error())
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseEventIssueEvents(t *testing.T) {
+	p := domain.Project{ID: 1}
+	i := domain.Issue{ID: 1, Title: "Bug", Status: 1, ProjectID: 1, Project: p}
+	iu := i
+	iu.Status = 2
+	iu.Labels = []domain.Label{}
+	im := i
+	im.ProjectID = 2
+	im.Project = domain.Project{ID: 2}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("Add", &domain.Issue{Title: "Bug", Status: 1, ProjectID: 1, Project: p}).Return(&i, nil)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("Update", iu).Return(iu, nil)
+	ms.On("Move", i, im.Project).Return(im, nil)
+	ms.On("Remove", i).Return(true, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	eucm := new(ucTesting.EventUseCaseMock)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueCreated{Issue: i}}).Return(nil)
+	eucm.On("Transaction", []domain.DomainEvent{
+		domain.IssueUpdated{Issue: iu, Changes: domain.GetIssueChanges(i, iu)},
+		domain.IssueStatusChanged{Issue: iu, PreviousStatus: 1},
+	}).Return(nil)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueMoved{Issue: im, PreviousProjectID: 1}}).Return(nil)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueRemoved{Issue: i}}).Return(errors.New("test error"))
+
+	uc := usecases.NewIssueUseCase(new(dTesting.IssueRepositoryMock), nil, eucm)
+
+	_, err := uc.Add("Bug", "", 1, 0, 0, "", "", p, map[string]domain.Label{})
+
+	assert.Nil(t, err)
+
+	_, err = uc.Update(uint(1), "Bug", "", 2, 0, 0, "", "", map[string]domain.Label{})
+
+	assert.Nil(t, err)
+
+	_, err = uc.Move(uint(1), im.Project)
+
+	assert.Nil(t, err)
+
+	_, err = uc.Remove(uint(1))
+
+	assert.Equal(t, "test error", err.Error())
+
+	ms.AssertExpectations(t)
+	eucm.AssertExpectations(t)
+	eucm.AssertNumberOfCalls(t, "Transaction", 4)
+}
+
+func TestUseCaseEventLabelEvents(t *testing.T) {
+	l := domain.Label{ID: 1, Name: "bug"}
+	target := domain.Label{ID: 2, Name: "defect", ProjectID: 1}
+
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("Add", &domain.Label{Name: "bug"}).Return(&l, nil)
+	ms.On("FindByID", uint(1)).Return(l, nil)
+	ms.On("FindByID", uint(2)).Return(target, nil)
+	ms.On("Merge", l, target).Return(domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3}, nil)
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	eucm := new(ucTesting.EventUseCaseMock)
+	eucm.On("Transaction", []domain.DomainEvent{domain.LabelCreated{Label: l}}).Return(nil)
+	eucm.On("Transaction", []domain.DomainEvent{domain.LabelRemoved{Label: l}}).Return(nil)
+
+	uc := usecases.NewLabelUseCase(new(dTesting.LabelRepositoryMock), eucm)
+
+	_, err := uc.Add("bug", "", 0)
+
+	assert.Nil(t, err)
+
+	_, err = uc.Merge(uint(1), uint(2))
+
+	assert.Nil(t, err)
+
+	ms.AssertExpectations(t)
+	eucm.AssertExpectations(t)
+}
+
+func TestUseCaseEventProjectEvents(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "Tracker"}
+	pu := p
+	pu.Description = "Issues"
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("Update", pu).Return(pu, nil)
+	ms.On("Remove", uint(1)).Return(true, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	eucm := new(ucTesting.EventUseCaseMock)
+	eucm.On("Transaction", []domain.DomainEvent{domain.ProjectUpdated{Project: pu}}).Return(nil)
+	eucm.On("Transaction", []domain.DomainEvent{domain.ProjectRemoved{Project: p}}).Return(nil)
+
+	uc := usecases.NewProjectUseCase(new(dTesting.ProjectRepositoryMock), nil, eucm)
+
+	_, err := uc.Update(uint(1), pu.Name, pu.Description)
+
+	assert.Nil(t, err)
+
+	_, err = uc.Remove(uint(1))
+
+	assert.Nil(t, err)
+
+	ms.AssertExpectations(t)
+	eucm.AssertExpectations(t)
+}
+
+func TestUseCaseEventIssueTimeAndAttachmentEvents(t *testing.T) {
+	p := domain.Project{ID: 1}
+	i := domain.Issue{ID: 1, ProjectID: 1, Project: p, RemainingEstimate: 60}
+	logged := i
+	logged.TimeSpent = 30
+	logged.RemainingEstimate = 30
+	w := domain.WorkLog{ID: 2, IssueID: 1, Author: "alice", Minutes: 30}
+	a := domain.Attachment{ID: 3, IssueID: 1, Filename: "log.txt"}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(i, nil).Once()
+	ms.On("AddWorkLog", i, &domain.WorkLog{Author: "alice", Minutes: 30}).Return(&w, nil)
+	ms.On("FindByID", uint(1)).Return(logged, nil).Once()
+	ms.On("FindWorkLogByID", uint(2)).Return(w, nil)
+	ms.On("FindByID", uint(1)).Return(logged, nil).Once()
+	ms.On("RemoveWorkLog", logged, w).Return(true, nil)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("AddAttachment", i, &domain.Attachment{Filename: "log.txt"}, []byte("log")).Return(&a, nil)
+	ms.On("FindAttachmentByID", uint(3)).Return(a, nil)
+	ms.On("RemoveAttachment", i, a).Return(true, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	eucm := new(ucTesting.EventUseCaseMock)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueUpdated{Issue: logged, Changes: domain.GetIssueChanges(i, logged)}}).Return(nil)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueUpdated{Issue: i, Changes: domain.GetIssueChanges(logged, i)}}).Return(nil)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueUpdated{Issue: i, Changes: []domain.IssueChange{{Field: "attachments", Current: "log.txt"}}}}).Return(nil)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueUpdated{Issue: i, Changes: []domain.IssueChange{{Field: "attachments", Previous: "log.txt"}}}}).Return(errors.New("test error"))
+
+	uc := usecases.NewIssueUseCase(new(dTesting.IssueRepositoryMock), nil, eucm)
+
+	_, err := uc.AddWorkLog(1, "alice", 30, time.Time{}, "")
+
+	assert.Nil(t, err)
+
+	_, err = uc.RemoveWorkLog(2)
+
+	assert.Nil(t, err)
+
+	_, err = uc.AddAttachment(1, "log.txt", []byte("log"))
+
+	assert.Nil(t, err)
+
+	status, err := uc.RemoveAttachment(3)
+
+	assert.Equal(t, "test error", err.Error())
+	assert.False(t, status)
+
+	ms.AssertExpectations(t)
+	eucm.AssertExpectations(t)
+	eucm.AssertNumberOfCalls(t, "Transaction", 4)
+}
+
+func TestUseCaseEventIssueChainEvents(t *testing.T) {
+	p := domain.Project{ID: 2}
+	i := domain.Issue{ID: 1, ProjectID: 1}
+	moved := domain.Issue{ID: 1, ProjectID: 2, Project: p}
+	chain := &domain.AutomationChain{Depth: 1, Fired: map[uint]bool{1: true}}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("Move", i, p).Return(moved, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	eucm := new(ucTesting.EventUseCaseMock)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueMoved{Issue: moved, PreviousProjectID: 1, Chain: chain}}).Return(nil).Once()
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueMoved{Issue: moved, PreviousProjectID: 1}}).Return(nil).Once()
+
+	uc := usecases.NewIssueUseCase(new(dTesting.IssueRepositoryMock), nil, eucm)
+
+	_, err := uc.WithChain(chain).Move(1, p)
+
+	assert.Nil(t, err)
+
+	_, err = uc.Move(1, p)
+
+	assert.Nil(t, err)
+
+	ms.AssertExpectations(t)
+	eucm.AssertExpectations(t)
+}
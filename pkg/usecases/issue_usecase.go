@@ -2,70 +2,329 @@ package usecases
 
 import (
 	"go-issue-tracker/pkg/domain"
+	"log"
+	"time"
 )
 
 // IssueUseCase interface
 type IssueUseCase interface {
-	Add(title string, description string, status int, project domain.Project, labels map[string]domain.Label) (*domain.Issue, error)
-	Update(id uint, title string, description string, status int, labels map[string]domain.Label) (domain.Issue, error)
+	Add(title string, description string, status int, priority int, severity int, reporter string, assignee string, project domain.Project, labels map[string]domain.Label) (*domain.Issue, error)
+	AddFromTemplate(title string, description string, status int, priority int, severity int, reporter string, assignee string, project domain.Project, labels map[string]domain.Label, template domain.IssueTemplate) (*domain.Issue, error)
+	Update(id uint, title string, description string, status int, priority int, severity int, reporter string, assignee string, labels map[string]domain.Label) (domain.Issue, error)
+	BulkUpdate(ids []uint, operation domain.IssueBulkOperation) ([]domain.IssueBulkResult, error)
+	BulkUpdateFound(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions, operation domain.IssueBulkOperation) ([]domain.IssueBulkResult, error)
+	Move(id uint, project domain.Project) (domain.Issue, error)
+	SetCustomFields(id uint, fields []domain.CustomField, values map[string]string) (domain.Issue, error)
 	FindByID(id uint) (domain.Issue, error)
-	Find(title string, projectID uint, labels []string) ([]domain.Issue, error)
+	FindByProjectAndID(projectID uint, id uint) (domain.Issue, error)
+	Find(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions) ([]domain.Issue, error)
 	FindAll() ([]domain.Issue, error)
 	Remove(id uint) (bool, error)
+	MigratePriorityLabels() (int, error)
+	CheckSLA(now time.Time) (int, error)
+	SetEstimates(id uint, originalEstimate int, remainingEstimate int) (domain.Issue, error)
+	AddWorkLog(issueID uint, author string, minutes int, loggedAt time.Time, comment string) (*domain.WorkLog, error)
+	RemoveWorkLog(id uint) (bool, error)
+	FindWorkLogByID(id uint) (domain.WorkLog, error)
+	FindWorkLogs(issueID uint) ([]domain.WorkLog, error)
+	FindTimeSummary(projectID uint) (domain.TimeSummary, error)
+	FindTimesheet(projectID uint, from time.Time, to time.Time) ([]domain.TimesheetEntry, error)
+	AddAttachment(issueID uint, filename string, content []byte) (*domain.Attachment, error)
+	RemoveAttachment(id uint) (bool, error)
+	FindAttachmentByID(id uint) (domain.Attachment, error)
+	FindAttachments(issueID uint) ([]domain.Attachment, error)
+	ReadAttachment(id uint) (domain.Attachment, []byte, error)
+	WithChain(chain *domain.AutomationChain) IssueUseCase
 }
 
 // IssueUseCase struct
 type issueUseCase struct {
-	service domain.IssueService
+	repository    domain.IssueRepository
+	service       domain.IssueService
+	notifications NotificationUseCase
+	events        EventUseCase
+	chain         *domain.AutomationChain
 }
 
-// NewIssueUseCase to create new IssueUseCase
-func NewIssueUseCase(repository domain.IssueRepository) IssueUseCase {
+// NewIssueUseCase to create new IssueUseCase, watchers are not notified about changes when
+// notifications are nil and no domain events are published when events are nil
+func NewIssueUseCase(repository domain.IssueRepository, notifications NotificationUseCase, events EventUseCase) IssueUseCase {
 	return &issueUseCase{
-		service: domain.GetDefaultIssueService(repository),
+		repository:    repository,
+		service:       domain.GetDefaultIssueService(repository),
+		notifications: notifications,
+		events:        events,
 	}
 }
 
-// Add to add new issue
-func (uc *issueUseCase) Add(title string, description string, status int, project domain.Project, labels map[string]domain.Label) (*domain.Issue, error) {
+// WithChain to get IssueUseCase which publishes events of its changes as part of automation chain
+func (uc *issueUseCase) WithChain(chain *domain.AutomationChain) IssueUseCase {
+	chained := *uc
+	chained.chain = chain
+	return &chained
+}
+
+// transaction to run change by service bound to transaction, domain events returned by change are
+// published in the same transaction, change runs without transaction when events are nil
+func (uc *issueUseCase) transaction(change func(service domain.IssueService) ([]domain.DomainEvent, error)) error {
+	if uc.events == nil {
+		_, err := change(uc.service)
+		return err
+	}
+	return uc.events.Transaction(func(tx domain.Transaction) ([]domain.DomainEvent, error) {
+		events, err := change(domain.GetDefaultIssueService(uc.repository.WithTransaction(tx)))
+		if uc.chain != nil {
+			for i, event := range events {
+				events[i] = domain.SetEventChain(event, uc.chain)
+			}
+		}
+		return events, err
+	})
+}
+
+// notify to notify watchers of issue about event and changed fields, failed notification does not fail
+// committed change
+func (uc *issueUseCase) notify(issue domain.Issue, event string, changes []domain.IssueChange) {
+	if uc.notifications == nil {
+		return
+	}
+	if _, err := uc.notifications.NotifyIssue(issue, event, changes); err != nil {
+		log.Printf("Notifying watchers of issue %d failed: %v", issue.ID, err)
+	}
+}
+
+// bulkUpdate to apply operation to issues and publish events of changed issues, watchers of changed
+// issues are notified after commit
+func (uc *issueUseCase) bulkUpdate(items []domain.Issue, operation domain.IssueBulkOperation) ([]domain.IssueBulkResult, error) {
+	var results []domain.IssueBulkResult
+	err := uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if results, err = service.BulkUpdate(append([]domain.Issue{}, items...), operation); err != nil {
+			return nil, err
+		}
+		events := []domain.DomainEvent{}
+		for _, item := range getBulkApplied(items, results) {
+			previous := item
+			operation.Apply(&item)
+			events = append(events, domain.GetIssueEvents(previous, item)...)
+		}
+		return events, nil
+	})
+	if err != nil {
+		return results, err
+	}
+
+	for _, item := range getBulkApplied(items, results) {
+		event := domain.NotificationEventUpdated
+		if operation.Status != nil && *operation.Status != item.Status {
+			event = domain.NotificationEventStatusChanged
+		}
+		previous := item
+		operation.Apply(&item)
+		uc.notify(item, event, domain.GetIssueChanges(previous, item))
+	}
+	return results, nil
+}
+
+// getBulkApplied to get issues bulk operation was applied to
+func getBulkApplied(items []domain.Issue, results []domain.IssueBulkResult) []domain.Issue {
+	applied := make(map[uint]bool)
+	for _, result := range results {
+		applied[result.ID] = result.Status
+	}
+	issues := []domain.Issue{}
+	for _, item := range items {
+		if applied[item.ID] {
+			issues = append(issues, item)
+		}
+	}
+	return issues
+}
+
+// Add to add new issue, reporter and assignee watch it in the same transaction
+func (uc *issueUseCase) Add(title string, description string, status int, priority int, severity int, reporter string, assignee string, project domain.Project, labels map[string]domain.Label) (*domain.Issue, error) {
 	item := new(domain.Issue)
 	item.Title = title
 	item.Description = description
 	item.Status = status
+	item.Priority = priority
+	item.Severity = severity
+	item.Reporter = reporter
+	item.Assignee = assignee
 	item.ProjectID = project.ID
 	item.Project = project
 	for _, label := range labels {
 		item.Labels = append(item.Labels, label)
 	}
 
-	itemAdded, err := uc.service.Add(item)
+	var itemAdded *domain.Issue
+	err := uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemAdded, err = service.Add(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.IssueCreated{Issue: *itemAdded}}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	return itemAdded, nil
+}
+
+// AddFromTemplate to add new issue merging provided values with issue template
+func (uc *issueUseCase) AddFromTemplate(title string, description string, status int, priority int, severity int, reporter string, assignee string, project domain.Project, labels map[string]domain.Label, template domain.IssueTemplate) (*domain.Issue, error) {
+	item := new(domain.Issue)
+	item.Title = title
+	item.Description = description
+	item.Status = status
+	item.Priority = priority
+	item.Severity = severity
+	item.Reporter = reporter
+	item.Assignee = assignee
+	item.ProjectID = project.ID
+	item.Project = project
+	for _, label := range labels {
+		item.Labels = append(item.Labels, label)
+	}
+	if err := template.Apply(item); err != nil {
+		return nil, err
+	}
 
+	var itemAdded *domain.Issue
+	err := uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemAdded, err = service.Add(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.IssueCreated{Issue: *itemAdded}}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return itemAdded, nil
 }
 
-// Update to update issue
-func (uc *issueUseCase) Update(id uint, title string, description string, status int, labels map[string]domain.Label) (domain.Issue, error) {
+// Update to update issue, added label of exclusive group replaces label of that group, new reporter or
+// assignee watches issue in the same transaction
+func (uc *issueUseCase) Update(id uint, title string, description string, status int, priority int, severity int, reporter string, assignee string, labels map[string]domain.Label) (domain.Issue, error) {
 	item, err := uc.service.FindByID(id)
 	if err != nil {
 		return item, err
 	}
 
+	previous := item
+	event := domain.NotificationEventUpdated
+	if item.Status != status {
+		event = domain.NotificationEventStatusChanged
+	}
+
 	item.Title = title
 	item.Description = description
 	item.Status = status
+	item.Priority = priority
+	item.Severity = severity
+	item.Reporter = reporter
+	item.Assignee = assignee
 	item.Labels = []domain.Label{}
 	for _, label := range labels {
 		item.Labels = append(item.Labels, label)
 	}
+	item.Labels = domain.ReplaceExclusiveLabels(previous.Labels, item.Labels)
+
+	var itemUpdated domain.Issue
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemUpdated, err = service.Update(item); err != nil {
+			return nil, err
+		}
+		return domain.GetIssueEvents(previous, itemUpdated), nil
+	})
+	if err != nil {
+		return itemUpdated, err
+	}
+
+	uc.notify(itemUpdated, event, domain.GetIssueChanges(previous, itemUpdated))
+	return itemUpdated, nil
+}
+
+// BulkUpdate to apply operation to issues with provided IDs
+func (uc *issueUseCase) BulkUpdate(ids []uint, operation domain.IssueBulkOperation) ([]domain.IssueBulkResult, error) {
+	items := []domain.Issue{}
+	results := make([]domain.IssueBulkResult, len(ids))
+	valid := true
+	for i, id := range ids {
+		results[i] = domain.IssueBulkResult{
+			ID:    id,
+			Error: "not applied",
+		}
+		item, err := uc.service.FindByID(id)
+		if err != nil {
+			results[i].Error = err.Error()
+			valid = false
+			continue
+		}
+		items = append(items, item)
+	}
+	if !valid {
+		return results, domain.NewValidationError("bulk operation not valid")
+	}
+
+	return uc.bulkUpdate(items, operation)
+}
+
+// BulkUpdateFound to apply operation to issues matching provided filter
+func (uc *issueUseCase) BulkUpdateFound(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions, operation domain.IssueBulkOperation) ([]domain.IssueBulkResult, error) {
+	items, err := uc.service.Find(title, projectID, labels, customFields, options)
+	if err != nil {
+		return []domain.IssueBulkResult{}, err
+	}
 
-	itemUpdated, err := uc.service.Update(item)
+	return uc.bulkUpdate(items, operation)
+}
+
+// Move to move issue to another project
+func (uc *issueUseCase) Move(id uint, project domain.Project) (domain.Issue, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	var itemMoved domain.Issue
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemMoved, err = service.Move(item, project); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.IssueMoved{Issue: itemMoved, PreviousProjectID: item.ProjectID}}, nil
+	})
+	if err != nil {
+		return itemMoved, err
+	}
+
+	uc.notify(itemMoved, domain.NotificationEventUpdated, domain.GetIssueChanges(item, itemMoved))
+	return itemMoved, nil
+}
+
+// SetCustomFields to set values of custom fields of issue, fields are custom fields of issue project
+func (uc *issueUseCase) SetCustomFields(id uint, fields []domain.CustomField, values map[string]string) (domain.Issue, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	var itemUpdated domain.Issue
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemUpdated, err = service.SetCustomFields(item, fields, values); err != nil {
+			return nil, err
+		}
+		return domain.GetIssueEvents(item, itemUpdated), nil
+	})
 	if err != nil {
 		return itemUpdated, err
 	}
 
+	uc.notify(itemUpdated, domain.NotificationEventUpdated, domain.GetIssueChanges(item, itemUpdated))
 	return itemUpdated, nil
 }
 
@@ -78,9 +337,18 @@ func (uc *issueUseCase) FindByID(id uint) (domain.Issue, error) {
 	return item, nil
 }
 
+// FindByProjectAndID to find issue by project and ID
+func (uc *issueUseCase) FindByProjectAndID(projectID uint, id uint) (domain.Issue, error) {
+	item, err := uc.service.FindByProjectAndID(projectID, id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
 // Find to find issues
-func (uc *issueUseCase) Find(title string, projectID uint, labels []string) ([]domain.Issue, error) {
-	items, err := uc.service.Find(title, projectID, labels)
+func (uc *issueUseCase) Find(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions) ([]domain.Issue, error) {
+	items, err := uc.service.Find(title, projectID, labels, customFields, options)
 	if err != nil {
 		return items, err
 	}
@@ -98,9 +366,291 @@ func (uc *issueUseCase) FindAll() ([]domain.Issue, error) {
 
 // Remove to remove issue
 func (uc *issueUseCase) Remove(id uint) (bool, error) {
-	status, err := uc.service.Remove(id)
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return false, err
+	}
+
+	var status bool
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if status, err = service.Remove(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.IssueRemoved{Issue: item}}, nil
+	})
 	if err != nil {
 		return status, err
 	}
 	return status, nil
 }
+
+// MigratePriorityLabels to move priority labels of issues into issue priority, issues are migrated one by one,
+// each in own transaction with its events, it returns number of migrated issues
+func (uc *issueUseCase) MigratePriorityLabels() (int, error) {
+	items, err := uc.service.FindAll()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, item := range items {
+		migrated, labels, ok := item.MigratePriorityLabels()
+		if !ok {
+			continue
+		}
+		err := uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+			if _, err := service.MigratePriority(migrated, labels); err != nil {
+				return nil, err
+			}
+			return domain.GetIssueEvents(item, migrated), nil
+		})
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// CheckSLA to flag issues breaching SLA of their project at provided time, issues whose due date or SLA
+// status changed are updated one by one, each in own transaction with its events, it returns number of
+// updated issues
+func (uc *issueUseCase) CheckSLA(now time.Time) (int, error) {
+	items, err := uc.service.FindAll()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, item := range items {
+		checked, changed := item.CheckSLA(now)
+		if !changed {
+			continue
+		}
+		err := uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+			itemUpdated, err := service.UpdateSLA(checked)
+			if err != nil {
+				return nil, err
+			}
+			return domain.GetIssueEvents(item, itemUpdated), nil
+		})
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// SetEstimates to set original and remaining estimate of issue in minutes
+func (uc *issueUseCase) SetEstimates(id uint, originalEstimate int, remainingEstimate int) (domain.Issue, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	var itemUpdated domain.Issue
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemUpdated, err = service.SetEstimates(item, originalEstimate, remainingEstimate); err != nil {
+			return nil, err
+		}
+		return domain.GetIssueEvents(item, itemUpdated), nil
+	})
+	if err != nil {
+		return itemUpdated, err
+	}
+
+	uc.notify(itemUpdated, domain.NotificationEventUpdated, domain.GetIssueChanges(item, itemUpdated))
+	return itemUpdated, nil
+}
+
+// AddWorkLog to log time spent on issue in minutes
+func (uc *issueUseCase) AddWorkLog(issueID uint, author string, minutes int, loggedAt time.Time, comment string) (*domain.WorkLog, error) {
+	issue, err := uc.service.FindByID(issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := new(domain.WorkLog)
+	item.Author = author
+	item.Minutes = minutes
+	item.LoggedAt = loggedAt
+	item.Comment = comment
+
+	var itemAdded *domain.WorkLog
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemAdded, err = service.AddWorkLog(issue, item); err != nil {
+			return nil, err
+		}
+		return uc.getTimeEvents(service, issue)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if itemAdded.Comment != "" {
+		uc.notify(issue, domain.NotificationEventCommented, nil)
+	} else {
+		uc.notify(issue, domain.NotificationEventUpdated, nil)
+	}
+	return itemAdded, nil
+}
+
+// RemoveWorkLog to remove work log
+func (uc *issueUseCase) RemoveWorkLog(id uint) (bool, error) {
+	workLog, err := uc.service.FindWorkLogByID(id)
+	if err != nil {
+		return false, err
+	}
+	issue, err := uc.service.FindByID(workLog.IssueID)
+	if err != nil {
+		return false, err
+	}
+
+	var status bool
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if status, err = service.RemoveWorkLog(issue, workLog); err != nil {
+			return nil, err
+		}
+		return uc.getTimeEvents(service, issue)
+	})
+	if err != nil {
+		return false, err
+	}
+	return status, nil
+}
+
+// getTimeEvents to get events of issue whose time spent or remaining estimate was changed by work log,
+// issue is found again by service bound to transaction of the change
+func (uc *issueUseCase) getTimeEvents(service domain.IssueService, previous domain.Issue) ([]domain.DomainEvent, error) {
+	item, err := service.FindByID(previous.ID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.GetIssueEvents(previous, item), nil
+}
+
+// FindWorkLogByID to find work log by ID
+func (uc *issueUseCase) FindWorkLogByID(id uint) (domain.WorkLog, error) {
+	item, err := uc.service.FindWorkLogByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindWorkLogs to find work logs of issue
+func (uc *issueUseCase) FindWorkLogs(issueID uint) ([]domain.WorkLog, error) {
+	items, err := uc.service.FindWorkLogs(issueID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// FindTimeSummary to sum estimates and time spent of issues of project
+func (uc *issueUseCase) FindTimeSummary(projectID uint) (domain.TimeSummary, error) {
+	item, err := uc.service.FindTimeSummary(projectID)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindTimesheet to sum time logged in time range per author and week
+func (uc *issueUseCase) FindTimesheet(projectID uint, from time.Time, to time.Time) ([]domain.TimesheetEntry, error) {
+	items, err := uc.service.FindTimesheet(projectID, from, to)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// AddAttachment to attach file to issue
+func (uc *issueUseCase) AddAttachment(issueID uint, filename string, content []byte) (*domain.Attachment, error) {
+	issue, err := uc.service.FindByID(issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := new(domain.Attachment)
+	item.Filename = filename
+
+	var itemAdded *domain.Attachment
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemAdded, err = service.AddAttachment(issue, item, content); err != nil {
+			return nil, err
+		}
+		change := domain.IssueChange{Field: "attachments", Current: itemAdded.Filename}
+		return []domain.DomainEvent{domain.IssueUpdated{Issue: issue, Changes: []domain.IssueChange{change}}}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notify(issue, domain.NotificationEventUpdated, nil)
+	return itemAdded, nil
+}
+
+// RemoveAttachment to remove attachment together with its file
+func (uc *issueUseCase) RemoveAttachment(id uint) (bool, error) {
+	attachment, err := uc.service.FindAttachmentByID(id)
+	if err != nil {
+		return false, err
+	}
+	issue, err := uc.service.FindByID(attachment.IssueID)
+	if err != nil {
+		return false, err
+	}
+
+	var status bool
+	err = uc.transaction(func(service domain.IssueService) ([]domain.DomainEvent, error) {
+		var err error
+		if status, err = service.RemoveAttachment(issue, attachment); err != nil {
+			return nil, err
+		}
+		change := domain.IssueChange{Field: "attachments", Previous: attachment.Filename}
+		return []domain.DomainEvent{domain.IssueUpdated{Issue: issue, Changes: []domain.IssueChange{change}}}, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return status, nil
+}
+
+// FindAttachmentByID to find attachment by ID
+func (uc *issueUseCase) FindAttachmentByID(id uint) (domain.Attachment, error) {
+	item, err := uc.service.FindAttachmentByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAttachments to find attachments of issue
+func (uc *issueUseCase) FindAttachments(issueID uint) ([]domain.Attachment, error) {
+	items, err := uc.service.FindAttachments(issueID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// ReadAttachment to find attachment by ID and read its content
+func (uc *issueUseCase) ReadAttachment(id uint) (domain.Attachment, []byte, error) {
+	item, err := uc.service.FindAttachmentByID(id)
+	if err != nil {
+		return item, nil, err
+	}
+
+	content, err := uc.service.ReadAttachment(item)
+	if err != nil {
+		return item, nil, err
+	}
+	return item, content, nil
+}
@@ -6,7 +6,9 @@ import (
 	"go-issue-tracker/pkg/domain"
 	dTesting "go-issue-tracker/pkg/domain/testing"
 	"go-issue-tracker/pkg/usecases"
+	ucTesting "go-issue-tracker/pkg/usecases/testing"
 	"testing"
+	"time"
 )
 
 func TestUseCaseIssueNewIssueUseCase(t *testing.T) {
@@ -18,7 +20,7 @@ func TestUseCaseIssueNewIssueUseCase(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 }
@@ -39,6 +41,8 @@ func TestUseCaseIssueAdd(t *testing.T) {
 	i.Title = "test-title"
 	i.Description = "test-description"
 	i.Status = 1
+	i.Priority = domain.PriorityHigh
+	i.Severity = domain.SeverityMinor
 	i.ProjectID = p.ID
 	i.Project = p
 	i.Labels = []domain.Label{
@@ -57,11 +61,11 @@ func TestUseCaseIssueAdd(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.Add(i.Title, i.Description, i.Status, p, l)
+	item, err := uc.Add(i.Title, i.Description, i.Status, i.Priority, i.Severity, "", "", p, l)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
@@ -71,6 +75,83 @@ func TestUseCaseIssueAdd(t *testing.T) {
 	mr.AssertExpectations(t)
 }
 
+func TestUseCaseIssueAddFromTemplate(t *testing.T) {
+	p := domain.Project{
+		ID:   1,
+		Name: "test-name",
+	}
+	l := map[string]domain.Label{
+		"test-name": domain.Label{
+			ID:   1,
+			Name: "test-name",
+		},
+	}
+	status := 2
+	tpl := domain.IssueTemplate{
+		ID:          1,
+		ProjectID:   1,
+		TitlePrefix: "[Bug] ",
+		Description: "template-description",
+		Status:      &status,
+		Labels:      []domain.Label{{ID: 2, Name: "bug"}},
+	}
+	i := new(domain.Issue)
+	i.Title = "[Bug] test-title"
+	i.Description = "template-description"
+	i.Status = 2
+	i.ProjectID = p.ID
+	i.Project = p
+	i.Labels = []domain.Label{{ID: 1, Name: "test-name"}, {ID: 2, Name: "bug"}}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("Add", i).Return(i, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.AddFromTemplate("test-title", "", domain.IssueStatusDefault, domain.PriorityNone, domain.SeverityNone, "", "", p, l, tpl)
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueAddFromTemplateErr(t *testing.T) {
+	p := domain.Project{
+		ID:   1,
+		Name: "test-name",
+	}
+	tpl := domain.IssueTemplate{
+		ID:        1,
+		ProjectID: 2,
+	}
+
+	ms := new(dTesting.IssueServiceMock)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.AddFromTemplate("test-title", "", 1, domain.PriorityNone, domain.SeverityNone, "", "", p, map[string]domain.Label{}, tpl)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
 func TestUseCaseIssueAddErr(t *testing.T) {
 	p := domain.Project{
 		ID:          1,
@@ -95,11 +176,11 @@ func TestUseCaseIssueAddErr(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.Add(i.Title, i.Description, i.Status, p, l)
+	item, err := uc.Add(i.Title, i.Description, i.Status, i.Priority, i.Severity, "", "", p, l)
 
 	assert.NotNil(t, err)
 	assert.Nil(t, item)
@@ -128,6 +209,8 @@ func TestUseCaseIssueUpdate(t *testing.T) {
 	iff.Project = p
 	iff.Labels = []domain.Label{}
 	iu := iff
+	iu.Priority = domain.PriorityUrgent
+	iu.Severity = domain.SeverityBlocker
 	iu.Labels = []domain.Label{
 		domain.Label{
 			ID:   1,
@@ -145,11 +228,11 @@ func TestUseCaseIssueUpdate(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.Update(iff.ID, iff.Title, iff.Description, iff.Status, l)
+	item, err := uc.Update(iff.ID, iff.Title, iff.Description, iff.Status, iu.Priority, iu.Severity, "", "", l)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
@@ -160,6 +243,32 @@ func TestUseCaseIssueUpdate(t *testing.T) {
 	mr.AssertExpectations(t)
 }
 
+func TestUseCaseIssueUpdateExclusiveLabel(t *testing.T) {
+	g := &domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+	low := domain.Label{ID: 1, Name: "low", GroupID: 1, Group: g}
+	high := domain.Label{ID: 2, Name: "high", GroupID: 1, Group: g}
+	i := domain.Issue{ID: 1, Title: "test-title", Status: 1, Labels: []domain.Label{low}}
+	iu := i
+	iu.Labels = []domain.Label{high}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", i.ID).Return(i, nil)
+	ms.On("Update", iu).Return(iu, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	uc := usecases.NewIssueUseCase(new(dTesting.IssueRepositoryMock), nil, nil)
+
+	item, err := uc.Update(i.ID, i.Title, i.Description, i.Status, i.Priority, i.Severity, "", "", map[string]domain.Label{"low": low, "high": high})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.Label{high}, item.Labels)
+
+	ms.AssertExpectations(t)
+}
+
 func TestUseCaseIssueUpdateErr(t *testing.T) {
 	p := domain.Project{
 		ID:          1,
@@ -197,11 +306,11 @@ func TestUseCaseIssueUpdateErr(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.Update(iff.ID, iff.Title, iff.Description, iff.Status, l)
+	item, err := uc.Update(iff.ID, iff.Title, iff.Description, iff.Status, iff.Priority, iff.Severity, "", "", l)
 
 	assert.NotNil(t, err)
 	assert.NotNil(t, item)
@@ -229,11 +338,11 @@ func TestUseCaseIssueUpdateFindByIDErr(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.Update(1, "test-title", "test-description", 1, l)
+	item, err := uc.Update(1, "test-title", "test-description", 1, domain.PriorityNone, domain.SeverityNone, "", "", l)
 
 	assert.NotNil(t, err)
 	assert.NotNil(t, item)
@@ -243,6 +352,321 @@ func TestUseCaseIssueUpdateFindByIDErr(t *testing.T) {
 	mr.AssertExpectations(t)
 }
 
+func TestUseCaseIssueBulkUpdate(t *testing.T) {
+	i := domain.Issue{
+		ID:     1,
+		Status: 1,
+	}
+	status := 2
+	op := domain.IssueBulkOperation{
+		Status: &status,
+	}
+	results := []domain.IssueBulkResult{
+		{ID: 1, Status: true},
+	}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", i.ID).Return(i, nil)
+	ms.On("BulkUpdate", []domain.Issue{i}, op).Return(results, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	items, err := uc.BulkUpdate([]uint{i.ID}, op)
+
+	assert.Nil(t, err)
+	assert.Equal(t, results, items)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueBulkUpdateFindByIDErr(t *testing.T) {
+	i := domain.Issue{
+		ID: 1,
+	}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	items, err := uc.BulkUpdate([]uint{1, 2}, domain.IssueBulkOperation{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.IssueBulkResult{
+		{ID: 1, Status: false, Error: "not applied"},
+		{ID: 2, Status: false, Error: "record not found"},
+	}, items)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueBulkUpdateFound(t *testing.T) {
+	issues := []domain.Issue{
+		{
+			ID: 1,
+		},
+	}
+	status := 2
+	op := domain.IssueBulkOperation{
+		Status: &status,
+	}
+	results := []domain.IssueBulkResult{
+		{ID: 1, Status: true},
+	}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("Find", "test", uint(1), []string{"1"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(issues, nil)
+	ms.On("BulkUpdate", issues, op).Return(results, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	items, err := uc.BulkUpdateFound("test", uint(1), []string{"1"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}, op)
+
+	assert.Nil(t, err)
+	assert.Equal(t, results, items)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueBulkUpdateFoundErr(t *testing.T) {
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("Find", "test", uint(1), []string{}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return([]domain.Issue{}, errors.New("test error"))
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	items, err := uc.BulkUpdateFound("test", uint(1), []string{}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}, domain.IssueBulkOperation{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(items))
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueMove(t *testing.T) {
+	p := domain.Project{
+		ID: 2,
+	}
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 1,
+	}
+	moved := domain.Issue{
+		ID:        1,
+		ProjectID: 2,
+		Project:   p,
+	}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", i.ID).Return(i, nil)
+	ms.On("Move", i, p).Return(moved, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.Move(i.ID, p)
+
+	assert.Nil(t, err)
+	assert.Equal(t, moved, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueMoveErr(t *testing.T) {
+	p := domain.Project{
+		ID: 1,
+	}
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 1,
+	}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", i.ID).Return(i, nil)
+	ms.On("Move", i, p).Return(i, errors.New("test error"))
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	_, err := uc.Move(i.ID, p)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueSetCustomFields(t *testing.T) {
+	fields := []domain.CustomField{{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}}
+	values := map[string]string{"customer": "ACME"}
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 1,
+	}
+	updated := domain.Issue{
+		ID:           1,
+		ProjectID:    1,
+		CustomFields: []domain.CustomFieldValue{{IssueID: 1, FieldID: 1, Field: fields[0], Value: "ACME"}},
+	}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", i.ID).Return(i, nil)
+	ms.On("SetCustomFields", i, fields, values).Return(updated, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.SetCustomFields(i.ID, fields, values)
+
+	assert.Nil(t, err)
+	assert.Equal(t, updated, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueSetCustomFieldsErr(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 1,
+	}
+	values := map[string]string{"customer": "ACME"}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	ms.On("SetCustomFields", i, []domain.CustomField{}, values).Return(i, errors.New("custom field customer does not belong to issue project"))
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	_, err := uc.SetCustomFields(uint(2), []domain.CustomField{}, values)
+
+	assert.NotNil(t, err)
+
+	_, err = uc.SetCustomFields(uint(1), []domain.CustomField{}, values)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueMoveFindByIDErr(t *testing.T) {
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Issue{}, errors.New("test error"))
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	_, err := uc.Move(uint(1), domain.Project{ID: 2})
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueFindByProjectAndID(t *testing.T) {
+	i := domain.Issue{
+		ID:        1,
+		ProjectID: 2,
+	}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByProjectAndID", uint(1), i.ID).Return(i, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.FindByProjectAndID(uint(1), i.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, i, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseIssueFindByProjectAndIDErr(t *testing.T) {
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByProjectAndID", uint(1), uint(1)).Return(domain.Issue{}, errors.New("test error"))
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	_, err := uc.FindByProjectAndID(uint(1), uint(1))
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
 func TestUseCaseIssueFindByID(t *testing.T) {
 	i := domain.Issue{}
 	i.Title = "test-title"
@@ -260,7 +684,7 @@ func TestUseCaseIssueFindByID(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -291,7 +715,7 @@ func TestUseCaseIssueFindByIDErr(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -322,7 +746,7 @@ func TestUseCaseIssueFind(t *testing.T) {
 	}
 
 	ms := new(dTesting.IssueServiceMock)
-	ms.On("Find", "test", uint(1), []string{"test1", "test2"}).Return(issues, nil)
+	ms.On("Find", "test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(issues, nil)
 	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
 		return ms
 	}
@@ -330,11 +754,11 @@ func TestUseCaseIssueFind(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
-	items, err := uc.Find("test", uint(1), []string{"test1", "test2"})
+	items, err := uc.Find("test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{})
 
 	assert.Nil(t, err)
 	assert.NotNil(t, items)
@@ -348,7 +772,7 @@ func TestUseCaseIssueFindErr(t *testing.T) {
 	issues := []domain.Issue{}
 
 	ms := new(dTesting.IssueServiceMock)
-	ms.On("Find", "test", uint(1), []string{"test1", "test2"}).Return(issues, errors.New("test error"))
+	ms.On("Find", "test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{}).Return(issues, errors.New("test error"))
 	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
 		return ms
 	}
@@ -356,11 +780,11 @@ func TestUseCaseIssueFindErr(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
-	items, err := uc.Find("test", uint(1), []string{"test1", "test2"})
+	items, err := uc.Find("test", uint(1), []string{"test1", "test2"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{})
 
 	assert.NotNil(t, err)
 	assert.NotNil(t, items)
@@ -395,7 +819,7 @@ func TestUseCaseIssueFindAll(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -421,7 +845,7 @@ func TestUseCaseIssueFindAllErr(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -437,7 +861,8 @@ func TestUseCaseIssueFindAllErr(t *testing.T) {
 
 func TestUseCaseIssueRemove(t *testing.T) {
 	ms := new(dTesting.IssueServiceMock)
-	ms.On("Remove", uint(1)).Return(true, nil)
+	ms.On("FindByID", uint(1)).Return(domain.Issue{ID: 1}, nil)
+	ms.On("Remove", domain.Issue{ID: 1}).Return(true, nil)
 	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
 		return ms
 	}
@@ -445,7 +870,7 @@ func TestUseCaseIssueRemove(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -460,7 +885,8 @@ func TestUseCaseIssueRemove(t *testing.T) {
 
 func TestUseCaseIssueRemoveErr(t *testing.T) {
 	ms := new(dTesting.IssueServiceMock)
-	ms.On("Remove", uint(1)).Return(false, errors.New("test error"))
+	ms.On("FindByID", uint(1)).Return(domain.Issue{ID: 1}, nil)
+	ms.On("Remove", domain.Issue{ID: 1}).Return(false, errors.New("test error"))
 	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
 		return ms
 	}
@@ -468,7 +894,7 @@ func TestUseCaseIssueRemoveErr(t *testing.T) {
 
 	mr := new(dTesting.IssueRepositoryMock)
 
-	uc := usecases.NewIssueUseCase(mr)
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -480,3 +906,382 @@ func TestUseCaseIssueRemoveErr(t *testing.T) {
 	ms.AssertExpectations(t)
 	mr.AssertExpectations(t)
 }
+
+func TestUseCaseIssueMigratePriorityLabels(t *testing.T) {
+	bug := domain.Label{ID: 1, Name: "bug"}
+	high := domain.Label{ID: 3, Name: "priority:high"}
+	i := domain.Issue{ID: 1, ProjectID: 1, Labels: []domain.Label{bug, high}}
+	migrated := domain.Issue{ID: 1, ProjectID: 1, Labels: []domain.Label{bug}, Priority: domain.PriorityHigh}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindAll").Return([]domain.Issue{i, {ID: 2, Labels: []domain.Label{bug}}}, nil).Twice()
+	ms.On("FindAll").Return([]domain.Issue{}, errors.New("test error")).Once()
+	ms.On("MigratePriority", migrated, []domain.Label{high}).Return(migrated, nil).Once()
+	ms.On("MigratePriority", migrated, []domain.Label{high}).Return(migrated, errors.New("test error")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	eucm := new(ucTesting.EventUseCaseMock)
+	eucm.On("Transaction", domain.GetIssueEvents(i, migrated)).Return(nil)
+
+	uc := usecases.NewIssueUseCase(new(dTesting.IssueRepositoryMock), nil, eucm)
+
+	count, err := uc.MigratePriorityLabels()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = uc.MigratePriorityLabels()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, count)
+
+	count, err = uc.MigratePriorityLabels()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, count)
+
+	ms.AssertExpectations(t)
+	eucm.AssertExpectations(t)
+	eucm.AssertNumberOfCalls(t, "Transaction", 1)
+}
+
+func TestUseCaseIssueCheckSLA(t *testing.T) {
+	now := time.Date(2020, time.January, 1, 10, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-5 * time.Hour)
+	dueAt := createdAt.Add(4 * time.Hour)
+	p := domain.Project{ID: 1, Settings: domain.ProjectSettings{SLAPolicy: "urgent:4h"}}
+	i := domain.Issue{ID: 1, ProjectID: 1, Priority: domain.PriorityUrgent, Project: p, CreatedAt: createdAt, DueAt: &dueAt, SLAStatus: domain.SLAStatusAtRisk}
+	breached := i
+	breached.SLAStatus = domain.SLAStatusBreached
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindAll").Return([]domain.Issue{i, breached}, nil).Twice()
+	ms.On("FindAll").Return([]domain.Issue{}, errors.New("test error")).Once()
+	ms.On("UpdateSLA", breached).Return(breached, nil).Once()
+	ms.On("UpdateSLA", breached).Return(breached, errors.New("test error")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	eucm := new(ucTesting.EventUseCaseMock)
+	eucm.On("Transaction", []domain.DomainEvent{domain.IssueUpdated{Issue: breached, Changes: []domain.IssueChange{{Field: "slaStatus", Previous: "at_risk", Current: "breached"}}}}).Return(nil)
+
+	uc := usecases.NewIssueUseCase(new(dTesting.IssueRepositoryMock), nil, eucm)
+
+	count, err := uc.CheckSLA(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = uc.CheckSLA(now)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, count)
+
+	count, err = uc.CheckSLA(now)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, count)
+
+	ms.AssertExpectations(t)
+	eucm.AssertExpectations(t)
+}
+
+func TestUseCaseIssueSetEstimates(t *testing.T) {
+	i := domain.Issue{ID: 1, ProjectID: 1}
+	updated := domain.Issue{ID: 1, ProjectID: 1, OriginalEstimate: 480, RemainingEstimate: 480}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	ms.On("SetEstimates", i, 480, domain.EstimateDefault).Return(updated, nil).Once()
+	ms.On("SetEstimates", i, -1, domain.EstimateDefault).Return(i, errors.New("original estimate not valid")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.SetEstimates(uint(1), 480, domain.EstimateDefault)
+
+	assert.Nil(t, err)
+	assert.Equal(t, updated, item)
+
+	_, err = uc.SetEstimates(uint(1), -1, domain.EstimateDefault)
+
+	assert.NotNil(t, err)
+
+	_, err = uc.SetEstimates(uint(2), 480, domain.EstimateDefault)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseIssueAddWorkLog(t *testing.T) {
+	loggedAt := time.Date(2020, time.January, 6, 10, 0, 0, 0, time.UTC)
+	i := domain.Issue{ID: 1, ProjectID: 1}
+	w := &domain.WorkLog{Author: "alice", Minutes: 90, LoggedAt: loggedAt, Comment: "review"}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	ms.On("AddWorkLog", i, w).Return(w, nil).Once()
+	ms.On("AddWorkLog", i, w).Return(w, errors.New("test error")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.AddWorkLog(uint(1), "alice", 90, loggedAt, "review")
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, item)
+
+	item, err = uc.AddWorkLog(uint(1), "alice", 90, loggedAt, "review")
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	item, err = uc.AddWorkLog(uint(2), "alice", 90, loggedAt, "review")
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseIssueRemoveWorkLog(t *testing.T) {
+	i := domain.Issue{ID: 1, ProjectID: 1}
+	w := domain.WorkLog{ID: 2, IssueID: 1, Minutes: 30}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindWorkLogByID", uint(2)).Return(w, nil)
+	ms.On("FindWorkLogByID", uint(3)).Return(domain.WorkLog{IssueID: 4}, nil)
+	ms.On("FindWorkLogByID", uint(5)).Return(domain.WorkLog{}, errors.New("record not found"))
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("FindByID", uint(4)).Return(domain.Issue{}, errors.New("record not found"))
+	ms.On("RemoveWorkLog", i, w).Return(true, nil).Once()
+	ms.On("RemoveWorkLog", i, w).Return(false, errors.New("test error")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	status, err := uc.RemoveWorkLog(uint(2))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	for _, id := range []uint{2, 3, 5} {
+		status, err = uc.RemoveWorkLog(id)
+
+		assert.NotNil(t, err)
+		assert.False(t, status)
+	}
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseIssueFindWorkLogs(t *testing.T) {
+	from := time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	w := []domain.WorkLog{{ID: 1, IssueID: 1, Minutes: 30}}
+	summary := domain.TimeSummary{OriginalEstimate: 60, RemainingEstimate: 30, TimeSpent: 30}
+	entries := []domain.TimesheetEntry{{Author: "alice", Week: "2020-W02", WeekStart: from, Minutes: 30}}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindWorkLogByID", uint(1)).Return(w[0], nil).Once()
+	ms.On("FindWorkLogByID", uint(1)).Return(domain.WorkLog{}, errors.New("record not found")).Once()
+	ms.On("FindWorkLogs", uint(1)).Return(w, nil).Once()
+	ms.On("FindWorkLogs", uint(1)).Return([]domain.WorkLog{}, errors.New("test error")).Once()
+	ms.On("FindTimeSummary", uint(1)).Return(summary, nil).Once()
+	ms.On("FindTimeSummary", uint(1)).Return(domain.TimeSummary{}, errors.New("test error")).Once()
+	ms.On("FindTimesheet", uint(1), from, to).Return(entries, nil).Once()
+	ms.On("FindTimesheet", uint(1), from, to).Return([]domain.TimesheetEntry{}, errors.New("test error")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.FindWorkLogByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, w[0], item)
+
+	_, err = uc.FindWorkLogByID(uint(1))
+
+	assert.NotNil(t, err)
+
+	items, err := uc.FindWorkLogs(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, items)
+
+	_, err = uc.FindWorkLogs(uint(1))
+
+	assert.NotNil(t, err)
+
+	sum, err := uc.FindTimeSummary(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, summary, sum)
+
+	_, err = uc.FindTimeSummary(uint(1))
+
+	assert.NotNil(t, err)
+
+	sheet, err := uc.FindTimesheet(uint(1), from, to)
+
+	assert.Nil(t, err)
+	assert.Equal(t, entries, sheet)
+
+	_, err = uc.FindTimesheet(uint(1), from, to)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseIssueAddAttachment(t *testing.T) {
+	i := domain.Issue{ID: 1, ProjectID: 1}
+	a := &domain.Attachment{Filename: "log.txt"}
+	content := []byte("log")
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Issue{}, errors.New("record not found"))
+	ms.On("AddAttachment", i, a, content).Return(a, nil).Once()
+	ms.On("AddAttachment", i, a, content).Return((*domain.Attachment)(nil), errors.New("test error")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	item, err := uc.AddAttachment(uint(1), "log.txt", content)
+
+	assert.Nil(t, err)
+	assert.Equal(t, a, item)
+
+	for _, id := range []uint{1, 2} {
+		item, err = uc.AddAttachment(id, "log.txt", content)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, item)
+	}
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseIssueRemoveAttachment(t *testing.T) {
+	i := domain.Issue{ID: 1, ProjectID: 1}
+	a := domain.Attachment{ID: 2, IssueID: 1}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindAttachmentByID", uint(2)).Return(a, nil)
+	ms.On("FindAttachmentByID", uint(3)).Return(domain.Attachment{IssueID: 4}, nil)
+	ms.On("FindAttachmentByID", uint(5)).Return(domain.Attachment{}, errors.New("record not found"))
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("FindByID", uint(4)).Return(domain.Issue{}, errors.New("record not found"))
+	ms.On("RemoveAttachment", i, a).Return(true, nil).Once()
+	ms.On("RemoveAttachment", i, a).Return(false, errors.New("test error")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	status, err := uc.RemoveAttachment(uint(2))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	for _, id := range []uint{2, 3, 5} {
+		status, err = uc.RemoveAttachment(id)
+
+		assert.NotNil(t, err)
+		assert.False(t, status)
+	}
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseIssueFindAttachments(t *testing.T) {
+	a := []domain.Attachment{{ID: 2, IssueID: 1, Filename: "log.txt"}}
+	content := []byte("log")
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindAttachments", uint(1)).Return(a, nil).Once()
+	ms.On("FindAttachments", uint(1)).Return([]domain.Attachment{}, errors.New("test error")).Once()
+	ms.On("FindAttachmentByID", uint(2)).Return(a[0], nil).Times(3)
+	ms.On("FindAttachmentByID", uint(3)).Return(domain.Attachment{}, errors.New("record not found"))
+	ms.On("ReadAttachment", a[0]).Return(content, nil).Once()
+	ms.On("ReadAttachment", a[0]).Return([]byte{}, errors.New("test error")).Once()
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	mr := new(dTesting.IssueRepositoryMock)
+
+	uc := usecases.NewIssueUseCase(mr, nil, nil)
+
+	items, err := uc.FindAttachments(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, a, items)
+
+	_, err = uc.FindAttachments(uint(1))
+
+	assert.NotNil(t, err)
+
+	item, err := uc.FindAttachmentByID(uint(2))
+
+	assert.Nil(t, err)
+	assert.Equal(t, a[0], item)
+
+	_, err = uc.FindAttachmentByID(uint(3))
+
+	assert.NotNil(t, err)
+
+	item, data, err := uc.ReadAttachment(uint(2))
+
+	assert.Nil(t, err)
+	assert.Equal(t, a[0], item)
+	assert.Equal(t, content, data)
+
+	for _, id := range []uint{2, 3} {
+		_, data, err = uc.ReadAttachment(id)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, data)
+	}
+
+	ms.AssertExpectations(t)
+}
@@ -0,0 +1,275 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"go-issue-tracker/pkg/domain"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JobUseCase interface
+type JobUseCase interface {
+	Add(project domain.Project, name string, kind string, schedule string, params []string) (*domain.Job, error)
+	Update(id uint, name string, schedule string, params []string, active bool) (domain.Job, error)
+	FindByID(id uint) (domain.Job, error)
+	FindAll() ([]domain.Job, error)
+	Remove(id uint) (bool, error)
+	Run(id uint) (domain.Job, error)
+	RunDue(now time.Time) (int, error)
+}
+
+// jobUseCase struct
+type jobUseCase struct {
+	service  domain.JobService
+	owner    string
+	issues   IssueUseCase
+	labels   LabelUseCase
+	projects ProjectUseCase
+}
+
+// NewJobUseCase to create new JobUseCase, owner identifies server instance in job locks, jobs change
+// issues through issues use case, so their changes notify watchers like any other change
+func NewJobUseCase(repository domain.JobRepository, owner string, issues IssueUseCase, labels LabelUseCase, projects ProjectUseCase) JobUseCase {
+	return &jobUseCase{
+		service:  domain.GetDefaultJobService(repository),
+		owner:    owner,
+		issues:   issues,
+		labels:   labels,
+		projects: projects,
+	}
+}
+
+// Add to add new active job to project
+func (uc *jobUseCase) Add(project domain.Project, name string, kind string, schedule string, params []string) (*domain.Job, error) {
+	item := new(domain.Job)
+	item.ProjectID = project.ID
+	item.Name = name
+	item.Kind = kind
+	item.Schedule = schedule
+	item.Params = strings.Join(params, ",")
+	item.Active = true
+
+	itemAdded, err := uc.service.Add(item, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return itemAdded, nil
+}
+
+// Update to update job, kind and project of job can not be changed
+func (uc *jobUseCase) Update(id uint, name string, schedule string, params []string, active bool) (domain.Job, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	item.Name = name
+	item.Schedule = schedule
+	item.Params = strings.Join(params, ",")
+	item.Active = active
+
+	itemUpdated, err := uc.service.Update(item, time.Now())
+	if err != nil {
+		return itemUpdated, err
+	}
+	return itemUpdated, nil
+}
+
+// FindByID to find job by ID
+func (uc *jobUseCase) FindByID(id uint) (domain.Job, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAll to find all jobs
+func (uc *jobUseCase) FindAll() ([]domain.Job, error) {
+	items, err := uc.service.FindAll()
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Remove to remove job
+func (uc *jobUseCase) Remove(id uint) (bool, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := uc.service.Remove(item)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// Run to run job immediately regardless of its schedule, failure of run is recorded in job
+func (uc *jobUseCase) Run(id uint) (domain.Job, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	now := time.Now()
+	locked, err := uc.service.Lock(item, uc.owner, now, false)
+	if err != nil {
+		return item, err
+	}
+	if !locked {
+		return item, fmt.Errorf("job %s is running", item.Name)
+	}
+
+	count, runErr := uc.run(item, now)
+	itemFinished, err := uc.service.Finish(item, uc.owner, now, count, runErr)
+	if err != nil {
+		return itemFinished, err
+	}
+	return itemFinished, nil
+}
+
+// RunDue to run due jobs, job is skipped when another server instance locked it first
+func (uc *jobUseCase) RunDue(now time.Time) (int, error) {
+	items, err := uc.service.FindDue(now)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, item := range items {
+		locked, err := uc.service.Lock(item, uc.owner, now, true)
+		if err != nil {
+			return count, err
+		}
+		if !locked {
+			continue
+		}
+		changed, runErr := uc.run(item, now)
+		if runErr != nil {
+			log.Printf("Job %d failed: %v", item.ID, runErr)
+		}
+		if _, err := uc.service.Finish(item, uc.owner, now, changed, runErr); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// run to run job, it returns number of changed or added issues
+func (uc *jobUseCase) run(job domain.Job, now time.Time) (int, error) {
+	params, err := job.GetParams()
+	if err != nil {
+		return 0, err
+	}
+	switch job.Kind {
+	case domain.JobKindStaleLabel:
+		return uc.runStaleLabel(job, params, now)
+	case domain.JobKindStaleClose:
+		return uc.runStaleClose(job, params, now)
+	case domain.JobKindRecurringIssue:
+		return uc.runRecurringIssue(job, params)
+	}
+	return 0, fmt.Errorf("kind %s not valid", job.Kind)
+}
+
+// runStaleLabel to add label to issues of project not updated for days, issues which already have
+// label or whose status is skipped are ignored
+func (uc *jobUseCase) runStaleLabel(job domain.Job, params domain.JobParams, now time.Time) (int, error) {
+	label, err := uc.labels.FindByName(params.Label, job.ProjectID)
+	if err != nil {
+		return 0, fmt.Errorf("label %s: %v", params.Label, err)
+	}
+	options := domain.IssueFindOptions{UpdatedBefore: now.AddDate(0, 0, -params.Days)}
+	items, err := uc.issues.Find("", job.ProjectID, []string{}, []domain.CustomFieldFilter{}, options)
+	if err != nil {
+		return 0, err
+	}
+
+	ids := []uint{}
+	for _, item := range items {
+		labeled := false
+		for _, l := range item.Labels {
+			if l.ID == label.ID {
+				labeled = true
+				break
+			}
+		}
+		if !labeled && !params.IsSkipped(item.Status) {
+			ids = append(ids, item.ID)
+		}
+	}
+	return uc.updateEach(ids, domain.IssueBulkOperation{AddLabels: []domain.Label{label}})
+}
+
+// runStaleClose to set status of issues of project with label not updated for days, adding of label
+// updates issue, so days are grace period since issue was labeled by stale label job
+func (uc *jobUseCase) runStaleClose(job domain.Job, params domain.JobParams, now time.Time) (int, error) {
+	label, err := uc.labels.FindByName(params.Label, job.ProjectID)
+	if err != nil {
+		return 0, fmt.Errorf("label %s: %v", params.Label, err)
+	}
+	options := domain.IssueFindOptions{UpdatedBefore: now.AddDate(0, 0, -params.Days)}
+	items, err := uc.issues.Find("", job.ProjectID, []string{strconv.Itoa(int(label.ID))}, []domain.CustomFieldFilter{}, options)
+	if err != nil {
+		return 0, err
+	}
+
+	ids := []uint{}
+	for _, item := range items {
+		if item.Status != params.Status {
+			ids = append(ids, item.ID)
+		}
+	}
+	return uc.updateEach(ids, domain.IssueBulkOperation{Status: &params.Status})
+}
+
+// updateEach to apply operation to each issue separately, so issue which fails does not revert changes
+// of other issues, it returns number of changed issues and error of first failed issue
+func (uc *jobUseCase) updateEach(ids []uint, operation domain.IssueBulkOperation) (int, error) {
+	count := 0
+	var failed error
+	for _, id := range ids {
+		results, err := uc.issues.BulkUpdate([]uint{id}, operation)
+		if err != nil {
+			if len(results) == 1 && results[0].Error != "" {
+				err = errors.New(results[0].Error)
+			}
+			if failed == nil {
+				failed = fmt.Errorf("issue %d: %v", id, err)
+			}
+			continue
+		}
+		count++
+	}
+	if failed != nil {
+		return count, fmt.Errorf("%d of %d issues not changed, %v", len(ids)-count, len(ids), failed)
+	}
+	return count, nil
+}
+
+// runRecurringIssue to add issue from template of project
+func (uc *jobUseCase) runRecurringIssue(job domain.Job, params domain.JobParams) (int, error) {
+	template, err := uc.projects.FindTemplateByID(params.TemplateID)
+	if err != nil {
+		return 0, fmt.Errorf("template %d: %v", params.TemplateID, err)
+	}
+	if template.ProjectID != job.ProjectID {
+		return 0, fmt.Errorf("template %d does not belong to project", params.TemplateID)
+	}
+	project, err := uc.projects.FindByID(job.ProjectID)
+	if err != nil {
+		return 0, fmt.Errorf("project %d: %v", job.ProjectID, err)
+	}
+
+	if _, err := uc.issues.AddFromTemplate(params.Title, "", domain.IssueStatusDefault, domain.PriorityNone, domain.SeverityNone, "", "", project, map[string]domain.Label{}, template); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
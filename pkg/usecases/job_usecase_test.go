@@ -0,0 +1,263 @@
+package usecases_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"go-issue-tracker/pkg/usecases"
+	ucTesting "go-issue-tracker/pkg/usecases/testing"
+	"testing"
+	"time"
+)
+
+func prepareJobServiceMock() *dTesting.JobServiceMock {
+	ms := new(dTesting.JobServiceMock)
+	domain.GetDefaultJobService = func(r domain.JobRepository) domain.JobService {
+		return ms
+	}
+	return ms
+}
+
+func TestUseCaseJobNewJobUseCase(t *testing.T) {
+	prepareJobServiceMock()
+	defer domain.ResetDefaultJobService()
+
+	uc := usecases.NewJobUseCase(new(dTesting.JobRepositoryMock), "a", nil, nil, nil)
+
+	assert.NotNil(t, uc)
+}
+
+func TestUseCaseJobAddAndUpdate(t *testing.T) {
+	j := domain.Job{ID: 1, ProjectID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, Schedule: domain.JobScheduleDaily, Params: "days:60,label:stale", Active: true}
+	updated := domain.Job{ID: 1, ProjectID: 1, Name: "Stale issues", Kind: domain.JobKindStaleLabel, Schedule: domain.JobScheduleWeekly, Params: "days:30,label:stale", Active: false}
+
+	ms := prepareJobServiceMock()
+	defer domain.ResetDefaultJobService()
+	ms.On("Add", &domain.Job{ProjectID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, Schedule: domain.JobScheduleDaily, Params: "days:60,label:stale", Active: true}, mock.Anything).Return(&j, nil)
+	ms.On("Add", &domain.Job{ProjectID: 1, Name: "Backup", Kind: "backup", Schedule: domain.JobScheduleDaily, Active: true}, mock.Anything).Return((*domain.Job)(nil), errors.New("kind backup not valid"))
+	ms.On("FindByID", uint(1)).Return(j, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Job{}, errors.New("record not found"))
+	ms.On("Update", updated, mock.Anything).Return(updated, nil)
+
+	uc := usecases.NewJobUseCase(new(dTesting.JobRepositoryMock), "a", nil, nil, nil)
+
+	item, err := uc.Add(domain.Project{ID: 1}, "Stale", domain.JobKindStaleLabel, domain.JobScheduleDaily, []string{"days:60", "label:stale"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &j, item)
+
+	item, err = uc.Add(domain.Project{ID: 1}, "Backup", "backup", domain.JobScheduleDaily, nil)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	job, err := uc.Update(1, "Stale issues", domain.JobScheduleWeekly, []string{"days:30", "label:stale"}, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, updated, job)
+
+	_, err = uc.Update(2, "Stale issues", domain.JobScheduleWeekly, nil, false)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseJobFindAndRemove(t *testing.T) {
+	j := domain.Job{ID: 1, ProjectID: 1}
+
+	ms := prepareJobServiceMock()
+	defer domain.ResetDefaultJobService()
+	ms.On("FindByID", uint(1)).Return(j, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Job{}, errors.New("record not found"))
+	ms.On("FindAll").Return([]domain.Job{j}, nil)
+	ms.On("Remove", j).Return(true, nil)
+
+	uc := usecases.NewJobUseCase(new(dTesting.JobRepositoryMock), "a", nil, nil, nil)
+
+	item, err := uc.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, j, item)
+
+	items, err := uc.FindAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	status, err := uc.Remove(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = uc.Remove(2)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseJobRunDue(t *testing.T) {
+	now := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	stale := domain.Label{ID: 5, Name: "stale", ProjectID: 1}
+	label := domain.Job{ID: 1, ProjectID: 1, Kind: domain.JobKindStaleLabel, Params: "days:60,label:stale,skipStatus:4", Active: true}
+	closing := domain.Job{ID: 2, ProjectID: 1, Kind: domain.JobKindStaleClose, Params: "days:14,label:stale,status:4", Active: true}
+	recurring := domain.Job{ID: 3, ProjectID: 1, Kind: domain.JobKindRecurringIssue, Params: "templateId:7,title:Rotate certificates", Active: true}
+	locked := domain.Job{ID: 4, ProjectID: 1, Kind: domain.JobKindRecurringIssue, Params: "templateId:7,title:Rotate certificates", Active: true}
+	template := domain.IssueTemplate{ID: 7, ProjectID: 1}
+	project := domain.Project{ID: 1}
+
+	ms := prepareJobServiceMock()
+	defer domain.ResetDefaultJobService()
+	ms.On("FindDue", now).Return([]domain.Job{label, closing, recurring, locked}, nil)
+	ms.On("Lock", label, "a", now, true).Return(true, nil)
+	ms.On("Lock", closing, "a", now, true).Return(true, nil)
+	ms.On("Lock", recurring, "a", now, true).Return(true, nil)
+	ms.On("Lock", locked, "a", now, true).Return(false, nil)
+	ms.On("Finish", label, "a", now, 1, nil).Return(label, nil)
+	ms.On("Finish", closing, "a", now, 1, nil).Return(closing, nil)
+	ms.On("Finish", recurring, "a", now, 1, nil).Return(recurring, nil)
+
+	closed := 4
+	miuc := new(ucTesting.IssueUseCaseMock)
+	miuc.On("Find", "", uint(1), []string{}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{UpdatedBefore: now.AddDate(0, 0, -60)}).Return([]domain.Issue{
+		{ID: 1, Status: 1},
+		{ID: 2, Status: 1, Labels: []domain.Label{stale}},
+		{ID: 3, Status: 4},
+	}, nil)
+	miuc.On("BulkUpdate", []uint{1}, domain.IssueBulkOperation{AddLabels: []domain.Label{stale}}).Return([]domain.IssueBulkResult{{ID: 1, Status: true}}, nil)
+	miuc.On("Find", "", uint(1), []string{"5"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{UpdatedBefore: now.AddDate(0, 0, -14)}).Return([]domain.Issue{
+		{ID: 2, Status: 1, Labels: []domain.Label{stale}},
+		{ID: 4, Status: 4, Labels: []domain.Label{stale}},
+	}, nil)
+	miuc.On("BulkUpdate", []uint{2}, domain.IssueBulkOperation{Status: &closed}).Return([]domain.IssueBulkResult{{ID: 2, Status: true}}, nil)
+	miuc.On("AddFromTemplate", "Rotate certificates", "", domain.IssueStatusDefault, domain.PriorityNone, domain.SeverityNone, "", "", project, map[string]domain.Label{}, template).Return(&domain.Issue{ID: 10}, nil)
+	mluc := new(ucTesting.LabelUseCaseMock)
+	mluc.On("FindByName", "stale", uint(1)).Return(stale, nil)
+	mpuc := new(ucTesting.ProjectUseCaseMock)
+	mpuc.On("FindTemplateByID", uint(7)).Return(template, nil)
+	mpuc.On("FindByID", uint(1)).Return(project, nil)
+
+	uc := usecases.NewJobUseCase(new(dTesting.JobRepositoryMock), "a", miuc, mluc, mpuc)
+
+	count, err := uc.RunDue(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+
+	ms.AssertExpectations(t)
+	miuc.AssertExpectations(t)
+	mluc.AssertExpectations(t)
+	mpuc.AssertExpectations(t)
+}
+
+func TestUseCaseJobRunDueFailed(t *testing.T) {
+	now := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	j := domain.Job{ID: 1, ProjectID: 1, Kind: domain.JobKindRecurringIssue, Params: "templateId:7,title:Rotate certificates", Active: true}
+
+	ms := prepareJobServiceMock()
+	defer domain.ResetDefaultJobService()
+	ms.On("FindDue", now).Return([]domain.Job{j}, nil).Once()
+	ms.On("Lock", j, "a", now, true).Return(true, nil).Once()
+	ms.On("Finish", j, "a", now, 0, errors.New("template 7 does not belong to project")).Return(j, nil).Once()
+	ms.On("FindDue", now).Return([]domain.Job{j}, nil).Once()
+	ms.On("Lock", j, "a", now, true).Return(false, errors.New("test error")).Once()
+	ms.On("FindDue", now).Return([]domain.Job{}, errors.New("test error")).Once()
+
+	mpuc := new(ucTesting.ProjectUseCaseMock)
+	mpuc.On("FindTemplateByID", uint(7)).Return(domain.IssueTemplate{ID: 7, ProjectID: 2}, nil)
+
+	uc := usecases.NewJobUseCase(new(dTesting.JobRepositoryMock), "a", nil, nil, mpuc)
+
+	count, err := uc.RunDue(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = uc.RunDue(now)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = uc.RunDue(now)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mpuc.AssertExpectations(t)
+}
+
+func TestUseCaseJobRun(t *testing.T) {
+	j := domain.Job{ID: 1, ProjectID: 1, Name: "Stale", Kind: domain.JobKindStaleLabel, Params: "days:60,label:stale", Active: true}
+	finished := j
+	finished.LastStatus = domain.JobRunFailed
+	finished.LastError = "label stale: record not found"
+
+	ms := prepareJobServiceMock()
+	defer domain.ResetDefaultJobService()
+	ms.On("FindByID", uint(1)).Return(j, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Job{}, errors.New("record not found"))
+	ms.On("Lock", j, "a", mock.Anything, false).Return(true, nil).Once()
+	ms.On("Finish", j, "a", mock.Anything, 0, errors.New("label stale: record not found")).Return(finished, nil).Once()
+	ms.On("Lock", j, "a", mock.Anything, false).Return(false, nil).Once()
+
+	mluc := new(ucTesting.LabelUseCaseMock)
+	mluc.On("FindByName", "stale", uint(1)).Return(domain.Label{}, errors.New("record not found"))
+
+	uc := usecases.NewJobUseCase(new(dTesting.JobRepositoryMock), "a", nil, mluc, nil)
+
+	item, err := uc.Run(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, finished, item)
+
+	_, err = uc.Run(1)
+
+	assert.Equal(t, "job Stale is running", err.Error())
+
+	_, err = uc.Run(2)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mluc.AssertExpectations(t)
+}
+
+func TestUseCaseJobRunDuePartial(t *testing.T) {
+	now := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	stale := domain.Label{ID: 5, Name: "stale", ProjectID: 1}
+	j := domain.Job{ID: 1, ProjectID: 1, Kind: domain.JobKindStaleClose, Params: "days:14,label:stale,status:4", Active: true}
+
+	ms := prepareJobServiceMock()
+	defer domain.ResetDefaultJobService()
+	ms.On("FindDue", now).Return([]domain.Job{j}, nil)
+	ms.On("Lock", j, "a", now, true).Return(true, nil)
+	ms.On("Finish", j, "a", now, 2, errors.New("1 of 3 issues not changed, issue 2: status 4 not valid")).Return(j, nil)
+
+	closed := 4
+	miuc := new(ucTesting.IssueUseCaseMock)
+	miuc.On("Find", "", uint(1), []string{"5"}, []domain.CustomFieldFilter{}, domain.IssueFindOptions{UpdatedBefore: now.AddDate(0, 0, -14)}).Return([]domain.Issue{
+		{ID: 1, Status: 1, Labels: []domain.Label{stale}},
+		{ID: 2, Status: 1, Labels: []domain.Label{stale}},
+		{ID: 3, Status: 2, Labels: []domain.Label{stale}},
+	}, nil)
+	miuc.On("BulkUpdate", []uint{1}, domain.IssueBulkOperation{Status: &closed}).Return([]domain.IssueBulkResult{{ID: 1, Status: true}}, nil)
+	miuc.On("BulkUpdate", []uint{2}, domain.IssueBulkOperation{Status: &closed}).Return([]domain.IssueBulkResult{{ID: 2, Error: "status 4 not valid"}}, errors.New("bulk operation not valid"))
+	miuc.On("BulkUpdate", []uint{3}, domain.IssueBulkOperation{Status: &closed}).Return([]domain.IssueBulkResult{{ID: 3, Status: true}}, nil)
+	mluc := new(ucTesting.LabelUseCaseMock)
+	mluc.On("FindByName", "stale", uint(1)).Return(stale, nil)
+
+	uc := usecases.NewJobUseCase(new(dTesting.JobRepositoryMock), "a", miuc, mluc, nil)
+
+	count, err := uc.RunDue(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	ms.AssertExpectations(t)
+	miuc.AssertExpectations(t)
+	mluc.AssertExpectations(t)
+}
@@ -6,33 +6,65 @@ import (
 
 // LabelUseCase interface
 type LabelUseCase interface {
-	Add(name string, colorHexCode string) (*domain.Label, error)
+	Add(name string, colorHexCode string, projectID uint) (*domain.Label, error)
 	Update(id uint, name string, colorHexCode string) (domain.Label, error)
 	FindByID(id uint) (domain.Label, error)
-	FindByName(name string) (domain.Label, error)
-	Find(name string) ([]domain.Label, error)
+	FindByName(name string, projectID uint) (domain.Label, error)
+	Find(name string, projectID uint) ([]domain.Label, error)
 	FindAll() ([]domain.Label, error)
 	Remove(id uint) (bool, error)
+	MergePreview(sourceID uint, targetID uint) (domain.LabelMerge, error)
+	Merge(sourceID uint, targetID uint) (domain.LabelMerge, error)
+	AddGroup(name string, exclusive bool) (*domain.LabelGroup, error)
+	UpdateGroup(id uint, name string, exclusive bool) (domain.LabelGroup, error)
+	FindGroupByID(id uint) (domain.LabelGroup, error)
+	FindAllGroups() ([]domain.LabelGroup, error)
+	RemoveGroup(id uint) (bool, error)
+	SetGroup(id uint, groupID uint) (domain.Label, error)
 }
 
 // LabelUseCase struct
 type labelUseCase struct {
-	service domain.LabelService
+	repository domain.LabelRepository
+	service    domain.LabelService
+	events     EventUseCase
 }
 
-// NewLabelUseCase to create new LabelUseCase
-func NewLabelUseCase(repository domain.LabelRepository) LabelUseCase {
+// NewLabelUseCase to create new LabelUseCase, no domain events are published when events are nil
+func NewLabelUseCase(repository domain.LabelRepository, events EventUseCase) LabelUseCase {
 	return &labelUseCase{
-		service: domain.GetDefaultLabelService(repository),
+		repository: repository,
+		service:    domain.GetDefaultLabelService(repository),
+		events:     events,
 	}
 }
 
-// Add to add new label
-func (uc *labelUseCase) Add(name string, colorHexCode string) (*domain.Label, error) {
+// transaction to run change by service bound to transaction, domain events returned by change are
+// published in the same transaction, change runs without transaction when events are nil
+func (uc *labelUseCase) transaction(change func(service domain.LabelService) ([]domain.DomainEvent, error)) error {
+	if uc.events == nil {
+		_, err := change(uc.service)
+		return err
+	}
+	return uc.events.Transaction(func(tx domain.Transaction) ([]domain.DomainEvent, error) {
+		return change(domain.GetDefaultLabelService(uc.repository.WithTransaction(tx)))
+	})
+}
+
+// Add to add new label, global when project ID is 0
+func (uc *labelUseCase) Add(name string, colorHexCode string, projectID uint) (*domain.Label, error) {
 	item := new(domain.Label)
 	item.Name = name
 	item.ColorHexCode = colorHexCode
-	itemAdded, err := uc.service.Add(item)
+	item.ProjectID = projectID
+	var itemAdded *domain.Label
+	err := uc.transaction(func(service domain.LabelService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemAdded, err = service.Add(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.LabelCreated{Label: *itemAdded}}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +80,14 @@ func (uc *labelUseCase) Update(id uint, name string, colorHexCode string) (domai
 
 	item.Name = name
 	item.ColorHexCode = colorHexCode
-	itemUpdated, err := uc.service.Update(item)
+	var itemUpdated domain.Label
+	err = uc.transaction(func(service domain.LabelService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemUpdated, err = service.Update(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.LabelUpdated{Label: itemUpdated}}, nil
+	})
 	if err != nil {
 		return itemUpdated, err
 	}
@@ -64,18 +103,18 @@ func (uc *labelUseCase) FindByID(id uint) (domain.Label, error) {
 	return item, nil
 }
 
-// FindByName to find label by name
-func (uc *labelUseCase) FindByName(name string) (domain.Label, error) {
-	item, err := uc.service.FindByName(name)
+// FindByName to find label by name available in project
+func (uc *labelUseCase) FindByName(name string, projectID uint) (domain.Label, error) {
+	item, err := uc.service.FindByName(name, projectID)
 	if err != nil {
 		return item, err
 	}
 	return item, nil
 }
 
-// Find to find label by name
-func (uc *labelUseCase) Find(name string) ([]domain.Label, error) {
-	items, err := uc.service.Find(name)
+// Find to find label by name, limited to global and project labels when project ID is provided
+func (uc *labelUseCase) Find(name string, projectID uint) ([]domain.Label, error) {
+	items, err := uc.service.Find(name, projectID)
 	if err != nil {
 		return items, err
 	}
@@ -93,9 +132,156 @@ func (uc *labelUseCase) FindAll() ([]domain.Label, error) {
 
 // Remove to remove label
 func (uc *labelUseCase) Remove(id uint) (bool, error) {
-	status, err := uc.service.Remove(id)
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return false, err
+	}
+
+	var status bool
+	err = uc.transaction(func(service domain.LabelService) ([]domain.DomainEvent, error) {
+		var err error
+		if status, err = service.Remove(id); err != nil || !status {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.LabelRemoved{Label: item}}, nil
+	})
 	if err != nil {
 		return status, err
 	}
 	return status, nil
 }
+
+// findMergeLabels to find source and target labels of merge
+func (uc *labelUseCase) findMergeLabels(sourceID uint, targetID uint) (domain.Label, domain.Label, error) {
+	source, err := uc.service.FindByID(sourceID)
+	if err != nil {
+		return source, domain.Label{}, err
+	}
+	target, err := uc.service.FindByID(targetID)
+	if err != nil {
+		return source, target, err
+	}
+	return source, target, nil
+}
+
+// MergePreview to get number of issues affected by merge
+func (uc *labelUseCase) MergePreview(sourceID uint, targetID uint) (domain.LabelMerge, error) {
+	source, target, err := uc.findMergeLabels(sourceID, targetID)
+	if err != nil {
+		return domain.LabelMerge{}, err
+	}
+
+	item, err := uc.service.MergePreview(source, target)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Merge to merge source label into target label
+func (uc *labelUseCase) Merge(sourceID uint, targetID uint) (domain.LabelMerge, error) {
+	source, target, err := uc.findMergeLabels(sourceID, targetID)
+	if err != nil {
+		return domain.LabelMerge{}, err
+	}
+
+	var item domain.LabelMerge
+	err = uc.transaction(func(service domain.LabelService) ([]domain.DomainEvent, error) {
+		var err error
+		if item, err = service.Merge(source, target); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.LabelRemoved{Label: source}}, nil
+	})
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// AddGroup to add new label group
+func (uc *labelUseCase) AddGroup(name string, exclusive bool) (*domain.LabelGroup, error) {
+	item := new(domain.LabelGroup)
+	item.Name = name
+	item.Exclusive = exclusive
+	itemAdded, err := uc.service.AddGroup(item)
+	if err != nil {
+		return nil, err
+	}
+	return itemAdded, nil
+}
+
+// UpdateGroup to update label group
+func (uc *labelUseCase) UpdateGroup(id uint, name string, exclusive bool) (domain.LabelGroup, error) {
+	item, err := uc.service.FindGroupByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	item.Name = name
+	item.Exclusive = exclusive
+	itemUpdated, err := uc.service.UpdateGroup(item)
+	if err != nil {
+		return itemUpdated, err
+	}
+	return itemUpdated, nil
+}
+
+// FindGroupByID to find label group by ID
+func (uc *labelUseCase) FindGroupByID(id uint) (domain.LabelGroup, error) {
+	item, err := uc.service.FindGroupByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindAllGroups to find all label groups
+func (uc *labelUseCase) FindAllGroups() ([]domain.LabelGroup, error) {
+	items, err := uc.service.FindAllGroups()
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveGroup to remove label group
+func (uc *labelUseCase) RemoveGroup(id uint) (bool, error) {
+	status, err := uc.service.RemoveGroup(id)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// SetGroup to assign label to group, group ID 0 removes label from its group
+func (uc *labelUseCase) SetGroup(id uint, groupID uint) (domain.Label, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	item.GroupID = 0
+	item.Group = nil
+	if groupID != 0 {
+		group, err := uc.service.FindGroupByID(groupID)
+		if err != nil {
+			return item, err
+		}
+		group.Labels = nil
+		item.GroupID = group.ID
+		item.Group = &group
+	}
+	var itemUpdated domain.Label
+	err = uc.transaction(func(service domain.LabelService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemUpdated, err = service.Update(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.LabelUpdated{Label: itemUpdated}}, nil
+	})
+	if err != nil {
+		return itemUpdated, err
+	}
+	return itemUpdated, nil
+}
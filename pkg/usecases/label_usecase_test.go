@@ -18,7 +18,7 @@ func TestUseCaseLabelNewLabelUseCase(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 }
@@ -27,6 +27,7 @@ func TestUseCaseLabelAdd(t *testing.T) {
 	l := new(domain.Label)
 	l.Name = "test-name"
 	l.ColorHexCode = "FFFFFF"
+	l.ProjectID = 2
 
 	ms := new(dTesting.LabelServiceMock)
 	ms.On("Add", l).Return(l, nil)
@@ -37,11 +38,11 @@ func TestUseCaseLabelAdd(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.Add(l.Name, l.ColorHexCode)
+	item, err := uc.Add(l.Name, l.ColorHexCode, l.ProjectID)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
@@ -65,11 +66,11 @@ func TestUseCaseLabelAddErr(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.Add(l.Name, l.ColorHexCode)
+	item, err := uc.Add(l.Name, l.ColorHexCode, l.ProjectID)
 
 	assert.NotNil(t, err)
 	assert.Nil(t, item)
@@ -94,7 +95,7 @@ func TestUseCaseLabelUpdate(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -124,7 +125,7 @@ func TestUseCaseLabelUpdateErr(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -148,7 +149,7 @@ func TestUseCaseLabelUpdateFindByIDErr(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -176,7 +177,7 @@ func TestUseCaseLabelFindByID(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -204,7 +205,7 @@ func TestUseCaseLabelFindByIDErr(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -224,7 +225,7 @@ func TestUseCaseLabelFindByName(t *testing.T) {
 	l.ColorHexCode = "FFFFFF"
 
 	ms := new(dTesting.LabelServiceMock)
-	ms.On("FindByName", l.Name).Return(l, nil)
+	ms.On("FindByName", l.Name, uint(0)).Return(l, nil)
 	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
 		return ms
 	}
@@ -232,11 +233,11 @@ func TestUseCaseLabelFindByName(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.FindByName(l.Name)
+	item, err := uc.FindByName(l.Name, uint(0))
 
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
@@ -252,7 +253,7 @@ func TestUseCaseLabelFindByNameErr(t *testing.T) {
 	l.ColorHexCode = "FFFFFF"
 
 	ms := new(dTesting.LabelServiceMock)
-	ms.On("FindByName", l.Name).Return(l, errors.New("test error"))
+	ms.On("FindByName", l.Name, uint(0)).Return(l, errors.New("test error"))
 	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
 		return ms
 	}
@@ -260,11 +261,11 @@ func TestUseCaseLabelFindByNameErr(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
-	item, err := uc.FindByName(l.Name)
+	item, err := uc.FindByName(l.Name, uint(0))
 
 	assert.NotNil(t, err)
 	assert.NotNil(t, item)
@@ -287,7 +288,7 @@ func TestUseCaseLabelFind(t *testing.T) {
 	}
 
 	ms := new(dTesting.LabelServiceMock)
-	ms.On("Find", "test").Return(labels, nil)
+	ms.On("Find", "test", uint(0)).Return(labels, nil)
 	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
 		return ms
 	}
@@ -295,11 +296,11 @@ func TestUseCaseLabelFind(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
-	items, err := uc.Find("test")
+	items, err := uc.Find("test", uint(0))
 
 	assert.Nil(t, err)
 	assert.NotNil(t, items)
@@ -313,7 +314,7 @@ func TestUseCaseLabelFindErr(t *testing.T) {
 	labels := []domain.Label{}
 
 	ms := new(dTesting.LabelServiceMock)
-	ms.On("Find", "test").Return(labels, errors.New("test error"))
+	ms.On("Find", "test", uint(0)).Return(labels, errors.New("test error"))
 	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
 		return ms
 	}
@@ -321,11 +322,11 @@ func TestUseCaseLabelFindErr(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
-	items, err := uc.Find("test")
+	items, err := uc.Find("test", uint(0))
 
 	assert.NotNil(t, err)
 	assert.NotNil(t, items)
@@ -356,7 +357,7 @@ func TestUseCaseLabelFindAll(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -382,7 +383,7 @@ func TestUseCaseLabelFindAllErr(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -398,6 +399,7 @@ func TestUseCaseLabelFindAllErr(t *testing.T) {
 
 func TestUseCaseLabelRemove(t *testing.T) {
 	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Label{ID: 1}, nil)
 	ms.On("Remove", uint(1)).Return(true, nil)
 	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
 		return ms
@@ -406,7 +408,7 @@ func TestUseCaseLabelRemove(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -421,6 +423,7 @@ func TestUseCaseLabelRemove(t *testing.T) {
 
 func TestUseCaseLabelRemoveErr(t *testing.T) {
 	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Label{ID: 1}, nil)
 	ms.On("Remove", uint(1)).Return(false, errors.New("test error"))
 	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
 		return ms
@@ -429,7 +432,7 @@ func TestUseCaseLabelRemoveErr(t *testing.T) {
 
 	mr := new(dTesting.LabelRepositoryMock)
 
-	uc := usecases.NewLabelUseCase(mr)
+	uc := usecases.NewLabelUseCase(mr, nil)
 
 	assert.NotNil(t, uc)
 
@@ -441,3 +444,258 @@ func TestUseCaseLabelRemoveErr(t *testing.T) {
 	ms.AssertExpectations(t)
 	mr.AssertExpectations(t)
 }
+
+func TestUseCaseLabelMergePreview(t *testing.T) {
+	source := domain.Label{ID: 1, Name: "Bug"}
+	target := domain.Label{ID: 2, Name: "bug"}
+	lm := domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3}
+
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindByID", source.ID).Return(source, nil)
+	ms.On("FindByID", target.ID).Return(target, nil)
+	ms.On("MergePreview", source, target).Return(lm, nil)
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	item, err := uc.MergePreview(source.ID, target.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, lm, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelMergePreviewFindByIDErr(t *testing.T) {
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Label{ID: 1}, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Label{}, errors.New("record not found"))
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	_, err := uc.MergePreview(uint(1), uint(2))
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelMerge(t *testing.T) {
+	source := domain.Label{ID: 1, Name: "Bug"}
+	target := domain.Label{ID: 2, Name: "bug"}
+	lm := domain.LabelMerge{SourceID: 1, TargetID: 2, Issues: 3}
+
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindByID", source.ID).Return(source, nil)
+	ms.On("FindByID", target.ID).Return(target, nil)
+	ms.On("Merge", source, target).Return(lm, nil)
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	item, err := uc.Merge(source.ID, target.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, lm, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelMergeErr(t *testing.T) {
+	source := domain.Label{ID: 1, Name: "Bug"}
+	target := domain.Label{ID: 2, Name: "bug"}
+
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindByID", source.ID).Return(source, nil)
+	ms.On("FindByID", target.ID).Return(target, nil)
+	ms.On("Merge", source, target).Return(domain.LabelMerge{}, errors.New("test error"))
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	_, err := uc.Merge(source.ID, target.ID)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelAddGroup(t *testing.T) {
+	g := &domain.LabelGroup{Name: "priority", Exclusive: true}
+
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("AddGroup", g).Return(g, nil)
+	ms.On("AddGroup", &domain.LabelGroup{Name: "area"}).Return((*domain.LabelGroup)(nil), errors.New("test error"))
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	item, err := uc.AddGroup("priority", true)
+	assert.Nil(t, err)
+	assert.Equal(t, g, item)
+
+	item, err = uc.AddGroup("area", false)
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelUpdateGroup(t *testing.T) {
+	g := domain.LabelGroup{ID: 1, Name: "priority", Exclusive: true}
+
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindGroupByID", uint(1)).Return(domain.LabelGroup{ID: 1, Name: "prio"}, nil)
+	ms.On("FindGroupByID", uint(2)).Return(domain.LabelGroup{}, errors.New("record not found"))
+	ms.On("UpdateGroup", g).Return(g, nil)
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	item, err := uc.UpdateGroup(uint(1), "priority", true)
+	assert.Nil(t, err)
+	assert.Equal(t, g, item)
+
+	_, err = uc.UpdateGroup(uint(2), "priority", true)
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelFindGroups(t *testing.T) {
+	g := domain.LabelGroup{ID: 1, Name: "priority"}
+
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindGroupByID", uint(1)).Return(g, nil)
+	ms.On("FindAllGroups").Return([]domain.LabelGroup{g}, nil)
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	item, err := uc.FindGroupByID(uint(1))
+	assert.Nil(t, err)
+	assert.Equal(t, g, item)
+
+	items, err := uc.FindAllGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.LabelGroup{g}, items)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelRemoveGroup(t *testing.T) {
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("RemoveGroup", uint(1)).Return(true, nil)
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	status, err := uc.RemoveGroup(uint(1))
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelSetGroup(t *testing.T) {
+	g := domain.LabelGroup{ID: 2, Name: "priority", Exclusive: true}
+	l := domain.Label{ID: 1, Name: "high", GroupID: 2, Group: &g}
+
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Label{ID: 1, Name: "high"}, nil)
+	ms.On("FindGroupByID", uint(2)).Return(domain.LabelGroup{ID: 2, Name: "priority", Exclusive: true, Labels: []domain.Label{{ID: 3}}}, nil)
+	ms.On("Update", l).Return(l, nil)
+	ms.On("Update", domain.Label{ID: 1, Name: "high"}).Return(domain.Label{ID: 1, Name: "high"}, nil)
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	item, err := uc.SetGroup(uint(1), uint(2))
+	assert.Nil(t, err)
+	assert.Equal(t, l, item)
+
+	item, err = uc.SetGroup(uint(1), uint(0))
+	assert.Nil(t, err)
+	assert.Nil(t, item.Group)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseLabelSetGroupErrs(t *testing.T) {
+	ms := new(dTesting.LabelServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Label{ID: 1, Name: "high"}, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Label{}, errors.New("record not found"))
+	ms.On("FindGroupByID", uint(3)).Return(domain.LabelGroup{}, errors.New("record not found"))
+	domain.GetDefaultLabelService = func(r domain.LabelRepository) domain.LabelService {
+		return ms
+	}
+	defer domain.ResetDefaultLabelService()
+
+	mr := new(dTesting.LabelRepositoryMock)
+
+	uc := usecases.NewLabelUseCase(mr, nil)
+
+	_, err := uc.SetGroup(uint(2), uint(3))
+	assert.NotNil(t, err)
+
+	_, err = uc.SetGroup(uint(1), uint(3))
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// MailUseCase interface
+type MailUseCase interface {
+	SetPreference(user string, email string, delivery string) (*domain.MailPreference, error)
+	FindPreference(user string) (domain.MailPreference, error)
+	QueueNotifications(now time.Time) (int, error)
+	ProcessQueue(now time.Time) (int, error)
+}
+
+// mailUseCase struct
+type mailUseCase struct {
+	service domain.MailService
+}
+
+// NewMailUseCase to create new MailUseCase, queue can not be processed when sender is nil
+func NewMailUseCase(repository domain.MailRepository, sender domain.MailSender) MailUseCase {
+	return &mailUseCase{
+		service: domain.GetDefaultMailService(repository, sender),
+	}
+}
+
+// SetPreference to set email and delivery of user
+func (uc *mailUseCase) SetPreference(user string, email string, delivery string) (*domain.MailPreference, error) {
+	item := new(domain.MailPreference)
+	item.User = user
+	item.Email = email
+	item.Delivery = delivery
+
+	itemSaved, err := uc.service.SetPreference(item, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return itemSaved, nil
+}
+
+// FindPreference to find preference of user
+func (uc *mailUseCase) FindPreference(user string) (domain.MailPreference, error) {
+	item, err := uc.service.FindPreference(user)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// QueueNotifications to queue emails with notifications created since last run
+func (uc *mailUseCase) QueueNotifications(now time.Time) (int, error) {
+	count, err := uc.service.QueueNotifications(now)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// ProcessQueue to send due messages
+func (uc *mailUseCase) ProcessQueue(now time.Time) (int, error) {
+	count, err := uc.service.ProcessQueue(now)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
@@ -0,0 +1,81 @@
+package usecases_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"go-issue-tracker/pkg/usecases"
+	"testing"
+	"time"
+)
+
+func TestUseCaseMailNewMailUseCase(t *testing.T) {
+	ms := new(dTesting.MailServiceMock)
+	domain.GetDefaultMailService = func(r domain.MailRepository, s domain.MailSender) domain.MailService {
+		return ms
+	}
+	defer domain.ResetDefaultMailService()
+
+	uc := usecases.NewMailUseCase(new(dTesting.MailRepositoryMock), new(dTesting.MailSenderMock))
+
+	assert.NotNil(t, uc)
+}
+
+func TestUseCaseMailPreference(t *testing.T) {
+	p := &domain.MailPreference{ID: 1, User: "alice", Email: "alice@example.com", Delivery: domain.MailDeliveryDigest}
+
+	ms := new(dTesting.MailServiceMock)
+	ms.On("SetPreference", &domain.MailPreference{User: "alice", Email: "alice@example.com", Delivery: "digest"}, mock.AnythingOfType("time.Time")).Return(p, nil)
+	ms.On("SetPreference", &domain.MailPreference{User: "alice", Email: "alice"}, mock.AnythingOfType("time.Time")).Return((*domain.MailPreference)(nil), errors.New("email alice not valid"))
+	ms.On("FindPreference", "alice").Return(*p, nil)
+	domain.GetDefaultMailService = func(r domain.MailRepository, s domain.MailSender) domain.MailService {
+		return ms
+	}
+	defer domain.ResetDefaultMailService()
+
+	uc := usecases.NewMailUseCase(new(dTesting.MailRepositoryMock), nil)
+
+	item, err := uc.SetPreference("alice", "alice@example.com", "digest")
+
+	assert.Nil(t, err)
+	assert.Equal(t, p, item)
+
+	item, err = uc.SetPreference("alice", "alice", "")
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	found, err := uc.FindPreference("alice")
+
+	assert.Nil(t, err)
+	assert.Equal(t, *p, found)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseMailQueue(t *testing.T) {
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+
+	ms := new(dTesting.MailServiceMock)
+	ms.On("QueueNotifications", now).Return(3, nil)
+	ms.On("ProcessQueue", now).Return(0, errors.New("mail sender not configured"))
+	domain.GetDefaultMailService = func(r domain.MailRepository, s domain.MailSender) domain.MailService {
+		return ms
+	}
+	defer domain.ResetDefaultMailService()
+
+	uc := usecases.NewMailUseCase(new(dTesting.MailRepositoryMock), nil)
+
+	count, err := uc.QueueNotifications(now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+
+	_, err = uc.ProcessQueue(now)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+}
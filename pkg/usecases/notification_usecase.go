@@ -0,0 +1,149 @@
+package usecases
+
+import (
+	"go-issue-tracker/pkg/domain"
+)
+
+// NotificationUseCase interface
+type NotificationUseCase interface {
+	WatchIssue(user string, issue domain.Issue) (*domain.Watcher, error)
+	WatchProject(user string, project domain.Project) (*domain.Watcher, error)
+	Unwatch(id uint) (bool, error)
+	FindWatcherByID(id uint) (domain.Watcher, error)
+	FindWatchers(user string, issueID uint, projectID uint) ([]domain.Watcher, error)
+	NotifyIssue(issue domain.Issue, event string, changes []domain.IssueChange) (int, error)
+	NotifyProject(project domain.Project, event string) (int, error)
+	FindNotificationByID(id uint) (domain.Notification, error)
+	FindNotifications(user string, unread bool) ([]domain.Notification, error)
+	MarkNotificationRead(id uint, read bool) (domain.Notification, error)
+	MarkAllNotificationsRead(user string) (int, error)
+}
+
+// notificationUseCase struct
+type notificationUseCase struct {
+	service domain.NotificationService
+}
+
+// NewNotificationUseCase to create new NotificationUseCase
+func NewNotificationUseCase(repository domain.NotificationRepository) NotificationUseCase {
+	return &notificationUseCase{
+		service: domain.GetDefaultNotificationService(repository),
+	}
+}
+
+// WatchIssue to subscribe user to notifications of issue
+func (uc *notificationUseCase) WatchIssue(user string, issue domain.Issue) (*domain.Watcher, error) {
+	item := new(domain.Watcher)
+	item.User = user
+	item.IssueID = issue.ID
+
+	itemAdded, err := uc.service.Watch(item)
+	if err != nil {
+		return nil, err
+	}
+	return itemAdded, nil
+}
+
+// WatchProject to subscribe user to notifications of project and its issues
+func (uc *notificationUseCase) WatchProject(user string, project domain.Project) (*domain.Watcher, error) {
+	item := new(domain.Watcher)
+	item.User = user
+	item.ProjectID = project.ID
+
+	itemAdded, err := uc.service.Watch(item)
+	if err != nil {
+		return nil, err
+	}
+	return itemAdded, nil
+}
+
+// Unwatch to unsubscribe user by removing watcher
+func (uc *notificationUseCase) Unwatch(id uint) (bool, error) {
+	watcher, err := uc.service.FindWatcherByID(id)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := uc.service.Unwatch(watcher)
+	if err != nil {
+		return false, err
+	}
+	return status, nil
+}
+
+// FindWatcherByID to find watcher by ID
+func (uc *notificationUseCase) FindWatcherByID(id uint) (domain.Watcher, error) {
+	item, err := uc.service.FindWatcherByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindWatchers to find watchers of user, issue or project
+func (uc *notificationUseCase) FindWatchers(user string, issueID uint, projectID uint) ([]domain.Watcher, error) {
+	items, err := uc.service.FindWatchers(user, issueID, projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// NotifyIssue to notify watchers of issue and its project about event and changed fields
+func (uc *notificationUseCase) NotifyIssue(issue domain.Issue, event string, changes []domain.IssueChange) (int, error) {
+	count, err := uc.service.NotifyIssue(issue, event, changes)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// NotifyProject to notify watchers of project about event
+func (uc *notificationUseCase) NotifyProject(project domain.Project, event string) (int, error) {
+	count, err := uc.service.NotifyProject(project, event)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// FindNotificationByID to find notification by ID
+func (uc *notificationUseCase) FindNotificationByID(id uint) (domain.Notification, error) {
+	item, err := uc.service.FindNotificationByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindNotifications to find notifications in inbox of user
+func (uc *notificationUseCase) FindNotifications(user string, unread bool) ([]domain.Notification, error) {
+	items, err := uc.service.FindNotifications(user, unread)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// MarkNotificationRead to mark notification as read or unread
+func (uc *notificationUseCase) MarkNotificationRead(id uint, read bool) (domain.Notification, error) {
+	item, err := uc.service.FindNotificationByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	itemUpdated, err := uc.service.MarkRead(item, read)
+	if err != nil {
+		return itemUpdated, err
+	}
+	return itemUpdated, nil
+}
+
+// MarkAllNotificationsRead to mark all notifications in inbox of user as read
+func (uc *notificationUseCase) MarkAllNotificationsRead(user string) (int, error) {
+	count, err := uc.service.MarkAllRead(user)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
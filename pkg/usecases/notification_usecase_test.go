@@ -0,0 +1,268 @@
+package usecases_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"go-issue-tracker/pkg/usecases"
+	ucTesting "go-issue-tracker/pkg/usecases/testing"
+	"testing"
+)
+
+func TestUseCaseNotificationNewNotificationUseCase(t *testing.T) {
+	ms := new(dTesting.NotificationServiceMock)
+	domain.GetDefaultNotificationService = func(r domain.NotificationRepository) domain.NotificationService {
+		return ms
+	}
+	defer domain.ResetDefaultNotificationService()
+
+	mr := new(dTesting.NotificationRepositoryMock)
+
+	uc := usecases.NewNotificationUseCase(mr)
+
+	assert.NotNil(t, uc)
+}
+
+func TestUseCaseNotificationWatch(t *testing.T) {
+	wi := &domain.Watcher{ID: 1, User: "alice", IssueID: 2}
+	wp := &domain.Watcher{ID: 2, User: "alice", ProjectID: 3}
+
+	ms := new(dTesting.NotificationServiceMock)
+	ms.On("Watch", &domain.Watcher{User: "alice", IssueID: 2}).Return(wi, nil)
+	ms.On("Watch", &domain.Watcher{User: "alice", ProjectID: 3}).Return(wp, nil)
+	ms.On("Watch", &domain.Watcher{User: "", ProjectID: 3}).Return((*domain.Watcher)(nil), errors.New("user not provided"))
+	domain.GetDefaultNotificationService = func(r domain.NotificationRepository) domain.NotificationService {
+		return ms
+	}
+	defer domain.ResetDefaultNotificationService()
+
+	uc := usecases.NewNotificationUseCase(new(dTesting.NotificationRepositoryMock))
+
+	item, err := uc.WatchIssue("alice", domain.Issue{ID: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wi, item)
+
+	item, err = uc.WatchProject("alice", domain.Project{ID: 3})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wp, item)
+
+	item, err = uc.WatchProject("", domain.Project{ID: 3})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseNotificationUnwatch(t *testing.T) {
+	w := domain.Watcher{ID: 1, User: "alice", IssueID: 2}
+
+	ms := new(dTesting.NotificationServiceMock)
+	ms.On("FindWatcherByID", uint(1)).Return(w, nil)
+	ms.On("Unwatch", w).Return(true, nil)
+	ms.On("FindWatcherByID", uint(2)).Return(domain.Watcher{}, errors.New("record not found"))
+	domain.GetDefaultNotificationService = func(r domain.NotificationRepository) domain.NotificationService {
+		return ms
+	}
+	defer domain.ResetDefaultNotificationService()
+
+	uc := usecases.NewNotificationUseCase(new(dTesting.NotificationRepositoryMock))
+
+	status, err := uc.Unwatch(uint(1))
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = uc.Unwatch(uint(2))
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseNotificationFindWatchers(t *testing.T) {
+	w := []domain.Watcher{{ID: 1, User: "alice", IssueID: 2}}
+
+	ms := new(dTesting.NotificationServiceMock)
+	ms.On("FindWatchers", "alice", uint(0), uint(0)).Return(w, nil)
+	ms.On("FindWatcherByID", uint(1)).Return(w[0], nil)
+	domain.GetDefaultNotificationService = func(r domain.NotificationRepository) domain.NotificationService {
+		return ms
+	}
+	defer domain.ResetDefaultNotificationService()
+
+	uc := usecases.NewNotificationUseCase(new(dTesting.NotificationRepositoryMock))
+
+	items, err := uc.FindWatchers("alice", uint(0), uint(0))
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, items)
+
+	item, err := uc.FindWatcherByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, w[0], item)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseNotificationNotify(t *testing.T) {
+	i := domain.Issue{ID: 2, ProjectID: 3}
+	p := domain.Project{ID: 3}
+	c := []domain.IssueChange{{Field: "status", Previous: "1", Current: "2"}}
+
+	ms := new(dTesting.NotificationServiceMock)
+	ms.On("NotifyIssue", i, domain.NotificationEventStatusChanged, c).Return(2, nil)
+	ms.On("NotifyProject", p, domain.NotificationEventUpdated).Return(0, errors.New("test error"))
+	domain.GetDefaultNotificationService = func(r domain.NotificationRepository) domain.NotificationService {
+		return ms
+	}
+	defer domain.ResetDefaultNotificationService()
+
+	uc := usecases.NewNotificationUseCase(new(dTesting.NotificationRepositoryMock))
+
+	count, err := uc.NotifyIssue(i, domain.NotificationEventStatusChanged, c)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = uc.NotifyProject(p, domain.NotificationEventUpdated)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseNotificationFindNotifications(t *testing.T) {
+	n := []domain.Notification{{ID: 1, User: "alice"}}
+
+	ms := new(dTesting.NotificationServiceMock)
+	ms.On("FindNotifications", "alice", true).Return(n, nil)
+	ms.On("FindNotificationByID", uint(1)).Return(n[0], nil)
+	domain.GetDefaultNotificationService = func(r domain.NotificationRepository) domain.NotificationService {
+		return ms
+	}
+	defer domain.ResetDefaultNotificationService()
+
+	uc := usecases.NewNotificationUseCase(new(dTesting.NotificationRepositoryMock))
+
+	items, err := uc.FindNotifications("alice", true)
+
+	assert.Nil(t, err)
+	assert.Equal(t, n, items)
+
+	item, err := uc.FindNotificationByID(uint(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, n[0], item)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseNotificationMarkNotificationRead(t *testing.T) {
+	n := domain.Notification{ID: 1, User: "alice"}
+	read := domain.Notification{ID: 1, User: "alice", Read: true}
+
+	ms := new(dTesting.NotificationServiceMock)
+	ms.On("FindNotificationByID", uint(1)).Return(n, nil)
+	ms.On("MarkRead", n, true).Return(read, nil)
+	ms.On("FindNotificationByID", uint(2)).Return(domain.Notification{}, errors.New("record not found"))
+	ms.On("MarkAllRead", "alice").Return(3, nil)
+	domain.GetDefaultNotificationService = func(r domain.NotificationRepository) domain.NotificationService {
+		return ms
+	}
+	defer domain.ResetDefaultNotificationService()
+
+	uc := usecases.NewNotificationUseCase(new(dTesting.NotificationRepositoryMock))
+
+	item, err := uc.MarkNotificationRead(uint(1), true)
+
+	assert.Nil(t, err)
+	assert.True(t, item.Read)
+
+	_, err = uc.MarkNotificationRead(uint(2), true)
+
+	assert.NotNil(t, err)
+
+	count, err := uc.MarkAllNotificationsRead("alice")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseNotificationIssueChanges(t *testing.T) {
+	i := domain.Issue{ID: 1, Title: "test-title", Status: 1, ProjectID: 1, Labels: []domain.Label{}}
+	iu := i
+	iu.Status = 2
+	w := &domain.WorkLog{ID: 1, IssueID: 1, Author: "alice", Minutes: 30, Comment: "review"}
+
+	ms := new(dTesting.IssueServiceMock)
+	ms.On("FindByID", uint(1)).Return(i, nil)
+	ms.On("Update", iu).Return(iu, nil)
+	ms.On("AddWorkLog", i, &domain.WorkLog{Author: "alice", Minutes: 30, Comment: "review"}).Return(w, nil)
+	ms.On("BulkUpdate", []domain.Issue{i}, domain.IssueBulkOperation{Status: &iu.Status}).Return([]domain.IssueBulkResult{{ID: 1, Status: true}}, nil)
+	domain.GetDefaultIssueService = func(r domain.IssueRepository) domain.IssueService {
+		return ms
+	}
+	defer domain.ResetDefaultIssueService()
+
+	c := []domain.IssueChange{{Field: "status", Previous: "1", Current: "2"}}
+
+	nucm := new(ucTesting.NotificationUseCaseMock)
+	nucm.On("NotifyIssue", iu, domain.NotificationEventStatusChanged, c).Return(1, nil).Once()
+	nucm.On("NotifyIssue", i, domain.NotificationEventCommented, []domain.IssueChange(nil)).Return(0, errors.New("test error")).Once()
+	nucm.On("NotifyIssue", iu, domain.NotificationEventStatusChanged, c).Return(1, nil).Once()
+
+	uc := usecases.NewIssueUseCase(new(dTesting.IssueRepositoryMock), nucm, nil)
+
+	item, err := uc.Update(uint(1), i.Title, i.Description, 2, i.Priority, i.Severity, "", "", map[string]domain.Label{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, iu, item)
+
+	workLog, err := uc.AddWorkLog(uint(1), "alice", 30, w.LoggedAt, "review")
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, workLog)
+
+	results, err := uc.BulkUpdate([]uint{1}, domain.IssueBulkOperation{Status: &iu.Status})
+
+	assert.Nil(t, err)
+	assert.True(t, results[0].Status)
+
+	ms.AssertExpectations(t)
+	nucm.AssertExpectations(t)
+}
+
+func TestUseCaseNotificationProjectChanges(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	pu := p
+	pu.Description = "test-description"
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("Update", pu).Return(pu, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	nucm := new(ucTesting.NotificationUseCaseMock)
+	nucm.On("NotifyProject", pu, domain.NotificationEventUpdated).Return(1, nil)
+
+	uc := usecases.NewProjectUseCase(new(dTesting.ProjectRepositoryMock), nucm, nil)
+
+	item, err := uc.Update(uint(1), pu.Name, pu.Description)
+
+	assert.Nil(t, err)
+	assert.Equal(t, pu, item)
+
+	ms.AssertExpectations(t)
+	nucm.AssertExpectations(t)
+}
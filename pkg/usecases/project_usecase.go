@@ -2,27 +2,70 @@ package usecases
 
 import (
 	"go-issue-tracker/pkg/domain"
+	"log"
 )
 
 // ProjectUseCase interface
 type ProjectUseCase interface {
 	Add(name string, description string) (*domain.Project, error)
 	Update(id uint, name string, description string) (domain.Project, error)
+	UpdateSettings(id uint, settings domain.ProjectSettings, defaultLabels []domain.Label) (domain.Project, error)
 	FindByID(id uint) (domain.Project, error)
 	Find(name string) ([]domain.Project, error)
 	FindAll() ([]domain.Project, error)
+	FindArchived() ([]domain.Project, error)
 	Remove(id uint) (bool, error)
+	RemoveWithMode(id uint, mode string, targetID uint) (domain.ProjectRemoval, error)
+	AddTemplate(projectID uint, name string, titlePrefix string, description string, status *int, labels []domain.Label) (*domain.IssueTemplate, error)
+	UpdateTemplate(id uint, name string, titlePrefix string, description string, status *int, labels []domain.Label) (domain.IssueTemplate, error)
+	FindTemplateByID(id uint) (domain.IssueTemplate, error)
+	FindTemplates(projectID uint) ([]domain.IssueTemplate, error)
+	RemoveTemplate(id uint) (bool, error)
+	AddCustomField(projectID uint, name string, fieldType string, options string) (*domain.CustomField, error)
+	UpdateCustomField(id uint, name string, options string) (domain.CustomField, error)
+	FindCustomFieldByID(id uint) (domain.CustomField, error)
+	FindCustomFields(projectID uint) ([]domain.CustomField, error)
+	RemoveCustomField(id uint) (bool, error)
 }
 
 // ProjectUseCase struct
 type projectUseCase struct {
-	service domain.ProjectService
+	repository    domain.ProjectRepository
+	service       domain.ProjectService
+	notifications NotificationUseCase
+	events        EventUseCase
 }
 
-// NewProjectUseCase to create new ProjectUseCase
-func NewProjectUseCase(repository domain.ProjectRepository) ProjectUseCase {
+// NewProjectUseCase to create new ProjectUseCase, watchers are not notified about changes when
+// notifications are nil and no domain events are published when events are nil
+func NewProjectUseCase(repository domain.ProjectRepository, notifications NotificationUseCase, events EventUseCase) ProjectUseCase {
 	return &projectUseCase{
-		service: domain.GetDefaultProjectService(repository),
+		repository:    repository,
+		service:       domain.GetDefaultProjectService(repository),
+		notifications: notifications,
+		events:        events,
+	}
+}
+
+// transaction to run change by service bound to transaction, domain events returned by change are
+// published in the same transaction, change runs without transaction when events are nil
+func (uc *projectUseCase) transaction(change func(service domain.ProjectService) ([]domain.DomainEvent, error)) error {
+	if uc.events == nil {
+		_, err := change(uc.service)
+		return err
+	}
+	return uc.events.Transaction(func(tx domain.Transaction) ([]domain.DomainEvent, error) {
+		return change(domain.GetDefaultProjectService(uc.repository.WithTransaction(tx)))
+	})
+}
+
+// notify to notify watchers of project about event, failed notification does not fail committed change
+func (uc *projectUseCase) notify(project domain.Project, event string) {
+	if uc.notifications == nil {
+		return
+	}
+	if _, err := uc.notifications.NotifyProject(project, event); err != nil {
+		log.Printf("Notifying watchers of project %d failed: %v", project.ID, err)
 	}
 }
 
@@ -31,7 +74,14 @@ func (uc *projectUseCase) Add(name string, description string) (*domain.Project,
 	item := new(domain.Project)
 	item.Name = name
 	item.Description = description
-	itemAdded, err := uc.service.Add(item)
+	var itemAdded *domain.Project
+	err := uc.transaction(func(service domain.ProjectService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemAdded, err = service.Add(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.ProjectCreated{Project: *itemAdded}}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +97,44 @@ func (uc *projectUseCase) Update(id uint, name string, description string) (doma
 
 	item.Name = name
 	item.Description = description
-	itemUpdated, err := uc.service.Update(item)
+	var itemUpdated domain.Project
+	err = uc.transaction(func(service domain.ProjectService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemUpdated, err = service.Update(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.ProjectUpdated{Project: itemUpdated}}, nil
+	})
+	if err != nil {
+		return itemUpdated, err
+	}
+
+	uc.notify(itemUpdated, domain.NotificationEventUpdated)
+	return itemUpdated, nil
+}
+
+// UpdateSettings to update project settings and default labels
+func (uc *projectUseCase) UpdateSettings(id uint, settings domain.ProjectSettings, defaultLabels []domain.Label) (domain.Project, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	item.Settings = settings
+	item.DefaultLabels = defaultLabels
+	var itemUpdated domain.Project
+	err = uc.transaction(func(service domain.ProjectService) ([]domain.DomainEvent, error) {
+		var err error
+		if itemUpdated, err = service.UpdateSettings(item); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.ProjectUpdated{Project: itemUpdated}}, nil
+	})
 	if err != nil {
 		return itemUpdated, err
 	}
+
+	uc.notify(itemUpdated, domain.NotificationEventUpdated)
 	return itemUpdated, nil
 }
 
@@ -81,9 +165,197 @@ func (uc *projectUseCase) FindAll() ([]domain.Project, error) {
 	return items, nil
 }
 
-// Remove to remove label
+// FindArchived to find archived projects
+func (uc *projectUseCase) FindArchived() ([]domain.Project, error) {
+	items, err := uc.service.FindArchived()
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Remove to remove project
 func (uc *projectUseCase) Remove(id uint) (bool, error) {
-	status, err := uc.service.Remove(id)
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return false, err
+	}
+
+	var status bool
+	err = uc.transaction(func(service domain.ProjectService) ([]domain.DomainEvent, error) {
+		var err error
+		if status, err = service.Remove(id); err != nil || !status {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.ProjectRemoved{Project: item}}, nil
+	})
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// RemoveWithMode to remove project deleting, moving to target project or archiving its issues
+func (uc *projectUseCase) RemoveWithMode(id uint, mode string, targetID uint) (domain.ProjectRemoval, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return domain.ProjectRemoval{}, err
+	}
+
+	var target *domain.Project
+	if mode == domain.ProjectRemovalMove && targetID != 0 {
+		targetItem, err := uc.service.FindByID(targetID)
+		if err != nil {
+			return domain.ProjectRemoval{}, domain.NewValidationError("target project not valid")
+		}
+		target = &targetItem
+	}
+
+	var removal domain.ProjectRemoval
+	err = uc.transaction(func(service domain.ProjectService) ([]domain.DomainEvent, error) {
+		var err error
+		if removal, err = service.RemoveWithMode(item, mode, target); err != nil {
+			return nil, err
+		}
+		return []domain.DomainEvent{domain.ProjectRemoved{Project: item}}, nil
+	})
+	if err != nil {
+		return removal, err
+	}
+	return removal, nil
+}
+
+// AddTemplate to add new issue template to project
+func (uc *projectUseCase) AddTemplate(projectID uint, name string, titlePrefix string, description string, status *int, labels []domain.Label) (*domain.IssueTemplate, error) {
+	project, err := uc.service.FindByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := new(domain.IssueTemplate)
+	item.ProjectID = project.ID
+	item.Name = name
+	item.TitlePrefix = titlePrefix
+	item.Description = description
+	item.Status = status
+	item.Labels = labels
+	itemAdded, err := uc.service.AddTemplate(project, item)
+	if err != nil {
+		return nil, err
+	}
+	return itemAdded, nil
+}
+
+// UpdateTemplate to update issue template
+func (uc *projectUseCase) UpdateTemplate(id uint, name string, titlePrefix string, description string, status *int, labels []domain.Label) (domain.IssueTemplate, error) {
+	item, err := uc.service.FindTemplateByID(id)
+	if err != nil {
+		return item, err
+	}
+	project, err := uc.service.FindByID(item.ProjectID)
+	if err != nil {
+		return item, err
+	}
+
+	item.Name = name
+	item.TitlePrefix = titlePrefix
+	item.Description = description
+	item.Status = status
+	item.Labels = labels
+	itemUpdated, err := uc.service.UpdateTemplate(project, item)
+	if err != nil {
+		return itemUpdated, err
+	}
+	return itemUpdated, nil
+}
+
+// FindTemplateByID to find issue template by ID
+func (uc *projectUseCase) FindTemplateByID(id uint) (domain.IssueTemplate, error) {
+	item, err := uc.service.FindTemplateByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindTemplates to find issue templates of project
+func (uc *projectUseCase) FindTemplates(projectID uint) ([]domain.IssueTemplate, error) {
+	items, err := uc.service.FindTemplates(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveTemplate to remove issue template
+func (uc *projectUseCase) RemoveTemplate(id uint) (bool, error) {
+	status, err := uc.service.RemoveTemplate(id)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// AddCustomField to add new custom field to project
+func (uc *projectUseCase) AddCustomField(projectID uint, name string, fieldType string, options string) (*domain.CustomField, error) {
+	project, err := uc.service.FindByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := new(domain.CustomField)
+	item.ProjectID = project.ID
+	item.Name = name
+	item.Type = fieldType
+	item.Options = options
+	itemAdded, err := uc.service.AddCustomField(project, item)
+	if err != nil {
+		return nil, err
+	}
+	return itemAdded, nil
+}
+
+// UpdateCustomField to update name and options of custom field
+func (uc *projectUseCase) UpdateCustomField(id uint, name string, options string) (domain.CustomField, error) {
+	item, err := uc.service.FindCustomFieldByID(id)
+	if err != nil {
+		return item, err
+	}
+	project, err := uc.service.FindByID(item.ProjectID)
+	if err != nil {
+		return item, err
+	}
+
+	item.Name = name
+	item.Options = options
+	itemUpdated, err := uc.service.UpdateCustomField(project, item)
+	if err != nil {
+		return itemUpdated, err
+	}
+	return itemUpdated, nil
+}
+
+// FindCustomFieldByID to find custom field by ID
+func (uc *projectUseCase) FindCustomFieldByID(id uint) (domain.CustomField, error) {
+	item, err := uc.service.FindCustomFieldByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindCustomFields to find custom fields of project
+func (uc *projectUseCase) FindCustomFields(projectID uint) ([]domain.CustomField, error) {
+	items, err := uc.service.FindCustomFields(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// RemoveCustomField to remove custom field and its values
+func (uc *projectUseCase) RemoveCustomField(id uint) (bool, error) {
+	status, err := uc.service.RemoveCustomField(id)
 	if err != nil {
 		return status, err
 	}
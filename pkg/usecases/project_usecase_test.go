@@ -18,7 +18,7 @@ func TestUseCaseProjectNewProjectUseCase(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 }
@@ -37,7 +37,7 @@ func TestUseCaseProjectAdd(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -65,7 +65,7 @@ func TestUseCaseProjectAddErr(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -94,7 +94,7 @@ func TestUseCaseProjectUpdate(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -124,7 +124,7 @@ func TestUseCaseProjectUpdateErr(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -148,7 +148,7 @@ func TestUseCaseProjectUpdateFindByIDErr(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -176,7 +176,7 @@ func TestUseCaseProjectFindByID(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -204,7 +204,7 @@ func TestUseCaseProjectFindByIDErr(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -239,7 +239,7 @@ func TestUseCaseProjectFind(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -265,7 +265,7 @@ func TestUseCaseProjectFindErr(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -300,7 +300,7 @@ func TestUseCaseProjectFindAll(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -326,7 +326,7 @@ func TestUseCaseProjectFindAllErr(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -342,6 +342,7 @@ func TestUseCaseProjectFindAllErr(t *testing.T) {
 
 func TestUseCaseProjectRemove(t *testing.T) {
 	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Project{ID: 1}, nil)
 	ms.On("Remove", uint(1)).Return(true, nil)
 	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
 		return ms
@@ -350,7 +351,7 @@ func TestUseCaseProjectRemove(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -365,6 +366,7 @@ func TestUseCaseProjectRemove(t *testing.T) {
 
 func TestUseCaseProjectRemoveErr(t *testing.T) {
 	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Project{ID: 1}, nil)
 	ms.On("Remove", uint(1)).Return(false, errors.New("test error"))
 	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
 		return ms
@@ -373,7 +375,7 @@ func TestUseCaseProjectRemoveErr(t *testing.T) {
 
 	mr := new(dTesting.ProjectRepositoryMock)
 
-	uc := usecases.NewProjectUseCase(mr)
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
 
 	assert.NotNil(t, uc)
 
@@ -385,3 +387,532 @@ func TestUseCaseProjectRemoveErr(t *testing.T) {
 	ms.AssertExpectations(t)
 	mr.AssertExpectations(t)
 }
+
+func TestUseCaseProjectFindArchived(t *testing.T) {
+	p := []domain.Project{
+		domain.Project{ID: 1, Name: "test-name", Archived: true},
+	}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindArchived").Return(p, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	items, err := uc.FindArchived()
+
+	assert.Nil(t, err)
+	assert.Equal(t, p, items)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectFindArchivedErr(t *testing.T) {
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindArchived").Return([]domain.Project{}, errors.New("test error"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	items, err := uc.FindArchived()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []domain.Project{}, items)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectRemoveWithMode(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	tp := domain.Project{ID: 2, Name: "test-target"}
+	removal := domain.ProjectRemoval{ID: 1, Mode: domain.ProjectRemovalMove, Issues: 2}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("FindByID", uint(2)).Return(tp, nil)
+	ms.On("RemoveWithMode", p, domain.ProjectRemovalMove, &tp).Return(removal, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	item, err := uc.RemoveWithMode(uint(1), domain.ProjectRemovalMove, uint(2))
+
+	assert.Nil(t, err)
+	assert.Equal(t, removal, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectRemoveWithModeErr(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	ms.On("FindByID", uint(3)).Return(domain.Project{}, errors.New("record not found"))
+	ms.On("RemoveWithMode", p, domain.ProjectRemovalCascade, (*domain.Project)(nil)).Return(domain.ProjectRemoval{}, errors.New("test error"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	tests := []struct {
+		id       uint
+		mode     string
+		targetID uint
+		err      string
+	}{
+		{3, domain.ProjectRemovalCascade, 0, "record not found"},
+		{1, domain.ProjectRemovalMove, 2, "target project not valid"},
+		{1, domain.ProjectRemovalCascade, 0, "test error"},
+	}
+
+	for _, ts := range tests {
+		_, err := uc.RemoveWithMode(ts.id, ts.mode, ts.targetID)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, ts.err, err.Error())
+	}
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectUpdateSettings(t *testing.T) {
+	settings := domain.ProjectSettings{MaxLabels: 2, DefaultStatus: 1}
+	labels := []domain.Label{{ID: 1, Name: "bug"}}
+	p := domain.Project{ID: 1, Name: "test-name", Settings: settings, DefaultLabels: labels}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Project{ID: 1, Name: "test-name"}, nil)
+	ms.On("UpdateSettings", p).Return(p, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	item, err := uc.UpdateSettings(uint(1), settings, labels)
+
+	assert.Nil(t, err)
+	assert.Equal(t, p, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectUpdateSettingsErrs(t *testing.T) {
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(domain.Project{ID: 1}, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	ms.On("UpdateSettings", domain.Project{ID: 1}).Return(domain.Project{ID: 1}, errors.New("max labels not valid"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	_, err := uc.UpdateSettings(uint(2), domain.ProjectSettings{}, nil)
+	assert.NotNil(t, err)
+
+	_, err = uc.UpdateSettings(uint(1), domain.ProjectSettings{}, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, "max labels not valid", err.Error())
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectAddTemplate(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	labels := []domain.Label{{ID: 1, Name: "bug"}}
+	status := 1
+	tpl := &domain.IssueTemplate{
+		ProjectID:   1,
+		Name:        "bug",
+		TitlePrefix: "[Bug] ",
+		Description: "test-description",
+		Status:      &status,
+		Labels:      labels,
+	}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("AddTemplate", p, tpl).Return(tpl, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	item, err := uc.AddTemplate(uint(1), "bug", "[Bug] ", "test-description", &status, labels)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tpl, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectAddTemplateErrs(t *testing.T) {
+	p := domain.Project{ID: 1, Archived: true}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	ms.On("AddTemplate", p, &domain.IssueTemplate{ProjectID: 1, Name: "bug"}).Return((*domain.IssueTemplate)(nil), errors.New("project is archived"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	_, err := uc.AddTemplate(uint(2), "bug", "", "", nil, nil)
+	assert.NotNil(t, err)
+
+	item, err := uc.AddTemplate(uint(1), "bug", "", "", nil, nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectUpdateTemplate(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	labels := []domain.Label{{ID: 1, Name: "bug"}}
+	status := 1
+	tpl := domain.IssueTemplate{
+		ID:          1,
+		ProjectID:   1,
+		Name:        "bug",
+		TitlePrefix: "[Bug] ",
+		Description: "test-description",
+		Status:      &status,
+		Labels:      labels,
+	}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindTemplateByID", uint(1)).Return(domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "old"}, nil)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("UpdateTemplate", p, tpl).Return(tpl, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	item, err := uc.UpdateTemplate(uint(1), "bug", "[Bug] ", "test-description", &status, labels)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tpl, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectUpdateTemplateErrs(t *testing.T) {
+	p := domain.Project{ID: 1, Archived: true}
+	tpl := domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "bug"}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindTemplateByID", uint(1)).Return(tpl, nil)
+	ms.On("FindTemplateByID", uint(2)).Return(domain.IssueTemplate{}, errors.New("record not found"))
+	ms.On("FindTemplateByID", uint(3)).Return(domain.IssueTemplate{ID: 3, ProjectID: 3}, nil)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("FindByID", uint(3)).Return(domain.Project{}, errors.New("record not found"))
+	ms.On("UpdateTemplate", p, tpl).Return(tpl, errors.New("project is archived"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	_, err := uc.UpdateTemplate(uint(2), "bug", "", "", nil, nil)
+	assert.NotNil(t, err)
+
+	_, err = uc.UpdateTemplate(uint(3), "bug", "", "", nil, nil)
+	assert.NotNil(t, err)
+
+	_, err = uc.UpdateTemplate(uint(1), "bug", "", "", nil, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, "project is archived", err.Error())
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectFindTemplates(t *testing.T) {
+	tpl := domain.IssueTemplate{ID: 1, ProjectID: 1, Name: "bug"}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindTemplateByID", uint(1)).Return(tpl, nil)
+	ms.On("FindTemplates", uint(1)).Return([]domain.IssueTemplate{tpl}, nil)
+	ms.On("RemoveTemplate", uint(1)).Return(true, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	item, err := uc.FindTemplateByID(uint(1))
+	assert.Nil(t, err)
+	assert.Equal(t, tpl, item)
+
+	items, err := uc.FindTemplates(uint(1))
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.IssueTemplate{tpl}, items)
+
+	status, err := uc.RemoveTemplate(uint(1))
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectFindTemplatesErr(t *testing.T) {
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindTemplateByID", uint(1)).Return(domain.IssueTemplate{}, errors.New("test error"))
+	ms.On("FindTemplates", uint(1)).Return([]domain.IssueTemplate{}, errors.New("test error"))
+	ms.On("RemoveTemplate", uint(1)).Return(false, errors.New("test error"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	_, err := uc.FindTemplateByID(uint(1))
+	assert.NotNil(t, err)
+
+	_, err = uc.FindTemplates(uint(1))
+	assert.NotNil(t, err)
+
+	_, err = uc.RemoveTemplate(uint(1))
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectAddCustomField(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	f := &domain.CustomField{
+		ProjectID: 1,
+		Name:      "environment",
+		Type:      domain.CustomFieldTypeSelect,
+		Options:   "dev,prod",
+	}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("AddCustomField", p, f).Return(f, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	item, err := uc.AddCustomField(uint(1), "environment", domain.CustomFieldTypeSelect, "dev,prod")
+
+	assert.Nil(t, err)
+	assert.Equal(t, f, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectAddCustomFieldErrs(t *testing.T) {
+	p := domain.Project{ID: 1, Archived: true}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Project{}, errors.New("record not found"))
+	ms.On("AddCustomField", p, &domain.CustomField{ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}).Return((*domain.CustomField)(nil), errors.New("project is archived"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	_, err := uc.AddCustomField(uint(2), "customer", domain.CustomFieldTypeText, "")
+	assert.NotNil(t, err)
+
+	item, err := uc.AddCustomField(uint(1), "customer", domain.CustomFieldTypeText, "")
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectUpdateCustomField(t *testing.T) {
+	p := domain.Project{ID: 1, Name: "test-name"}
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "env", Type: domain.CustomFieldTypeSelect, Options: "dev,staging,prod"}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindCustomFieldByID", uint(1)).Return(domain.CustomField{ID: 1, ProjectID: 1, Name: "environment", Type: domain.CustomFieldTypeSelect, Options: "dev,prod"}, nil)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("UpdateCustomField", p, f).Return(f, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	item, err := uc.UpdateCustomField(uint(1), "env", "dev,staging,prod")
+
+	assert.Nil(t, err)
+	assert.Equal(t, f, item)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectUpdateCustomFieldErrs(t *testing.T) {
+	p := domain.Project{ID: 1, Archived: true}
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindCustomFieldByID", uint(1)).Return(f, nil)
+	ms.On("FindCustomFieldByID", uint(2)).Return(domain.CustomField{}, errors.New("record not found"))
+	ms.On("FindCustomFieldByID", uint(3)).Return(domain.CustomField{ID: 3, ProjectID: 3}, nil)
+	ms.On("FindByID", uint(1)).Return(p, nil)
+	ms.On("FindByID", uint(3)).Return(domain.Project{}, errors.New("record not found"))
+	ms.On("UpdateCustomField", p, f).Return(f, errors.New("project is archived"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	_, err := uc.UpdateCustomField(uint(2), "customer", "")
+	assert.NotNil(t, err)
+
+	_, err = uc.UpdateCustomField(uint(3), "customer", "")
+	assert.NotNil(t, err)
+
+	_, err = uc.UpdateCustomField(uint(1), "customer", "")
+	assert.NotNil(t, err)
+	assert.Equal(t, "project is archived", err.Error())
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectFindCustomFields(t *testing.T) {
+	f := domain.CustomField{ID: 1, ProjectID: 1, Name: "customer", Type: domain.CustomFieldTypeText}
+
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindCustomFieldByID", uint(1)).Return(f, nil)
+	ms.On("FindCustomFields", uint(1)).Return([]domain.CustomField{f}, nil)
+	ms.On("RemoveCustomField", uint(1)).Return(true, nil)
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	item, err := uc.FindCustomFieldByID(uint(1))
+	assert.Nil(t, err)
+	assert.Equal(t, f, item)
+
+	items, err := uc.FindCustomFields(uint(1))
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.CustomField{f}, items)
+
+	status, err := uc.RemoveCustomField(uint(1))
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
+
+func TestUseCaseProjectFindCustomFieldsErr(t *testing.T) {
+	ms := new(dTesting.ProjectServiceMock)
+	ms.On("FindCustomFieldByID", uint(1)).Return(domain.CustomField{}, errors.New("test error"))
+	ms.On("FindCustomFields", uint(1)).Return([]domain.CustomField{}, errors.New("test error"))
+	ms.On("RemoveCustomField", uint(1)).Return(false, errors.New("test error"))
+	domain.GetDefaultProjectService = func(r domain.ProjectRepository) domain.ProjectService {
+		return ms
+	}
+	defer domain.ResetDefaultProjectService()
+
+	mr := new(dTesting.ProjectRepositoryMock)
+
+	uc := usecases.NewProjectUseCase(mr, nil, nil)
+
+	_, err := uc.FindCustomFieldByID(uint(1))
+	assert.NotNil(t, err)
+
+	_, err = uc.FindCustomFields(uint(1))
+	assert.NotNil(t, err)
+
+	_, err = uc.RemoveCustomField(uint(1))
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+	mr.AssertExpectations(t)
+}
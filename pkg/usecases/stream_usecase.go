@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"go-issue-tracker/pkg/domain"
+	"sync"
+	"time"
+)
+
+// StreamUseCase interface is stream of domain events for real-time clients
+type StreamUseCase interface {
+	HandleEvent(event domain.DomainEvent) error
+	Subscribe(projectID uint, lastEventID uint64) (*domain.StreamSubscription, []domain.StreamEvent, bool)
+	Unsubscribe(subscription *domain.StreamSubscription)
+}
+
+// streamUseCase struct
+type streamUseCase struct {
+	mutex         sync.Mutex
+	lastID        uint64
+	log           []domain.StreamEvent
+	subscriptions map[*domain.StreamSubscription]bool
+}
+
+// NewStreamUseCase to create new StreamUseCase, event log and subscriptions are kept in memory of process
+func NewStreamUseCase() StreamUseCase {
+	return &streamUseCase{
+		log:           []domain.StreamEvent{},
+		subscriptions: make(map[*domain.StreamSubscription]bool),
+	}
+}
+
+// HandleEvent to add event to event log and send it to matching subscriptions, it is subscribed synchronously
+// to event bus, so it never blocks and subscription whose buffer is full is dropped
+func (uc *streamUseCase) HandleEvent(event domain.DomainEvent) error {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	item, err := domain.NewStreamEvent(uc.lastID+1, event, time.Now())
+	if err != nil {
+		return err
+	}
+	uc.lastID = item.ID
+	uc.log = append(uc.log, item)
+	if len(uc.log) > domain.StreamLogSize {
+		uc.log = uc.log[len(uc.log)-domain.StreamLogSize:]
+	}
+
+	for subscription := range uc.subscriptions {
+		if !item.Matches(subscription.ProjectID) {
+			continue
+		}
+		select {
+		case subscription.Events <- item:
+		default:
+			delete(uc.subscriptions, subscription)
+			close(subscription.Done)
+		}
+	}
+	return nil
+}
+
+// Subscribe to subscribe to events of project, events after last event ID are returned from event log for
+// resumption and flag is false when some of them are no longer in event log, zero ID starts without resumption
+func (uc *streamUseCase) Subscribe(projectID uint, lastEventID uint64) (*domain.StreamSubscription, []domain.StreamEvent, bool) {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	subscription := domain.NewStreamSubscription(projectID)
+	uc.subscriptions[subscription] = true
+
+	items := []domain.StreamEvent{}
+	if lastEventID == 0 {
+		return subscription, items, true
+	}
+	for _, item := range uc.log {
+		if item.ID > lastEventID && item.Matches(projectID) {
+			items = append(items, item)
+		}
+	}
+	complete := lastEventID <= uc.lastID
+	if len(uc.log) > 0 && lastEventID+1 < uc.log[0].ID {
+		complete = false
+	}
+	return subscription, items, complete
+}
+
+// Unsubscribe to stop sending events to subscription
+func (uc *streamUseCase) Unsubscribe(subscription *domain.StreamSubscription) {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+	delete(uc.subscriptions, subscription)
+}
@@ -0,0 +1,97 @@
+package usecases_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/usecases"
+	"testing"
+)
+
+func TestUseCaseStreamHandleEventAndSubscribe(t *testing.T) {
+	uc := usecases.NewStreamUseCase()
+
+	all, items, complete := uc.Subscribe(0, 0)
+
+	assert.True(t, complete)
+	assert.Equal(t, 0, len(items))
+
+	project, _, _ := uc.Subscribe(2, 0)
+
+	assert.Nil(t, uc.HandleEvent(domain.IssueCreated{Issue: domain.Issue{ID: 1, ProjectID: 1}}))
+	assert.Nil(t, uc.HandleEvent(domain.IssueCreated{Issue: domain.Issue{ID: 2, ProjectID: 2}}))
+	assert.Nil(t, uc.HandleEvent(domain.LabelCreated{Label: domain.Label{ID: 1}}))
+
+	assert.Equal(t, 3, len(all.Events))
+	assert.Equal(t, 2, len(project.Events))
+	item := <-project.Events
+	assert.Equal(t, uint64(2), item.ID)
+	item = <-project.Events
+	assert.Equal(t, uint64(3), item.ID)
+
+	uc.Unsubscribe(project)
+
+	assert.Nil(t, uc.HandleEvent(domain.ProjectUpdated{Project: domain.Project{ID: 2}}))
+
+	assert.Equal(t, 4, len(all.Events))
+	assert.Equal(t, 0, len(project.Events))
+
+	_, items, complete = uc.Subscribe(2, 1)
+
+	assert.True(t, complete)
+	assert.Equal(t, 3, len(items))
+	assert.Equal(t, uint64(2), items[0].ID)
+
+	_, items, complete = uc.Subscribe(0, 4)
+
+	assert.True(t, complete)
+	assert.Equal(t, 0, len(items))
+
+	_, items, complete = uc.Subscribe(0, 5)
+
+	assert.False(t, complete)
+	assert.Equal(t, 0, len(items))
+}
+
+func TestUseCaseStreamLogIsBounded(t *testing.T) {
+	uc := usecases.NewStreamUseCase()
+
+	for i := 0; i < domain.StreamLogSize+10; i++ {
+		assert.Nil(t, uc.HandleEvent(domain.IssueUpdated{Issue: domain.Issue{ID: 1, ProjectID: 1}}))
+	}
+
+	_, items, complete := uc.Subscribe(1, 10)
+
+	assert.True(t, complete)
+	assert.Equal(t, domain.StreamLogSize, len(items))
+	assert.Equal(t, uint64(11), items[0].ID)
+
+	_, items, complete = uc.Subscribe(1, 9)
+
+	assert.False(t, complete)
+	assert.Equal(t, domain.StreamLogSize, len(items))
+}
+
+func TestUseCaseStreamDropsSlowSubscription(t *testing.T) {
+	uc := usecases.NewStreamUseCase()
+
+	slow, _, _ := uc.Subscribe(0, 0)
+
+	for i := 0; i < domain.StreamBufferSize; i++ {
+		assert.Nil(t, uc.HandleEvent(domain.IssueUpdated{Issue: domain.Issue{ID: 1, ProjectID: 1}}))
+	}
+
+	select {
+	case <-slow.Done:
+		t.Error("subscription dropped before buffer is full")
+	default:
+	}
+
+	assert.Nil(t, uc.HandleEvent(domain.IssueUpdated{Issue: domain.Issue{ID: 1, ProjectID: 1}}))
+
+	<-slow.Done
+	assert.Equal(t, domain.StreamBufferSize, len(slow.Events))
+
+	assert.Nil(t, uc.HandleEvent(domain.IssueUpdated{Issue: domain.Issue{ID: 1, ProjectID: 1}}))
+
+	uc.Unsubscribe(slow)
+}
@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// AutomationUseCaseMock is a mock of AutomationUseCase
+type AutomationUseCaseMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *AutomationUseCaseMock) Add(project domain.Project, name string, trigger string, conditions []string, actions []string) (*domain.AutomationRule, error) {
+	args := m.Called(project, name, trigger, conditions, actions)
+	return args.Get(0).(*domain.AutomationRule), args.Error(1)
+}
+
+// Update mock
+func (m *AutomationUseCaseMock) Update(id uint, name string, trigger string, conditions []string, actions []string, active bool) (domain.AutomationRule, error) {
+	args := m.Called(id, name, trigger, conditions, actions, active)
+	return args.Get(0).(domain.AutomationRule), args.Error(1)
+}
+
+// FindByID mock
+func (m *AutomationUseCaseMock) FindByID(id uint) (domain.AutomationRule, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.AutomationRule), args.Error(1)
+}
+
+// Find mock
+func (m *AutomationUseCaseMock) Find(projectID uint) ([]domain.AutomationRule, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.AutomationRule), args.Error(1)
+}
+
+// Remove mock
+func (m *AutomationUseCaseMock) Remove(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindExecutions mock
+func (m *AutomationUseCaseMock) FindExecutions(ruleID uint) ([]domain.AutomationExecution, error) {
+	args := m.Called(ruleID)
+	return args.Get(0).([]domain.AutomationExecution), args.Error(1)
+}
+
+// HandleEvent mock
+func (m *AutomationUseCaseMock) HandleEvent(event domain.DomainEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
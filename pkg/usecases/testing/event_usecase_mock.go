@@ -0,0 +1,40 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/usecases"
+	"time"
+)
+
+// EventUseCaseMock is a mock of EventUseCase
+type EventUseCaseMock struct {
+	mock.Mock
+}
+
+// Subscribe mock
+func (m *EventUseCaseMock) Subscribe(handler domain.EventHandler, names ...string) {
+	m.Called(handler, names)
+}
+
+// SubscribeAsync mock
+func (m *EventUseCaseMock) SubscribeAsync(subscriber string, handler domain.EventHandler, names ...string) error {
+	args := m.Called(subscriber, handler, names)
+	return args.Error(0)
+}
+
+// Transaction mock runs change without transaction and records its events
+func (m *EventUseCaseMock) Transaction(change usecases.EventChange) error {
+	events, err := change(nil)
+	if err != nil {
+		return err
+	}
+	args := m.Called(events)
+	return args.Error(0)
+}
+
+// ProcessOutbox mock
+func (m *EventUseCaseMock) ProcessOutbox(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
@@ -3,6 +3,8 @@ package testing
 import (
 	"github.com/stretchr/testify/mock"
 	"go-issue-tracker/pkg/domain"
+	"go-issue-tracker/pkg/usecases"
+	"time"
 )
 
 // IssueUseCaseMock is a mock of IssueUseCase
@@ -11,14 +13,50 @@ type IssueUseCaseMock struct {
 }
 
 // Add mock
-func (m *IssueUseCaseMock) Add(title string, description string, status int, project domain.Project, labels map[string]domain.Label) (*domain.Issue, error) {
-	args := m.Called(title, description, status, project, labels)
+func (m *IssueUseCaseMock) Add(title string, description string, status int, priority int, severity int, reporter string, assignee string, project domain.Project, labels map[string]domain.Label) (*domain.Issue, error) {
+	args := m.Called(title, description, status, priority, severity, reporter, assignee, project, labels)
+	return args.Get(0).(*domain.Issue), args.Error(1)
+}
+
+// AddFromTemplate mock
+func (m *IssueUseCaseMock) AddFromTemplate(title string, description string, status int, priority int, severity int, reporter string, assignee string, project domain.Project, labels map[string]domain.Label, template domain.IssueTemplate) (*domain.Issue, error) {
+	args := m.Called(title, description, status, priority, severity, reporter, assignee, project, labels, template)
 	return args.Get(0).(*domain.Issue), args.Error(1)
 }
 
 // Update mock
-func (m *IssueUseCaseMock) Update(id uint, title string, description string, status int, labels map[string]domain.Label) (domain.Issue, error) {
-	args := m.Called(id, title, description, status, labels)
+func (m *IssueUseCaseMock) Update(id uint, title string, description string, status int, priority int, severity int, reporter string, assignee string, labels map[string]domain.Label) (domain.Issue, error) {
+	args := m.Called(id, title, description, status, priority, severity, reporter, assignee, labels)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// BulkUpdate mock
+func (m *IssueUseCaseMock) BulkUpdate(ids []uint, operation domain.IssueBulkOperation) ([]domain.IssueBulkResult, error) {
+	args := m.Called(ids, operation)
+	return args.Get(0).([]domain.IssueBulkResult), args.Error(1)
+}
+
+// BulkUpdateFound mock
+func (m *IssueUseCaseMock) BulkUpdateFound(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions, operation domain.IssueBulkOperation) ([]domain.IssueBulkResult, error) {
+	args := m.Called(title, projectID, labels, customFields, options, operation)
+	return args.Get(0).([]domain.IssueBulkResult), args.Error(1)
+}
+
+// Move mock
+func (m *IssueUseCaseMock) Move(id uint, project domain.Project) (domain.Issue, error) {
+	args := m.Called(id, project)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// FindByProjectAndID mock
+func (m *IssueUseCaseMock) FindByProjectAndID(projectID uint, id uint) (domain.Issue, error) {
+	args := m.Called(projectID, id)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// SetCustomFields mock
+func (m *IssueUseCaseMock) SetCustomFields(id uint, fields []domain.CustomField, values map[string]string) (domain.Issue, error) {
+	args := m.Called(id, fields, values)
 	return args.Get(0).(domain.Issue), args.Error(1)
 }
 
@@ -29,8 +67,8 @@ func (m *IssueUseCaseMock) FindByID(id uint) (domain.Issue, error) {
 }
 
 // Find mock
-func (m *IssueUseCaseMock) Find(title string, projectID uint, labels []string) ([]domain.Issue, error) {
-	args := m.Called(title, projectID, labels)
+func (m *IssueUseCaseMock) Find(title string, projectID uint, labels []string, customFields []domain.CustomFieldFilter, options domain.IssueFindOptions) ([]domain.Issue, error) {
+	args := m.Called(title, projectID, labels, customFields, options)
 	return args.Get(0).([]domain.Issue), args.Error(1)
 }
 
@@ -45,3 +83,93 @@ func (m *IssueUseCaseMock) Remove(id uint) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
+
+// MigratePriorityLabels mock
+func (m *IssueUseCaseMock) MigratePriorityLabels() (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+// CheckSLA mock
+func (m *IssueUseCaseMock) CheckSLA(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
+
+// SetEstimates mock
+func (m *IssueUseCaseMock) SetEstimates(id uint, originalEstimate int, remainingEstimate int) (domain.Issue, error) {
+	args := m.Called(id, originalEstimate, remainingEstimate)
+	return args.Get(0).(domain.Issue), args.Error(1)
+}
+
+// AddWorkLog mock
+func (m *IssueUseCaseMock) AddWorkLog(issueID uint, author string, minutes int, loggedAt time.Time, comment string) (*domain.WorkLog, error) {
+	args := m.Called(issueID, author, minutes, loggedAt, comment)
+	return args.Get(0).(*domain.WorkLog), args.Error(1)
+}
+
+// RemoveWorkLog mock
+func (m *IssueUseCaseMock) RemoveWorkLog(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindWorkLogByID mock
+func (m *IssueUseCaseMock) FindWorkLogByID(id uint) (domain.WorkLog, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.WorkLog), args.Error(1)
+}
+
+// FindWorkLogs mock
+func (m *IssueUseCaseMock) FindWorkLogs(issueID uint) ([]domain.WorkLog, error) {
+	args := m.Called(issueID)
+	return args.Get(0).([]domain.WorkLog), args.Error(1)
+}
+
+// FindTimeSummary mock
+func (m *IssueUseCaseMock) FindTimeSummary(projectID uint) (domain.TimeSummary, error) {
+	args := m.Called(projectID)
+	return args.Get(0).(domain.TimeSummary), args.Error(1)
+}
+
+// FindTimesheet mock
+func (m *IssueUseCaseMock) FindTimesheet(projectID uint, from time.Time, to time.Time) ([]domain.TimesheetEntry, error) {
+	args := m.Called(projectID, from, to)
+	return args.Get(0).([]domain.TimesheetEntry), args.Error(1)
+}
+
+// AddAttachment mock
+func (m *IssueUseCaseMock) AddAttachment(issueID uint, filename string, content []byte) (*domain.Attachment, error) {
+	args := m.Called(issueID, filename, content)
+	return args.Get(0).(*domain.Attachment), args.Error(1)
+}
+
+// RemoveAttachment mock
+func (m *IssueUseCaseMock) RemoveAttachment(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindAttachmentByID mock
+func (m *IssueUseCaseMock) FindAttachmentByID(id uint) (domain.Attachment, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Attachment), args.Error(1)
+}
+
+// FindAttachments mock
+func (m *IssueUseCaseMock) FindAttachments(issueID uint) ([]domain.Attachment, error) {
+	args := m.Called(issueID)
+	return args.Get(0).([]domain.Attachment), args.Error(1)
+}
+
+// ReadAttachment mock
+func (m *IssueUseCaseMock) ReadAttachment(id uint) (domain.Attachment, []byte, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Attachment), args.Get(1).([]byte), args.Error(2)
+}
+
+// WithChain mock
+func (m *IssueUseCaseMock) WithChain(chain *domain.AutomationChain) usecases.IssueUseCase {
+	args := m.Called(chain)
+	return args.Get(0).(usecases.IssueUseCase)
+}
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// JobUseCaseMock is a mock of JobUseCase
+type JobUseCaseMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *JobUseCaseMock) Add(project domain.Project, name string, kind string, schedule string, params []string) (*domain.Job, error) {
+	args := m.Called(project, name, kind, schedule, params)
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+// Update mock
+func (m *JobUseCaseMock) Update(id uint, name string, schedule string, params []string, active bool) (domain.Job, error) {
+	args := m.Called(id, name, schedule, params, active)
+	return args.Get(0).(domain.Job), args.Error(1)
+}
+
+// FindByID mock
+func (m *JobUseCaseMock) FindByID(id uint) (domain.Job, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Job), args.Error(1)
+}
+
+// FindAll mock
+func (m *JobUseCaseMock) FindAll() ([]domain.Job, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.Job), args.Error(1)
+}
+
+// Remove mock
+func (m *JobUseCaseMock) Remove(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// Run mock
+func (m *JobUseCaseMock) Run(id uint) (domain.Job, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Job), args.Error(1)
+}
+
+// RunDue mock
+func (m *JobUseCaseMock) RunDue(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
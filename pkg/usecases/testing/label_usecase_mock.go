@@ -11,8 +11,8 @@ type LabelUseCaseMock struct {
 }
 
 // Add mock
-func (m *LabelUseCaseMock) Add(name string, colorHexCode string) (*domain.Label, error) {
-	args := m.Called(name, colorHexCode)
+func (m *LabelUseCaseMock) Add(name string, colorHexCode string, projectID uint) (*domain.Label, error) {
+	args := m.Called(name, colorHexCode, projectID)
 	return args.Get(0).(*domain.Label), args.Error(1)
 }
 
@@ -29,14 +29,14 @@ func (m *LabelUseCaseMock) FindByID(id uint) (domain.Label, error) {
 }
 
 // FindByName mock
-func (m *LabelUseCaseMock) FindByName(name string) (domain.Label, error) {
-	args := m.Called(name)
+func (m *LabelUseCaseMock) FindByName(name string, projectID uint) (domain.Label, error) {
+	args := m.Called(name, projectID)
 	return args.Get(0).(domain.Label), args.Error(1)
 }
 
 // Find mock
-func (m *LabelUseCaseMock) Find(name string) ([]domain.Label, error) {
-	args := m.Called(name)
+func (m *LabelUseCaseMock) Find(name string, projectID uint) ([]domain.Label, error) {
+	args := m.Called(name, projectID)
 	return args.Get(0).([]domain.Label), args.Error(1)
 }
 
@@ -51,3 +51,51 @@ func (m *LabelUseCaseMock) Remove(id uint) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
+
+// MergePreview mock
+func (m *LabelUseCaseMock) MergePreview(sourceID uint, targetID uint) (domain.LabelMerge, error) {
+	args := m.Called(sourceID, targetID)
+	return args.Get(0).(domain.LabelMerge), args.Error(1)
+}
+
+// Merge mock
+func (m *LabelUseCaseMock) Merge(sourceID uint, targetID uint) (domain.LabelMerge, error) {
+	args := m.Called(sourceID, targetID)
+	return args.Get(0).(domain.LabelMerge), args.Error(1)
+}
+
+// AddGroup mock
+func (m *LabelUseCaseMock) AddGroup(name string, exclusive bool) (*domain.LabelGroup, error) {
+	args := m.Called(name, exclusive)
+	return args.Get(0).(*domain.LabelGroup), args.Error(1)
+}
+
+// UpdateGroup mock
+func (m *LabelUseCaseMock) UpdateGroup(id uint, name string, exclusive bool) (domain.LabelGroup, error) {
+	args := m.Called(id, name, exclusive)
+	return args.Get(0).(domain.LabelGroup), args.Error(1)
+}
+
+// FindGroupByID mock
+func (m *LabelUseCaseMock) FindGroupByID(id uint) (domain.LabelGroup, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.LabelGroup), args.Error(1)
+}
+
+// FindAllGroups mock
+func (m *LabelUseCaseMock) FindAllGroups() ([]domain.LabelGroup, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.LabelGroup), args.Error(1)
+}
+
+// RemoveGroup mock
+func (m *LabelUseCaseMock) RemoveGroup(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// SetGroup mock
+func (m *LabelUseCaseMock) SetGroup(id uint, groupID uint) (domain.Label, error) {
+	args := m.Called(id, groupID)
+	return args.Get(0).(domain.Label), args.Error(1)
+}
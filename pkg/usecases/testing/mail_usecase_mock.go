@@ -0,0 +1,36 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// MailUseCaseMock is a mock of MailUseCase
+type MailUseCaseMock struct {
+	mock.Mock
+}
+
+// SetPreference mock
+func (m *MailUseCaseMock) SetPreference(user string, email string, delivery string) (*domain.MailPreference, error) {
+	args := m.Called(user, email, delivery)
+	return args.Get(0).(*domain.MailPreference), args.Error(1)
+}
+
+// FindPreference mock
+func (m *MailUseCaseMock) FindPreference(user string) (domain.MailPreference, error) {
+	args := m.Called(user)
+	return args.Get(0).(domain.MailPreference), args.Error(1)
+}
+
+// QueueNotifications mock
+func (m *MailUseCaseMock) QueueNotifications(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
+
+// ProcessQueue mock
+func (m *MailUseCaseMock) ProcessQueue(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,77 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// NotificationUseCaseMock is a mock of NotificationUseCase
+type NotificationUseCaseMock struct {
+	mock.Mock
+}
+
+// WatchIssue mock
+func (m *NotificationUseCaseMock) WatchIssue(user string, issue domain.Issue) (*domain.Watcher, error) {
+	args := m.Called(user, issue)
+	return args.Get(0).(*domain.Watcher), args.Error(1)
+}
+
+// WatchProject mock
+func (m *NotificationUseCaseMock) WatchProject(user string, project domain.Project) (*domain.Watcher, error) {
+	args := m.Called(user, project)
+	return args.Get(0).(*domain.Watcher), args.Error(1)
+}
+
+// Unwatch mock
+func (m *NotificationUseCaseMock) Unwatch(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// FindWatcherByID mock
+func (m *NotificationUseCaseMock) FindWatcherByID(id uint) (domain.Watcher, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Watcher), args.Error(1)
+}
+
+// FindWatchers mock
+func (m *NotificationUseCaseMock) FindWatchers(user string, issueID uint, projectID uint) ([]domain.Watcher, error) {
+	args := m.Called(user, issueID, projectID)
+	return args.Get(0).([]domain.Watcher), args.Error(1)
+}
+
+// NotifyIssue mock
+func (m *NotificationUseCaseMock) NotifyIssue(issue domain.Issue, event string, changes []domain.IssueChange) (int, error) {
+	args := m.Called(issue, event, changes)
+	return args.Int(0), args.Error(1)
+}
+
+// NotifyProject mock
+func (m *NotificationUseCaseMock) NotifyProject(project domain.Project, event string) (int, error) {
+	args := m.Called(project, event)
+	return args.Int(0), args.Error(1)
+}
+
+// FindNotificationByID mock
+func (m *NotificationUseCaseMock) FindNotificationByID(id uint) (domain.Notification, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Notification), args.Error(1)
+}
+
+// FindNotifications mock
+func (m *NotificationUseCaseMock) FindNotifications(user string, unread bool) ([]domain.Notification, error) {
+	args := m.Called(user, unread)
+	return args.Get(0).([]domain.Notification), args.Error(1)
+}
+
+// MarkNotificationRead mock
+func (m *NotificationUseCaseMock) MarkNotificationRead(id uint, read bool) (domain.Notification, error) {
+	args := m.Called(id, read)
+	return args.Get(0).(domain.Notification), args.Error(1)
+}
+
+// MarkAllNotificationsRead mock
+func (m *NotificationUseCaseMock) MarkAllNotificationsRead(user string) (int, error) {
+	args := m.Called(user)
+	return args.Int(0), args.Error(1)
+}
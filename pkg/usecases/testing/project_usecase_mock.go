@@ -45,3 +45,81 @@ func (m *ProjectUseCaseMock) Remove(id uint) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
+
+// FindArchived mock
+func (m *ProjectUseCaseMock) FindArchived() ([]domain.Project, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.Project), args.Error(1)
+}
+
+// RemoveWithMode mock
+func (m *ProjectUseCaseMock) RemoveWithMode(id uint, mode string, targetID uint) (domain.ProjectRemoval, error) {
+	args := m.Called(id, mode, targetID)
+	return args.Get(0).(domain.ProjectRemoval), args.Error(1)
+}
+
+// UpdateSettings mock
+func (m *ProjectUseCaseMock) UpdateSettings(id uint, settings domain.ProjectSettings, defaultLabels []domain.Label) (domain.Project, error) {
+	args := m.Called(id, settings, defaultLabels)
+	return args.Get(0).(domain.Project), args.Error(1)
+}
+
+// AddTemplate mock
+func (m *ProjectUseCaseMock) AddTemplate(projectID uint, name string, titlePrefix string, description string, status *int, labels []domain.Label) (*domain.IssueTemplate, error) {
+	args := m.Called(projectID, name, titlePrefix, description, status, labels)
+	return args.Get(0).(*domain.IssueTemplate), args.Error(1)
+}
+
+// UpdateTemplate mock
+func (m *ProjectUseCaseMock) UpdateTemplate(id uint, name string, titlePrefix string, description string, status *int, labels []domain.Label) (domain.IssueTemplate, error) {
+	args := m.Called(id, name, titlePrefix, description, status, labels)
+	return args.Get(0).(domain.IssueTemplate), args.Error(1)
+}
+
+// FindTemplateByID mock
+func (m *ProjectUseCaseMock) FindTemplateByID(id uint) (domain.IssueTemplate, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.IssueTemplate), args.Error(1)
+}
+
+// FindTemplates mock
+func (m *ProjectUseCaseMock) FindTemplates(projectID uint) ([]domain.IssueTemplate, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.IssueTemplate), args.Error(1)
+}
+
+// RemoveTemplate mock
+func (m *ProjectUseCaseMock) RemoveTemplate(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// AddCustomField mock
+func (m *ProjectUseCaseMock) AddCustomField(projectID uint, name string, fieldType string, options string) (*domain.CustomField, error) {
+	args := m.Called(projectID, name, fieldType, options)
+	return args.Get(0).(*domain.CustomField), args.Error(1)
+}
+
+// UpdateCustomField mock
+func (m *ProjectUseCaseMock) UpdateCustomField(id uint, name string, options string) (domain.CustomField, error) {
+	args := m.Called(id, name, options)
+	return args.Get(0).(domain.CustomField), args.Error(1)
+}
+
+// FindCustomFieldByID mock
+func (m *ProjectUseCaseMock) FindCustomFieldByID(id uint) (domain.CustomField, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.CustomField), args.Error(1)
+}
+
+// FindCustomFields mock
+func (m *ProjectUseCaseMock) FindCustomFields(projectID uint) ([]domain.CustomField, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.CustomField), args.Error(1)
+}
+
+// RemoveCustomField mock
+func (m *ProjectUseCaseMock) RemoveCustomField(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
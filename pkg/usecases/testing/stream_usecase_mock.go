@@ -0,0 +1,28 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+)
+
+// StreamUseCaseMock is a mock of StreamUseCase
+type StreamUseCaseMock struct {
+	mock.Mock
+}
+
+// HandleEvent mock
+func (m *StreamUseCaseMock) HandleEvent(event domain.DomainEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+// Subscribe mock
+func (m *StreamUseCaseMock) Subscribe(projectID uint, lastEventID uint64) (*domain.StreamSubscription, []domain.StreamEvent, bool) {
+	args := m.Called(projectID, lastEventID)
+	return args.Get(0).(*domain.StreamSubscription), args.Get(1).([]domain.StreamEvent), args.Bool(2)
+}
+
+// Unsubscribe mock
+func (m *StreamUseCaseMock) Unsubscribe(subscription *domain.StreamSubscription) {
+	m.Called(subscription)
+}
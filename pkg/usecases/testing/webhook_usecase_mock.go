@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	"time"
+)
+
+// WebhookUseCaseMock is a mock of WebhookUseCase
+type WebhookUseCaseMock struct {
+	mock.Mock
+}
+
+// Add mock
+func (m *WebhookUseCaseMock) Add(project domain.Project, url string, secret string, events []string) (*domain.Webhook, error) {
+	args := m.Called(project, url, secret, events)
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+// Update mock
+func (m *WebhookUseCaseMock) Update(id uint, url string, secret string, events []string, active bool) (domain.Webhook, error) {
+	args := m.Called(id, url, secret, events, active)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+// FindByID mock
+func (m *WebhookUseCaseMock) FindByID(id uint) (domain.Webhook, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+// Find mock
+func (m *WebhookUseCaseMock) Find(projectID uint) ([]domain.Webhook, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.Webhook), args.Error(1)
+}
+
+// Remove mock
+func (m *WebhookUseCaseMock) Remove(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+// Publish mock
+func (m *WebhookUseCaseMock) Publish(event string, projectID uint, data interface{}) (int, error) {
+	args := m.Called(event, projectID, data)
+	return args.Int(0), args.Error(1)
+}
+
+// HandleEvent mock
+func (m *WebhookUseCaseMock) HandleEvent(event domain.DomainEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+// ProcessQueue mock
+func (m *WebhookUseCaseMock) ProcessQueue(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
+
+// FindDeliveryByID mock
+func (m *WebhookUseCaseMock) FindDeliveryByID(id uint) (domain.WebhookDelivery, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.WebhookDelivery), args.Error(1)
+}
+
+// FindDeliveries mock
+func (m *WebhookUseCaseMock) FindDeliveries(webhookID uint) ([]domain.WebhookDelivery, error) {
+	args := m.Called(webhookID)
+	return args.Get(0).([]domain.WebhookDelivery), args.Error(1)
+}
+
+// Redeliver mock
+func (m *WebhookUseCaseMock) Redeliver(deliveryID uint) (*domain.WebhookDelivery, error) {
+	args := m.Called(deliveryID)
+	return args.Get(0).(*domain.WebhookDelivery), args.Error(1)
+}
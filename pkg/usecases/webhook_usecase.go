@@ -0,0 +1,187 @@
+package usecases
+
+import (
+	"go-issue-tracker/pkg/domain"
+	"strings"
+	"time"
+)
+
+// WebhookUseCase interface
+type WebhookUseCase interface {
+	Add(project domain.Project, url string, secret string, events []string) (*domain.Webhook, error)
+	Update(id uint, url string, secret string, events []string, active bool) (domain.Webhook, error)
+	FindByID(id uint) (domain.Webhook, error)
+	Find(projectID uint) ([]domain.Webhook, error)
+	Remove(id uint) (bool, error)
+	Publish(event string, projectID uint, data interface{}) (int, error)
+	HandleEvent(event domain.DomainEvent) error
+	ProcessQueue(now time.Time) (int, error)
+	FindDeliveryByID(id uint) (domain.WebhookDelivery, error)
+	FindDeliveries(webhookID uint) ([]domain.WebhookDelivery, error)
+	Redeliver(deliveryID uint) (*domain.WebhookDelivery, error)
+}
+
+// webhookUseCase struct
+type webhookUseCase struct {
+	service domain.WebhookService
+}
+
+// NewWebhookUseCase to create new WebhookUseCase, queue can not be processed when sender is nil
+func NewWebhookUseCase(repository domain.WebhookRepository, sender domain.WebhookSender) WebhookUseCase {
+	return &webhookUseCase{
+		service: domain.GetDefaultWebhookService(repository, sender),
+	}
+}
+
+// Add to add new active webhook to project, webhook without events is subscribed to all of them
+func (uc *webhookUseCase) Add(project domain.Project, url string, secret string, events []string) (*domain.Webhook, error) {
+	item := new(domain.Webhook)
+	item.ProjectID = project.ID
+	item.URL = url
+	item.Secret = secret
+	item.Events = strings.Join(events, ",")
+	item.Active = true
+
+	itemAdded, err := uc.service.Add(item)
+	if err != nil {
+		return nil, err
+	}
+	return itemAdded, nil
+}
+
+// Update to update webhook, secret is kept when it is not provided
+func (uc *webhookUseCase) Update(id uint, url string, secret string, events []string, active bool) (domain.Webhook, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+
+	item.URL = url
+	if strings.TrimSpace(secret) != "" {
+		item.Secret = secret
+	}
+	item.Events = strings.Join(events, ",")
+	item.Active = active
+
+	itemUpdated, err := uc.service.Update(item)
+	if err != nil {
+		return itemUpdated, err
+	}
+	return itemUpdated, nil
+}
+
+// FindByID to find webhook by ID
+func (uc *webhookUseCase) FindByID(id uint) (domain.Webhook, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Find to find webhooks of project
+func (uc *webhookUseCase) Find(projectID uint) ([]domain.Webhook, error) {
+	items, err := uc.service.Find(projectID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Remove to remove webhook together with its delivery log
+func (uc *webhookUseCase) Remove(id uint) (bool, error) {
+	item, err := uc.service.FindByID(id)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := uc.service.Remove(item)
+	if err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// Publish to queue deliveries of event with data for webhooks of project
+func (uc *webhookUseCase) Publish(event string, projectID uint, data interface{}) (int, error) {
+	count, err := uc.service.Publish(event, projectID, data, time.Now())
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// HandleEvent to publish webhook event of domain event, it is subscribed to event bus, IssueStatusChanged
+// and LabelsChanged are not published because they follow IssueUpdated
+func (uc *webhookUseCase) HandleEvent(event domain.DomainEvent) error {
+	var err error
+	switch e := event.(type) {
+	case domain.IssueCreated:
+		_, err = uc.Publish(domain.WebhookEventIssueCreated, e.Issue.ProjectID, e.Issue)
+	case domain.IssueUpdated:
+		_, err = uc.Publish(domain.WebhookEventIssueUpdated, e.Issue.ProjectID, e.Issue)
+	case domain.IssueMoved:
+		_, err = uc.Publish(domain.WebhookEventIssueUpdated, e.Issue.ProjectID, e.Issue)
+		if err == nil && e.PreviousProjectID != e.Issue.ProjectID {
+			previous := e.Issue
+			previous.ProjectID = e.PreviousProjectID
+			_, err = uc.Publish(domain.WebhookEventIssueUpdated, previous.ProjectID, previous)
+		}
+	case domain.IssueRemoved:
+		_, err = uc.Publish(domain.WebhookEventIssueDeleted, e.Issue.ProjectID, e.Issue)
+	case domain.LabelCreated:
+		_, err = uc.Publish(domain.WebhookEventLabelCreated, e.Label.ProjectID, e.Label)
+	case domain.LabelUpdated:
+		_, err = uc.Publish(domain.WebhookEventLabelUpdated, e.Label.ProjectID, e.Label)
+	case domain.LabelRemoved:
+		_, err = uc.Publish(domain.WebhookEventLabelDeleted, e.Label.ProjectID, e.Label)
+	case domain.ProjectCreated:
+		_, err = uc.Publish(domain.WebhookEventProjectCreated, e.Project.ID, e.Project)
+	case domain.ProjectUpdated:
+		_, err = uc.Publish(domain.WebhookEventProjectUpdated, e.Project.ID, e.Project)
+	case domain.ProjectRemoved:
+		_, err = uc.Publish(domain.WebhookEventProjectDeleted, e.Project.ID, e.Project)
+	}
+	return err
+}
+
+// ProcessQueue to send due deliveries
+func (uc *webhookUseCase) ProcessQueue(now time.Time) (int, error) {
+	count, err := uc.service.ProcessQueue(now)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// FindDeliveryByID to find delivery by ID
+func (uc *webhookUseCase) FindDeliveryByID(id uint) (domain.WebhookDelivery, error) {
+	item, err := uc.service.FindDeliveryByID(id)
+	if err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// FindDeliveries to find delivery log of webhook, newest first
+func (uc *webhookUseCase) FindDeliveries(webhookID uint) ([]domain.WebhookDelivery, error) {
+	items, err := uc.service.FindDeliveries(webhookID)
+	if err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// Redeliver to queue delivery again with its original payload
+func (uc *webhookUseCase) Redeliver(deliveryID uint) (*domain.WebhookDelivery, error) {
+	delivery, err := uc.service.FindDeliveryByID(deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := uc.service.Redeliver(delivery, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
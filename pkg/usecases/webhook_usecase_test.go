@@ -0,0 +1,232 @@
+package usecases_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-issue-tracker/pkg/domain"
+	dTesting "go-issue-tracker/pkg/domain/testing"
+	"go-issue-tracker/pkg/usecases"
+	"testing"
+	"time"
+)
+
+func prepareWebhookServiceMock() *dTesting.WebhookServiceMock {
+	ms := new(dTesting.WebhookServiceMock)
+	domain.GetDefaultWebhookService = func(r domain.WebhookRepository, s domain.WebhookSender) domain.WebhookService {
+		return ms
+	}
+	return ms
+}
+
+func TestUseCaseWebhookNewWebhookUseCase(t *testing.T) {
+	prepareWebhookServiceMock()
+	defer domain.ResetDefaultWebhookService()
+
+	uc := usecases.NewWebhookUseCase(new(dTesting.WebhookRepositoryMock), new(dTesting.WebhookSenderMock))
+
+	assert.NotNil(t, uc)
+}
+
+func TestUseCaseWebhookAdd(t *testing.T) {
+	w := &domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook", Events: "issue.created,issue.deleted", Active: true}
+
+	ms := prepareWebhookServiceMock()
+	defer domain.ResetDefaultWebhookService()
+	ms.On("Add", &domain.Webhook{ProjectID: 1, URL: "https://ci.example.com/hook", Events: "issue.created,issue.deleted", Active: true}).Return(w, nil)
+	ms.On("Add", &domain.Webhook{ProjectID: 1, URL: "ftp://ci.example.com", Active: true}).Return((*domain.Webhook)(nil), errors.New("url ftp://ci.example.com not valid"))
+
+	uc := usecases.NewWebhookUseCase(new(dTesting.WebhookRepositoryMock), nil)
+
+	item, err := uc.Add(domain.Project{ID: 1}, "https://ci.example.com/hook", "", []string{"issue.created", "issue.deleted"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, item)
+
+	item, err = uc.Add(domain.Project{ID: 1}, "ftp://ci.example.com", "", nil)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, item)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseWebhookUpdate(t *testing.T) {
+	w := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook", Secret: "secret", Active: true}
+	updated := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/v2", Secret: "secret", Events: "label.created", Active: false}
+	rotated := domain.Webhook{ID: 1, ProjectID: 1, URL: "https://ci.example.com/hook", Secret: "other", Active: true}
+
+	ms := prepareWebhookServiceMock()
+	defer domain.ResetDefaultWebhookService()
+	ms.On("FindByID", uint(1)).Return(w, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Webhook{}, errors.New("record not found"))
+	ms.On("Update", updated).Return(updated, nil)
+	ms.On("Update", rotated).Return(rotated, nil)
+
+	uc := usecases.NewWebhookUseCase(new(dTesting.WebhookRepositoryMock), nil)
+
+	item, err := uc.Update(1, "https://ci.example.com/v2", " ", []string{"label.created"}, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, updated, item)
+
+	item, err = uc.Update(1, "https://ci.example.com/hook", "other", nil, true)
+
+	assert.Nil(t, err)
+	assert.Equal(t, rotated, item)
+
+	_, err = uc.Update(2, "https://ci.example.com/hook", "", nil, true)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseWebhookFindAndRemove(t *testing.T) {
+	w := domain.Webhook{ID: 1, ProjectID: 1}
+
+	ms := prepareWebhookServiceMock()
+	defer domain.ResetDefaultWebhookService()
+	ms.On("FindByID", uint(1)).Return(w, nil)
+	ms.On("FindByID", uint(2)).Return(domain.Webhook{}, errors.New("record not found"))
+	ms.On("Find", uint(1)).Return([]domain.Webhook{w}, nil)
+	ms.On("Remove", w).Return(true, nil)
+
+	uc := usecases.NewWebhookUseCase(new(dTesting.WebhookRepositoryMock), nil)
+
+	item, err := uc.FindByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, w, item)
+
+	items, err := uc.Find(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	status, err := uc.Remove(1)
+
+	assert.Nil(t, err)
+	assert.True(t, status)
+
+	status, err = uc.Remove(2)
+
+	assert.NotNil(t, err)
+	assert.False(t, status)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseWebhookPublishAndProcessQueue(t *testing.T) {
+	now := time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC)
+	issue := domain.Issue{ID: 1, ProjectID: 1}
+
+	ms := prepareWebhookServiceMock()
+	defer domain.ResetDefaultWebhookService()
+	ms.On("Publish", domain.WebhookEventIssueCreated, uint(1), issue, mock.AnythingOfType("time.Time")).Return(2, nil)
+	ms.On("ProcessQueue", now).Return(0, errors.New("webhook sender not configured"))
+
+	uc := usecases.NewWebhookUseCase(new(dTesting.WebhookRepositoryMock), nil)
+
+	count, err := uc.Publish(domain.WebhookEventIssueCreated, 1, issue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = uc.ProcessQueue(now)
+
+	assert.NotNil(t, err)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseWebhookDeliveries(t *testing.T) {
+	d := domain.WebhookDelivery{ID: 1, WebhookID: 1, Event: "issue.created", Status: domain.WebhookDeliveryFailed}
+	redelivery := &domain.WebhookDelivery{ID: 2, WebhookID: 1, Event: "issue.created", Status: domain.WebhookDeliveryQueued}
+
+	ms := prepareWebhookServiceMock()
+	defer domain.ResetDefaultWebhookService()
+	ms.On("FindDeliveryByID", uint(1)).Return(d, nil)
+	ms.On("FindDeliveryByID", uint(3)).Return(domain.WebhookDelivery{}, errors.New("record not found"))
+	ms.On("FindDeliveries", uint(1)).Return([]domain.WebhookDelivery{d}, nil)
+	ms.On("Redeliver", d, mock.AnythingOfType("time.Time")).Return(redelivery, nil)
+
+	uc := usecases.NewWebhookUseCase(new(dTesting.WebhookRepositoryMock), nil)
+
+	item, err := uc.FindDeliveryByID(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, d, item)
+
+	items, err := uc.FindDeliveries(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(items))
+
+	redelivered, err := uc.Redeliver(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, redelivery, redelivered)
+
+	redelivered, err = uc.Redeliver(3)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, redelivered)
+
+	ms.AssertExpectations(t)
+}
+
+func TestUseCaseWebhookHandleEvent(t *testing.T) {
+	i := domain.Issue{ID: 1, Title: "Bug", ProjectID: 2}
+	previous := i
+	previous.ProjectID = 1
+	l := domain.Label{ID: 1, Name: "bug"}
+	p := domain.Project{ID: 2, Name: "Tracker"}
+
+	ms := prepareWebhookServiceMock()
+	defer domain.ResetDefaultWebhookService()
+	ms.On("Publish", domain.WebhookEventIssueCreated, uint(2), i, mock.AnythingOfType("time.Time")).Return(1, nil)
+	ms.On("Publish", domain.WebhookEventIssueUpdated, uint(2), i, mock.AnythingOfType("time.Time")).Return(1, nil)
+	ms.On("Publish", domain.WebhookEventIssueUpdated, uint(1), previous, mock.AnythingOfType("time.Time")).Return(1, nil)
+	ms.On("Publish", domain.WebhookEventIssueDeleted, uint(2), i, mock.AnythingOfType("time.Time")).Return(0, errors.New("test error"))
+	ms.On("Publish", domain.WebhookEventLabelCreated, uint(0), l, mock.AnythingOfType("time.Time")).Return(1, nil)
+	ms.On("Publish", domain.WebhookEventLabelUpdated, uint(0), l, mock.AnythingOfType("time.Time")).Return(1, nil)
+	ms.On("Publish", domain.WebhookEventLabelDeleted, uint(0), l, mock.AnythingOfType("time.Time")).Return(1, nil)
+	ms.On("Publish", domain.WebhookEventProjectCreated, uint(2), p, mock.AnythingOfType("time.Time")).Return(1, nil)
+	ms.On("Publish", domain.WebhookEventProjectUpdated, uint(2), p, mock.AnythingOfType("time.Time")).Return(1, nil)
+	ms.On("Publish", domain.WebhookEventProjectDeleted, uint(2), p, mock.AnythingOfType("time.Time")).Return(1, nil)
+
+	uc := usecases.NewWebhookUseCase(new(dTesting.WebhookRepositoryMock), nil)
+
+	tests := []struct {
+		event domain.DomainEvent
+		err   string
+	}{
+		{domain.IssueCreated{Issue: i}, ""},
+		{domain.IssueUpdated{Issue: i}, ""},
+		{domain.IssueStatusChanged{Issue: i, PreviousStatus: 1}, ""},
+		{domain.LabelsChanged{Issue: i}, ""},
+		{domain.IssueMoved{Issue: i, PreviousProjectID: 1}, ""},
+		{domain.IssueMoved{Issue: i, PreviousProjectID: 2}, ""},
+		{domain.IssueRemoved{Issue: i}, "test error"},
+		{domain.LabelCreated{Label: l}, ""},
+		{domain.LabelUpdated{Label: l}, ""},
+		{domain.LabelRemoved{Label: l}, ""},
+		{domain.ProjectCreated{Project: p}, ""},
+		{domain.ProjectUpdated{Project: p}, ""},
+		{domain.ProjectRemoved{Project: p}, ""},
+	}
+
+	for _, ts := range tests {
+		err := uc.HandleEvent(ts.event)
+
+		if ts.err == "" {
+			assert.Nil(t, err)
+		} else {
+			assert.Equal(t, ts.err, err.Error())
+		}
+	}
+
+	ms.AssertExpectations(t)
+	ms.AssertNumberOfCalls(t, "Publish", 12)
+}